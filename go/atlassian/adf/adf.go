@@ -0,0 +1,88 @@
+// Package adf parses the Atlassian Document Format (ADF) JSON tree Jira Cloud uses for rich-text
+// fields (issue description, comment bodies, the environment field), renders it down to plaintext
+// or markdown for callers that don't need the structured tree, and builds new ADF documents for
+// fields that require them on write (e.g. comment bodies).
+package adf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Mark is a formatting mark (e.g. strong, em, link) attached to a node.
+type Mark struct {
+	Type  string         `json:"type"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+// Node is one node in an ADF document tree. Text nodes set Text and have no Content; container
+// nodes (doc, paragraph, bulletList, listItem, heading, ...) set Content and leave Text empty.
+type Node struct {
+	Type    string         `json:"type"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+	Content []Node         `json:"content,omitempty"`
+	Marks   []Mark         `json:"marks,omitempty"`
+	Text    string         `json:"text,omitempty"`
+}
+
+// Parse decodes a raw ADF document (as returned by the Jira REST API, already JSON-unmarshaled
+// into map[string]any) into a Node tree.
+func Parse(raw map[string]any) (*Node, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("marshal ADF document: %w", err)
+	}
+	var doc Node
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal ADF document: %w", err)
+	}
+	return &doc, nil
+}
+
+// PlainText flattens the document to plaintext: text node values are concatenated, top-level
+// paragraph/heading nodes are separated by a blank line, and bulletList/orderedList items are
+// rendered as "- " prefixed lines.
+func (n *Node) PlainText() string {
+	if n == nil {
+		return ""
+	}
+	blocks := make([]string, 0, len(n.Content))
+	for _, child := range n.Content {
+		if b := renderBlock(child); strings.TrimSpace(b) != "" {
+			blocks = append(blocks, b)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// renderBlock renders one top-level block node (paragraph, heading, bulletList, orderedList, ...)
+// to its plaintext form.
+func renderBlock(n Node) string {
+	switch n.Type {
+	case "bulletList", "orderedList":
+		items := make([]string, 0, len(n.Content))
+		for _, item := range n.Content {
+			items = append(items, "- "+strings.Join(collectText(item), ""))
+		}
+		return strings.Join(items, "\n")
+	default:
+		return strings.Join(collectText(n), "")
+	}
+}
+
+// collectText concatenates every text node under n, recursing depth-first through inline marks
+// and nested blocks.
+func collectText(n Node) []string {
+	switch n.Type {
+	case "text":
+		return []string{n.Text}
+	case "hardBreak":
+		return []string{"\n"}
+	}
+	var out []string
+	for _, child := range n.Content {
+		out = append(out, collectText(child)...)
+	}
+	return out
+}
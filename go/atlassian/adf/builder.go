@@ -0,0 +1,91 @@
+package adf
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DocBuilder incrementally assembles an ADF document for fields Jira Cloud requires ADF on
+// (comment bodies, issue descriptions). Build each top-level block in document order, then call
+// Build to produce the map[string]any the REST client expects as a write payload.
+type DocBuilder struct {
+	content []Node
+}
+
+// NewDoc starts an empty ADF document.
+func NewDoc() *DocBuilder {
+	return &DocBuilder{}
+}
+
+// Paragraph appends a plain-text paragraph block.
+func (b *DocBuilder) Paragraph(text string) *DocBuilder {
+	b.content = append(b.content, Node{
+		Type:    "paragraph",
+		Content: []Node{{Type: "text", Text: text}},
+	})
+	return b
+}
+
+// Bullets appends a bullet list block, one listItem per item, each holding a single paragraph.
+func (b *DocBuilder) Bullets(items ...string) *DocBuilder {
+	listItems := make([]Node, 0, len(items))
+	for _, item := range items {
+		listItems = append(listItems, Node{
+			Type: "listItem",
+			Content: []Node{{
+				Type:    "paragraph",
+				Content: []Node{{Type: "text", Text: item}},
+			}},
+		})
+	}
+	b.content = append(b.content, Node{Type: "bulletList", Content: listItems})
+	return b
+}
+
+// Mention appends a paragraph containing a single user mention, identified by Jira account ID.
+func (b *DocBuilder) Mention(accountID string) *DocBuilder {
+	b.content = append(b.content, Node{
+		Type: "paragraph",
+		Content: []Node{{
+			Type:  "mention",
+			Attrs: map[string]any{"id": accountID},
+		}},
+	})
+	return b
+}
+
+// Code appends a fenced code block. lang is the ADF/CodeMirror language hint (e.g. "go", "json");
+// pass "" for a plain, unhighlighted block.
+func (b *DocBuilder) Code(lang string, body string) *DocBuilder {
+	node := Node{
+		Type:    "codeBlock",
+		Content: []Node{{Type: "text", Text: body}},
+	}
+	if lang != "" {
+		node.Attrs = map[string]any{"language": lang}
+	}
+	b.content = append(b.content, node)
+	return b
+}
+
+// Build renders the accumulated blocks into the {version:1, type:"doc", content:[...]} document
+// shape the Jira REST API expects, as a map[string]any ready to pass as a write body.
+func (b *DocBuilder) Build() map[string]any {
+	// Round-trip the content nodes through JSON so they end up as plain []any/map[string]any
+	// (matching the shape JiraRESTClient's write methods expect) rather than []Node.
+	raw, err := json.Marshal(b.content)
+	if err != nil {
+		// content only ever carries strings and maps built by this package, so marshaling
+		// cannot fail; panicking here would indicate a bug in this file.
+		panic(fmt.Sprintf("adf: marshal built document content: %v", err))
+	}
+	var content []any
+	if err := json.Unmarshal(raw, &content); err != nil {
+		panic(fmt.Sprintf("adf: unmarshal built document content: %v", err))
+	}
+	return map[string]any{
+		"version": 1,
+		"type":    "doc",
+		"content": content,
+	}
+}
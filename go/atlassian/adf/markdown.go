@@ -0,0 +1,95 @@
+package adf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Markdown flattens the document to markdown: strong/em/code marks become **/_/` delimiters,
+// bulletList/orderedList items are rendered as "- "/"1. " prefixed lines, and codeBlock nodes are
+// rendered as fenced code blocks. Top-level blocks are separated by a blank line, matching
+// PlainText's layout.
+func (n *Node) Markdown() string {
+	if n == nil {
+		return ""
+	}
+	blocks := make([]string, 0, len(n.Content))
+	for _, child := range n.Content {
+		if b := renderMarkdownBlock(child); strings.TrimSpace(b) != "" {
+			blocks = append(blocks, b)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func renderMarkdownBlock(n Node) string {
+	switch n.Type {
+	case "bulletList":
+		return renderMarkdownList(n, "- ")
+	case "orderedList":
+		return renderMarkdownOrderedList(n)
+	case "codeBlock":
+		lang, _ := n.Attrs["language"].(string)
+		return fmt.Sprintf("```%s\n%s\n```", lang, strings.Join(collectText(n), ""))
+	default:
+		return strings.Join(collectMarkdownText(n), "")
+	}
+}
+
+func renderMarkdownList(n Node, prefix string) string {
+	items := make([]string, 0, len(n.Content))
+	for _, item := range n.Content {
+		items = append(items, prefix+strings.Join(collectMarkdownText(item), ""))
+	}
+	return strings.Join(items, "\n")
+}
+
+func renderMarkdownOrderedList(n Node) string {
+	items := make([]string, 0, len(n.Content))
+	for idx, item := range n.Content {
+		items = append(items, fmt.Sprintf("%d. %s", idx+1, strings.Join(collectMarkdownText(item), "")))
+	}
+	return strings.Join(items, "\n")
+}
+
+// collectMarkdownText concatenates every text node under n, wrapping text nodes in their marks'
+// markdown delimiters and recursing depth-first through nested blocks (e.g. listItem > paragraph).
+func collectMarkdownText(n Node) []string {
+	switch n.Type {
+	case "text":
+		return []string{applyMarkdownMarks(n.Text, n.Marks)}
+	case "hardBreak":
+		return []string{"\n"}
+	case "mention":
+		if id, ok := n.Attrs["id"].(string); ok {
+			return []string{"@" + id}
+		}
+		if text, ok := n.Attrs["text"].(string); ok {
+			return []string{text}
+		}
+		return nil
+	}
+	var out []string
+	for _, child := range n.Content {
+		out = append(out, collectMarkdownText(child)...)
+	}
+	return out
+}
+
+// applyMarkdownMarks wraps text in the markdown delimiter for each of its marks, innermost mark
+// first so nested marks (e.g. bold code) render in the expected order.
+func applyMarkdownMarks(text string, marks []Mark) string {
+	for _, m := range marks {
+		switch m.Type {
+		case "strong":
+			text = "**" + text + "**"
+		case "em":
+			text = "_" + text + "_"
+		case "code":
+			text = "`" + text + "`"
+		case "strike":
+			text = "~~" + text + "~~"
+		}
+	}
+	return text
+}
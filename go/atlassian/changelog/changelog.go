@@ -0,0 +1,215 @@
+// Package changelog scans a git repository's commit history between two refs and produces a
+// conventional-commit-grouped changelog, enriched with the summary, type, and status of every
+// Jira issue referenced by those commits.
+package changelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"atlassian/atlassian/rest"
+	"atlassian/atlassian/vcs"
+)
+
+const (
+	commitSeparator = "\x1e"
+	fieldSeparator  = "\x1f"
+)
+
+// Options configures Generate.
+type Options struct {
+	// Client fetches the Jira issues referenced by commits in range. Required.
+	Client *rest.JiraRESTClient
+	// CloudID scopes Client.GetIssue calls.
+	CloudID string
+	// RepoPath is the working directory `git log` runs in. Defaults to "." when empty.
+	RepoPath string
+	// FromRef and ToRef bound the scanned range as the git revision range "FromRef..ToRef".
+	FromRef string
+	ToRef   string
+}
+
+// Entry is a single commit's contribution to the changelog, enriched with Jira issue metadata
+// when the commit references one.
+type Entry struct {
+	SHA          string `json:"sha"`
+	Subject      string `json:"subject"`
+	IssueKey     string `json:"issueKey,omitempty"`
+	IssueSummary string `json:"issueSummary,omitempty"`
+	IssueType    string `json:"issueType,omitempty"`
+	IssueStatus  string `json:"issueStatus,omitempty"`
+	Breaking     bool   `json:"breaking"`
+}
+
+// Section groups Entries under a single conventional-commit type (e.g. "feat", "fix"), plus the
+// synthetic "BREAKING CHANGE" type for entries whose commit carries that footer or a "!" marker.
+type Section struct {
+	Type    string  `json:"type"`
+	Entries []Entry `json:"entries"`
+}
+
+// knownSectionOrder fixes the rendering order of the conventional-commit types this package
+// recognizes; any other commit type is appended after these, in first-seen order, and commits
+// with no recognizable type are grouped under "other".
+var knownSectionOrder = []string{"BREAKING CHANGE", "feat", "fix", "perf", "refactor"}
+
+// Generate scans the commits in the "FromRef..ToRef" range of the repository at RepoPath,
+// groups them by conventional-commit type, and enriches each entry that references a Jira issue
+// by calling Client.GetIssue for that issue's summary, type, and status.
+func Generate(ctx context.Context, opts Options) ([]Section, error) {
+	if opts.Client == nil {
+		return nil, errors.New("changelog: Options.Client is required")
+	}
+	if strings.TrimSpace(opts.FromRef) == "" || strings.TrimSpace(opts.ToRef) == "" {
+		return nil, errors.New("changelog: FromRef and ToRef are required")
+	}
+
+	raw, err := gitLog(opts.RepoPath, opts.FromRef, opts.ToRef)
+	if err != nil {
+		return nil, err
+	}
+
+	entriesByType := map[string][]Entry{}
+	var seenOrder []string
+	for _, commit := range parseGitLog(raw) {
+		entry := buildEntry(ctx, opts, commit)
+
+		sectionType, ok := vcs.ParseConventionalCommitType(commit.subject)
+		if !ok {
+			sectionType = "other"
+		}
+		if _, ok := entriesByType[sectionType]; !ok {
+			seenOrder = append(seenOrder, sectionType)
+		}
+		entriesByType[sectionType] = append(entriesByType[sectionType], entry)
+
+		if entry.Breaking {
+			if _, ok := entriesByType["BREAKING CHANGE"]; !ok {
+				seenOrder = append(seenOrder, "BREAKING CHANGE")
+			}
+			entriesByType["BREAKING CHANGE"] = append(entriesByType["BREAKING CHANGE"], entry)
+		}
+	}
+
+	return orderSections(entriesByType, seenOrder), nil
+}
+
+// buildEntry enriches a parsed commit with the first Jira issue key it references, if any. A
+// failed lookup is not fatal to the whole changelog: the entry is kept with its Jira fields
+// blank so one deleted or inaccessible issue doesn't block the rest of the run.
+func buildEntry(ctx context.Context, opts Options, commit rawCommit) Entry {
+	entry := Entry{
+		SHA:      commit.sha,
+		Subject:  commit.subject,
+		Breaking: strings.Contains(commit.body, "BREAKING CHANGE") || strings.HasSuffix(strings.TrimSpace(strings.SplitN(commit.subject, ":", 2)[0]), "!"),
+	}
+
+	keys := vcs.ExtractIssueKeys(commit.subject + "\n" + commit.body)
+	if len(keys) == 0 {
+		return entry
+	}
+	entry.IssueKey = keys[0]
+
+	issue, err := opts.Client.GetIssue(ctx, opts.CloudID, entry.IssueKey)
+	if err != nil {
+		return entry
+	}
+	entry.IssueSummary = issue.Summary
+	entry.IssueType = issue.IssueType
+	entry.IssueStatus = issue.Status
+	return entry
+}
+
+// orderSections renders entriesByType as Sections in knownSectionOrder, followed by any
+// unrecognized types in the order they were first seen.
+func orderSections(entriesByType map[string][]Entry, seenOrder []string) []Section {
+	var sections []Section
+	rendered := map[string]bool{}
+
+	for _, sectionType := range knownSectionOrder {
+		entries, ok := entriesByType[sectionType]
+		if !ok {
+			continue
+		}
+		sections = append(sections, Section{Type: sectionType, Entries: entries})
+		rendered[sectionType] = true
+	}
+	for _, sectionType := range seenOrder {
+		if rendered[sectionType] {
+			continue
+		}
+		sections = append(sections, Section{Type: sectionType, Entries: entriesByType[sectionType]})
+		rendered[sectionType] = true
+	}
+	return sections
+}
+
+// RenderMarkdown renders sections as a "## type" heading per Section, each followed by a bullet
+// list of its entries. An entry referencing a Jira issue is rendered as "KEY: summary (status)";
+// one without a recognized issue key is rendered as its bare commit subject.
+func RenderMarkdown(sections []Section) string {
+	var b strings.Builder
+	for i, section := range sections {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "## %s\n", section.Type)
+		for _, entry := range section.Entries {
+			if entry.IssueKey != "" {
+				fmt.Fprintf(&b, "- %s: %s (%s)\n", entry.IssueKey, entry.IssueSummary, entry.IssueStatus)
+			} else {
+				fmt.Fprintf(&b, "- %s\n", entry.Subject)
+			}
+		}
+	}
+	return b.String()
+}
+
+// rawCommit is one commit as read off `git log`, before conventional-commit/Jira-key parsing.
+type rawCommit struct {
+	sha     string
+	subject string
+	body    string
+}
+
+// gitLog runs `git log` over the "fromRef..toRef" range in repoPath, oldest first, emitting one
+// record per commit delimited by commitSeparator/fieldSeparator so subjects and bodies can embed
+// arbitrary characters safely.
+func gitLog(repoPath string, fromRef string, toRef string) (string, error) {
+	dir := repoPath
+	if strings.TrimSpace(dir) == "" {
+		dir = "."
+	}
+	format := strings.Join([]string{"%H", "%s", "%b"}, fieldSeparator) + commitSeparator
+	cmd := exec.Command("git", "log", "--reverse", "--format="+format, fromRef+".."+toRef)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// parseGitLog splits the raw output of gitLog into one rawCommit per record.
+func parseGitLog(raw string) []rawCommit {
+	var commits []rawCommit
+	for _, record := range strings.Split(raw, commitSeparator) {
+		record = strings.Trim(record, "\n")
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+		fields := strings.SplitN(record, fieldSeparator, 3)
+		if len(fields) < 2 {
+			continue
+		}
+		commit := rawCommit{sha: fields[0], subject: fields[1]}
+		if len(fields) == 3 {
+			commit.body = strings.TrimSpace(fields[2])
+		}
+		commits = append(commits, commit)
+	}
+	return commits
+}
@@ -0,0 +1,14 @@
+package atlassian
+
+// FailingCriteria returns the subset of s.Criteria whose Status is "fail", in the same order
+// they appear on the scorecard, so callers can surface just the actionable items instead of the
+// full breakdown.
+func (s CompassScorecardScore) FailingCriteria() []CompassScorecardCriterion {
+	var failing []CompassScorecardCriterion
+	for _, c := range s.Criteria {
+		if c.Status == "fail" {
+			failing = append(failing, c)
+		}
+	}
+	return failing
+}
@@ -0,0 +1,227 @@
+// Package compassgraph builds an in-memory dependency graph out of Compass components and
+// relationships, and provides the traversal operations (ancestors/descendants, shortest path,
+// cycle detection via strongly connected components) that the flat CompassComponentFromGraphQL /
+// CompassRelationshipFromGraphQL mapper output doesn't offer on its own.
+package compassgraph
+
+import (
+	"strings"
+
+	"atlassian/atlassian"
+)
+
+// edge is a directed "start depends on end" relationship, carrying the relationship type and ID
+// so callers can distinguish DEPENDS_ON from other relationship kinds if Compass ever adds more.
+type edge struct {
+	relationshipID string
+	relType        string
+	to             string
+}
+
+// Graph is an in-memory, read-only view over a set of Compass components and the "start depends
+// on end" relationships between them. It's built once via New and then queried; it does not
+// track changes made after construction.
+type Graph struct {
+	components map[string]atlassian.CompassComponent
+	forward    map[string][]edge // id -> components id depends on
+	reverse    map[string][]edge // id -> components that depend on id
+}
+
+// New builds a Graph from the given components and relationships. Relationships referencing a
+// component not present in components are kept (the graph may have edges to nodes with no known
+// CompassComponent), but Component only returns data for nodes that were actually supplied.
+func New(components []atlassian.CompassComponent, relationships []atlassian.CompassRelationship) *Graph {
+	g := &Graph{
+		components: make(map[string]atlassian.CompassComponent, len(components)),
+		forward:    make(map[string][]edge),
+		reverse:    make(map[string][]edge),
+	}
+	for _, c := range components {
+		g.components[c.ID] = c
+	}
+	for _, r := range relationships {
+		g.forward[r.StartComponentID] = append(g.forward[r.StartComponentID], edge{relationshipID: r.ID, relType: r.Type, to: r.EndComponentID})
+		g.reverse[r.EndComponentID] = append(g.reverse[r.EndComponentID], edge{relationshipID: r.ID, relType: r.Type, to: r.StartComponentID})
+	}
+	return g
+}
+
+// Component returns the CompassComponent for id and whether it was known to the graph.
+func (g *Graph) Component(id string) (atlassian.CompassComponent, bool) {
+	c, ok := g.components[id]
+	return c, ok
+}
+
+// Descendants returns every component id transitively depends on (its dependencies), in
+// breadth-first order with no duplicates. id itself is not included.
+func (g *Graph) Descendants(id string) []string {
+	return g.walk(id, g.forward)
+}
+
+// Ancestors returns every component that transitively depends on id (its dependents), in
+// breadth-first order with no duplicates. id itself is not included.
+func (g *Graph) Ancestors(id string) []string {
+	return g.walk(id, g.reverse)
+}
+
+func (g *Graph) walk(start string, edges map[string][]edge) []string {
+	visited := map[string]bool{start: true}
+	queue := []string{start}
+	var out []string
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, e := range edges[cur] {
+			if visited[e.to] {
+				continue
+			}
+			visited[e.to] = true
+			out = append(out, e.to)
+			queue = append(queue, e.to)
+		}
+	}
+	return out
+}
+
+// ShortestPath returns the shortest dependency path from a to b (inclusive of both endpoints),
+// following forward (depends-on) edges, and whether a path exists.
+func (g *Graph) ShortestPath(a, b string) ([]string, bool) {
+	if a == b {
+		return []string{a}, true
+	}
+	visited := map[string]bool{a: true}
+	prev := map[string]string{}
+	queue := []string{a}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, e := range g.forward[cur] {
+			if visited[e.to] {
+				continue
+			}
+			visited[e.to] = true
+			prev[e.to] = cur
+			if e.to == b {
+				return reconstructPath(prev, a, b), true
+			}
+			queue = append(queue, e.to)
+		}
+	}
+	return nil, false
+}
+
+func reconstructPath(prev map[string]string, a, b string) []string {
+	path := []string{b}
+	for path[len(path)-1] != a {
+		path = append(path, prev[path[len(path)-1]])
+	}
+	// reverse in place
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// FilteredBFS walks forward (depends-on) edges breadth-first starting at id, returning every
+// visited component (including id itself, if it satisfies pred and is known to the graph) for
+// which pred returns true. A component failing pred is still traversed through (its own
+// dependencies are visited) so the filter only controls what's returned, not what's reachable.
+func (g *Graph) FilteredBFS(id string, pred func(atlassian.CompassComponent) bool) []atlassian.CompassComponent {
+	var out []atlassian.CompassComponent
+	if c, ok := g.components[id]; ok && pred(c) {
+		out = append(out, c)
+	}
+	for _, descendantID := range g.walk(id, g.forward) {
+		c, ok := g.components[descendantID]
+		if !ok || !pred(c) {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// StronglyConnectedComponents returns the graph's strongly connected components (via Tarjan's
+// algorithm) over the forward (depends-on) edges, in no particular order within or across
+// components. A strongly connected component with more than one member, or a single member with
+// a self-edge, indicates a dependency cycle.
+func (g *Graph) StronglyConnectedComponents() [][]string {
+	t := &tarjan{
+		index:   map[string]int{},
+		lowlink: map[string]int{},
+		onStack: map[string]bool{},
+		forward: g.forward,
+	}
+	for id := range g.allNodeIDs() {
+		if _, seen := t.index[id]; !seen {
+			t.strongConnect(id)
+		}
+	}
+	return t.result
+}
+
+// allNodeIDs returns every node ID touched by either a component definition or an edge endpoint.
+func (g *Graph) allNodeIDs() map[string]struct{} {
+	ids := make(map[string]struct{}, len(g.components))
+	for id := range g.components {
+		ids[id] = struct{}{}
+	}
+	for id, edges := range g.forward {
+		ids[id] = struct{}{}
+		for _, e := range edges {
+			ids[e.to] = struct{}{}
+		}
+	}
+	return ids
+}
+
+type tarjan struct {
+	index, lowlink map[string]int
+	onStack        map[string]bool
+	stack          []string
+	counter        int
+	forward        map[string][]edge
+	result         [][]string
+}
+
+func (t *tarjan) strongConnect(v string) {
+	t.index[v] = t.counter
+	t.lowlink[v] = t.counter
+	t.counter++
+	t.stack = append(t.stack, v)
+	t.onStack[v] = true
+
+	for _, e := range t.forward[v] {
+		w := e.to
+		if _, seen := t.index[w]; !seen {
+			t.strongConnect(w)
+			if t.lowlink[w] < t.lowlink[v] {
+				t.lowlink[v] = t.lowlink[w]
+			}
+		} else if t.onStack[w] {
+			if t.index[w] < t.lowlink[v] {
+				t.lowlink[v] = t.index[w]
+			}
+		}
+	}
+
+	if t.lowlink[v] == t.index[v] {
+		var component []string
+		for {
+			n := len(t.stack) - 1
+			w := t.stack[n]
+			t.stack = t.stack[:n]
+			t.onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		t.result = append(t.result, component)
+	}
+}
+
+// trimmedOrEmpty is a small helper shared by the Loader for normalizing IDs before map lookups.
+func trimmedOrEmpty(s string) string {
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,90 @@
+package compassgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/graph"
+)
+
+// Loader hydrates a Graph by paging through the Compass GraphQL API, starting from a single root
+// component and walking outward breadth-first up to a configurable depth, rather than loading
+// every component and relationship in a cloud up front.
+type Loader struct {
+	Client *graph.Client
+	// PageSize bounds each individual ListComponents/ListRelationships page; zero uses the
+	// client's own default.
+	PageSize int
+}
+
+// NewLoader builds a Loader against client.
+func NewLoader(client *graph.Client) *Loader {
+	return &Loader{Client: client}
+}
+
+// Load fetches every component known to cloudID (for node metadata) and the relationships
+// reachable from rootComponentID within maxDepth hops, then builds a Graph from them. maxDepth
+// less than 1 is treated as 1 (the root's direct relationships only).
+func (l *Loader) Load(ctx context.Context, cloudID string, rootComponentID string, maxDepth int) (*Graph, error) {
+	cloud := trimmedOrEmpty(cloudID)
+	if cloud == "" {
+		return nil, errors.New("cloudID is required")
+	}
+	root := trimmedOrEmpty(rootComponentID)
+	if root == "" {
+		return nil, errors.New("rootComponentID is required")
+	}
+	if maxDepth < 1 {
+		maxDepth = 1
+	}
+	pageSize := l.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+
+	components, err := l.Client.ListComponents(ctx, cloud, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("compassgraph: loading components: %w", err)
+	}
+
+	var relationships []atlassian.CompassRelationship
+	seenRelationships := map[string]struct{}{}
+	visitedComponents := map[string]struct{}{}
+	frontier := []string{root}
+
+	for depth := 0; depth < maxDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, id := range frontier {
+			if _, ok := visitedComponents[id]; ok {
+				continue
+			}
+			visitedComponents[id] = struct{}{}
+
+			rels, err := l.Client.ListRelationships(ctx, cloud, id, pageSize)
+			if err != nil {
+				return nil, fmt.Errorf("compassgraph: loading relationships for %s: %w", id, err)
+			}
+			for _, r := range rels {
+				if _, ok := seenRelationships[r.ID]; ok {
+					continue
+				}
+				seenRelationships[r.ID] = struct{}{}
+				relationships = append(relationships, r)
+
+				for _, neighbor := range [2]string{r.StartComponentID, r.EndComponentID} {
+					if neighbor == id {
+						continue
+					}
+					if _, ok := visitedComponents[neighbor]; !ok {
+						next = append(next, neighbor)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+
+	return New(components, relationships), nil
+}
@@ -0,0 +1,8 @@
+package atlassian
+
+import "errors"
+
+// ErrCircuitOpen is returned (wrapped) by rest.Transport when a host's circuit breaker has
+// tripped and is short-circuiting requests during its cooldown window. Callers can check for it
+// with errors.Is to distinguish "Jira is currently unhealthy" from an ordinary request failure.
+var ErrCircuitOpen = errors.New("atlassian: circuit breaker open")
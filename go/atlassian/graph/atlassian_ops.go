@@ -2,16 +2,110 @@ package graph
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"atlassian/atlassian"
+	"atlassian/atlassian/graph/gen"
+	"atlassian/atlassian/graph/mappers"
+	"atlassian/atlassian/pagination"
 )
 
+// opsPageSize is the `first` argument sent with each AtlassianOps page request; these connections
+// aren't exposed with a caller-tunable page size since, unlike the Jira iterators, nothing in this
+// subsystem yet needs to trade off request count against page size.
+const opsPageSize = 50
+
 func (c *Client) IterIssueIncidentsViaGraphQL(
 	ctx context.Context,
 	cloudID string,
 	issueKey string,
 ) ([]*atlassian.AtlassianOpsIncident, error) {
-	return []*atlassian.AtlassianOpsIncident{}, nil
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return nil, errors.New("cloudID is required")
+	}
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return nil, errors.New("issueKey is required")
+	}
+
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]gen.OpsIncidentNode, bool, *string, error) {
+		conn, err := c.fetchIssueIncidentsPage(ctx, cloud, key, opsPageSize, after)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		nodes := make([]gen.OpsIncidentNode, len(conn.Edges))
+		for i, edge := range conn.Edges {
+			nodes[i] = edge.Node
+		}
+		next, hasMore, err := nextAfterForOpsIncidents(conn.PageInfo, conn.Edges)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		if !hasMore {
+			return nodes, false, nil, nil
+		}
+		return nodes, true, &next, nil
+	}, pagination.CursorIteratorOptions{})
+
+	var out []*atlassian.AtlassianOpsIncident
+	for {
+		node, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			return out, nil
+		}
+		mapped, err := mappers.AtlassianOpsIncidentFromGraphQL(*node)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &mapped)
+	}
+}
+
+func (c *Client) fetchIssueIncidentsPage(ctx context.Context, cloudID, issueKey string, pageSize int, after *string) (*gen.OpsIncidentConnection, error) {
+	vars := map[string]any{
+		"cloudId":  cloudID,
+		"issueKey": issueKey,
+		"first":    pageSize,
+		"after":    after,
+	}
+	result, err := c.Execute(ctx, gen.OpsIssueIncidentsPageQuery, vars, "OpsIssueIncidentsPage", c.ExperimentalAPIs, 1)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Data == nil {
+		return nil, errors.New("missing data in OpsIssueIncidentsPage response")
+	}
+	conn, err := gen.DecodeOpsIssueIncidentsPage(result.Data)
+	if err != nil {
+		if len(result.Errors) > 0 {
+			return nil, &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+		}
+		return nil, fmt.Errorf("decode OpsIssueIncidentsPage: %w", err)
+	}
+	return conn, nil
+}
+
+func nextAfterForOpsIncidents(pageInfo gen.OpsIncidentPageInfo, edges []gen.OpsIncidentEdge) (string, bool, error) {
+	if !pageInfo.HasNextPage {
+		return "", false, nil
+	}
+	if gen.OpsIncidentsPageInfoHasEndCursor && pageInfo.EndCursor != nil && strings.TrimSpace(*pageInfo.EndCursor) != "" {
+		return strings.TrimSpace(*pageInfo.EndCursor), true, nil
+	}
+	if gen.OpsIncidentsEdgeHasCursor && len(edges) > 0 {
+		for i := len(edges) - 1; i >= 0; i-- {
+			if edges[i].Cursor != nil && strings.TrimSpace(*edges[i].Cursor) != "" {
+				return strings.TrimSpace(*edges[i].Cursor), true, nil
+			}
+		}
+	}
+	return "", false, errors.New("pagination cursor missing for ops.issue.incidents")
 }
 
 func (c *Client) IterProjectAlertsViaGraphQL(
@@ -19,7 +113,90 @@ func (c *Client) IterProjectAlertsViaGraphQL(
 	cloudID string,
 	projectKey string,
 ) ([]*atlassian.AtlassianOpsAlert, error) {
-	return []*atlassian.AtlassianOpsAlert{}, nil
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return nil, errors.New("cloudID is required")
+	}
+	project := strings.TrimSpace(projectKey)
+	if project == "" {
+		return nil, errors.New("projectKey is required")
+	}
+
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]gen.OpsAlertNode, bool, *string, error) {
+		conn, err := c.fetchProjectAlertsPage(ctx, cloud, project, opsPageSize, after)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		nodes := make([]gen.OpsAlertNode, len(conn.Edges))
+		for i, edge := range conn.Edges {
+			nodes[i] = edge.Node
+		}
+		next, hasMore, err := nextAfterForOpsAlerts(conn.PageInfo, conn.Edges)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		if !hasMore {
+			return nodes, false, nil, nil
+		}
+		return nodes, true, &next, nil
+	}, pagination.CursorIteratorOptions{})
+
+	var out []*atlassian.AtlassianOpsAlert
+	for {
+		node, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			return out, nil
+		}
+		mapped, err := mappers.AtlassianOpsAlertFromGraphQL(*node)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &mapped)
+	}
+}
+
+func (c *Client) fetchProjectAlertsPage(ctx context.Context, cloudID, projectKey string, pageSize int, after *string) (*gen.OpsAlertConnection, error) {
+	vars := map[string]any{
+		"cloudId":    cloudID,
+		"projectKey": projectKey,
+		"first":      pageSize,
+		"after":      after,
+	}
+	result, err := c.Execute(ctx, gen.OpsProjectAlertsPageQuery, vars, "OpsProjectAlertsPage", c.ExperimentalAPIs, 1)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Data == nil {
+		return nil, errors.New("missing data in OpsProjectAlertsPage response")
+	}
+	conn, err := gen.DecodeOpsProjectAlertsPage(result.Data)
+	if err != nil {
+		if len(result.Errors) > 0 {
+			return nil, &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+		}
+		return nil, fmt.Errorf("decode OpsProjectAlertsPage: %w", err)
+	}
+	return conn, nil
+}
+
+func nextAfterForOpsAlerts(pageInfo gen.OpsAlertPageInfo, edges []gen.OpsAlertEdge) (string, bool, error) {
+	if !pageInfo.HasNextPage {
+		return "", false, nil
+	}
+	if gen.OpsAlertsPageInfoHasEndCursor && pageInfo.EndCursor != nil && strings.TrimSpace(*pageInfo.EndCursor) != "" {
+		return strings.TrimSpace(*pageInfo.EndCursor), true, nil
+	}
+	if gen.OpsAlertsEdgeHasCursor && len(edges) > 0 {
+		for i := len(edges) - 1; i >= 0; i-- {
+			if edges[i].Cursor != nil && strings.TrimSpace(*edges[i].Cursor) != "" {
+				return strings.TrimSpace(*edges[i].Cursor), true, nil
+			}
+		}
+	}
+	return "", false, errors.New("pagination cursor missing for ops.project.alerts")
 }
 
 func (c *Client) IterProjectSchedulesViaGraphQL(
@@ -27,5 +204,88 @@ func (c *Client) IterProjectSchedulesViaGraphQL(
 	cloudID string,
 	projectKey string,
 ) ([]*atlassian.AtlassianOpsSchedule, error) {
-	return []*atlassian.AtlassianOpsSchedule{}, nil
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return nil, errors.New("cloudID is required")
+	}
+	project := strings.TrimSpace(projectKey)
+	if project == "" {
+		return nil, errors.New("projectKey is required")
+	}
+
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]gen.OpsScheduleNode, bool, *string, error) {
+		conn, err := c.fetchProjectSchedulesPage(ctx, cloud, project, opsPageSize, after)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		nodes := make([]gen.OpsScheduleNode, len(conn.Edges))
+		for i, edge := range conn.Edges {
+			nodes[i] = edge.Node
+		}
+		next, hasMore, err := nextAfterForOpsSchedules(conn.PageInfo, conn.Edges)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		if !hasMore {
+			return nodes, false, nil, nil
+		}
+		return nodes, true, &next, nil
+	}, pagination.CursorIteratorOptions{})
+
+	var out []*atlassian.AtlassianOpsSchedule
+	for {
+		node, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			return out, nil
+		}
+		mapped, err := mappers.AtlassianOpsScheduleFromGraphQL(*node)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &mapped)
+	}
+}
+
+func (c *Client) fetchProjectSchedulesPage(ctx context.Context, cloudID, projectKey string, pageSize int, after *string) (*gen.OpsScheduleConnection, error) {
+	vars := map[string]any{
+		"cloudId":    cloudID,
+		"projectKey": projectKey,
+		"first":      pageSize,
+		"after":      after,
+	}
+	result, err := c.Execute(ctx, gen.OpsProjectSchedulesPageQuery, vars, "OpsProjectSchedulesPage", c.ExperimentalAPIs, 1)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Data == nil {
+		return nil, errors.New("missing data in OpsProjectSchedulesPage response")
+	}
+	conn, err := gen.DecodeOpsProjectSchedulesPage(result.Data)
+	if err != nil {
+		if len(result.Errors) > 0 {
+			return nil, &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+		}
+		return nil, fmt.Errorf("decode OpsProjectSchedulesPage: %w", err)
+	}
+	return conn, nil
+}
+
+func nextAfterForOpsSchedules(pageInfo gen.OpsSchedulePageInfo, edges []gen.OpsScheduleEdge) (string, bool, error) {
+	if !pageInfo.HasNextPage {
+		return "", false, nil
+	}
+	if gen.OpsSchedulesPageInfoHasEndCursor && pageInfo.EndCursor != nil && strings.TrimSpace(*pageInfo.EndCursor) != "" {
+		return strings.TrimSpace(*pageInfo.EndCursor), true, nil
+	}
+	if gen.OpsSchedulesEdgeHasCursor && len(edges) > 0 {
+		for i := len(edges) - 1; i >= 0; i-- {
+			if edges[i].Cursor != nil && strings.TrimSpace(*edges[i].Cursor) != "" {
+				return strings.TrimSpace(*edges[i].Cursor), true, nil
+			}
+		}
+	}
+	return "", false, errors.New("pagination cursor missing for ops.project.schedules")
 }
@@ -0,0 +1,204 @@
+package codegen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/graph"
+)
+
+// FindRepoRoot resolves the repository root relative to this file's location, so tools under
+// go/tools/generate_*_models (and go/tools/atlas_gen) can locate graphql/schema.introspection.json
+// and oauth_tokens.txt without hand-rolling the same runtime.Caller walk.
+func FindRepoRoot() (string, error) {
+	_, thisFile, _, ok := runtime.Caller(0)
+	if !ok {
+		return "", errors.New("unable to locate codegen package path")
+	}
+	dir := filepath.Dir(thisFile)
+	return filepath.Clean(filepath.Join(dir, "..", "..", "..", "..")), nil
+}
+
+// ExperimentalAPIsFromEnv parses the comma-separated ATLASSIAN_GQL_EXPERIMENTAL_APIS environment
+// variable into the opt-in list expected by graph.SchemaFetchOptions/graph.Client.
+func ExperimentalAPIsFromEnv() []string {
+	raw := os.Getenv("ATLASSIAN_GQL_EXPERIMENTAL_APIS")
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	var out []string
+	for _, p := range strings.Split(raw, ",") {
+		if s := strings.TrimSpace(p); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// LoadEnvFile populates unset environment variables from a simple KEY=VALUE file (as produced by
+// `atlas auth export` into oauth_tokens.txt), tolerating a missing file, blank lines, "# comment"
+// lines, and an "export " prefix. Variables already set in the environment are left alone.
+func LoadEnvFile(path string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "export ") {
+			trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "export "))
+		}
+		eq := strings.Index(trimmed, "=")
+		if eq <= 0 {
+			continue
+		}
+		key := strings.TrimSpace(trimmed[:eq])
+		if key == "" {
+			continue
+		}
+		if _, ok := os.LookupEnv(key); ok {
+			continue
+		}
+		val := StripQuotes(strings.TrimSpace(trimmed[eq+1:]))
+		_ = os.Setenv(key, val)
+	}
+}
+
+// StripQuotes removes a single matching pair of surrounding single or double quotes from raw.
+func StripQuotes(raw string) string {
+	if len(raw) >= 2 {
+		first := raw[0]
+		last := raw[len(raw)-1]
+		if (first == '"' && last == '"') || (first == '\'' && last == '\'') {
+			return raw[1 : len(raw)-1]
+		}
+	}
+	return raw
+}
+
+// BuildAuthFromEnv resolves an atlassian.AuthProvider from whichever ATLASSIAN_* credentials are
+// set, trying OAuth refresh token, OAuth access token, Basic API token, then cookie auth in that
+// order. It returns nil when no credential set is complete.
+func BuildAuthFromEnv() atlassian.AuthProvider {
+	token := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN"))
+	refreshToken := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN"))
+	clientID := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_ID"))
+	clientSecret := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_SECRET"))
+	email := strings.TrimSpace(os.Getenv("ATLASSIAN_EMAIL"))
+	apiToken := strings.TrimSpace(os.Getenv("ATLASSIAN_API_TOKEN"))
+	cookiesJSON := strings.TrimSpace(os.Getenv("ATLASSIAN_COOKIES_JSON"))
+
+	if refreshToken != "" && clientID != "" && clientSecret != "" {
+		return &atlassian.OAuthRefreshTokenAuth{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RefreshToken: refreshToken,
+			Timeout:      30 * time.Second,
+		}
+	}
+	if token != "" {
+		if clientSecret != "" && token == clientSecret {
+			fmt.Fprintln(os.Stderr, "ATLASSIAN_OAUTH_ACCESS_TOKEN appears to be set to ATLASSIAN_CLIENT_SECRET; set an OAuth access token (not the client secret).")
+			return nil
+		}
+		return atlassian.BearerAuth{
+			TokenGetter: func() (string, error) { return token, nil },
+		}
+	}
+	if email != "" && apiToken != "" {
+		return atlassian.BasicAPITokenAuth{Email: email, Token: apiToken}
+	}
+	if cookiesJSON != "" {
+		var cookies map[string]string
+		if err := json.Unmarshal([]byte(cookiesJSON), &cookies); err == nil && len(cookies) > 0 {
+			var httpCookies []*http.Cookie
+			for k, v := range cookies {
+				httpCookies = append(httpCookies, &http.Cookie{Name: k, Value: v})
+			}
+			return atlassian.CookieAuth{Cookies: httpCookies}
+		}
+	}
+	return nil
+}
+
+// BootstrapOptions configures Bootstrap's schema acquisition step.
+type BootstrapOptions struct {
+	// RepoRoot is the repository root. Callers that already computed it (e.g. to build an output
+	// path) should pass it through rather than paying for a second FindRepoRoot call.
+	RepoRoot string
+	// ForceRefresh bypasses the schema cache and always re-fetches the introspection result.
+	ForceRefresh bool
+}
+
+// Bootstrap performs the setup every go/tools/generate_*_models main used to hand-roll: load
+// oauth_tokens.txt (or ATLASSIAN_OAUTH_TOKEN_FILE) into the environment, refresh
+// graphql/schema.introspection.json when it's missing, stale, or fetched under a different
+// ExperimentalAPIs set, and parse the result. It returns the parsed __schema object, ready for
+// ResolveOperations.
+func Bootstrap(ctx context.Context, opts BootstrapOptions) (map[string]any, error) {
+	tokenFile := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_TOKEN_FILE"))
+	if tokenFile == "" {
+		tokenFile = filepath.Join(opts.RepoRoot, "oauth_tokens.txt")
+	}
+	LoadEnvFile(tokenFile)
+
+	schemaPath := filepath.Join(opts.RepoRoot, "graphql", "schema.introspection.json")
+	experimentalAPIs := ExperimentalAPIsFromEnv()
+	maxAge := graph.ParseSchemaMaxAge(os.Getenv("ATLASSIAN_GQL_SCHEMA_MAX_AGE"))
+	refetch, err := graph.ShouldRefetchSchema(schemaPath, experimentalAPIs, maxAge, opts.ForceRefresh)
+	if err != nil {
+		return nil, err
+	}
+	if refetch {
+		if err := refetchSchema(ctx, schemaPath, experimentalAPIs); err != nil {
+			return nil, err
+		}
+	}
+
+	raw, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	return LoadSchema(raw)
+}
+
+func refetchSchema(ctx context.Context, schemaPath string, experimentalAPIs []string) error {
+	baseURL := strings.TrimSpace(os.Getenv("ATLASSIAN_GQL_BASE_URL"))
+	if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN")) != "" {
+		baseURL = "https://api.atlassian.com"
+	}
+	if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN")) != "" {
+		baseURL = "https://api.atlassian.com"
+	}
+	if baseURL == "" {
+		return fmt.Errorf("missing %s and ATLASSIAN_GQL_BASE_URL not set", schemaPath)
+	}
+	auth := BuildAuthFromEnv()
+	if auth == nil {
+		return errors.New("no credentials available in env vars to fetch schema")
+	}
+
+	opts := graph.SchemaFetchOptions{
+		OutputDir:        filepath.Dir(schemaPath),
+		ExperimentalAPIs: experimentalAPIs,
+		Timeout:          30 * time.Second,
+		HTTPClient:       &http.Client{Timeout: 30 * time.Second},
+	}
+	fetchedAt := time.Now()
+	if _, err := graph.FetchSchemaIntrospection(ctx, baseURL, auth, opts); err != nil {
+		return err
+	}
+	return graph.RecordSchemaFetch(schemaPath, experimentalAPIs, fetchedAt)
+}
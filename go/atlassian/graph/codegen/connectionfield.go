@@ -0,0 +1,402 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// NestedRefManifest names an object-typed field on a connection's node worth surfacing as its own
+// small ref struct, e.g. a scorecard node's "scorecard { id name }".
+type NestedRefManifest struct {
+	// Name is the GraphQL field name on the node, e.g. "scorecard".
+	Name string `json:"name"`
+	// Fields lists the ref type's own scalar/enum fields to select and decode.
+	Fields []FieldManifest `json:"fields"`
+}
+
+// ConnectionFieldManifest describes a Relay-style edges connection reached through a single
+// root entity lookup that may resolve behind a UNION/INTERFACE carrying a typed error variant,
+// e.g. `compass.component(id: ...) { ... on CompassComponent { scorecards { edges { node { ... } } } } }`.
+// This covers a shape ConnectionManifest doesn't: ConnectionManifest's connection field sits
+// directly on a namespace, with no parent entity lookup or union/error handling in between.
+type ConnectionFieldManifest struct {
+	Package       string `json:"package"`
+	GeneratorPath string `json:"generatorPath"`
+	// EntityName prefixes generated type names, e.g. "CompassComponentScorecards" yields
+	// CompassComponentScorecardsConnection, ...Edge, ...Node, ...Result.
+	EntityName string `json:"entityName"`
+
+	// Namespace is the root query field hosting ParentField, e.g. "compass"; empty if ParentField
+	// sits directly on Query.
+	Namespace string `json:"namespace,omitempty"`
+	// ParentField looks the connection's owning entity up by ParentIDArgName, e.g. "component".
+	ParentField string `json:"parentField"`
+	// ParentIDArgName is ParentField's identifying argument, e.g. "id".
+	ParentIDArgName string `json:"parentIdArgName"`
+
+	// ConnectionFieldName is the edges connection field on the resolved entity, e.g. "scorecards".
+	ConnectionFieldName string `json:"connectionFieldName"`
+
+	// Fields lists the connection node's own scalar/enum fields.
+	Fields []FieldManifest `json:"fields"`
+	// Refs lists the connection node's nested object-typed fields to surface as ref structs.
+	Refs []NestedRefManifest `json:"refs,omitempty"`
+
+	// EmitSeqIterator additionally renders an Iterate{Entity} range-over-func iterator
+	// (iter.Seq2[*{Entity}Node, error]) plus an Iterate{Entity}Chan channel-based equivalent for
+	// pre-1.23 Go, on top of the {Entity}Iterator every target already gets. Opt-in since most
+	// targets are well served by the imperative Next()-based iterator alone.
+	EmitSeqIterator bool `json:"emitSeqIterator,omitempty"`
+
+	// EmitHelpers additionally renders Clone/Equal/IsZero methods on every generated struct (refs,
+	// {Entity}Node, {Entity}Edge, {Entity}Connection, {Entity}Result), for callers who cache a
+	// decoded page or diff it across polling intervals and would otherwise need reflection (or
+	// hand-written code) to copy or compare pointer-heavy structs safely. Opt-in since most targets
+	// decode a page once and don't hold onto it.
+	EmitHelpers bool `json:"emitHelpers,omitempty"`
+}
+
+type resolvedConnectionFieldRef struct {
+	name     string
+	goName   string
+	typeName string
+	fields   []resolvedField
+}
+
+// ResolvedConnectionField is a ConnectionFieldManifest validated against a live schema, ready for
+// RenderConnectionField.
+type ResolvedConnectionField struct {
+	manifest *ConnectionFieldManifest
+
+	parentIDType   string
+	entityTypeName string
+	errorTypeName  string
+
+	connectionTypeName        string
+	edgeHasCursor             bool
+	connectionHasNodes        bool
+	pageInfoHasEndCursor      bool
+	pageInfoEndCursorNullable bool
+	emitSeqIterator           bool
+	emitHelpers               bool
+
+	fields []resolvedField
+	refs   []resolvedConnectionFieldRef
+
+	errorMessageNullable              bool
+	errorHasExtensions                bool
+	errorExtensionsNullable           bool
+	errorExtensionsHasStatusCode      bool
+	errorExtensionsStatusCodeNullable bool
+
+	enums           []resolvedEnum
+	extraImports    []string
+	supportSnippets []string
+}
+
+// ResolveConnectionField validates m against schema (existence of every referenced field, arg and
+// type) and resolves Go types for every selected field via tm, returning a value
+// RenderConnectionField can turn into source. It returns a descriptive error naming the missing
+// field/type the moment validation fails, mirroring ResolveOperations.
+func ResolveConnectionField(schema map[string]any, m *ConnectionFieldManifest, tm *TypeMapper) (*ResolvedConnectionField, error) {
+	types, err := TypesMap(schema)
+	if err != nil {
+		return nil, err
+	}
+	queryDef, err := QueryTypeDef(schema, types)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceDef, err := resolveNamespace(queryDef, types, m.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	parentField := GetField(namespaceDef, m.ParentField)
+	if parentField == nil {
+		return nil, fmt.Errorf("missing field %s", m.ParentField)
+	}
+	idArg := GetArg(parentField, m.ParentIDArgName)
+	if idArg == nil {
+		return nil, fmt.Errorf("field %s missing %s argument", m.ParentField, m.ParentIDArgName)
+	}
+	parentIDType, err := TypeRefToGQL(idArg["type"])
+	if err != nil {
+		return nil, err
+	}
+
+	returnTypeName, returnKind := UnwrapNamedType(parentField["type"])
+	if returnTypeName == "" {
+		return nil, fmt.Errorf("unable to resolve return type of field %s", m.ParentField)
+	}
+	returnDef := types[returnTypeName]
+	if returnDef == nil {
+		return nil, fmt.Errorf("missing type definition: %s", returnTypeName)
+	}
+
+	entityDef := returnDef
+	errorTypeName := ""
+	if returnKind == "UNION" || returnKind == "INTERFACE" {
+		entityDef, errorTypeName, err = resolveEntityAndErrorVariant(types, returnDef)
+		if err != nil {
+			return nil, err
+		}
+	}
+	entityTypeName, _ := entityDef["name"].(string)
+	if entityTypeName == "" {
+		return nil, fmt.Errorf("unable to resolve entity type name for field %s", m.ParentField)
+	}
+
+	connField := GetField(entityDef, m.ConnectionFieldName)
+	if connField == nil {
+		return nil, fmt.Errorf("missing field %s.%s", entityTypeName, m.ConnectionFieldName)
+	}
+	connTypeName, connKind := UnwrapNamedType(connField["type"])
+	if connTypeName == "" {
+		return nil, fmt.Errorf("unable to resolve type of field %s.%s", entityTypeName, m.ConnectionFieldName)
+	}
+	connDef := types[connTypeName]
+	if connDef == nil {
+		return nil, fmt.Errorf("missing type definition: %s", connTypeName)
+	}
+	if connKind == "UNION" || connKind == "INTERFACE" {
+		connDef, err = resolveConnectionVariant(types, connDef)
+		if err != nil {
+			return nil, err
+		}
+	}
+	connectionTypeName, _ := connDef["name"].(string)
+	edgesField := GetField(connDef, "edges")
+	pageInfoField := GetField(connDef, "pageInfo")
+	if edgesField == nil || pageInfoField == nil {
+		return nil, fmt.Errorf("missing required connection fields on %s", connectionTypeName)
+	}
+	connectionHasNodes := GetField(connDef, "nodes") != nil
+
+	// PageInfo's own shape is validated once, by ResolvePageInfoType, against this same schema; a
+	// connection field resolving to a different PageInfo type would mean the schema isn't using
+	// the single-shared-PageInfo Relay convention this generator assumes.
+	pageInfoTypeName, _ := UnwrapNamedType(pageInfoField["type"])
+	if pageInfoTypeName != "PageInfo" {
+		return nil, fmt.Errorf("field %s.%s's pageInfo resolves to %s, not the shared PageInfo type", entityTypeName, m.ConnectionFieldName, pageInfoTypeName)
+	}
+	pageInfoDef := types["PageInfo"]
+	if pageInfoDef == nil || GetField(pageInfoDef, "hasNextPage") == nil {
+		return nil, errors.New("missing shared PageInfo type definition")
+	}
+	endCursorField := GetField(pageInfoDef, "endCursor")
+	pageInfoHasEndCursor := endCursorField != nil
+	pageInfoEndCursorNullable := pageInfoHasEndCursor && !IsNonNull(endCursorField["type"])
+
+	edgeTypeName, _ := UnwrapNamedType(edgesField["type"])
+	edgeDef := types[edgeTypeName]
+	if edgeDef == nil {
+		return nil, fmt.Errorf("missing edge type definition: %s", edgeTypeName)
+	}
+	edgeHasCursor := GetField(edgeDef, "cursor") != nil
+
+	nodeField := GetField(edgeDef, "node")
+	if nodeField == nil {
+		return nil, fmt.Errorf("edge type %s missing node field", edgeTypeName)
+	}
+	nodeTypeName, _ := UnwrapNamedType(nodeField["type"])
+	nodeDef := types[nodeTypeName]
+	if nodeDef == nil {
+		return nil, fmt.Errorf("missing node type definition: %s", nodeTypeName)
+	}
+
+	scalarsUsed := map[string]bool{}
+	enumsUsed := map[string]string{}
+	fields, err := resolveFields(nodeDef, nodeTypeName, m.Fields, types, tm, scalarsUsed, enumsUsed)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []resolvedConnectionFieldRef
+	for _, rm := range m.Refs {
+		refField := GetField(nodeDef, rm.Name)
+		if refField == nil {
+			return nil, fmt.Errorf("node type %s missing ref field %s", nodeTypeName, rm.Name)
+		}
+		refTypeName, _ := UnwrapNamedType(refField["type"])
+		refDef := types[refTypeName]
+		if refDef == nil {
+			return nil, fmt.Errorf("missing ref type definition: %s", refTypeName)
+		}
+		refFields, err := resolveFields(refDef, refTypeName, rm.Fields, types, tm, scalarsUsed, enumsUsed)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, resolvedConnectionFieldRef{
+			name:     rm.Name,
+			goName:   ToGoName(rm.Name),
+			typeName: refTypeName,
+			fields:   refFields,
+		})
+	}
+
+	resolved := &ResolvedConnectionField{
+		manifest:                  m,
+		parentIDType:              parentIDType,
+		entityTypeName:            entityTypeName,
+		errorTypeName:             errorTypeName,
+		connectionTypeName:        connectionTypeName,
+		edgeHasCursor:             edgeHasCursor,
+		connectionHasNodes:        connectionHasNodes,
+		pageInfoHasEndCursor:      pageInfoHasEndCursor,
+		pageInfoEndCursorNullable: pageInfoEndCursorNullable,
+		emitSeqIterator:           m.EmitSeqIterator,
+		emitHelpers:               m.EmitHelpers,
+		fields:                    fields,
+		refs:                      refs,
+	}
+
+	if errorTypeName != "" {
+		errorDef := types[errorTypeName]
+		if errorDef == nil {
+			return nil, fmt.Errorf("missing error type definition: %s", errorTypeName)
+		}
+		errorMessageField := GetField(errorDef, "message")
+		if errorMessageField == nil {
+			return nil, fmt.Errorf("error type %s missing message field", errorTypeName)
+		}
+		resolved.errorMessageNullable = !IsNonNull(errorMessageField["type"])
+		if extField := GetField(errorDef, "extensions"); extField != nil {
+			resolved.errorHasExtensions = true
+			resolved.errorExtensionsNullable = !IsNonNull(extField["type"])
+			extTypeName, _ := UnwrapNamedType(extField["type"])
+			extDef := types[extTypeName]
+			if extDef == nil {
+				return nil, fmt.Errorf("missing error extensions type definition: %s", extTypeName)
+			}
+			if statusField := GetField(extDef, "statusCode"); statusField != nil {
+				resolved.errorExtensionsHasStatusCode = true
+				resolved.errorExtensionsStatusCodeNullable = !IsNonNull(statusField["type"])
+			}
+		}
+	}
+
+	importSet := map[string]bool{}
+	for name := range scalarsUsed {
+		mapping, _ := tm.ScalarMapping(name)
+		if mapping.Import != "" {
+			importSet[mapping.Import] = true
+		}
+		if mapping.Support != "" {
+			resolved.supportSnippets = append(resolved.supportSnippets, mapping.Support)
+		}
+	}
+	for imp := range importSet {
+		resolved.extraImports = append(resolved.extraImports, imp)
+	}
+	sort.Strings(resolved.extraImports)
+	sort.Strings(resolved.supportSnippets)
+
+	var enumNames []string
+	for graphQLName := range enumsUsed {
+		enumNames = append(enumNames, graphQLName)
+	}
+	sort.Strings(enumNames)
+	for _, graphQLName := range enumNames {
+		e, err := resolveEnum(types, graphQLName, enumsUsed[graphQLName])
+		if err != nil {
+			return nil, err
+		}
+		resolved.enums = append(resolved.enums, e)
+	}
+
+	return resolved, nil
+}
+
+// resolveEntityAndErrorVariant picks the "real" entity out of an entity union/interface (the
+// possibleType exposing both id and name fields - the same shape-sniff the Compass component
+// union has always needed, since CompassComponent itself carries no discriminating marker), and
+// separately identifies the union's typed error variant, if any (the possibleType exposing a
+// message field).
+func resolveEntityAndErrorVariant(types map[string]map[string]any, unionDef map[string]any) (entityDef map[string]any, errorTypeName string, err error) {
+	raw, _ := unionDef["possibleTypes"].([]any)
+	for _, item := range raw {
+		pt, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := pt["name"].(string)
+		if name == "" {
+			continue
+		}
+		def := types[name]
+		if def == nil {
+			continue
+		}
+		if entityDef == nil && GetField(def, "id") != nil && GetField(def, "name") != nil {
+			entityDef = def
+		}
+		if errorTypeName == "" && GetField(def, "message") != nil {
+			errorTypeName = name
+		}
+	}
+	if entityDef == nil {
+		unionName, _ := unionDef["name"].(string)
+		return nil, "", fmt.Errorf("unable to identify an entity type (with id+name fields) in union %s", unionName)
+	}
+	return entityDef, errorTypeName, nil
+}
+
+// resolveConnectionVariant picks the possibleType of a union/interface-typed connection field that
+// actually exposes the Relay pageInfo/edges shape.
+func resolveConnectionVariant(types map[string]map[string]any, unionDef map[string]any) (map[string]any, error) {
+	raw, _ := unionDef["possibleTypes"].([]any)
+	for _, item := range raw {
+		pt, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := pt["name"].(string)
+		if name == "" {
+			continue
+		}
+		def := types[name]
+		if def == nil {
+			continue
+		}
+		if GetField(def, "pageInfo") != nil && GetField(def, "edges") != nil {
+			return def, nil
+		}
+	}
+	unionName, _ := unionDef["name"].(string)
+	return nil, fmt.Errorf("unable to identify a Connection type in union %s", unionName)
+}
+
+// ResolvedPageInfoType is the schema's single canonical PageInfo shape, resolved once by
+// ResolvePageInfoType and shared by every ConnectionFieldManifest target's generated output.
+type ResolvedPageInfoType struct {
+	hasEndCursor      bool
+	endCursorNullable bool
+}
+
+// ResolvePageInfoType resolves the schema's PageInfo type once, so every ConnectionFieldManifest
+// target can reference one shared generated PageInfo struct instead of each redeclaring it.
+// GraphQL's Relay connection convention reuses a single PageInfo type across every connection
+// field in a schema, so resolving it independently of any one target is sound.
+func ResolvePageInfoType(schema map[string]any) (*ResolvedPageInfoType, error) {
+	types, err := TypesMap(schema)
+	if err != nil {
+		return nil, err
+	}
+	def := types["PageInfo"]
+	if def == nil {
+		return nil, errors.New("missing PageInfo type definition")
+	}
+	if GetField(def, "hasNextPage") == nil {
+		return nil, errors.New("PageInfo missing hasNextPage field")
+	}
+	endCursorField := GetField(def, "endCursor")
+	hasEndCursor := endCursorField != nil
+	nullable := true
+	if hasEndCursor {
+		nullable = !IsNonNull(endCursorField["type"])
+	}
+	return &ResolvedPageInfoType{hasEndCursor: hasEndCursor, endCursorNullable: nullable}, nil
+}
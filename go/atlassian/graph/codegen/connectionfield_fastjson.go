@@ -0,0 +1,313 @@
+package codegen
+
+import (
+	"fmt"
+)
+
+// fastjsonPrimitiveWriters maps every scalar Go type RenderConnectionField can emit fastjson code
+// for to the suffix of its fastjsonWrite* helper in RenderConnectionFieldCommon's support block.
+// Anything not listed here (enums, GraphQLURL, json.RawMessage, ...) isn't supported by --fastjson
+// yet; ResolveConnectionField's target schema just hasn't needed one of those on a connection node
+// so far, so renderFastJSONFields fails loudly instead of guessing at a writer for it.
+var fastjsonPrimitiveWriters = map[string]string{
+	"string":    "String",
+	"bool":      "Bool",
+	"int":       "Int",
+	"int64":     "Int64",
+	"float64":   "Float64",
+	"time.Time": "Time",
+}
+
+// fastjsonField is one field of a fastjson-rendered struct: either a scalar handled directly by a
+// fastjsonWrite* helper, or another generated type (a ref, a node, an edge list, ...) handled by
+// delegating to that type's own MarshalJSON/UnmarshalJSON.
+type fastjsonField struct {
+	jsonName string
+	goName   string
+	typeName string
+	optional bool
+	slice    bool
+}
+
+func fastjsonFieldGoType(f fastjsonField) string {
+	switch {
+	case f.slice:
+		return "[]" + f.typeName
+	case f.optional:
+		return "*" + f.typeName
+	default:
+		return f.typeName
+	}
+}
+
+// connectionFieldRefTypeName is the Go type name RenderConnectionField generates for a
+// NestedRefManifest ref on entity.
+func connectionFieldRefTypeName(entity string, r resolvedConnectionFieldRef) string {
+	return entity + r.goName + "Ref"
+}
+
+// fastjsonNodeFields derives the fastjson field list for entity's Node struct from resolved,
+// erroring out if any manifest field resolved to a Go type --fastjson doesn't know how to write.
+func fastjsonNodeFields(entity string, resolved *ResolvedConnectionField) ([]fastjsonField, error) {
+	fields := make([]fastjsonField, 0, len(resolved.fields)+len(resolved.refs))
+	for _, f := range resolved.fields {
+		if _, ok := fastjsonPrimitiveWriters[f.GoType]; !ok {
+			return nil, fmt.Errorf("fastjson: %sNode.%s has unsupported type %s", entity, f.GoName, f.GoType)
+		}
+		fields = append(fields, fastjsonField{jsonName: f.Name, goName: f.GoName, typeName: f.GoType, optional: f.Optional})
+	}
+	for _, r := range resolved.refs {
+		fields = append(fields, fastjsonField{jsonName: r.name, goName: r.goName, typeName: connectionFieldRefTypeName(entity, r), optional: true})
+	}
+	return fields, nil
+}
+
+func fastjsonEdgeFields(entity string, resolved *ResolvedConnectionField) []fastjsonField {
+	var fields []fastjsonField
+	if resolved.edgeHasCursor {
+		fields = append(fields, fastjsonField{jsonName: "cursor", goName: "Cursor", typeName: "string", optional: true})
+	}
+	fields = append(fields, fastjsonField{jsonName: "node", goName: "Node", typeName: entity + "Node"})
+	return fields
+}
+
+func fastjsonConnectionFields(entity string, resolved *ResolvedConnectionField) []fastjsonField {
+	fields := []fastjsonField{
+		{jsonName: "pageInfo", goName: "PageInfo", typeName: "PageInfo"},
+		{jsonName: "edges", goName: "Edges", typeName: entity + "Edge", slice: true},
+	}
+	if resolved.connectionHasNodes {
+		fields = append(fields, fastjsonField{jsonName: "nodes", goName: "Nodes", typeName: entity + "Node", slice: true})
+	}
+	return fields
+}
+
+func fastjsonRefFields(entity string, r resolvedConnectionFieldRef) ([]fastjsonField, error) {
+	refType := connectionFieldRefTypeName(entity, r)
+	fields := make([]fastjsonField, 0, len(r.fields))
+	for _, f := range r.fields {
+		if _, ok := fastjsonPrimitiveWriters[f.GoType]; !ok {
+			return nil, fmt.Errorf("fastjson: %s.%s has unsupported type %s", refType, f.GoName, f.GoType)
+		}
+		fields = append(fields, fastjsonField{jsonName: f.Name, goName: f.GoName, typeName: f.GoType, optional: f.Optional})
+	}
+	return fields, nil
+}
+
+func fastjsonPageInfoFields(pageInfo *ResolvedPageInfoType) []fastjsonField {
+	fields := []fastjsonField{{jsonName: "hasNextPage", goName: "HasNextPage", typeName: "bool"}}
+	if pageInfo.hasEndCursor {
+		fields = append(fields, fastjsonField{jsonName: "endCursor", goName: "EndCursor", typeName: "string", optional: pageInfo.endCursorNullable})
+	}
+	return fields
+}
+
+// renderFastJSONMarshal emits a value-receiver MarshalJSON for structName that writes fields
+// directly to a bytes.Buffer instead of reflecting over structName's fields the way
+// encoding/json's default struct encoder does.
+func renderFastJSONMarshal(structName string, fields []fastjsonField) []string {
+	lines := []string{
+		fmt.Sprintf("func (v %s) MarshalJSON() ([]byte, error) {", structName),
+		"\tvar buf bytes.Buffer",
+		"\tbuf.WriteByte('{')",
+	}
+	for i, f := range fields {
+		if i > 0 {
+			lines = append(lines, "\tbuf.WriteByte(',')")
+		}
+		lines = append(lines, fmt.Sprintf("\tbuf.WriteString(%q)", `"`+f.jsonName+`":`))
+		lines = append(lines, renderFastJSONFieldWrite(f)...)
+	}
+	lines = append(lines,
+		"\tbuf.WriteByte('}')",
+		"\treturn buf.Bytes(), nil",
+		"}",
+		"",
+	)
+	return lines
+}
+
+func renderFastJSONFieldWrite(f fastjsonField) []string {
+	accessor := "v." + f.goName
+
+	if suffix, ok := fastjsonPrimitiveWriters[f.typeName]; ok {
+		fn := "fastjsonWrite" + suffix
+		if f.optional {
+			fn += "Ptr"
+		}
+		return []string{fmt.Sprintf("\t%s(&buf, %s)", fn, accessor)}
+	}
+
+	if f.slice {
+		return []string{
+			"\tbuf.WriteByte('[')",
+			fmt.Sprintf("\tfor i, e := range %s {", accessor),
+			"\t\tif i > 0 {",
+			"\t\t\tbuf.WriteByte(',')",
+			"\t\t}",
+			"\t\tb, err := e.MarshalJSON()",
+			"\t\tif err != nil {",
+			"\t\t\treturn nil, err",
+			"\t\t}",
+			"\t\tbuf.Write(b)",
+			"\t}",
+			"\tbuf.WriteByte(']')",
+		}
+	}
+
+	if f.optional {
+		return []string{
+			fmt.Sprintf("\tif %s == nil {", accessor),
+			"\t\tbuf.WriteString(\"null\")",
+			"\t} else {",
+			fmt.Sprintf("\t\tb, err := %s.MarshalJSON()", accessor),
+			"\t\tif err != nil {",
+			"\t\t\treturn nil, err",
+			"\t\t}",
+			"\t\tbuf.Write(b)",
+			"\t}",
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("\tb, err := %s.MarshalJSON()", accessor),
+		"\tif err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		"\tbuf.Write(b)",
+	}
+}
+
+// renderFastJSONUnmarshal emits a pointer-receiver UnmarshalJSON for structName that tokenizes the
+// object and dispatches on the field name via a switch, rather than handing the whole payload to
+// encoding/json's reflection-based struct decoder. Nested types (refs, nodes, edge/node lists)
+// decode through their own generated UnmarshalJSON by virtue of encoding/json calling it for any
+// json.Unmarshaler it decodes into, so this doesn't need to re-implement their tokenizing too.
+func renderFastJSONUnmarshal(structName string, fields []fastjsonField) []string {
+	lines := []string{
+		fmt.Sprintf("func (v *%s) UnmarshalJSON(data []byte) error {", structName),
+		"\tdec := json.NewDecoder(bytes.NewReader(data))",
+		"\ttok, err := dec.Token()",
+		"\tif err != nil {",
+		"\t\treturn err",
+		"\t}",
+		"\tif delim, ok := tok.(json.Delim); !ok || delim != '{' {",
+		fmt.Sprintf("\t\treturn fmt.Errorf(%q)", structName+": expected a JSON object"),
+		"\t}",
+		"\tfor dec.More() {",
+		"\t\tkeyTok, err := dec.Token()",
+		"\t\tif err != nil {",
+		"\t\t\treturn err",
+		"\t\t}",
+		"\t\tkey, _ := keyTok.(string)",
+		"\t\tswitch key {",
+	}
+	for _, f := range fields {
+		lines = append(lines,
+			fmt.Sprintf("\t\tcase %q:", f.jsonName),
+			fmt.Sprintf("\t\t\tvar val %s", fastjsonFieldGoType(f)),
+			"\t\t\tif err := dec.Decode(&val); err != nil {",
+			fmt.Sprintf("\t\t\t\treturn fmt.Errorf(%q, err)", structName+"."+f.goName+": %w"),
+			"\t\t\t}",
+			fmt.Sprintf("\t\t\tv.%s = val", f.goName),
+		)
+	}
+	lines = append(lines,
+		"\t\tdefault:",
+		"\t\t\tvar skip json.RawMessage",
+		"\t\t\tif err := dec.Decode(&skip); err != nil {",
+		"\t\t\t\treturn err",
+		"\t\t\t}",
+		"\t\t}",
+		"\t}",
+		"\tif _, err := dec.Token(); err != nil {",
+		"\t\treturn err",
+		"\t}",
+		"\treturn nil",
+		"}",
+		"",
+	)
+	return lines
+}
+
+// fastjsonRuntimeSupport is the set of leaf-scalar writer helpers every fastjson-rendered
+// MarshalJSON calls into. It's rendered once, in RenderConnectionFieldCommon, rather than once per
+// generated *_api.go file, since every target in a run shares the same package.
+func fastjsonRuntimeSupport() []string {
+	return []string{
+		`func fastjsonWriteString(buf *bytes.Buffer, s string) {
+	buf.WriteString(strconv.Quote(s))
+}`,
+		``,
+		`func fastjsonWriteStringPtr(buf *bytes.Buffer, s *string) {
+	if s == nil {
+		buf.WriteString("null")
+		return
+	}
+	fastjsonWriteString(buf, *s)
+}`,
+		``,
+		`func fastjsonWriteBool(buf *bytes.Buffer, b bool) {
+	buf.WriteString(strconv.FormatBool(b))
+}`,
+		``,
+		`func fastjsonWriteBoolPtr(buf *bytes.Buffer, b *bool) {
+	if b == nil {
+		buf.WriteString("null")
+		return
+	}
+	fastjsonWriteBool(buf, *b)
+}`,
+		``,
+		`func fastjsonWriteInt(buf *bytes.Buffer, i int) {
+	buf.WriteString(strconv.Itoa(i))
+}`,
+		``,
+		`func fastjsonWriteIntPtr(buf *bytes.Buffer, i *int) {
+	if i == nil {
+		buf.WriteString("null")
+		return
+	}
+	fastjsonWriteInt(buf, *i)
+}`,
+		``,
+		`func fastjsonWriteInt64(buf *bytes.Buffer, i int64) {
+	buf.WriteString(strconv.FormatInt(i, 10))
+}`,
+		``,
+		`func fastjsonWriteInt64Ptr(buf *bytes.Buffer, i *int64) {
+	if i == nil {
+		buf.WriteString("null")
+		return
+	}
+	fastjsonWriteInt64(buf, *i)
+}`,
+		``,
+		`func fastjsonWriteFloat64(buf *bytes.Buffer, f float64) {
+	buf.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+}`,
+		``,
+		`func fastjsonWriteFloat64Ptr(buf *bytes.Buffer, f *float64) {
+	if f == nil {
+		buf.WriteString("null")
+		return
+	}
+	fastjsonWriteFloat64(buf, *f)
+}`,
+		``,
+		`func fastjsonWriteTime(buf *bytes.Buffer, t time.Time) {
+	buf.WriteByte('"')
+	buf.WriteString(t.UTC().Format(time.RFC3339Nano))
+	buf.WriteByte('"')
+}`,
+		``,
+		`func fastjsonWriteTimePtr(buf *bytes.Buffer, t *time.Time) {
+	if t == nil {
+		buf.WriteString("null")
+		return
+	}
+	fastjsonWriteTime(buf, *t)
+}`,
+		``,
+	}
+}
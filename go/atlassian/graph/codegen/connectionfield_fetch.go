@@ -0,0 +1,164 @@
+package codegen
+
+import "fmt"
+
+// renderFetchCallRuntime emits the CallOpt/callConfig/backoff/deadline plumbing every generated
+// Fetch{Entity} function shares, once per package via RenderConnectionFieldCommon - so adding a
+// new ConnectionFieldManifest target gets a working Fetch{Entity} function without its file
+// redeclaring the retry and deadline machinery.
+func renderFetchCallRuntime() []string {
+	return []string{
+		"// CallOpt configures a generated Fetch{Entity} call's retry count and deadline. Every",
+		"// Fetch{Entity} function in this package shares the same options, so callers learn one knob",
+		"// instead of one per entity.",
+		"type CallOpt func(*callConfig)",
+		"",
+		"type callConfig struct {",
+		"\tmaxRetries int",
+		"\ttimeout    time.Duration",
+		"\tdeadline   time.Time",
+		"}",
+		"",
+		"func newCallConfig() callConfig {",
+		"\treturn callConfig{maxRetries: 3}",
+		"}",
+		"",
+		"// WithMaxRetries overrides the default of 3 additional attempts after the first, for a",
+		"// GraphQL-surfaced rate limit error.",
+		"func WithMaxRetries(n int) CallOpt {",
+		"\treturn func(c *callConfig) { c.maxRetries = n }",
+		"}",
+		"",
+		"// WithTimeout bounds a single Fetch{Entity} call - every retry included - to d from when",
+		"// it's called, unless ctx already carries an earlier deadline.",
+		"func WithTimeout(d time.Duration) CallOpt {",
+		"\treturn func(c *callConfig) { c.timeout = d }",
+		"}",
+		"",
+		"// WithDeadline bounds a single Fetch{Entity} call - every retry included - to t, unless ctx",
+		"// already carries an earlier deadline.",
+		"func WithDeadline(t time.Time) CallOpt {",
+		"\treturn func(c *callConfig) { c.deadline = t }",
+		"}",
+		"",
+		"// resolveCallDeadline derives the context one Fetch{Entity} call (and every retry within it)",
+		"// runs under from cfg's WithTimeout/WithDeadline option, narrowed further by whatever deadline",
+		"// ctx itself already carries. The returned cancel is safe to defer unconditionally - a",
+		"// context.WithDeadline/WithTimeout cancel is a no-op once its context is already done.",
+		"func resolveCallDeadline(ctx context.Context, cfg callConfig) (context.Context, context.CancelFunc) {",
+		"\tswitch {",
+		"\tcase !cfg.deadline.IsZero():",
+		"\t\treturn context.WithDeadline(ctx, cfg.deadline)",
+		"\tcase cfg.timeout > 0:",
+		"\t\treturn context.WithTimeout(ctx, cfg.timeout)",
+		"\tdefault:",
+		"\t\treturn context.WithCancel(ctx)",
+		"\t}",
+		"}",
+		"",
+		"const (",
+		"\tretryBaseDelay = 250 * time.Millisecond",
+		"\tretryMaxDelay  = 30 * time.Second",
+		")",
+		"",
+		"// retryDelay computes how long to wait before retry attempt n (1-indexed) after a rate-limit",
+		"// error: rlErr's own Retry-After value when present, otherwise jittered exponential backoff",
+		"// starting at retryBaseDelay and capped at retryMaxDelay - the same shape rest.Transport uses",
+		"// for HTTP 429/5xx, applied here to a GraphQL-surfaced rate limit instead.",
+		"func retryDelay(attempt int, rlErr *graphql.RateLimitError) time.Duration {",
+		"\tif rlErr != nil {",
+		"\t\tif secs, err := strconv.Atoi(rlErr.HeaderValue); err == nil && secs > 0 {",
+		"\t\t\twait := time.Duration(secs) * time.Second",
+		"\t\t\tif wait > retryMaxDelay {",
+		"\t\t\t\treturn retryMaxDelay",
+		"\t\t\t}",
+		"\t\t\treturn wait",
+		"\t\t}",
+		"\t}",
+		"\tbackoff := retryBaseDelay * time.Duration(math.Pow(2, float64(attempt-1)))",
+		"\tif backoff > retryMaxDelay {",
+		"\t\tbackoff = retryMaxDelay",
+		"\t}",
+		"\treturn backoff/2 + time.Duration(rand.Int63n(int64(backoff)/2+1))",
+		"}",
+		"",
+		"// waitForRetry blocks for delay, returning ctx.Err() early if ctx is done first - so a retry",
+		"// loop still waiting out a backoff when its deadline fires aborts promptly instead of",
+		"// sleeping the full delay out before noticing.",
+		"func waitForRetry(ctx context.Context, delay time.Duration) error {",
+		"\ttimer := time.NewTimer(delay)",
+		"\tdefer timer.Stop()",
+		"\tselect {",
+		"\tcase <-ctx.Done():",
+		"\t\treturn ctx.Err()",
+		"\tcase <-timer.C:",
+		"\t\treturn nil",
+		"\t}",
+		"}",
+		"",
+	}
+}
+
+// RenderConnectionFieldFetch emits Fetch{Entity}, the typed request function next to
+// Decode{Entity}: it marshals vars (an {Entity}Vars struct) into GraphQL variables, runs
+// {Entity}Query via c.Execute under a deadline resolveCallDeadline derives from opts and ctx,
+// retries a GraphQL-surfaced rate limit error with jittered backoff (honoring Retry-After) up to
+// the configured max retries, and decodes a successful response with the existing Decode{Entity}.
+// c is *Client, the same ghost execution type {Entity}Client's generated mock doubles for in
+// tests - this package assumes one such Client shared by every entity file, the same way it
+// assumes one shared PageInfo. HTTP- and transport-level 5xx retries already happen inside
+// c.Execute itself; this loop exists for the rate limit a 200 GraphQL response can still carry in
+// its errors[] payload, which a transport-level retry can't see.
+func RenderConnectionFieldFetch(resolved *ResolvedConnectionField) []string {
+	m := resolved.manifest
+	entity := m.EntityName
+	paramName := connectionFieldParentIDParamName(m)
+	argGoName := ToGoName(paramName)
+
+	return []string{
+		fmt.Sprintf("// %sVars holds %sQuery's variables for Fetch%s.", entity, entity, entity),
+		fmt.Sprintf("type %sVars struct {", entity),
+		fmt.Sprintf("\t%s string `json:%q`", argGoName, m.ParentIDArgName),
+		"}",
+		"",
+		fmt.Sprintf("func (c *Client) Fetch%s(ctx context.Context, vars %sVars, opts ...CallOpt) (*%sData, error) {", entity, entity, entity),
+		"\tcfg := newCallConfig()",
+		"\tfor _, opt := range opts {",
+		"\t\topt(&cfg)",
+		"\t}",
+		"\tctx, cancel := resolveCallDeadline(ctx, cfg)",
+		"\tdefer cancel()",
+		"",
+		"\tvarsJSON, err := json.Marshal(vars)",
+		"\tif err != nil {",
+		fmt.Sprintf("\t\treturn nil, fmt.Errorf(\"marshal %%s: %%w\", %q, err)", entity+"Vars"),
+		"\t}",
+		"\tvar varsMap map[string]any",
+		"\tif err := json.Unmarshal(varsJSON, &varsMap); err != nil {",
+		fmt.Sprintf("\t\treturn nil, fmt.Errorf(\"unmarshal %%s: %%w\", %q, err)", entity+"Vars"),
+		"\t}",
+		"",
+		"\tvar lastErr error",
+		"\tfor attempt := 1; attempt <= cfg.maxRetries+1; attempt++ {",
+		fmt.Sprintf("\t\tresult, err := c.Execute(ctx, %sQuery, varsMap, %q, c.ExperimentalAPIs, 1)", entity, entity),
+		"\t\tif err == nil {",
+		"\t\t\tif result == nil || result.Data == nil {",
+		fmt.Sprintf("\t\t\t\treturn nil, errors.New(%q)", "missing data in "+entity+" response"),
+		"\t\t\t}",
+		fmt.Sprintf("\t\t\treturn Decode%s(result.Data)", entity),
+		"\t\t}",
+		"\t\tlastErr = err",
+		"",
+		"\t\tvar rlErr *graphql.RateLimitError",
+		"\t\tif !errors.As(err, &rlErr) || attempt > cfg.maxRetries {",
+		"\t\t\treturn nil, err",
+		"\t\t}",
+		"\t\tif waitErr := waitForRetry(ctx, retryDelay(attempt, rlErr)); waitErr != nil {",
+		"\t\t\treturn nil, waitErr",
+		"\t\t}",
+		"\t}",
+		"\treturn nil, lastErr",
+		"}",
+		"",
+	}
+}
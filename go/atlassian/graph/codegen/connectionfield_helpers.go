@@ -0,0 +1,297 @@
+package codegen
+
+import "fmt"
+
+// helperPtrField describes one *RefType field a Clone/Equal pair needs to recurse into - a
+// connection node's nested ref (e.g. Node.Scorecard *CompassComponentScorecardsScorecardRef).
+type helperPtrField struct {
+	goName   string
+	typeName string
+}
+
+// helperNeq renders the "not equal" expression for a scalar field of goType: time.Time can't be
+// compared with !=  (it carries a monotonic reading that two otherwise-identical timestamps may
+// disagree on), so it goes through time.Time.Equal instead.
+func helperNeq(goType, a, b string) string {
+	if goType == "time.Time" {
+		return fmt.Sprintf("!%s.Equal(%s)", a, b)
+	}
+	return fmt.Sprintf("%s != %s", a, b)
+}
+
+// renderConnectionFieldHelpers emits Clone/Equal/IsZero for every ref type, {Entity}Node,
+// {Entity}Edge and {Entity}Connection, recursing ref-by-ref and edge/node-by-edge/node rather than
+// leaning on reflection or a shallow == a pointer-heavy generated struct can't use safely: two nil
+// pointers are equal, a nil-vs-set pointer pair isn't, and a set pair compares by value (or, for
+// time.Time fields, via time.Time.Equal rather than ==).
+func renderConnectionFieldHelpers(resolved *ResolvedConnectionField) []string {
+	entity := resolved.manifest.EntityName
+	refTypeName := func(r resolvedConnectionFieldRef) string { return connectionFieldRefTypeName(entity, r) }
+
+	var lines []string
+	for _, r := range resolved.refs {
+		lines = append(lines, renderFieldStructHelpers(refTypeName(r), r.fields, nil)...)
+	}
+
+	nodeRefs := make([]helperPtrField, 0, len(resolved.refs))
+	for _, r := range resolved.refs {
+		nodeRefs = append(nodeRefs, helperPtrField{goName: r.goName, typeName: refTypeName(r)})
+	}
+	lines = append(lines, renderFieldStructHelpers(entity+"Node", resolved.fields, nodeRefs)...)
+
+	lines = append(lines, renderConnectionFieldEdgeHelpers(entity, resolved.edgeHasCursor)...)
+	lines = append(lines, renderConnectionFieldConnectionHelpers(entity, resolved.connectionHasNodes)...)
+
+	return lines
+}
+
+// renderFieldStructHelpers emits Clone/Equal/IsZero for a struct built out of scalar fields (node
+// fields or a ref's own fields) plus, for {Entity}Node, its nested *RefType fields.
+func renderFieldStructHelpers(typeName string, fields []resolvedField, refFields []helperPtrField) []string {
+	lines := []string{
+		fmt.Sprintf("func (n %s) Clone() %s {", typeName, typeName),
+		"\tout := n",
+	}
+	for _, f := range fields {
+		if !f.Optional {
+			continue
+		}
+		lines = append(lines,
+			fmt.Sprintf("\tif n.%s != nil {", f.GoName),
+			fmt.Sprintf("\t\tv := *n.%s", f.GoName),
+			fmt.Sprintf("\t\tout.%s = &v", f.GoName),
+			"\t}",
+		)
+	}
+	for _, r := range refFields {
+		lines = append(lines,
+			fmt.Sprintf("\tif n.%s != nil {", r.goName),
+			fmt.Sprintf("\t\tv := n.%s.Clone()", r.goName),
+			fmt.Sprintf("\t\tout.%s = &v", r.goName),
+			"\t}",
+		)
+	}
+	lines = append(lines, "\treturn out", "}", "")
+
+	lines = append(lines, fmt.Sprintf("func (n %s) Equal(other %s) bool {", typeName, typeName))
+	for _, f := range fields {
+		if f.Optional {
+			lines = append(lines,
+				fmt.Sprintf("\tif (n.%s == nil) != (other.%s == nil) {", f.GoName, f.GoName),
+				"\t\treturn false",
+				"\t}",
+				fmt.Sprintf("\tif n.%s != nil && %s {", f.GoName, helperNeq(f.GoType, "*n."+f.GoName, "*other."+f.GoName)),
+				"\t\treturn false",
+				"\t}",
+			)
+			continue
+		}
+		lines = append(lines,
+			fmt.Sprintf("\tif %s {", helperNeq(f.GoType, "n."+f.GoName, "other."+f.GoName)),
+			"\t\treturn false",
+			"\t}",
+		)
+	}
+	for _, r := range refFields {
+		lines = append(lines,
+			fmt.Sprintf("\tif (n.%s == nil) != (other.%s == nil) {", r.goName, r.goName),
+			"\t\treturn false",
+			"\t}",
+			fmt.Sprintf("\tif n.%s != nil && !n.%s.Equal(*other.%s) {", r.goName, r.goName, r.goName),
+			"\t\treturn false",
+			"\t}",
+		)
+	}
+	lines = append(lines, "\treturn true", "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("func (n %s) IsZero() bool {", typeName),
+		fmt.Sprintf("\treturn n.Equal(%s{})", typeName),
+		"}",
+		"",
+	)
+	return lines
+}
+
+// renderConnectionFieldEdgeHelpers emits Clone/Equal/IsZero for {Entity}Edge, recursing into its
+// Node via {Entity}Node.Equal/Clone rather than comparing the embedded node field by field again.
+func renderConnectionFieldEdgeHelpers(entity string, hasCursor bool) []string {
+	edgeType := entity + "Edge"
+	lines := []string{
+		fmt.Sprintf("func (e %s) Clone() %s {", edgeType, edgeType),
+		"\tout := e",
+		"\tout.Node = e.Node.Clone()",
+	}
+	if hasCursor {
+		lines = append(lines,
+			"\tif e.Cursor != nil {",
+			"\t\tv := *e.Cursor",
+			"\t\tout.Cursor = &v",
+			"\t}",
+		)
+	}
+	lines = append(lines, "\treturn out", "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("func (e %s) Equal(other %s) bool {", edgeType, edgeType),
+		"\tif !e.Node.Equal(other.Node) {",
+		"\t\treturn false",
+		"\t}",
+	)
+	if hasCursor {
+		lines = append(lines,
+			"\tif (e.Cursor == nil) != (other.Cursor == nil) {",
+			"\t\treturn false",
+			"\t}",
+			"\tif e.Cursor != nil && *e.Cursor != *other.Cursor {",
+			"\t\treturn false",
+			"\t}",
+		)
+	}
+	lines = append(lines, "\treturn true", "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("func (e %s) IsZero() bool {", edgeType),
+		fmt.Sprintf("\treturn e.Equal(%s{})", edgeType),
+		"}",
+		"",
+	)
+	return lines
+}
+
+// renderConnectionFieldConnectionHelpers emits Clone/Equal/IsZero for {Entity}Connection,
+// recursing edge-by-edge (and node-by-node, if the connection also selects a flat Nodes list)
+// rather than comparing the backing slices with reflect.DeepEqual.
+func renderConnectionFieldConnectionHelpers(entity string, hasNodes bool) []string {
+	connType := entity + "Connection"
+	edgeType := entity + "Edge"
+	nodeType := entity + "Node"
+	lines := []string{
+		fmt.Sprintf("func (c %s) Clone() %s {", connType, connType),
+		"\tout := c",
+		"\tout.PageInfo = c.PageInfo.Clone()",
+		"\tif c.Edges != nil {",
+		fmt.Sprintf("\t\tout.Edges = make([]%s, len(c.Edges))", edgeType),
+		"\t\tfor i, e := range c.Edges {",
+		"\t\t\tout.Edges[i] = e.Clone()",
+		"\t\t}",
+		"\t}",
+	}
+	if hasNodes {
+		lines = append(lines,
+			"\tif c.Nodes != nil {",
+			fmt.Sprintf("\t\tout.Nodes = make([]%s, len(c.Nodes))", nodeType),
+			"\t\tfor i, n := range c.Nodes {",
+			"\t\t\tout.Nodes[i] = n.Clone()",
+			"\t\t}",
+			"\t}",
+		)
+	}
+	lines = append(lines, "\treturn out", "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("func (c %s) Equal(other %s) bool {", connType, connType),
+		"\tif !c.PageInfo.Equal(other.PageInfo) {",
+		"\t\treturn false",
+		"\t}",
+		"\tif len(c.Edges) != len(other.Edges) {",
+		"\t\treturn false",
+		"\t}",
+		"\tfor i := range c.Edges {",
+		"\t\tif !c.Edges[i].Equal(other.Edges[i]) {",
+		"\t\t\treturn false",
+		"\t\t}",
+		"\t}",
+	)
+	if hasNodes {
+		lines = append(lines,
+			"\tif len(c.Nodes) != len(other.Nodes) {",
+			"\t\treturn false",
+			"\t}",
+			"\tfor i := range c.Nodes {",
+			"\t\tif !c.Nodes[i].Equal(other.Nodes[i]) {",
+			"\t\t\treturn false",
+			"\t\t}",
+			"\t}",
+		)
+	}
+	lines = append(lines, "\treturn true", "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("func (c %s) IsZero() bool {", connType),
+		fmt.Sprintf("\treturn c.Equal(%s{})", connType),
+		"}",
+		"",
+	)
+	return lines
+}
+
+// renderConnectionFieldResultIsZero emits {Entity}Result.IsZero: the common "all members nil"
+// check callers who cache a decoded Result need to tell "hasn't been fetched yet" apart from "was
+// fetched and unmarshaled" without reaching for reflection.
+func renderConnectionFieldResultIsZero(entity string, members []UnionMember) []string {
+	lines := []string{
+		fmt.Sprintf("func (r %sResult) IsZero() bool {", entity),
+		"\treturn r.Typename == \"\" &&",
+	}
+	for _, mem := range members {
+		lines = append(lines, fmt.Sprintf("\t\tr.%s == nil &&", mem.GoFieldName))
+	}
+	lines = append(lines, "\t\tr.Unknown == nil", "}", "")
+	return lines
+}
+
+// renderPageInfoHelpers emits Clone/Equal/IsZero for the package's single shared PageInfo type,
+// rendered once via RenderConnectionFieldCommon - every {Entity}Connection.Clone/Equal above calls
+// into these rather than each generated file redeclaring them for the one PageInfo shape they all
+// share. endCursorNullable only matters when hasEndCursor is set: a non-null EndCursor is a plain
+// string field, not a pointer, so there's nothing to nil-check or deref.
+func renderPageInfoHelpers(hasEndCursor, endCursorNullable bool) []string {
+	cursorIsPointer := hasEndCursor && endCursorNullable
+
+	lines := []string{
+		"func (p PageInfo) Clone() PageInfo {",
+		"\tout := p",
+	}
+	if cursorIsPointer {
+		lines = append(lines,
+			"\tif p.EndCursor != nil {",
+			"\t\tv := *p.EndCursor",
+			"\t\tout.EndCursor = &v",
+			"\t}",
+		)
+	}
+	lines = append(lines, "\treturn out", "}", "")
+
+	lines = append(lines,
+		"func (p PageInfo) Equal(other PageInfo) bool {",
+		"\tif p.HasNextPage != other.HasNextPage {",
+		"\t\treturn false",
+		"\t}",
+	)
+	if cursorIsPointer {
+		lines = append(lines,
+			"\tif (p.EndCursor == nil) != (other.EndCursor == nil) {",
+			"\t\treturn false",
+			"\t}",
+			"\tif p.EndCursor != nil && *p.EndCursor != *other.EndCursor {",
+			"\t\treturn false",
+			"\t}",
+		)
+	} else if hasEndCursor {
+		lines = append(lines,
+			"\tif p.EndCursor != other.EndCursor {",
+			"\t\treturn false",
+			"\t}",
+		)
+	}
+	lines = append(lines, "\treturn true", "}", "")
+
+	lines = append(lines,
+		"func (p PageInfo) IsZero() bool {",
+		"\treturn p.Equal(PageInfo{})",
+		"}",
+		"",
+	)
+	return lines
+}
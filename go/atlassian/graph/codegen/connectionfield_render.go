@@ -0,0 +1,338 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderConnectionFieldCommon renders the shared PageInfo type and Fetch{Entity} call runtime
+// (CallOpt, retry/backoff, deadline resolution) every RenderConnectionField output references.
+// Generate it once per run (not once per target) so writing N ConnectionFieldManifest targets
+// into the same package never redeclares either. emitHelpers additionally renders PageInfo's own
+// Clone/Equal/IsZero - pass true whenever any target in the run sets EmitHelpers, since a
+// {Entity}Connection with EmitHelpers calls into PageInfo.Clone/Equal regardless of which target
+// asked for it.
+func RenderConnectionFieldCommon(pageInfo *ResolvedPageInfoType, generatorPath string, pkg string, fastJSON bool, emitHelpers bool) string {
+	lines := []string{
+		fmt.Sprintf("// Code generated by %s via atlassian/graph/codegen. DO NOT EDIT.", generatorPath),
+		fmt.Sprintf("package %s", pkg),
+		"",
+		"import (",
+		"\t\"context\"",
+		"\t\"errors\"",
+		"\t\"math\"",
+		"\t\"math/rand\"",
+		"\t\"strconv\"",
+		"\t\"time\"",
+		"",
+		"\t\"atlassian-graphql/graphql\"",
+	}
+	if fastJSON {
+		lines = append(lines, "\t\"bytes\"", "\t\"encoding/json\"", "\t\"fmt\"")
+	}
+	lines = append(lines, ")", "")
+	lines = append(lines,
+		"",
+		"// PageInfo is shared by every generated connection-field file in this package; GraphQL's",
+		"// Relay connection convention reuses one PageInfo type across every connection field in a",
+		"// schema, so every ConnectionFieldManifest target resolves against this same shape rather",
+		"// than each declaring its own.",
+		"type PageInfo struct {",
+		"\tHasNextPage bool `json:\"hasNextPage\"`",
+	)
+	if pageInfo.hasEndCursor {
+		lines = append(lines, "\tEndCursor "+connectionFieldGoType("string", pageInfo.endCursorNullable)+" "+connectionFieldJSONTag("endCursor", pageInfo.endCursorNullable))
+	}
+	lines = append(lines, "}", "")
+
+	if fastJSON {
+		lines = append(lines, fastjsonRuntimeSupport()...)
+		pageInfoFields := fastjsonPageInfoFields(pageInfo)
+		lines = append(lines, renderFastJSONMarshal("PageInfo", pageInfoFields)...)
+		lines = append(lines, renderFastJSONUnmarshal("PageInfo", pageInfoFields)...)
+	}
+
+	if emitHelpers {
+		lines = append(lines, renderPageInfoHelpers(pageInfo.hasEndCursor, pageInfo.endCursorNullable)...)
+	}
+
+	lines = append(lines, renderFetchCallRuntime()...)
+
+	return strings.Join(lines, "\n")
+}
+
+// RenderConnectionField turns a validated ResolvedConnectionField into Go source for one
+// *_api.go file. The result is unformatted; callers run it through go/format.Source. When fastJSON
+// is set, Node/Edge/Connection and every ref struct also get hand-written MarshalJSON/UnmarshalJSON
+// methods (see connectionfield_fastjson.go) instead of relying on encoding/json's reflection-based
+// struct codec, which matters on the hot pagination path these types sit on. It fails if any
+// selected field resolved to a Go type --fastjson doesn't have a writer for.
+func RenderConnectionField(resolved *ResolvedConnectionField, fastJSON bool) (string, error) {
+	m := resolved.manifest
+	if m.EntityName == "" {
+		return "", fmt.Errorf("manifest EntityName is required")
+	}
+	entity := m.EntityName
+
+	var nodeFastjsonFields, edgeFastjsonFields, connFastjsonFields []fastjsonField
+	if fastJSON {
+		var err error
+		nodeFastjsonFields, err = fastjsonNodeFields(entity, resolved)
+		if err != nil {
+			return "", err
+		}
+		edgeFastjsonFields = fastjsonEdgeFields(entity, resolved)
+		connFastjsonFields = fastjsonConnectionFields(entity, resolved)
+	}
+
+	lines := []string{
+		fmt.Sprintf("// Code generated by %s via atlassian/graph/codegen. DO NOT EDIT.", m.GeneratorPath),
+		fmt.Sprintf("package %s", m.Package),
+		"",
+		"import (",
+		"\t\"context\"",
+		"\t\"encoding/json\"",
+		"\t\"errors\"",
+		"\t\"fmt\"",
+		"\t\"time\"",
+		"",
+		"\t\"atlassian-graphql/graphql\"",
+	}
+	if fastJSON {
+		lines = append(lines, "\t\"bytes\"")
+	}
+	if resolved.emitSeqIterator {
+		lines = append(lines, "\t\"iter\"")
+	}
+	for _, imp := range resolved.extraImports {
+		lines = append(lines, fmt.Sprintf("\t%q", imp))
+	}
+	lines = append(lines, ")", "")
+
+	for _, snippet := range resolved.supportSnippets {
+		lines = append(lines, snippet, "")
+	}
+	for _, e := range resolved.enums {
+		lines = append(lines, renderEnum(e)...)
+	}
+
+	query := renderConnectionFieldQuery(m, resolved)
+
+	lines = append(lines,
+		"const (",
+		fmt.Sprintf("\t%sConnectionTypename = %q", entity, resolved.connectionTypeName),
+	)
+	if resolved.errorTypeName != "" {
+		lines = append(lines, fmt.Sprintf("\t%sErrorTypename = %q", entity, resolved.errorTypeName))
+	}
+	lines = append(lines,
+		")",
+		"",
+		fmt.Sprintf("const %sQuery = %q", entity, query),
+		"",
+	)
+
+	refTypeName := func(r resolvedConnectionFieldRef) string { return connectionFieldRefTypeName(entity, r) }
+	for _, r := range resolved.refs {
+		lines = append(lines, renderFieldStruct(refTypeName(r), r.fields)...)
+	}
+
+	nodeFields := append([]resolvedField{}, resolved.fields...)
+	nodeLines := []string{fmt.Sprintf("type %sNode struct {", entity)}
+	for _, f := range nodeFields {
+		goType := f.GoType
+		if f.Optional {
+			goType = "*" + goType
+		}
+		nodeLines = append(nodeLines, fmt.Sprintf("\t%s %s `json:\"%s\"`", f.GoName, goType, f.Name))
+	}
+	for _, r := range resolved.refs {
+		nodeLines = append(nodeLines, fmt.Sprintf("\t%s *%s `json:\"%s\"`", r.goName, refTypeName(r), r.name))
+	}
+	nodeLines = append(nodeLines, "}", "")
+	lines = append(lines, nodeLines...)
+
+	lines = append(lines, fmt.Sprintf("type %sEdge struct {", entity))
+	if resolved.edgeHasCursor {
+		lines = append(lines, "\tCursor *string `json:\"cursor\"`")
+	}
+	lines = append(lines,
+		fmt.Sprintf("\tNode %sNode `json:\"node\"`", entity),
+		"}",
+		"",
+	)
+
+	lines = append(lines,
+		fmt.Sprintf("type %sConnection struct {", entity),
+		"\tPageInfo PageInfo `json:\"pageInfo\"`",
+		fmt.Sprintf("\tEdges []%sEdge `json:\"edges\"`", entity),
+	)
+	if resolved.connectionHasNodes {
+		lines = append(lines, fmt.Sprintf("\tNodes []%sNode `json:\"nodes\"`", entity))
+	}
+	lines = append(lines, "}", "")
+
+	if fastJSON {
+		lines = append(lines, renderFastJSONMarshal(entity+"Node", nodeFastjsonFields)...)
+		lines = append(lines, renderFastJSONUnmarshal(entity+"Node", nodeFastjsonFields)...)
+		lines = append(lines, renderFastJSONMarshal(entity+"Edge", edgeFastjsonFields)...)
+		lines = append(lines, renderFastJSONUnmarshal(entity+"Edge", edgeFastjsonFields)...)
+		lines = append(lines, renderFastJSONMarshal(entity+"Connection", connFastjsonFields)...)
+		lines = append(lines, renderFastJSONUnmarshal(entity+"Connection", connFastjsonFields)...)
+		for _, r := range resolved.refs {
+			refFields, err := fastjsonRefFields(entity, r)
+			if err != nil {
+				return "", err
+			}
+			refType := refTypeName(r)
+			lines = append(lines, renderFastJSONMarshal(refType, refFields)...)
+			lines = append(lines, renderFastJSONUnmarshal(refType, refFields)...)
+		}
+	}
+
+	if resolved.emitHelpers {
+		lines = append(lines, renderConnectionFieldHelpers(resolved)...)
+	}
+
+	lines = append(lines, RenderConnectionFieldClientInterface(resolved)...)
+	lines = append(lines, RenderConnectionFieldIterator(resolved)...)
+	if resolved.emitSeqIterator {
+		lines = append(lines, RenderConnectionFieldSeqIterator(resolved)...)
+	}
+
+	if resolved.errorTypeName != "" {
+		errorExtensionsType := entity + "ErrorExtensions"
+		lines = append(lines, fmt.Sprintf("type %s struct {", errorExtensionsType))
+		if resolved.errorExtensionsHasStatusCode {
+			lines = append(lines, "\tStatusCode "+connectionFieldGoType("int", resolved.errorExtensionsStatusCodeNullable)+" "+connectionFieldJSONTag("statusCode", resolved.errorExtensionsStatusCodeNullable))
+		}
+		lines = append(lines, "}", "")
+
+		lines = append(lines,
+			fmt.Sprintf("type %sError struct {", entity),
+			"\tMessage "+connectionFieldGoType("string", resolved.errorMessageNullable)+" "+connectionFieldJSONTag("message", resolved.errorMessageNullable),
+		)
+		if resolved.errorHasExtensions {
+			lines = append(lines, "\tExtensions "+connectionFieldGoType(errorExtensionsType, resolved.errorExtensionsNullable)+" "+connectionFieldJSONTag("extensions", resolved.errorExtensionsNullable))
+		}
+		lines = append(lines, "}", "")
+	}
+
+	unionMembers := []UnionMember{
+		{Name: "Connection", TypenameConst: entity + "ConnectionTypename", GoFieldName: "Connection", PayloadType: entity + "Connection"},
+	}
+	if resolved.errorTypeName != "" {
+		unionMembers = append(unionMembers, UnionMember{
+			Name: "Error", TypenameConst: entity + "ErrorTypename", GoFieldName: "Error", PayloadType: entity + "Error", IsError: true,
+		})
+	}
+	lines = append(lines, renderUnionResult(entity, unionMembers)...)
+	if resolved.emitHelpers {
+		lines = append(lines, renderConnectionFieldResultIsZero(entity, unionMembers)...)
+	}
+
+	dataType := entity + "Data"
+	lines = append(lines,
+		fmt.Sprintf("type %s struct {", dataType),
+	)
+	if m.Namespace != "" {
+		lines = append(lines,
+			fmt.Sprintf("\t%s struct {", ToGoName(m.Namespace)),
+			fmt.Sprintf("\t\t%s %sResult `json:\"%s\"`", ToGoName(m.ParentField), entity, m.ParentField),
+			fmt.Sprintf("\t} `json:\"%s\"`", m.Namespace),
+		)
+	} else {
+		lines = append(lines, fmt.Sprintf("\t%s %sResult `json:\"%s\"`", ToGoName(m.ParentField), entity, m.ParentField))
+	}
+	lines = append(lines, "}", "")
+
+	resultPath := fmt.Sprintf("out.%s", ToGoName(m.ParentField))
+	if m.Namespace != "" {
+		resultPath = fmt.Sprintf("out.%s.%s", ToGoName(m.Namespace), ToGoName(m.ParentField))
+	}
+	lines = append(lines,
+		fmt.Sprintf("func Decode%s(data map[string]any) (*%sData, error) {", entity, entity),
+		"\tb, err := json.Marshal(data)",
+		"\tif err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		fmt.Sprintf("\tvar out %s", dataType),
+		"\tif err := json.Unmarshal(b, &out); err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		fmt.Sprintf("\t_ = %s", resultPath),
+		"\treturn &out, nil",
+		"}",
+		"",
+	)
+
+	lines = append(lines, RenderConnectionFieldFetch(resolved)...)
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderConnectionFieldQuery builds the GraphQL query text for one ConnectionFieldManifest
+// target, mirroring the hand-rolled query template the scorecards-only generator this replaces
+// used to build by hand for each connection field.
+func renderConnectionFieldQuery(m *ConnectionFieldManifest, resolved *ResolvedConnectionField) string {
+	pageInfoSelect := "pageInfo { hasNextPage"
+	if resolved.pageInfoHasEndCursor {
+		pageInfoSelect += " endCursor"
+	}
+	pageInfoSelect += " }"
+
+	nodeFields := make([]string, 0, len(resolved.fields)+len(resolved.refs))
+	for _, f := range resolved.fields {
+		nodeFields = append(nodeFields, f.Name)
+	}
+	for _, r := range resolved.refs {
+		sub := make([]string, len(r.fields))
+		for i, f := range r.fields {
+			sub[i] = f.Name
+		}
+		nodeFields = append(nodeFields, r.name+" { "+strings.Join(sub, " ")+" }")
+	}
+	nodeSelect := strings.Join(nodeFields, " ")
+
+	edgeSelect := "node { " + nodeSelect + " }"
+	if resolved.edgeHasCursor {
+		edgeSelect = "cursor " + edgeSelect
+	}
+
+	nodesSelect := ""
+	if resolved.connectionHasNodes {
+		nodesSelect = "\n          nodes { " + nodeSelect + " }"
+	}
+
+	errorFragment := ""
+	if resolved.errorTypeName != "" {
+		errorFields := "message"
+		if resolved.errorHasExtensions && resolved.errorExtensionsHasStatusCode {
+			errorFields += " extensions { statusCode }"
+		}
+		errorFragment = fmt.Sprintf("\n      ... on %s { %s }", resolved.errorTypeName, errorFields)
+	}
+
+	root := fmt.Sprintf("%s(id: $%s) {\n      __typename\n      ... on %s {\n        %s {\n          %s\n          edges { %s }%s\n        }\n      }%s\n    }", m.ParentField, m.ParentIDArgName, resolved.entityTypeName, m.ConnectionFieldName, pageInfoSelect, edgeSelect, nodesSelect, errorFragment)
+	if m.Namespace != "" {
+		root = fmt.Sprintf("%s {\n    %s\n  }", m.Namespace, root)
+	}
+	return fmt.Sprintf("query %s(\n  $%s: %s\n) {\n  %s\n}\n", m.EntityName, m.ParentIDArgName, resolved.parentIDType, root)
+}
+
+func connectionFieldGoType(base string, nullable bool) string {
+	if !nullable {
+		return base
+	}
+	if strings.HasPrefix(base, "[]") {
+		return base
+	}
+	return "*" + base
+}
+
+func connectionFieldJSONTag(name string, nullable bool) string {
+	if nullable {
+		return fmt.Sprintf("`json:\"%s,omitempty\"`", name)
+	}
+	return fmt.Sprintf("`json:\"%s\"`", name)
+}
@@ -0,0 +1,148 @@
+package codegen
+
+import "fmt"
+
+// RenderConnectionFieldSeqIterator renders Iterate{Entity} (a range-over-func iter.Seq2 iterator)
+// and Iterate{Entity}Chan (its channel-based equivalent for Go versions before 1.23's
+// range-over-func), for ConnectionFieldManifest targets that set EmitSeqIterator. Unlike
+// {Entity}Iterator's Next()-based transport, these consume a fetch callback returning the whole
+// {Entity}Result so they can stop on the first typed {Entity}Error instead of only a transport-level
+// Go error.
+func RenderConnectionFieldSeqIterator(resolved *ResolvedConnectionField) []string {
+	m := resolved.manifest
+	entity := m.EntityName
+	optType := entity + "IterOpt"
+	configType := entity + "IterConfig"
+	fetchType := fmt.Sprintf("func(ctx context.Context, after *string, pageSize int) (*%sResult, error)", entity)
+
+	lines := []string{
+		fmt.Sprintf("// %s configures Iterate%s/Iterate%sChan.", configType, entity, entity),
+		fmt.Sprintf("type %s struct {", configType),
+		"\tPageSize int",
+		"}",
+		"",
+		fmt.Sprintf("// %s is a functional option for Iterate%s/Iterate%sChan.", optType, entity, entity),
+		fmt.Sprintf("type %s func(*%s)", optType, configType),
+		"",
+		fmt.Sprintf("// With%sPageSize sets the page size Iterate%s/Iterate%sChan pass to fetch; the zero", entity, entity, entity),
+		"// value (the default) leaves page sizing entirely up to fetch's own implementation.",
+		fmt.Sprintf("func With%sPageSize(n int) %s {", entity, optType),
+		fmt.Sprintf("\treturn func(c *%s) { c.PageSize = n }", configType),
+		"}",
+		"",
+		fmt.Sprintf("// Iterate%s returns a range-over-func iterator that pages through a %sConnection via", entity, entity),
+		"// fetch, yielding one node at a time. It stops - without fetching a further page - the moment",
+		"// the caller's range body returns false, ctx is canceled, the connection is exhausted, or",
+		fmt.Sprintf("// fetch's %sResult resolves to its error variant, in which case the final yielded error", entity),
+		"// wraps that variant's message.",
+		fmt.Sprintf("func Iterate%s(ctx context.Context, fetch %s, opts ...%s) iter.Seq2[*%sNode, error] {", entity, fetchType, optType, entity),
+		fmt.Sprintf("\tvar cfg %s", configType),
+		"\tfor _, opt := range opts {",
+		"\t\topt(&cfg)",
+		"\t}",
+		fmt.Sprintf("\treturn func(yield func(*%sNode, error) bool) {", entity),
+		"\t\tvar after *string",
+		"\t\tfor {",
+		"\t\t\tif err := ctx.Err(); err != nil {",
+		"\t\t\t\tyield(nil, err)",
+		"\t\t\t\treturn",
+		"\t\t\t}",
+		"\t\t\tresult, err := fetch(ctx, after, cfg.PageSize)",
+		"\t\t\tif err != nil {",
+		"\t\t\t\tyield(nil, err)",
+		"\t\t\t\treturn",
+		"\t\t\t}",
+	}
+	if resolved.errorTypeName != "" {
+		lines = append(lines,
+			"\t\t\tif result.Error != nil {",
+			"\t\t\t\tyield(nil, fmt.Errorf(\"%s\", result.Error.Message))",
+			"\t\t\t\treturn",
+			"\t\t\t}",
+		)
+	}
+	lines = append(lines,
+		"\t\t\tif result.Connection == nil {",
+		"\t\t\t\treturn",
+		"\t\t\t}",
+		"\t\t\tfor _, edge := range result.Connection.Edges {",
+		"\t\t\t\tnode := edge.Node",
+		"\t\t\t\tif !yield(&node, nil) {",
+		"\t\t\t\t\treturn",
+		"\t\t\t\t}",
+		"\t\t\t}",
+		"\t\t\tif !result.Connection.PageInfo.HasNextPage {",
+		"\t\t\t\treturn",
+		"\t\t\t}",
+	)
+	lines = append(lines, connectionFieldSeqIteratorAdvance(resolved)...)
+	lines = append(lines,
+		"\t\t}",
+		"\t}",
+		"}",
+		"",
+	)
+
+	resultType := entity + "IterResult"
+	lines = append(lines,
+		fmt.Sprintf("// %s is one value sent on the channel Iterate%sChan returns.", resultType, entity),
+		fmt.Sprintf("type %s struct {", resultType),
+		fmt.Sprintf("\tNode *%sNode", entity),
+		"\tErr  error",
+		"}",
+		"",
+		fmt.Sprintf("// Iterate%sChan is Iterate%s's channel-based equivalent, for Go versions before 1.23's", entity, entity),
+		"// range-over-func iterators. Cancel ctx, or stop receiving, to end iteration early; the",
+		"// returned channel is always closed once iteration ends, including on error.",
+		fmt.Sprintf("func Iterate%sChan(ctx context.Context, fetch %s, opts ...%s) <-chan %s {", entity, fetchType, optType, resultType),
+		fmt.Sprintf("\tch := make(chan %s)", resultType),
+		"\tgo func() {",
+		"\t\tdefer close(ch)",
+		fmt.Sprintf("\t\tfor node, err := range Iterate%s(ctx, fetch, opts...) {", entity),
+		"\t\t\tselect {",
+		fmt.Sprintf("\t\t\tcase ch <- %s{Node: node, Err: err}:", resultType),
+		"\t\t\tcase <-ctx.Done():",
+		"\t\t\t\treturn",
+		"\t\t\t}",
+		"\t\t\tif err != nil {",
+		"\t\t\t\treturn",
+		"\t\t\t}",
+		"\t\t}",
+		"\t}()",
+		"\treturn ch",
+		"}",
+		"",
+	)
+
+	return lines
+}
+
+// connectionFieldSeqIteratorAdvance renders Iterate{Entity}'s "compute the next resume cursor"
+// tail, the Iterate{Entity} counterpart to connectionFieldIteratorAdvance.
+func connectionFieldSeqIteratorAdvance(resolved *ResolvedConnectionField) []string {
+	switch {
+	case resolved.pageInfoHasEndCursor:
+		if resolved.pageInfoEndCursorNullable {
+			return []string{
+				"\t\t\tif result.Connection.PageInfo.EndCursor == nil {",
+				"\t\t\t\treturn",
+				"\t\t\t}",
+				"\t\t\tafter = result.Connection.PageInfo.EndCursor",
+			}
+		}
+		return []string{"\t\t\tafter = &result.Connection.PageInfo.EndCursor"}
+	case resolved.edgeHasCursor:
+		return []string{
+			"\t\t\tif len(result.Connection.Edges) == 0 {",
+			"\t\t\t\treturn",
+			"\t\t\t}",
+			"\t\t\tlast := result.Connection.Edges[len(result.Connection.Edges)-1]",
+			"\t\t\tif last.Cursor == nil {",
+			"\t\t\t\treturn",
+			"\t\t\t}",
+			"\t\t\tafter = last.Cursor",
+		}
+	default:
+		return []string{"\t\t\treturn"}
+	}
+}
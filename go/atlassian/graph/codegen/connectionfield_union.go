@@ -0,0 +1,109 @@
+package codegen
+
+import "fmt"
+
+// UnionMember describes one variant of a discriminated GraphQL union result - the connection
+// payload, a typed error, or any additional variant (ValidationError, NotFoundError, a
+// permission-denied shape, etc.) a real Atlassian API might return alongside them. renderUnionResult
+// uses these to emit an N-way {Entity}Result.UnmarshalJSON switch instead of hardcoding a
+// connection-or-error pair as the only two shapes a generated result type can hold.
+type UnionMember struct {
+	// Name identifies this member in generated accessor names, e.g. "Connection", "NotFoundError".
+	Name string
+	// TypenameConst is the Go constant (already emitted elsewhere in the file) holding the GraphQL
+	// __typename value this member matches, e.g. "TeamConnectionTypename".
+	TypenameConst string
+	// GoFieldName is the struct field on the generated {Entity}Result holding this member's
+	// decoded payload, e.g. "Connection", "NotFoundError".
+	GoFieldName string
+	// PayloadType is the Go type this member decodes onto, e.g. "TeamConnection", "TeamError".
+	PayloadType string
+	// IsError marks this member as an error-class variant; the generated IsError() method returns
+	// true when the matched member has IsError set.
+	IsError bool
+}
+
+// renderUnionResult emits an {Entity}Result type discriminated by __typename across members, an
+// As{Name}() (*PayloadType, bool) accessor per member, and an IsError() bool helper. A __typename
+// matching none of members isn't a decode error: it's kept as raw JSON on the result's Unknown
+// field, so a union variant added server-side after this code was generated doesn't break
+// existing callers that haven't regenerated against it yet.
+func renderUnionResult(entity string, members []UnionMember) []string {
+	lines := []string{
+		fmt.Sprintf("type %sResult struct {", entity),
+		"\tTypename string `json:\"__typename\"`",
+	}
+	for _, mem := range members {
+		lines = append(lines, fmt.Sprintf("\t%s *%s `json:\"-\"`", mem.GoFieldName, mem.PayloadType))
+	}
+	lines = append(lines,
+		"\t// Unknown holds the raw JSON payload when Typename didn't match any member above - e.g.",
+		"\t// a union variant the server added after this code was generated.",
+		"\tUnknown json.RawMessage `json:\"-\"`",
+		"}",
+		"",
+	)
+
+	lines = append(lines,
+		fmt.Sprintf("func (r *%sResult) UnmarshalJSON(data []byte) error {", entity),
+		"\tvar base struct {",
+		"\t\tTypename string `json:\"__typename\"`",
+		"\t}",
+		"\tif err := json.Unmarshal(data, &base); err != nil {",
+		"\t\treturn err",
+		"\t}",
+		"\tif base.Typename == \"\" {",
+		fmt.Sprintf("\t\treturn errors.New(%q)", "missing __typename for "+entity+"Result"),
+		"\t}",
+		"\tr.Typename = base.Typename",
+		"\tswitch base.Typename {",
+	)
+	for _, mem := range members {
+		lines = append(lines,
+			fmt.Sprintf("\tcase %s:", mem.TypenameConst),
+			fmt.Sprintf("\t\tvar v %s", mem.PayloadType),
+			"\t\tif err := json.Unmarshal(data, &v); err != nil {",
+			"\t\t\treturn err",
+			"\t\t}",
+			fmt.Sprintf("\t\tr.%s = &v", mem.GoFieldName),
+		)
+	}
+	lines = append(lines,
+		"\tdefault:",
+		"\t\tr.Unknown = append(json.RawMessage(nil), data...)",
+		"\t}",
+		"\treturn nil",
+		"}",
+		"",
+	)
+
+	for _, mem := range members {
+		lines = append(lines,
+			fmt.Sprintf("func (r *%sResult) As%s() (*%s, bool) {", entity, mem.Name, mem.PayloadType),
+			fmt.Sprintf("\treturn r.%s, r.%s != nil", mem.GoFieldName, mem.GoFieldName),
+			"}",
+			"",
+		)
+	}
+
+	lines = append(lines,
+		fmt.Sprintf("// IsError reports whether %sResult decoded to one of its error-class members.", entity),
+		fmt.Sprintf("func (r *%sResult) IsError() bool {", entity),
+		"\tswitch {",
+	)
+	for _, mem := range members {
+		if !mem.IsError {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("\tcase r.%s != nil:", mem.GoFieldName), "\t\treturn true")
+	}
+	lines = append(lines,
+		"\tdefault:",
+		"\t\treturn false",
+		"\t}",
+		"}",
+		"",
+	)
+
+	return lines
+}
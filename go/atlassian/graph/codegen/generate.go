@@ -0,0 +1,390 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type resolvedField struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Optional bool
+}
+
+type resolvedLookup struct {
+	namespace string
+	fieldName string
+	argOrder  []string
+	argTypes  map[string]string
+	fields    []resolvedField
+}
+
+type resolvedConnection struct {
+	namespace            string
+	fieldName            string
+	argOrder             []string
+	argTypes             map[string]string
+	filterArgName        string
+	filterInputFieldName string
+	filterInputType      string
+	optInDirective       string
+	nodeFieldPath        []string
+	nodeOptional         bool
+	fields               []resolvedField
+	pageInfoHasEndCursor bool
+}
+
+type resolvedEnum struct {
+	graphQLName string
+	goName      string
+	values      []string
+}
+
+// ResolvedOperations is a manifest validated against a live schema: every field, arg and type it
+// names has been confirmed to exist, with Go types already resolved via the TypeMapper. Render
+// only has to stitch strings together; ResolveOperations is where introspection errors surface.
+type ResolvedOperations struct {
+	manifest        *OperationManifest
+	lookup          *resolvedLookup
+	connection      *resolvedConnection
+	enums           []resolvedEnum
+	extraImports    []string
+	supportSnippets []string
+}
+
+// ResolveOperations validates manifest against schema (existence of every referenced field, arg,
+// input field and PageInfo shape) and resolves Go types for all selected fields via tm, returning
+// a value Render can turn into source. It returns a descriptive error naming the missing
+// field/type/arg the moment validation fails, rather than deferring to a panic or a malformed
+// template.
+func ResolveOperations(schema map[string]any, manifest *OperationManifest, tm *TypeMapper) (*ResolvedOperations, error) {
+	if manifest.Lookup == nil && manifest.Connection == nil {
+		return nil, errors.New("manifest must set at least one of lookup or connection")
+	}
+	types, err := TypesMap(schema)
+	if err != nil {
+		return nil, err
+	}
+	queryDef, err := QueryTypeDef(schema, types)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ResolvedOperations{manifest: manifest}
+	scalarsUsed := map[string]bool{}
+	enumsUsed := map[string]string{} // graphQLName -> goName
+
+	if manifest.Lookup != nil {
+		resolved, err := resolveLookup(queryDef, types, manifest.Lookup, tm, scalarsUsed, enumsUsed)
+		if err != nil {
+			return nil, err
+		}
+		out.lookup = resolved
+	}
+	if manifest.Connection != nil {
+		resolved, err := resolveConnection(schema, queryDef, types, manifest.Connection, tm, scalarsUsed, enumsUsed)
+		if err != nil {
+			return nil, err
+		}
+		out.connection = resolved
+	}
+
+	importSet := map[string]bool{}
+	var supportSnippets []string
+	for name := range scalarsUsed {
+		mapping, _ := tm.ScalarMapping(name)
+		if mapping.Import != "" {
+			importSet[mapping.Import] = true
+		}
+		if mapping.Support != "" {
+			supportSnippets = append(supportSnippets, mapping.Support)
+		}
+	}
+	for imp := range importSet {
+		out.extraImports = append(out.extraImports, imp)
+	}
+	sort.Strings(out.extraImports)
+	sort.Strings(supportSnippets)
+	out.supportSnippets = supportSnippets
+
+	var enumNames []string
+	for graphQLName := range enumsUsed {
+		enumNames = append(enumNames, graphQLName)
+	}
+	sort.Strings(enumNames)
+	for _, graphQLName := range enumNames {
+		resolved, err := resolveEnum(types, graphQLName, enumsUsed[graphQLName])
+		if err != nil {
+			return nil, err
+		}
+		out.enums = append(out.enums, resolved)
+	}
+
+	return out, nil
+}
+
+// resolveEnum looks up a GraphQL ENUM type's enumValues and pairs them with the Go type name
+// registered for it via TypeMapper.RegisterEnum.
+func resolveEnum(types map[string]map[string]any, graphQLName, goName string) (resolvedEnum, error) {
+	def := types[graphQLName]
+	if def == nil {
+		return resolvedEnum{}, fmt.Errorf("missing enum type definition: %s", graphQLName)
+	}
+	raw, _ := def["enumValues"].([]any)
+	var values []string
+	for _, v := range raw {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _ := m["name"].(string); name != "" {
+			values = append(values, name)
+		}
+	}
+	if len(values) == 0 {
+		return resolvedEnum{}, fmt.Errorf("enum %s has no enumValues", graphQLName)
+	}
+	return resolvedEnum{graphQLName: graphQLName, goName: goName, values: values}, nil
+}
+
+func resolveNamespace(queryDef map[string]any, types map[string]map[string]any, namespace string) (map[string]any, error) {
+	if namespace == "" {
+		return queryDef, nil
+	}
+	field := GetField(queryDef, namespace)
+	if field == nil {
+		return nil, fmt.Errorf("missing namespace field Query.%s", namespace)
+	}
+	typeName, _ := UnwrapNamedType(field["type"])
+	def := types[typeName]
+	if def == nil {
+		return nil, fmt.Errorf("missing namespace type definition: %s", typeName)
+	}
+	return def, nil
+}
+
+func resolveFields(nodeDef map[string]any, nodeTypeName string, manifestFields []FieldManifest, types map[string]map[string]any, tm *TypeMapper, scalarsUsed map[string]bool, enumsUsed map[string]string) ([]resolvedField, error) {
+	fields := make([]resolvedField, 0, len(manifestFields))
+	var missing []string
+	for _, mf := range manifestFields {
+		f := GetField(nodeDef, mf.Name)
+		if f == nil {
+			missing = append(missing, fmt.Sprintf("type %s.fields.%s", nodeTypeName, mf.Name))
+			continue
+		}
+		res, err := ResolveFieldType(f["type"], types, tm)
+		if err != nil {
+			return nil, err
+		}
+		if res.ScalarName != "" {
+			scalarsUsed[res.ScalarName] = true
+		}
+		if res.EnumName != "" {
+			enumsUsed[res.EnumName] = res.GoType
+		}
+		optional := !IsNonNull(f["type"])
+		if mf.ForceOptional != nil {
+			optional = *mf.ForceOptional
+		}
+		fields = append(fields, resolvedField{
+			Name:     mf.Name,
+			GoName:   ToGoName(mf.Name),
+			GoType:   res.GoType,
+			Optional: optional,
+		})
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing required fields:\n- %s", strings.Join(missing, "\n- "))
+	}
+	return fields, nil
+}
+
+func resolveLookup(queryDef map[string]any, types map[string]map[string]any, m *LookupManifest, tm *TypeMapper, scalarsUsed map[string]bool, enumsUsed map[string]string) (*resolvedLookup, error) {
+	namespaceDef, err := resolveNamespace(queryDef, types, m.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var field map[string]any
+	var fieldName string
+	for _, candidate := range m.LookupFieldCandidates {
+		if f := GetField(namespaceDef, candidate); f != nil {
+			field = f
+			fieldName = candidate
+			break
+		}
+	}
+	if field == nil {
+		return nil, fmt.Errorf("missing lookup field (tried %s)", strings.Join(m.LookupFieldCandidates, ", "))
+	}
+
+	argTypes := make(map[string]string, len(m.ArgNames))
+	for _, argName := range m.ArgNames {
+		arg := GetArg(field, argName)
+		if arg == nil {
+			return nil, fmt.Errorf("lookup field %s missing %s arg", fieldName, argName)
+		}
+		gqlType, err := TypeRefToGQL(arg["type"])
+		if err != nil {
+			return nil, err
+		}
+		argTypes[argName] = gqlType
+	}
+
+	nodeTypeName, _ := UnwrapNamedType(field["type"])
+	nodeDef := types[nodeTypeName]
+	if nodeDef == nil {
+		return nil, fmt.Errorf("missing lookup node type definition: %s", nodeTypeName)
+	}
+	fields, err := resolveFields(nodeDef, nodeTypeName, m.Fields, types, tm, scalarsUsed, enumsUsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resolvedLookup{
+		namespace: m.Namespace,
+		fieldName: fieldName,
+		argOrder:  m.ArgNames,
+		argTypes:  argTypes,
+		fields:    fields,
+	}, nil
+}
+
+func resolveConnection(schema map[string]any, queryDef map[string]any, types map[string]map[string]any, m *ConnectionManifest, tm *TypeMapper, scalarsUsed map[string]bool, enumsUsed map[string]string) (*resolvedConnection, error) {
+	namespaceDef, err := resolveNamespace(queryDef, types, m.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	field := GetField(namespaceDef, m.FieldName)
+	if field == nil {
+		return nil, fmt.Errorf("missing connection field %s", m.FieldName)
+	}
+
+	argTypes := make(map[string]string, len(m.ArgNames))
+	for _, argName := range m.ArgNames {
+		arg := GetArg(field, argName)
+		if arg == nil {
+			return nil, fmt.Errorf("connection field %s missing %s arg", m.FieldName, argName)
+		}
+		gqlType, err := TypeRefToGQL(arg["type"])
+		if err != nil {
+			return nil, err
+		}
+		argTypes[argName] = gqlType
+	}
+
+	filterInputType := ""
+	if m.FilterArgName != "" {
+		filterArg := GetArg(field, m.FilterArgName)
+		if filterArg == nil {
+			return nil, fmt.Errorf("connection field %s missing %s arg", m.FieldName, m.FilterArgName)
+		}
+		filterTypeName, _ := UnwrapNamedType(filterArg["type"])
+		filterDef := types[filterTypeName]
+		if filterDef == nil {
+			return nil, fmt.Errorf("missing filter input type %s", filterTypeName)
+		}
+		filterField := GetInputField(filterDef, m.FilterInputFieldName)
+		if filterField == nil {
+			return nil, fmt.Errorf("missing input field %s.%s", filterTypeName, m.FilterInputFieldName)
+		}
+		filterInputType, err = TypeRefToGQL(filterField["type"])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	connTypeName, _ := UnwrapNamedType(field["type"])
+	connDef := types[connTypeName]
+	if connDef == nil {
+		return nil, fmt.Errorf("missing connection type %s", connTypeName)
+	}
+	nodesField := GetField(connDef, "nodes")
+	pageInfoField := GetField(connDef, "pageInfo")
+	if nodesField == nil {
+		return nil, fmt.Errorf("missing nodes field on %s", connTypeName)
+	}
+	if pageInfoField == nil {
+		return nil, fmt.Errorf("missing pageInfo field on %s", connTypeName)
+	}
+
+	nodeTypeName, _ := UnwrapNamedType(nodesField["type"])
+	nodeDef := types[nodeTypeName]
+	if nodeDef == nil {
+		return nil, fmt.Errorf("missing connection node type %s", nodeTypeName)
+	}
+	nodeOptional := false
+	entityTypeName := nodeTypeName
+	entityDef := nodeDef
+	for _, step := range m.NodeFieldPath {
+		stepField := GetField(entityDef, step)
+		if stepField == nil {
+			return nil, fmt.Errorf("missing node field %s.%s", entityTypeName, step)
+		}
+		nodeOptional = !IsNonNull(stepField["type"])
+		entityTypeName, _ = UnwrapNamedType(stepField["type"])
+		entityDef = types[entityTypeName]
+		if entityDef == nil {
+			return nil, fmt.Errorf("missing node type definition: %s", entityTypeName)
+		}
+	}
+
+	pageInfoTypeName, _ := UnwrapNamedType(pageInfoField["type"])
+	pageInfoDef := types[pageInfoTypeName]
+	if pageInfoDef == nil {
+		return nil, fmt.Errorf("missing PageInfo type definition: %s", pageInfoTypeName)
+	}
+	if GetField(pageInfoDef, "hasNextPage") == nil {
+		return nil, fmt.Errorf("missing PageInfo.hasNextPage on %s", pageInfoTypeName)
+	}
+	pageInfoHasEndCursor := GetField(pageInfoDef, "endCursor") != nil
+
+	if m.OptInDirective != "" && !HasDirective(schema, "optIn") {
+		return nil, errors.New("schema missing optIn directive")
+	}
+
+	fields, err := resolveFields(entityDef, entityTypeName, m.Fields, types, tm, scalarsUsed, enumsUsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resolvedConnection{
+		namespace:            m.Namespace,
+		fieldName:            m.FieldName,
+		argOrder:             m.ArgNames,
+		argTypes:             argTypes,
+		filterArgName:        m.FilterArgName,
+		filterInputFieldName: m.FilterInputFieldName,
+		filterInputType:      filterInputType,
+		optInDirective:       m.OptInDirective,
+		nodeFieldPath:        m.NodeFieldPath,
+		nodeOptional:         nodeOptional,
+		fields:               fields,
+		pageInfoHasEndCursor: pageInfoHasEndCursor,
+	}, nil
+}
+
+// ToGoName converts a GraphQL field name (camelCase) to an exported Go identifier, upper-casing
+// the common ID/URL initialisms the way the rest of this codebase does (e.g. NormalizeTeamID).
+func ToGoName(name string) string {
+	if name == "" {
+		return ""
+	}
+	var out []rune
+	for i, r := range name {
+		if i == 0 {
+			out = append(out, []rune(strings.ToUpper(string(r)))...)
+			continue
+		}
+		out = append(out, r)
+	}
+	s := string(out)
+	for _, r := range []struct{ from, to string }{{"Id", "ID"}, {"Url", "URL"}} {
+		s = strings.ReplaceAll(s, r.from, r.to)
+	}
+	return s
+}
@@ -0,0 +1,242 @@
+// Package codegen centralizes the GraphQL introspection plumbing shared by the
+// go/tools/generate_*_models generators: walking a schema.introspection.json document, resolving
+// field/arg/type definitions, and mapping GraphQL types to Go types. Individual generators still
+// own their CLI wiring and output paths, but describe *what* to generate via an OperationManifest
+// instead of hand-rolling their own introspection walk.
+package codegen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// LoadSchema extracts the __schema object from a raw introspection response, which may be the
+// bare `{"__schema": ...}` shape or the full `{"data": {"__schema": ...}}` GraphQL envelope.
+func LoadSchema(raw []byte) (map[string]any, error) {
+	var envelope map[string]any
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, err
+	}
+	if data, ok := envelope["data"].(map[string]any); ok {
+		if schema, ok := data["__schema"].(map[string]any); ok {
+			return schema, nil
+		}
+	}
+	if schema, ok := envelope["__schema"].(map[string]any); ok {
+		return schema, nil
+	}
+	return nil, errors.New("introspection JSON missing data.__schema")
+}
+
+// TypesMap indexes __schema.types[] by name for O(1) lookups during introspection.
+func TypesMap(schema map[string]any) (map[string]map[string]any, error) {
+	rawTypes, ok := schema["types"].([]any)
+	if !ok {
+		return nil, errors.New("introspection JSON missing __schema.types[]")
+	}
+	out := make(map[string]map[string]any)
+	for _, t := range rawTypes {
+		m, ok := t.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _ := m["name"].(string); name != "" {
+			out[name] = m
+		}
+	}
+	return out, nil
+}
+
+// QueryTypeDef resolves __schema.queryType to its full type definition in types.
+func QueryTypeDef(schema map[string]any, types map[string]map[string]any) (map[string]any, error) {
+	queryType, ok := schema["queryType"].(map[string]any)
+	if !ok {
+		return nil, errors.New("introspection JSON missing __schema.queryType")
+	}
+	name, _ := queryType["name"].(string)
+	if name == "" {
+		return nil, errors.New("introspection JSON missing __schema.queryType.name")
+	}
+	def := types[name]
+	if def == nil {
+		return nil, fmt.Errorf("missing query type definition: %s", name)
+	}
+	return def, nil
+}
+
+// MutationTypeDef resolves __schema.mutationType to its full type definition in types. Unlike
+// QueryTypeDef, a missing mutationType is a schema that simply has no mutations, not malformed
+// introspection JSON - callers decide whether that's fatal for them.
+func MutationTypeDef(schema map[string]any, types map[string]map[string]any) (map[string]any, error) {
+	mutationType, ok := schema["mutationType"].(map[string]any)
+	if !ok {
+		return nil, errors.New("schema has no mutationType")
+	}
+	name, _ := mutationType["name"].(string)
+	if name == "" {
+		return nil, errors.New("introspection JSON missing __schema.mutationType.name")
+	}
+	def := types[name]
+	if def == nil {
+		return nil, fmt.Errorf("missing mutation type definition: %s", name)
+	}
+	return def, nil
+}
+
+// UnwrapNamedType walks through NON_NULL/LIST wrappers to find the underlying named type.
+func UnwrapNamedType(typeRef any) (name string, kind string) {
+	cur, _ := typeRef.(map[string]any)
+	for i := 0; i < 16 && cur != nil; i++ {
+		if n, ok := cur["name"].(string); ok && n != "" {
+			name = n
+			kind, _ = cur["kind"].(string)
+			return
+		}
+		next, _ := cur["ofType"].(map[string]any)
+		cur = next
+	}
+	return "", ""
+}
+
+// TypeRefToGQL renders a typeRef back into GraphQL type syntax, e.g. "[ID!]!".
+func TypeRefToGQL(typeRef any) (string, error) {
+	m, ok := typeRef.(map[string]any)
+	if !ok {
+		return "", errors.New("invalid typeRef")
+	}
+	kind, _ := m["kind"].(string)
+	switch kind {
+	case "NON_NULL":
+		inner, err := TypeRefToGQL(m["ofType"])
+		if err != nil {
+			return "", err
+		}
+		return inner + "!", nil
+	case "LIST":
+		inner, err := TypeRefToGQL(m["ofType"])
+		if err != nil {
+			return "", err
+		}
+		return "[" + inner + "]", nil
+	default:
+		name, _ := m["name"].(string)
+		if name == "" {
+			return "", errors.New("invalid named typeRef")
+		}
+		return name, nil
+	}
+}
+
+// GetField looks up a field by name on an OBJECT/INTERFACE type definition.
+func GetField(typeDef map[string]any, name string) map[string]any {
+	return findNamed(typeDef["fields"], name)
+}
+
+// GetInputField looks up a field by name on an INPUT_OBJECT type definition.
+func GetInputField(typeDef map[string]any, name string) map[string]any {
+	return findNamed(typeDef["inputFields"], name)
+}
+
+// GetArg looks up an argument by name on a field definition.
+func GetArg(fieldDef map[string]any, name string) map[string]any {
+	return findNamed(fieldDef["args"], name)
+}
+
+func findNamed(raw any, name string) map[string]any {
+	items, _ := raw.([]any)
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if m["name"] == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// IsNonNull reports whether a typeRef is wrapped in NON_NULL.
+func IsNonNull(typeRef any) bool {
+	m, ok := typeRef.(map[string]any)
+	if !ok {
+		return false
+	}
+	kind, _ := m["kind"].(string)
+	return kind == "NON_NULL"
+}
+
+// BaseTypeInfo strips NON_NULL/LIST wrappers and returns the named type's name and kind.
+func BaseTypeInfo(typeRef any) (name string, kind string) {
+	cur, _ := typeRef.(map[string]any)
+	for i := 0; i < 16 && cur != nil; i++ {
+		k, _ := cur["kind"].(string)
+		if k == "NON_NULL" || k == "LIST" {
+			next, _ := cur["ofType"].(map[string]any)
+			cur = next
+			kind = k
+			continue
+		}
+		name, _ = cur["name"].(string)
+		return name, k
+	}
+	return "", ""
+}
+
+// HasDirective reports whether the schema declares a directive with the given name, e.g. "optIn".
+func HasDirective(schema map[string]any, name string) bool {
+	raw, _ := schema["directives"].([]any)
+	for _, d := range raw {
+		m, ok := d.(map[string]any)
+		if !ok {
+			continue
+		}
+		if m["name"] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldTypeResolution is the result of resolving a field's typeRef against a TypeMapper: the Go
+// type to use, plus which scalar or enum (if either) it came from, so callers can track which
+// scalars/enums actually ended up in the generated output.
+type FieldTypeResolution struct {
+	GoType     string
+	ScalarName string
+	EnumName   string
+}
+
+// ResolveFieldType resolves the Go type for a field's typeRef via tm: SCALAR kinds consult
+// tm.ScalarMapping, ENUM kinds consult tm.EnumGoName and fall back to plain "string" when the enum
+// hasn't been registered (preserving the old flatten-to-string behavior for generators that don't
+// care about a particular enum).
+func ResolveFieldType(typeRef any, types map[string]map[string]any, tm *TypeMapper) (FieldTypeResolution, error) {
+	name, kind := BaseTypeInfo(typeRef)
+	if name == "" {
+		return FieldTypeResolution{}, errors.New("invalid typeRef")
+	}
+	switch kind {
+	case "SCALAR":
+		if mapping, ok := tm.ScalarMapping(name); ok {
+			return FieldTypeResolution{GoType: mapping.GoType, ScalarName: name}, nil
+		}
+		return FieldTypeResolution{}, fmt.Errorf("unsupported scalar %s", name)
+	case "ENUM":
+		if goName, ok := tm.EnumGoName(name); ok {
+			return FieldTypeResolution{GoType: goName, EnumName: name}, nil
+		}
+		return FieldTypeResolution{GoType: "string"}, nil
+	default:
+		if def, ok := types[name]; ok {
+			if k, _ := def["kind"].(string); k == "ENUM" {
+				if goName, ok := tm.EnumGoName(name); ok {
+					return FieldTypeResolution{GoType: goName, EnumName: name}, nil
+				}
+				return FieldTypeResolution{GoType: "string"}, nil
+			}
+		}
+		return FieldTypeResolution{}, fmt.Errorf("unsupported field type %s (%s)", name, kind)
+	}
+}
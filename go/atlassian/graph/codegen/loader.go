@@ -0,0 +1,14 @@
+package codegen
+
+import "encoding/json"
+
+// LoadManifest parses a JSON-encoded OperationManifest, e.g. one checked into a generator's
+// directory as manifest.json. YAML manifests can be supported the same way by converting to JSON
+// first (ghodss/yaml-style); this repo has no YAML dependency today, so only JSON is wired up.
+func LoadManifest(raw []byte) (*OperationManifest, error) {
+	var m OperationManifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
@@ -0,0 +1,75 @@
+package codegen
+
+// FieldManifest names a scalar/enum field to select off a GraphQL object type and decode onto the
+// generated Go struct.
+type FieldManifest struct {
+	// Name is the GraphQL field name, e.g. "displayName".
+	Name string `json:"name"`
+
+	// ForceOptional overrides the schema's own NON_NULL-ness for this field's generated Go type -
+	// nil (the default) uses whatever the schema says, true forces a pointer type even for a
+	// NON_NULL field, false forces a value type even for a nullable one. An override for schemas
+	// derived from hand-authored SDL (see ParseSDL) that got a nullability marker wrong, or for a
+	// caller that wants a pointer regardless so it can distinguish "absent" from "zero value".
+	ForceOptional *bool `json:"forceOptional,omitempty"`
+}
+
+// LookupManifest describes a single-item root query field that looks a node up by argument, e.g.
+// `team(id: ..., siteId: ...) { ... }`. LookupFieldCandidates lists field names to try in order,
+// so a manifest can prefer a newer field (e.g. "teamV2") while still working against schemas that
+// only expose the older one ("team").
+type LookupManifest struct {
+	// Namespace is the root query field hosting the lookup field, e.g. "team"; empty if the
+	// lookup field sits directly on Query.
+	Namespace string `json:"namespace,omitempty"`
+	// LookupFieldCandidates is tried in order; the first one present in the schema is used.
+	LookupFieldCandidates []string `json:"lookupFieldCandidates"`
+	// ArgNames lists the lookup field's arguments, in the order they appear in the generated
+	// query and Go type name, e.g. ["id", "siteId"].
+	ArgNames []string `json:"argNames"`
+	// Fields are the node's scalar/enum fields to select and decode.
+	Fields []FieldManifest `json:"fields"`
+}
+
+// ConnectionManifest describes a paginated root query field returning a Relay-style connection
+// (a `nodes`/`pageInfo` shape), e.g. `teamSearchV2(...) { nodes { team { ... } } pageInfo { ... } }`.
+type ConnectionManifest struct {
+	Namespace string `json:"namespace,omitempty"`
+	FieldName string `json:"fieldName"`
+	// ArgNames lists the field's non-filter arguments, in query/signature order.
+	ArgNames []string `json:"argNames"`
+	// FilterArgName is the argument that wraps FilterInputFieldName, e.g. "filter".
+	FilterArgName string `json:"filterArgName,omitempty"`
+	// FilterInputFieldName is the single input field read out of the filter argument's input
+	// type, e.g. "query".
+	FilterInputFieldName string `json:"filterInputFieldName,omitempty"`
+	// OptInDirective, if set, is applied to the field as `@optIn(to: "...")`.
+	OptInDirective string `json:"optInDirective,omitempty"`
+	// NodeFieldPath unwraps the connection's node type down to the entity being modeled, e.g.
+	// ["team"] for a search result node shaped as `{ team { ... } }`.
+	NodeFieldPath []string `json:"nodeFieldPath"`
+	Fields        []FieldManifest `json:"fields"`
+}
+
+// OperationManifest bundles the operations rendered into a single generated file. A generator
+// builds one of these (in Go, or by loading it from JSON with LoadManifest) instead of writing
+// its own introspection walk and template.
+type OperationManifest struct {
+	// Package is the generated file's package name, e.g. "gen".
+	Package string `json:"package"`
+	// EntityName prefixes generated type names, e.g. "Team" -> TeamNode, TeamPageInfo.
+	EntityName string `json:"entityName"`
+	// GeneratorPath is recorded in the "Code generated by ..." header comment.
+	GeneratorPath string `json:"generatorPath"`
+
+	Lookup     *LookupManifest     `json:"lookup,omitempty"`
+	Connection *ConnectionManifest `json:"connection,omitempty"`
+
+	// ExtraSource, if set, is emitted verbatim right after the import block. It's an escape
+	// hatch for entity-specific helpers that aren't derivable from the schema itself, e.g. a
+	// NormalizeTeamID function built around an ARI prefix convention.
+	ExtraSource string `json:"-"`
+	// ExtraImports lists additional import paths (without quotes) required by ExtraSource, e.g.
+	// "strings".
+	ExtraImports []string `json:"-"`
+}
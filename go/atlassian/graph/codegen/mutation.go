@@ -0,0 +1,262 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MutationManifest describes one mutation field reached (optionally behind Namespace) off the
+// schema's Mutation root, taking a single INPUT_OBJECT argument and resolving to a UNION/INTERFACE
+// result carrying a typed success payload plus a typed error variant, e.g.
+// `compass.createScorecard(input: ...) { ... on CreateScorecardPayload { scorecard { ... } } ... on
+// QueryError { message } }`. This is the mutation-side counterpart to ConnectionFieldManifest: both
+// resolve a union/error-typed result reached through a namespace field, but a mutation has no
+// parent-entity lookup to thread a cursor/pagination argument through.
+type MutationManifest struct {
+	Package       string `json:"package"`
+	GeneratorPath string `json:"generatorPath"`
+	// EntityName prefixes generated type names, e.g. "CompassCreateScorecard" yields
+	// CompassCreateScorecardInput, ...Result, and the CompassCreateScorecardClient interface's Run
+	// method.
+	EntityName string `json:"entityName"`
+
+	// Namespace is the root mutation field hosting FieldName, e.g. "compass"; empty if FieldName
+	// sits directly on Mutation.
+	Namespace string `json:"namespace,omitempty"`
+	// FieldName is the mutation field itself, e.g. "createScorecard".
+	FieldName string `json:"fieldName"`
+	// InputArgName is FieldName's input argument, e.g. "input".
+	InputArgName string `json:"inputArgName"`
+
+	// ResultFields lists the success payload's own scalar/enum fields to select and decode.
+	ResultFields []FieldManifest `json:"resultFields"`
+}
+
+// resolvedMutationInputField is one scalar/enum field of the mutation's INPUT_OBJECT argument.
+type resolvedMutationInputField struct {
+	name     string
+	goName   string
+	goType   string
+	optional bool
+}
+
+// ResolvedMutation is a MutationManifest validated against a live schema, ready for
+// RenderMutation.
+type ResolvedMutation struct {
+	manifest *MutationManifest
+
+	inputTypeName string
+	inputFields   []resolvedMutationInputField
+
+	resultTypeName string
+	errorTypeName  string
+	resultFields   []resolvedField
+
+	errorMessageNullable              bool
+	errorHasExtensions                bool
+	errorExtensionsNullable           bool
+	errorExtensionsHasStatusCode      bool
+	errorExtensionsStatusCodeNullable bool
+
+	enums           []resolvedEnum
+	extraImports    []string
+	supportSnippets []string
+}
+
+// ResolveMutation validates m against schema (existence of the field, its input argument, the
+// input object's fields, and the result union's success/error variants) and resolves Go types for
+// every selected field via tm, returning a value RenderMutation can turn into source.
+func ResolveMutation(schema map[string]any, m *MutationManifest, tm *TypeMapper) (*ResolvedMutation, error) {
+	types, err := TypesMap(schema)
+	if err != nil {
+		return nil, err
+	}
+	mutationDef, err := MutationTypeDef(schema, types)
+	if err != nil {
+		return nil, err
+	}
+	namespaceDef, err := resolveNamespace(mutationDef, types, m.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	mutField := GetField(namespaceDef, m.FieldName)
+	if mutField == nil {
+		return nil, fmt.Errorf("missing field %s", m.FieldName)
+	}
+	inputArg := GetArg(mutField, m.InputArgName)
+	if inputArg == nil {
+		return nil, fmt.Errorf("field %s missing %s argument", m.FieldName, m.InputArgName)
+	}
+	inputTypeName, inputKind := UnwrapNamedType(inputArg["type"])
+	if inputTypeName == "" {
+		return nil, fmt.Errorf("unable to resolve type of argument %s.%s", m.FieldName, m.InputArgName)
+	}
+	if inputKind != "INPUT_OBJECT" {
+		return nil, fmt.Errorf("argument %s.%s is %s, not an input object", m.FieldName, m.InputArgName, inputKind)
+	}
+	inputDef := types[inputTypeName]
+	if inputDef == nil {
+		return nil, fmt.Errorf("missing input type definition: %s", inputTypeName)
+	}
+
+	scalarsUsed := map[string]bool{}
+	enumsUsed := map[string]string{}
+
+	rawInputFields, _ := inputDef["inputFields"].([]any)
+	inputFields := make([]resolvedMutationInputField, 0, len(rawInputFields))
+	for _, rf := range rawInputFields {
+		f, ok := rf.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := f["name"].(string)
+		if name == "" {
+			continue
+		}
+		res, err := ResolveFieldType(f["type"], types, tm)
+		if err != nil {
+			return nil, fmt.Errorf("input field %s.%s: %w", inputTypeName, name, err)
+		}
+		if res.ScalarName != "" {
+			scalarsUsed[res.ScalarName] = true
+		}
+		if res.EnumName != "" {
+			enumsUsed[res.EnumName] = res.GoType
+		}
+		inputFields = append(inputFields, resolvedMutationInputField{
+			name:     name,
+			goName:   ToGoName(name),
+			goType:   res.GoType,
+			optional: !IsNonNull(f["type"]),
+		})
+	}
+
+	returnTypeName, returnKind := UnwrapNamedType(mutField["type"])
+	if returnTypeName == "" {
+		return nil, fmt.Errorf("unable to resolve return type of field %s", m.FieldName)
+	}
+	returnDef := types[returnTypeName]
+	if returnDef == nil {
+		return nil, fmt.Errorf("missing type definition: %s", returnTypeName)
+	}
+
+	resultDef := returnDef
+	errorTypeName := ""
+	if returnKind == "UNION" || returnKind == "INTERFACE" {
+		resultDef, errorTypeName, err = resolveMutationResultAndError(types, returnDef)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resultTypeName, _ := resultDef["name"].(string)
+	if resultTypeName == "" {
+		return nil, fmt.Errorf("unable to resolve result type name for field %s", m.FieldName)
+	}
+
+	resultFields, err := resolveFields(resultDef, resultTypeName, m.ResultFields, types, tm, scalarsUsed, enumsUsed)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := &ResolvedMutation{
+		manifest:       m,
+		inputTypeName:  inputTypeName,
+		inputFields:    inputFields,
+		resultTypeName: resultTypeName,
+		errorTypeName:  errorTypeName,
+		resultFields:   resultFields,
+	}
+
+	if errorTypeName != "" {
+		errorDef := types[errorTypeName]
+		if errorDef == nil {
+			return nil, fmt.Errorf("missing error type definition: %s", errorTypeName)
+		}
+		errorMessageField := GetField(errorDef, "message")
+		if errorMessageField == nil {
+			return nil, fmt.Errorf("error type %s missing message field", errorTypeName)
+		}
+		resolved.errorMessageNullable = !IsNonNull(errorMessageField["type"])
+		if extField := GetField(errorDef, "extensions"); extField != nil {
+			resolved.errorHasExtensions = true
+			resolved.errorExtensionsNullable = !IsNonNull(extField["type"])
+			extTypeName, _ := UnwrapNamedType(extField["type"])
+			extDef := types[extTypeName]
+			if extDef == nil {
+				return nil, fmt.Errorf("missing error extensions type definition: %s", extTypeName)
+			}
+			if statusField := GetField(extDef, "statusCode"); statusField != nil {
+				resolved.errorExtensionsHasStatusCode = true
+				resolved.errorExtensionsStatusCodeNullable = !IsNonNull(statusField["type"])
+			}
+		}
+	}
+
+	importSet := map[string]bool{}
+	for name := range scalarsUsed {
+		mapping, _ := tm.ScalarMapping(name)
+		if mapping.Import != "" {
+			importSet[mapping.Import] = true
+		}
+		if mapping.Support != "" {
+			resolved.supportSnippets = append(resolved.supportSnippets, mapping.Support)
+		}
+	}
+	for imp := range importSet {
+		resolved.extraImports = append(resolved.extraImports, imp)
+	}
+	sort.Strings(resolved.extraImports)
+	sort.Strings(resolved.supportSnippets)
+
+	var enumNames []string
+	for graphQLName := range enumsUsed {
+		enumNames = append(enumNames, graphQLName)
+	}
+	sort.Strings(enumNames)
+	for _, graphQLName := range enumNames {
+		e, err := resolveEnum(types, graphQLName, enumsUsed[graphQLName])
+		if err != nil {
+			return nil, err
+		}
+		resolved.enums = append(resolved.enums, e)
+	}
+
+	return resolved, nil
+}
+
+// resolveMutationResultAndError picks a mutation result union's success payload (the first
+// possibleType that doesn't expose a message field) and, separately, its typed error variant (the
+// possibleType that does) - the mutation-result equivalent of resolveEntityAndErrorVariant, which
+// can't be reused as-is since a mutation success payload has no id+name shape to sniff for.
+func resolveMutationResultAndError(types map[string]map[string]any, unionDef map[string]any) (resultDef map[string]any, errorTypeName string, err error) {
+	raw, _ := unionDef["possibleTypes"].([]any)
+	for _, item := range raw {
+		pt, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := pt["name"].(string)
+		if name == "" {
+			continue
+		}
+		def := types[name]
+		if def == nil {
+			continue
+		}
+		if GetField(def, "message") != nil {
+			if errorTypeName == "" {
+				errorTypeName = name
+			}
+			continue
+		}
+		if resultDef == nil {
+			resultDef = def
+		}
+	}
+	if resultDef == nil {
+		unionName, _ := unionDef["name"].(string)
+		return nil, "", fmt.Errorf("unable to identify a result type in union %s", unionName)
+	}
+	return resultDef, errorTypeName, nil
+}
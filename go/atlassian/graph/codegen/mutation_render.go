@@ -0,0 +1,199 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderMutation turns a validated ResolvedMutation into Go source for one *_api.go file. The
+// result is unformatted; callers run it through go/format.Source. Unlike RenderConnectionField,
+// there's no fastjson variant - mutations aren't on a hot repeated-fetch path, so reflection-based
+// encoding/json is plenty.
+func RenderMutation(resolved *ResolvedMutation) (string, error) {
+	m := resolved.manifest
+	if m.EntityName == "" {
+		return "", fmt.Errorf("manifest EntityName is required")
+	}
+	entity := m.EntityName
+
+	lines := []string{
+		fmt.Sprintf("// Code generated by %s via atlassian/graph/codegen. DO NOT EDIT.", m.GeneratorPath),
+		fmt.Sprintf("package %s", m.Package),
+		"",
+		"import (",
+		"\t\"context\"",
+		"\t\"encoding/json\"",
+		"\t\"errors\"",
+		"\t\"fmt\"",
+	}
+	for _, imp := range resolved.extraImports {
+		lines = append(lines, fmt.Sprintf("\t%q", imp))
+	}
+	lines = append(lines, ")", "")
+
+	for _, snippet := range resolved.supportSnippets {
+		lines = append(lines, snippet, "")
+	}
+	for _, e := range resolved.enums {
+		lines = append(lines, renderEnum(e)...)
+	}
+
+	query := renderMutationQuery(m, resolved)
+
+	lines = append(lines,
+		"const (",
+		fmt.Sprintf("\t%sPayloadTypename = %q", entity, resolved.resultTypeName),
+	)
+	if resolved.errorTypeName != "" {
+		lines = append(lines, fmt.Sprintf("\t%sErrorTypename = %q", entity, resolved.errorTypeName))
+	}
+	lines = append(lines,
+		")",
+		"",
+		fmt.Sprintf("const %sMutation = %q", entity, query),
+		"",
+	)
+
+	inputLines := []string{fmt.Sprintf("type %sInput struct {", entity)}
+	for _, f := range resolved.inputFields {
+		goType := f.goType
+		if f.optional {
+			goType = "*" + goType
+		}
+		inputLines = append(inputLines, fmt.Sprintf("\t%s %s `json:\"%s,omitempty\"`", f.goName, goType, f.name))
+	}
+	inputLines = append(inputLines, "}", "")
+	lines = append(lines, inputLines...)
+
+	lines = append(lines, renderFieldStruct(entity+"Payload", resolved.resultFields)...)
+
+	lines = append(lines, RenderMutationClientInterface(resolved)...)
+
+	if resolved.errorTypeName != "" {
+		errorExtensionsType := entity + "ErrorExtensions"
+		lines = append(lines, fmt.Sprintf("type %s struct {", errorExtensionsType))
+		if resolved.errorExtensionsHasStatusCode {
+			lines = append(lines, "\tStatusCode "+connectionFieldGoType("int", resolved.errorExtensionsStatusCodeNullable)+" "+connectionFieldJSONTag("statusCode", resolved.errorExtensionsStatusCodeNullable))
+		}
+		lines = append(lines, "}", "")
+
+		lines = append(lines,
+			fmt.Sprintf("type %sError struct {", entity),
+			"\tMessage "+connectionFieldGoType("string", resolved.errorMessageNullable)+" "+connectionFieldJSONTag("message", resolved.errorMessageNullable),
+		)
+		if resolved.errorHasExtensions {
+			lines = append(lines, "\tExtensions "+connectionFieldGoType(errorExtensionsType, resolved.errorExtensionsNullable)+" "+connectionFieldJSONTag("extensions", resolved.errorExtensionsNullable))
+		}
+		lines = append(lines, "}", "")
+	}
+
+	lines = append(lines,
+		fmt.Sprintf("type %sResult struct {", entity),
+		"\tTypename string `json:\"__typename\"`",
+		fmt.Sprintf("\tPayload *%sPayload `json:\"-\"`", entity),
+	)
+	if resolved.errorTypeName != "" {
+		lines = append(lines, fmt.Sprintf("\tError *%sError `json:\"-\"`", entity))
+	}
+	lines = append(lines, "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("func (r *%sResult) UnmarshalJSON(data []byte) error {", entity),
+		"\tvar base struct {",
+		"\t\tTypename string `json:\"__typename\"`",
+		"\t}",
+		"\tif err := json.Unmarshal(data, &base); err != nil {",
+		"\t\treturn err",
+		"\t}",
+		"\tif base.Typename == \"\" {",
+		fmt.Sprintf("\t\treturn errors.New(%q)", "missing __typename for "+m.FieldName),
+		"\t}",
+		"\tr.Typename = base.Typename",
+		"\tswitch base.Typename {",
+		fmt.Sprintf("\tcase %sPayloadTypename:", entity),
+		fmt.Sprintf("\t\tvar payload %sPayload", entity),
+		"\t\tif err := json.Unmarshal(data, &payload); err != nil {",
+		"\t\t\treturn err",
+		"\t\t}",
+		"\t\tr.Payload = &payload",
+	)
+	if resolved.errorTypeName != "" {
+		lines = append(lines,
+			fmt.Sprintf("\tcase %sErrorTypename:", entity),
+			fmt.Sprintf("\t\tvar errResp %sError", entity),
+			"\t\tif err := json.Unmarshal(data, &errResp); err != nil {",
+			"\t\t\treturn err",
+			"\t\t}",
+			"\t\tr.Error = &errResp",
+		)
+	}
+	lines = append(lines,
+		"\tdefault:",
+		"\t\treturn fmt.Errorf(\"unsupported result type: %s\", base.Typename)",
+		"\t}",
+		"\treturn nil",
+		"}",
+		"",
+	)
+
+	resultPath := fmt.Sprintf("out.%s", ToGoName(m.FieldName))
+	if m.Namespace != "" {
+		resultPath = fmt.Sprintf("out.%s.%s", ToGoName(m.Namespace), ToGoName(m.FieldName))
+	}
+	dataType := entity + "Data"
+	lines = append(lines, fmt.Sprintf("type %s struct {", dataType))
+	if m.Namespace != "" {
+		lines = append(lines,
+			fmt.Sprintf("\t%s struct {", ToGoName(m.Namespace)),
+			fmt.Sprintf("\t\t%s %sResult `json:\"%s\"`", ToGoName(m.FieldName), entity, m.FieldName),
+			fmt.Sprintf("\t} `json:\"%s\"`", m.Namespace),
+		)
+	} else {
+		lines = append(lines, fmt.Sprintf("\t%s %sResult `json:\"%s\"`", ToGoName(m.FieldName), entity, m.FieldName))
+	}
+	lines = append(lines, "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("func Decode%s(data map[string]any) (*%sData, error) {", entity, entity),
+		"\tb, err := json.Marshal(data)",
+		"\tif err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		fmt.Sprintf("\tvar out %s", dataType),
+		"\tif err := json.Unmarshal(b, &out); err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		fmt.Sprintf("\t_ = %s", resultPath),
+		"\treturn &out, nil",
+		"}",
+	)
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderMutationQuery builds the GraphQL mutation text for one MutationManifest target, following
+// the same `... on {Type} { fields }` discriminated-result shape renderConnectionFieldQuery uses
+// for connections, but selecting the mutation field's single input argument instead of a
+// parent-entity id plus pagination args.
+func renderMutationQuery(m *MutationManifest, resolved *ResolvedMutation) string {
+	payloadFields := make([]string, len(resolved.resultFields))
+	for i, f := range resolved.resultFields {
+		payloadFields[i] = f.Name
+	}
+	payloadFragment := fmt.Sprintf("... on %s { %s }", resolved.resultTypeName, strings.Join(payloadFields, " "))
+
+	errorFragment := ""
+	if resolved.errorTypeName != "" {
+		errorFields := "message"
+		if resolved.errorHasExtensions && resolved.errorExtensionsHasStatusCode {
+			errorFields += " extensions { statusCode }"
+		}
+		errorFragment = fmt.Sprintf(" ... on %s { %s }", resolved.errorTypeName, errorFields)
+	}
+
+	root := fmt.Sprintf("%s(%s: $%s) {\n      __typename\n      %s%s\n    }", m.FieldName, m.InputArgName, m.InputArgName, payloadFragment, errorFragment)
+	if m.Namespace != "" {
+		root = fmt.Sprintf("%s {\n    %s\n  }", m.Namespace, root)
+	}
+	return fmt.Sprintf("mutation %s(\n  $%s: %s!\n) {\n  %s\n}\n", m.EntityName, m.InputArgName, resolved.inputTypeName, root)
+}
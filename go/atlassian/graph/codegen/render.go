@@ -0,0 +1,584 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Render turns a validated ResolvedOperations into Go source. The result is unformatted; callers
+// run it through go/format.Source (generators already do this for their hand-written templates,
+// so Render doesn't duplicate that step).
+func Render(resolved *ResolvedOperations) (string, error) {
+	entity := resolved.manifest.EntityName
+	if entity == "" {
+		return "", fmt.Errorf("manifest EntityName is required")
+	}
+
+	var lines []string
+	lines = append(lines,
+		fmt.Sprintf("// Code generated by %s via atlassian/graph/codegen. DO NOT EDIT.", resolved.manifest.GeneratorPath),
+		fmt.Sprintf("package %s", resolved.manifest.Package),
+		"",
+	)
+
+	lines = append(lines, "import (", "\t\"encoding/json\"", "\t\"errors\"")
+	if resolved.lookup != nil || resolved.connection != nil {
+		lines = append(lines, "\t\"context\"")
+	}
+	if resolved.connection != nil {
+		lines = append(lines, "\t\"atlassian/atlassian/pagination\"")
+	}
+	for _, imp := range resolved.extraImports {
+		lines = append(lines, fmt.Sprintf("\t%q", imp))
+	}
+	for _, imp := range resolved.manifest.ExtraImports {
+		lines = append(lines, fmt.Sprintf("\t%q", imp))
+	}
+	lines = append(lines, ")", "")
+
+	for _, snippet := range resolved.supportSnippets {
+		lines = append(lines, snippet, "")
+	}
+
+	if resolved.manifest.ExtraSource != "" {
+		lines = append(lines, resolved.manifest.ExtraSource, "")
+	}
+
+	for _, e := range resolved.enums {
+		lines = append(lines, renderEnum(e)...)
+	}
+
+	if resolved.lookup != nil {
+		lines = append(lines, renderLookup(entity, resolved.lookup)...)
+	}
+	if resolved.connection != nil {
+		lines = append(lines, renderConnection(entity, resolved.connection, resolved.lookup == nil)...)
+		lines = append(lines, renderIterator(entity, resolved.connection)...)
+	}
+	if resolved.lookup != nil || resolved.connection != nil {
+		lines = append(lines, renderClientInterface(entity, resolved)...)
+		lines = append(lines, renderMockClient(entity, resolved)...)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func renderFieldStruct(typeName string, fields []resolvedField) []string {
+	lines := []string{fmt.Sprintf("type %s struct {", typeName)}
+	for _, f := range fields {
+		goType := f.GoType
+		if f.Optional {
+			goType = "*" + goType
+		}
+		lines = append(lines, fmt.Sprintf("\t%s %s `json:\"%s\"`", f.GoName, goType, f.Name))
+	}
+	lines = append(lines, "}", "")
+	return lines
+}
+
+func renderSelectionSet(fields []resolvedField, indent string) string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name
+	}
+	return strings.Join(names, "\n"+indent)
+}
+
+func renderArgs(argOrder []string) string {
+	parts := make([]string, len(argOrder))
+	for i, name := range argOrder {
+		parts[i] = fmt.Sprintf("%s: $%s", name, name)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func renderVarDecls(argTypes map[string]string, argOrder []string) string {
+	parts := make([]string, len(argOrder))
+	for i, name := range argOrder {
+		parts[i] = fmt.Sprintf("$%s: %s", name, argTypes[name])
+	}
+	return strings.Join(parts, ", ")
+}
+
+func renderLookup(entity string, l *resolvedLookup) []string {
+	queryName := ToGoName(l.fieldName)
+	nodeType := entity + "Node"
+	dataType := queryName + "Data"
+
+	selection := renderSelectionSet(l.fields, "    ")
+	root := fmt.Sprintf("%s(%s) {\n    %s\n  }", l.fieldName, renderArgs(l.argOrder), selection)
+	if l.namespace != "" {
+		root = fmt.Sprintf("%s {\n  %s\n  }", l.namespace, root)
+	}
+	query := fmt.Sprintf("query %s(%s) {\n  %s\n}\n", queryName, renderVarDecls(l.argTypes, l.argOrder), root)
+
+	lines := []string{fmt.Sprintf("const %sQuery = `%s`", queryName, query), ""}
+	lines = append(lines, renderFieldStruct(nodeType, l.fields)...)
+
+	innerField := l.fieldName
+	dataLines := []string{fmt.Sprintf("type %s struct {", dataType)}
+	if l.namespace != "" {
+		dataLines = append(dataLines,
+			fmt.Sprintf("\t%s *struct {", ToGoName(l.namespace)),
+			fmt.Sprintf("\t\t%s *%s `json:\"%s\"`", queryName, nodeType, innerField),
+			fmt.Sprintf("\t} `json:\"%s\"`", l.namespace),
+		)
+	} else {
+		dataLines = append(dataLines, fmt.Sprintf("\t%s *%s `json:\"%s\"`", queryName, nodeType, innerField))
+	}
+	dataLines = append(dataLines, "}", "")
+	lines = append(lines, dataLines...)
+
+	lines = append(lines,
+		fmt.Sprintf("func Decode%s(data map[string]any) (*%s, error) {", entity, nodeType),
+		"\tb, err := json.Marshal(data)",
+		"\tif err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		fmt.Sprintf("\tvar out %s", dataType),
+		"\tif err := json.Unmarshal(b, &out); err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+	)
+	if l.namespace != "" {
+		lines = append(lines,
+			fmt.Sprintf("\tif out.%s == nil {", ToGoName(l.namespace)),
+			fmt.Sprintf("\t\treturn nil, errors.New(\"missing %s\")", l.namespace),
+			"\t}",
+			fmt.Sprintf("\tif out.%s.%s == nil {", ToGoName(l.namespace), queryName),
+			fmt.Sprintf("\t\treturn nil, errors.New(\"missing %s node\")", l.fieldName),
+			"\t}",
+			fmt.Sprintf("\treturn out.%s.%s, nil", ToGoName(l.namespace), queryName),
+		)
+	} else {
+		lines = append(lines,
+			fmt.Sprintf("\tif out.%s == nil {", queryName),
+			fmt.Sprintf("\t\treturn nil, errors.New(\"missing %s node\")", l.fieldName),
+			"\t}",
+			fmt.Sprintf("\treturn out.%s, nil", queryName),
+		)
+	}
+	lines = append(lines, "}", "")
+	return lines
+}
+
+func renderConnection(entity string, c *resolvedConnection, needsNodeStruct bool) []string {
+	queryName := ToGoName(c.fieldName)
+	pageInfoType := entity + "PageInfo"
+	resultNodeType := entity + "SearchResultNode"
+	connType := entity + "SearchConnection"
+	dataType := queryName + "Data"
+	entityField := c.nodeFieldPath[len(c.nodeFieldPath)-1]
+	entityNodeType := entity + "Node"
+
+	argOrder := append([]string{}, c.argOrder...)
+	argParts := make([]string, 0, len(argOrder)+1)
+	for _, name := range argOrder {
+		argParts = append(argParts, fmt.Sprintf("%s: $%s", name, name))
+	}
+	if c.filterArgName != "" {
+		argParts = append(argParts, fmt.Sprintf("%s: { %s: $%s }", c.filterArgName, c.filterInputFieldName, c.filterInputFieldName))
+	}
+
+	varOrder := append([]string{}, argOrder...)
+	varTypes := map[string]string{}
+	for k, v := range c.argTypes {
+		varTypes[k] = v
+	}
+	if c.filterArgName != "" {
+		varOrder = append(varOrder, c.filterInputFieldName)
+		varTypes[c.filterInputFieldName] = c.filterInputType
+	}
+
+	pageInfoSelect := "hasNextPage"
+	if c.pageInfoHasEndCursor {
+		pageInfoSelect += " endCursor"
+	}
+
+	optIn := ""
+	if c.optInDirective != "" {
+		optIn = fmt.Sprintf(` @optIn(to: "%s")`, c.optInDirective)
+	}
+
+	nodeSelection := renderSelectionSet(c.fields, "          ")
+	nestedOpen := strings.Repeat("  ", len(c.nodeFieldPath))
+	var nodeBody strings.Builder
+	for i, step := range c.nodeFieldPath {
+		indent := strings.Repeat("  ", i+3)
+		nodeBody.WriteString(fmt.Sprintf("%s%s {\n", indent, step))
+	}
+	nodeBody.WriteString(fmt.Sprintf("%s  %s\n", nestedOpen, nodeSelection))
+	for i := len(c.nodeFieldPath) - 1; i >= 0; i-- {
+		indent := strings.Repeat("  ", i+3)
+		nodeBody.WriteString(fmt.Sprintf("%s}\n", indent))
+	}
+
+	root := fmt.Sprintf("%s(\n    %s\n  )%s {\n    pageInfo { %s }\n    nodes {\n%s    }\n  }", c.fieldName, strings.Join(argParts, ",\n    "), optIn, pageInfoSelect, nodeBody.String())
+	if c.namespace != "" {
+		root = fmt.Sprintf("%s {\n  %s\n  }", c.namespace, root)
+	}
+	query := fmt.Sprintf("query %s(%s) {\n  %s\n}\n", queryName, renderVarDecls(varTypes, varOrder), root)
+
+	lines := []string{
+		fmt.Sprintf("const %sPageInfoHasEndCursor = %t", entity, c.pageInfoHasEndCursor),
+		"",
+		fmt.Sprintf("const %sQuery = `%s`", queryName, query),
+		"",
+	}
+	lines = append(lines,
+		fmt.Sprintf("type %s struct {", pageInfoType),
+		"\tHasNextPage bool `json:\"hasNextPage\"`",
+		"\tEndCursor   *string `json:\"endCursor,omitempty\"`",
+		"}", "",
+	)
+
+	if needsNodeStruct {
+		lines = append(lines, renderFieldStruct(entityNodeType, c.fields)...)
+	}
+
+	entityFieldType := entityNodeType
+	if c.nodeOptional {
+		entityFieldType = "*" + entityNodeType
+	}
+	lines = append(lines,
+		fmt.Sprintf("type %s struct {", resultNodeType),
+		fmt.Sprintf("\t%s %s `json:\"%s\"`", ToGoName(entityField), entityFieldType, entityField),
+		"}", "",
+	)
+	lines = append(lines,
+		fmt.Sprintf("type %s struct {", connType),
+		fmt.Sprintf("\tPageInfo %s `json:\"pageInfo\"`", pageInfoType),
+		fmt.Sprintf("\tNodes    []%s `json:\"nodes\"`", resultNodeType),
+		"}", "",
+	)
+
+	dataLines := []string{fmt.Sprintf("type %s struct {", dataType)}
+	if c.namespace != "" {
+		dataLines = append(dataLines,
+			fmt.Sprintf("\t%s *struct {", ToGoName(c.namespace)),
+			fmt.Sprintf("\t\t%s *%s `json:\"%s\"`", queryName, connType, c.fieldName),
+			fmt.Sprintf("\t} `json:\"%s\"`", c.namespace),
+		)
+	} else {
+		dataLines = append(dataLines, fmt.Sprintf("\t%s *%s `json:\"%s\"`", queryName, connType, c.fieldName))
+	}
+	dataLines = append(dataLines, "}", "")
+	lines = append(lines, dataLines...)
+
+	lines = append(lines,
+		fmt.Sprintf("func Decode%s(data map[string]any) (*%s, error) {", queryName, connType),
+		"\tb, err := json.Marshal(data)",
+		"\tif err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		fmt.Sprintf("\tvar out %s", dataType),
+		"\tif err := json.Unmarshal(b, &out); err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+	)
+	if c.namespace != "" {
+		lines = append(lines,
+			fmt.Sprintf("\tif out.%s == nil {", ToGoName(c.namespace)),
+			fmt.Sprintf("\t\treturn nil, errors.New(\"missing %s\")", c.namespace),
+			"\t}",
+			fmt.Sprintf("\tif out.%s.%s == nil {", ToGoName(c.namespace), queryName),
+			fmt.Sprintf("\t\treturn nil, errors.New(\"missing %s\")", c.fieldName),
+			"\t}",
+			fmt.Sprintf("\treturn out.%s.%s, nil", ToGoName(c.namespace), queryName),
+		)
+	} else {
+		lines = append(lines,
+			fmt.Sprintf("\tif out.%s == nil {", queryName),
+			fmt.Sprintf("\t\treturn nil, errors.New(\"missing %s\")", c.fieldName),
+			"\t}",
+			fmt.Sprintf("\treturn out.%s, nil", queryName),
+		)
+	}
+	lines = append(lines, "}", "")
+	return lines
+}
+
+// renderIterator emits a {Entity}SearchIterator that walks the connection rendered by
+// renderConnection page by page, built on pagination.CursorIterator. The generic iterator only
+// knows about fetch-level retry/stop; unwrapping the entity node and deciding whether a nil node
+// is fatal or skippable is connection-specific, so that logic lives here instead of in the shared
+// pagination package.
+func renderIterator(entity string, c *resolvedConnection) []string {
+	resultNodeType := entity + "SearchResultNode"
+	connType := entity + "SearchConnection"
+	entityNodeType := entity + "Node"
+	entityField := ToGoName(c.nodeFieldPath[len(c.nodeFieldPath)-1])
+
+	errPolicyType := entity + "SearchErrorPolicy"
+	optsType := entity + "SearchIteratorOptions"
+	fetcherType := entity + "SearchPageFetcher"
+	iteratorType := entity + "SearchIterator"
+
+	lines := []string{
+		fmt.Sprintf("type %s int", errPolicyType),
+		"",
+		"const (",
+		fmt.Sprintf("\t// %sStop surfaces a fetch error or nil %s from Next and halts iteration. Default.", errPolicyType, entityNodeType),
+		fmt.Sprintf("\t%sStop %s = iota", errPolicyType, errPolicyType),
+		fmt.Sprintf("\t// %sSkipNull skips a page node whose %s is nil instead of failing Next.", errPolicyType, entityField),
+		fmt.Sprintf("\t%sSkipNull", errPolicyType),
+		fmt.Sprintf("\t// %sRetry retries a failed page fetch once before halting.", errPolicyType),
+		fmt.Sprintf("\t%sRetry", errPolicyType),
+		")", "",
+	}
+
+	lines = append(lines,
+		fmt.Sprintf("// %s configures a %s.", optsType, iteratorType),
+		fmt.Sprintf("type %s struct {", optsType),
+		"\t// PageSize is the `first` argument sent with each page request; defaults to 50.",
+		"\tPageSize int",
+		"\t// ErrorPolicy governs page-fetch retries and nil-node handling.",
+		fmt.Sprintf("\tErrorPolicy %s", errPolicyType),
+		"\t// MaxPages bounds how many pages are fetched; zero means unlimited.",
+		"\tMaxPages int",
+		"}", "",
+	)
+
+	lines = append(lines,
+		fmt.Sprintf("// %s fetches one page of the %s connection, resuming after the given cursor.", fetcherType, entity),
+		fmt.Sprintf("type %s func(ctx context.Context, after *string, first int) (*%s, error)", fetcherType, connType),
+		"",
+	)
+
+	lines = append(lines,
+		fmt.Sprintf("// %s walks %s page by page via a %s, transparently issuing follow-up", iteratorType, connType, fetcherType),
+		"// queries using endCursor while hasNextPage is true.",
+		fmt.Sprintf("type %s struct {", iteratorType),
+		fmt.Sprintf("\tinner *pagination.CursorIterator[%s]", resultNodeType),
+		fmt.Sprintf("\topts  %s", optsType),
+		"}", "",
+	)
+
+	lines = append(lines,
+		fmt.Sprintf("// New%s builds a %s with the given page fetcher and options.", iteratorType, iteratorType),
+		fmt.Sprintf("func New%s(fetch %s, opts %s) *%s {", iteratorType, fetcherType, optsType, iteratorType),
+		"\tpageSize := opts.PageSize",
+		"\tif pageSize <= 0 {",
+		"\t\tpageSize = 50",
+		"\t}",
+		"\tinnerOpts := pagination.CursorIteratorOptions{MaxPages: opts.MaxPages}",
+		fmt.Sprintf("\tif opts.ErrorPolicy == %sRetry {", errPolicyType),
+		"\t\tinnerOpts.ErrorPolicy = pagination.CursorErrorRetry",
+		"\t}",
+		fmt.Sprintf("\tinner := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]%s, bool, *string, error) {", resultNodeType),
+		"\t\tconn, err := fetch(ctx, after, pageSize)",
+		"\t\tif err != nil {",
+		"\t\t\treturn nil, false, nil, err",
+		"\t\t}",
+		"\t\tif conn == nil {",
+		fmt.Sprintf("\t\t\treturn nil, false, nil, errors.New(\"nil %s\")", connType),
+		"\t\t}",
+		"\t\treturn conn.Nodes, conn.PageInfo.HasNextPage, conn.PageInfo.EndCursor, nil",
+		"\t}, innerOpts)",
+		fmt.Sprintf("\treturn &%s{inner: inner, opts: opts}", iteratorType),
+		"}", "",
+	)
+
+	entityFieldExpr := "node." + entityField
+	var nullHandling []string
+	if c.nodeOptional {
+		nullHandling = []string{
+			fmt.Sprintf("\t\tif node.%s == nil {", entityField),
+			fmt.Sprintf("\t\t\tif it.opts.ErrorPolicy == %sSkipNull {", errPolicyType),
+			"\t\t\t\tcontinue",
+			"\t\t\t}",
+			fmt.Sprintf("\t\t\treturn nil, errors.New(\"nil %s in %s\")", entityField, resultNodeType),
+			"\t\t}",
+			fmt.Sprintf("\t\treturn %s, nil", entityFieldExpr),
+		}
+	} else {
+		nullHandling = []string{
+			fmt.Sprintf("\t\treturn &%s, nil", entityFieldExpr),
+		}
+	}
+
+	lines = append(lines, fmt.Sprintf("// Next returns the next %s, or (nil, nil) once the connection is exhausted.", entityNodeType))
+	lines = append(lines, fmt.Sprintf("func (it *%s) Next(ctx context.Context) (*%s, error) {", iteratorType, entityNodeType))
+	lines = append(lines, "\tfor {")
+	lines = append(lines, "\t\tnode, err := it.inner.Next(ctx)")
+	lines = append(lines, "\t\tif err != nil {")
+	lines = append(lines, "\t\t\treturn nil, err")
+	lines = append(lines, "\t\t}")
+	lines = append(lines, "\t\tif node == nil {")
+	lines = append(lines, "\t\t\treturn nil, nil")
+	lines = append(lines, "\t\t}")
+	lines = append(lines, nullHandling...)
+	lines = append(lines, "\t}")
+	lines = append(lines, "}", "")
+
+	lines = append(lines,
+		"// Err returns the error (if any) that halted iteration.",
+		fmt.Sprintf("func (it *%s) Err() error {", iteratorType),
+		"\treturn it.inner.Err()",
+		"}", "",
+	)
+	lines = append(lines,
+		"// Close stops the iterator; subsequent Next calls return (nil, nil).",
+		fmt.Sprintf("func (it *%s) Close() error {", iteratorType),
+		"\treturn it.inner.Close()",
+		"}", "",
+	)
+	lines = append(lines,
+		fmt.Sprintf("// Channel streams the raw %s values on a channel, for consumers that want the full", resultNodeType),
+		"// connection node rather than just the unwrapped entity.",
+		fmt.Sprintf("func (it *%s) Channel(ctx context.Context) <-chan %s {", iteratorType, resultNodeType),
+		"\treturn it.inner.Channel(ctx)",
+		"}", "",
+	)
+
+	return lines
+}
+
+// renderClientInterface emits a minimal {Entity}Client interface covering the operations this
+// manifest resolves, so callers can depend on the interface instead of a concrete transport and
+// swap in {Entity}MockClient for unit tests.
+func renderClientInterface(entity string, resolved *ResolvedOperations) []string {
+	clientType := entity + "Client"
+	lines := []string{
+		fmt.Sprintf("// %s is the subset of the Teamwork Graph client that generated %s code depends", clientType, entity),
+		fmt.Sprintf("// on; %sMockClient implements it for unit tests.", entity),
+		fmt.Sprintf("type %s interface {", clientType),
+	}
+	if resolved.lookup != nil {
+		queryName := ToGoName(resolved.lookup.fieldName)
+		lines = append(lines, fmt.Sprintf("\t%s(ctx context.Context, args map[string]any) (*%sNode, error)", queryName, entity))
+	}
+	if resolved.connection != nil {
+		queryName := ToGoName(resolved.connection.fieldName)
+		lines = append(lines, fmt.Sprintf("\t%s(ctx context.Context, args map[string]any) (*%sSearchConnection, error)", queryName, entity))
+	}
+	lines = append(lines, "}", "")
+	return lines
+}
+
+// renderMockClient emits an in-memory {Entity}Client fake, seeded via With{Entity}s and
+// WithSearchResults, so downstream unit tests don't need a live Teamwork Graph API to exercise
+// code built on {Entity}Client.
+func renderMockClient(entity string, resolved *ResolvedOperations) []string {
+	mockType := entity + "MockClient"
+	nodeType := entity + "Node"
+	resultNodeType := entity + "SearchResultNode"
+
+	lines := []string{
+		fmt.Sprintf("// %s is an in-memory %sClient fake for unit tests.", mockType, entity),
+		fmt.Sprintf("type %s struct {", mockType),
+	}
+	if resolved.lookup != nil {
+		lines = append(lines, fmt.Sprintf("\t%ss map[string]%s", strings.ToLower(entity), nodeType))
+	}
+	if resolved.connection != nil {
+		lines = append(lines, "\tsearchResults map[string][]"+resultNodeType)
+	}
+	lines = append(lines, "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("// New%s builds an empty %s; chain With%ss/WithSearchResults to seed it.", mockType, mockType, entity),
+		fmt.Sprintf("func New%s() *%s {", mockType, mockType),
+		fmt.Sprintf("\treturn &%s{", mockType),
+	)
+	if resolved.lookup != nil {
+		lines = append(lines, fmt.Sprintf("\t\t%ss: map[string]%s{},", strings.ToLower(entity), nodeType))
+	}
+	if resolved.connection != nil {
+		lines = append(lines, "\t\tsearchResults: map[string][]"+resultNodeType+"{},")
+	}
+	lines = append(lines, "\t}", "}", "")
+
+	if resolved.lookup != nil {
+		field := strings.ToLower(entity) + "s"
+		keyArg := "id"
+		if len(resolved.lookup.argOrder) > 0 {
+			keyArg = resolved.lookup.argOrder[0]
+		}
+		lines = append(lines,
+			fmt.Sprintf("// With%ss seeds the mock with %s lookups keyed by %s, matched against node.ID.", entity, strings.ToLower(entity), keyArg),
+			fmt.Sprintf("func (m *%s) With%ss(%ss []%s) *%s {", mockType, entity, strings.ToLower(entity), nodeType, mockType),
+			fmt.Sprintf("\tfor _, item := range %ss {", strings.ToLower(entity)),
+			fmt.Sprintf("\t\tm.%s[item.ID] = item", field),
+			"\t}",
+			"\treturn m",
+			"}", "",
+		)
+
+		queryName := ToGoName(resolved.lookup.fieldName)
+		lines = append(lines,
+			fmt.Sprintf("func (m *%s) %s(ctx context.Context, args map[string]any) (*%s, error) {", mockType, queryName, nodeType),
+			fmt.Sprintf("\t%s, _ := args[%q].(string)", keyArg, keyArg),
+			fmt.Sprintf("\titem, ok := m.%s[%s]", field, keyArg),
+			"\tif !ok {",
+			fmt.Sprintf("\t\treturn nil, errors.New(\"mock: no %s seeded for %s \" + %s)", strings.ToLower(entity), keyArg, keyArg),
+			"\t}",
+			"\treturn &item, nil",
+			"}", "",
+		)
+	}
+
+	if resolved.connection != nil {
+		filterKey := resolved.connection.filterInputFieldName
+		if filterKey == "" {
+			filterKey = "query"
+		}
+		queryName := ToGoName(resolved.connection.fieldName)
+		pageInfoType := entity + "PageInfo"
+		connType := entity + "SearchConnection"
+		lines = append(lines,
+			fmt.Sprintf("// WithSearchResults seeds the mock's %s results for the given filter %s.", resolved.connection.fieldName, filterKey),
+			fmt.Sprintf("func (m *%s) WithSearchResults(%s string, results []%s) *%s {", mockType, filterKey, resultNodeType, mockType),
+			fmt.Sprintf("\tm.searchResults[%s] = results", filterKey),
+			"\treturn m",
+			"}", "",
+		)
+		lines = append(lines,
+			fmt.Sprintf("func (m *%s) %s(ctx context.Context, args map[string]any) (*%s, error) {", mockType, queryName, connType),
+			fmt.Sprintf("\t%s, _ := args[%q].(string)", filterKey, filterKey),
+			fmt.Sprintf("\treturn &%s{", connType),
+			fmt.Sprintf("\t\tPageInfo: %s{HasNextPage: false},", pageInfoType),
+			fmt.Sprintf("\t\tNodes:    m.searchResults[%s],", filterKey),
+			"\t}, nil",
+			"}", "",
+		)
+	}
+
+	return lines
+}
+
+// renderEnum emits a registered GraphQL enum as a named Go string type with one typed constant per
+// enum value and a Values() method listing them all, instead of the default flatten-to-string
+// handling unregistered enums get.
+func renderEnum(e resolvedEnum) []string {
+	lines := []string{fmt.Sprintf("type %s string", e.goName), "", "const ("}
+	for _, v := range e.values {
+		lines = append(lines, fmt.Sprintf("\t%s%s %s = %q", e.goName, enumValueGoName(v), e.goName, v))
+	}
+	lines = append(lines, ")", "")
+
+	lines = append(lines, fmt.Sprintf("// Values returns every %s value declared in the schema.", e.goName))
+	lines = append(lines, fmt.Sprintf("func (%s) Values() []%s {", e.goName, e.goName))
+	names := make([]string, len(e.values))
+	for i, v := range e.values {
+		names[i] = e.goName + enumValueGoName(v)
+	}
+	lines = append(lines, fmt.Sprintf("\treturn []%s{%s}", e.goName, strings.Join(names, ", ")))
+	lines = append(lines, "}", "")
+	return lines
+}
+
+// enumValueGoName converts a GraphQL enum value (conventionally SCREAMING_SNAKE_CASE) into a
+// Go-identifier-safe CamelCase suffix, e.g. "ON_HOLD" -> "OnHold".
+func enumValueGoName(value string) string {
+	parts := strings.Split(value, "_")
+	var b strings.Builder
+	for _, p := range parts {
+		if p == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(p[:1]))
+		b.WriteString(strings.ToLower(p[1:]))
+	}
+	return b.String()
+}
@@ -0,0 +1,41 @@
+package codegen
+
+// ScalarMapper resolves a GraphQL SCALAR type name (e.g. "DateTime", "ARI") to the Go type used to
+// decode it, returning ok=false for scalars it doesn't recognize.
+type ScalarMapper func(name string) (goType string, ok bool)
+
+// DefaultScalarMapper handles the built-in GraphQL scalars plus the custom scalars commonly seen
+// across Atlassian's Teamwork Graph schema: DateTime, URL and ARI decode as opaque strings
+// (callers that need parsed values build on top, e.g. graph.FetchSchemaIntrospection's cache
+// metadata parses its own "fetchedAt" separately), and JSON decodes as json.RawMessage so callers
+// can defer shape-specific unmarshaling.
+func DefaultScalarMapper(name string) (string, bool) {
+	switch name {
+	case "ID", "String", "DateTime", "URL", "ARI":
+		return "string", true
+	case "Int":
+		return "int", true
+	case "Float":
+		return "float64", true
+	case "Boolean":
+		return "bool", true
+	case "JSON":
+		return "json.RawMessage", true
+	default:
+		return "", false
+	}
+}
+
+// ChainScalarMappers tries each mapper in order, returning the first match. It lets a generator
+// layer schema-specific scalars (e.g. a Jira-only "ADF" scalar) on top of DefaultScalarMapper
+// without forking the whole mapping function.
+func ChainScalarMappers(mappers ...ScalarMapper) ScalarMapper {
+	return func(name string) (string, bool) {
+		for _, m := range mappers {
+			if goType, ok := m(name); ok {
+				return goType, ok
+			}
+		}
+		return "", false
+	}
+}
@@ -0,0 +1,256 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SchemaFieldDiff describes how one field on a SchemaTypeDiff's type changed between two
+// introspection snapshots: its GraphQL type (covering nullability and list-ness, since
+// TypeRefToGQL renders both) and/or any argument's type.
+type SchemaFieldDiff struct {
+	FieldName    string
+	OldType      string
+	NewType      string
+	ArgTypeDiffs []string
+}
+
+// SchemaTypeDiff summarizes how one type reachable from DiffSchemaIntrospection's roots changed
+// between two introspection snapshots.
+type SchemaTypeDiff struct {
+	TypeName      string
+	AddedFields   []string
+	RemovedFields []string
+	ChangedFields []SchemaFieldDiff
+}
+
+// Empty reports whether d has nothing worth reporting.
+func (d SchemaTypeDiff) Empty() bool {
+	return len(d.AddedFields) == 0 && len(d.RemovedFields) == 0 && len(d.ChangedFields) == 0
+}
+
+// Lines renders d as human-readable report lines, e.g. for -check/-diff output.
+func (d SchemaTypeDiff) Lines() []string {
+	var lines []string
+	for _, f := range d.AddedFields {
+		lines = append(lines, fmt.Sprintf("%s: + field %s", d.TypeName, f))
+	}
+	for _, f := range d.RemovedFields {
+		lines = append(lines, fmt.Sprintf("%s: - field %s", d.TypeName, f))
+	}
+	for _, c := range d.ChangedFields {
+		lines = append(lines, fmt.Sprintf("%s.%s: %s -> %s", d.TypeName, c.FieldName, c.OldType, c.NewType))
+		for _, a := range c.ArgTypeDiffs {
+			lines = append(lines, fmt.Sprintf("%s.%s(%s)", d.TypeName, c.FieldName, a))
+		}
+	}
+	return lines
+}
+
+// DiffSchemaIntrospection compares every type reachable from rootTypeNames between oldSchema and
+// newSchema, reporting added/removed fields and fields whose type (nullability, list-ness, or base
+// named type, all folded into the TypeRefToGQL string) or any argument's type changed. It's the
+// building block a generator's -check/-diff flag uses to catch a live schema drifting out from
+// under a cached schema.introspection.json before that breaks runtime unmarshalling.
+func DiffSchemaIntrospection(oldSchema, newSchema map[string]any, rootTypeNames []string) ([]SchemaTypeDiff, error) {
+	oldTypes, err := TypesMap(oldSchema)
+	if err != nil {
+		return nil, fmt.Errorf("old schema: %w", err)
+	}
+	newTypes, err := TypesMap(newSchema)
+	if err != nil {
+		return nil, fmt.Errorf("new schema: %w", err)
+	}
+
+	var diffs []SchemaTypeDiff
+	for _, name := range reachableTypeNames(rootTypeNames, oldTypes, newTypes) {
+		oldDef, oldOK := oldTypes[name]
+		newDef, newOK := newTypes[name]
+		if !oldOK || !newOK {
+			// A type that only exists on one side shows up as a field add/remove one level up
+			// (the field whose type used to or now resolves to it), not as a per-field diff on a
+			// type definition that doesn't exist in one of the two snapshots.
+			continue
+		}
+		if d := diffTypeFields(name, oldDef, newDef); !d.Empty() {
+			diffs = append(diffs, d)
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].TypeName < diffs[j].TypeName })
+	return diffs, nil
+}
+
+// reachableTypeNames walks both schemas' field types outward from roots, since a field that only
+// exists in one snapshot can lead somewhere the other snapshot's walk alone wouldn't reach.
+func reachableTypeNames(roots []string, oldTypes, newTypes map[string]map[string]any) []string {
+	seen := make(map[string]bool)
+	var queue []string
+	for _, r := range roots {
+		if r != "" && !seen[r] {
+			seen[r] = true
+			queue = append(queue, r)
+		}
+	}
+	for i := 0; i < len(queue); i++ {
+		name := queue[i]
+		for _, types := range []map[string]map[string]any{oldTypes, newTypes} {
+			def, ok := types[name]
+			if !ok {
+				continue
+			}
+			for _, next := range fieldTargetTypeNames(def, types) {
+				if !seen[next] {
+					seen[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+	}
+	sort.Strings(queue)
+	return queue
+}
+
+// fieldTargetTypeNames collects every compound (OBJECT/INTERFACE/UNION/INPUT_OBJECT) type name a
+// field on def can lead to, plus, for a UNION/INTERFACE, every possibleTypes entry.
+func fieldTargetTypeNames(def map[string]any, types map[string]map[string]any) []string {
+	var out []string
+	rawFields, _ := def["fields"].([]any)
+	for _, rf := range rawFields {
+		f, ok := rf.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, kind := UnwrapNamedType(f["type"])
+		if name != "" && isCompoundKind(kind, types, name) {
+			out = append(out, name)
+		}
+	}
+	rawPossible, _ := def["possibleTypes"].([]any)
+	for _, rp := range rawPossible {
+		p, ok := rp.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _ := p["name"].(string); name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func isCompoundKind(kind string, types map[string]map[string]any, name string) bool {
+	switch kind {
+	case "OBJECT", "INTERFACE", "UNION", "INPUT_OBJECT":
+		return true
+	default:
+		if def, ok := types[name]; ok {
+			k, _ := def["kind"].(string)
+			return k == "OBJECT" || k == "INTERFACE" || k == "UNION" || k == "INPUT_OBJECT"
+		}
+		return false
+	}
+}
+
+// diffTypeFields compares oldDef and newDef's fields, assuming both are two snapshots of the same
+// named introspection type.
+func diffTypeFields(typeName string, oldDef, newDef map[string]any) SchemaTypeDiff {
+	oldByName := fieldsByName(oldDef["fields"])
+	newByName := fieldsByName(newDef["fields"])
+
+	diff := SchemaTypeDiff{TypeName: typeName}
+	for name := range oldByName {
+		if _, ok := newByName[name]; !ok {
+			diff.RemovedFields = append(diff.RemovedFields, name)
+		}
+	}
+	for name := range newByName {
+		if _, ok := oldByName[name]; !ok {
+			diff.AddedFields = append(diff.AddedFields, name)
+		}
+	}
+	sort.Strings(diff.RemovedFields)
+	sort.Strings(diff.AddedFields)
+
+	var commonNames []string
+	for name := range oldByName {
+		if _, ok := newByName[name]; ok {
+			commonNames = append(commonNames, name)
+		}
+	}
+	sort.Strings(commonNames)
+	for _, name := range commonNames {
+		oldField, newField := oldByName[name], newByName[name]
+		oldGQL, err1 := TypeRefToGQL(oldField["type"])
+		newGQL, err2 := TypeRefToGQL(newField["type"])
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		argDiffs := diffFieldArgs(oldField, newField)
+		if oldGQL != newGQL || len(argDiffs) > 0 {
+			diff.ChangedFields = append(diff.ChangedFields, SchemaFieldDiff{
+				FieldName:    name,
+				OldType:      oldGQL,
+				NewType:      newGQL,
+				ArgTypeDiffs: argDiffs,
+			})
+		}
+	}
+	return diff
+}
+
+func fieldsByName(raw any) map[string]map[string]any {
+	items, _ := raw.([]any)
+	out := make(map[string]map[string]any)
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _ := m["name"].(string); name != "" {
+			out[name] = m
+		}
+	}
+	return out
+}
+
+// diffFieldArgs reports "argName: added", "argName: removed" or "argName: oldType -> newType" for
+// every argument of oldField/newField whose presence or type changed.
+func diffFieldArgs(oldField, newField map[string]any) []string {
+	oldByName := fieldsByName(oldField["args"])
+	newByName := fieldsByName(newField["args"])
+
+	seen := make(map[string]bool)
+	var names []string
+	for name := range oldByName {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for name := range newByName {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var out []string
+	for _, name := range names {
+		oldArg, oldOK := oldByName[name]
+		newArg, newOK := newByName[name]
+		switch {
+		case oldOK && !newOK:
+			out = append(out, fmt.Sprintf("%s: removed", name))
+		case !oldOK && newOK:
+			out = append(out, fmt.Sprintf("%s: added", name))
+		default:
+			oldGQL, err1 := TypeRefToGQL(oldArg["type"])
+			newGQL, err2 := TypeRefToGQL(newArg["type"])
+			if err1 == nil && err2 == nil && oldGQL != newGQL {
+				out = append(out, fmt.Sprintf("%s: %s -> %s", name, oldGQL, newGQL))
+			}
+		}
+	}
+	return out
+}
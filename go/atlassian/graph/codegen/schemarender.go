@@ -0,0 +1,136 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderSchemaModel turns a SchemaModel produced by WalkSchema into Go source: one struct per
+// Object/Input Object, one discriminated struct per Union/Interface (a Typename field plus an
+// UnmarshalJSON switching on __typename into a pointer field per possible type - the same pattern
+// CompassSearchComponentsResult already used by hand in the searchComponents-only generator), one
+// named string type plus constants per Enum (via the existing renderEnum), and one DecodeXxx
+// helper per root Query/Mutation field. The result is unformatted; callers run it through
+// go/format.Source the same way every other generator under go/tools/generate_* does.
+func RenderSchemaModel(model *SchemaModel, packageName string, generatorPath string) (string, error) {
+	lines := []string{
+		fmt.Sprintf("// Code generated by %s via atlassian/graph/codegen. DO NOT EDIT.", generatorPath),
+		fmt.Sprintf("package %s", packageName),
+		"",
+	}
+	lines = append(lines, "import (", "\t\"encoding/json\"", "\t\"errors\"", "\t\"fmt\"")
+	for _, imp := range model.ExtraImports {
+		lines = append(lines, fmt.Sprintf("\t%q", imp))
+	}
+	lines = append(lines, ")", "")
+
+	for _, snippet := range model.SupportSnippets {
+		lines = append(lines, snippet, "")
+	}
+
+	for _, e := range model.Enums {
+		lines = append(lines, renderEnum(e)...)
+	}
+	for _, obj := range model.Objects {
+		lines = append(lines, renderFieldStruct(obj.name, obj.fields)...)
+	}
+	for _, obj := range model.InputObjects {
+		lines = append(lines, renderFieldStruct(obj.name, obj.fields)...)
+	}
+	for _, u := range model.Unions {
+		lines = append(lines, renderDiscriminatedType(u)...)
+	}
+	for _, i := range model.Interfaces {
+		lines = append(lines, renderDiscriminatedType(i)...)
+	}
+	for _, op := range model.Operations {
+		lines = append(lines, renderOperationDecoder(op)...)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderDiscriminatedType renders a Union or Interface as a struct carrying the raw __typename
+// plus one pointer field per possible type, and an UnmarshalJSON that decodes into whichever
+// pointer field matches the payload's __typename.
+func renderDiscriminatedType(u schemaUnion) []string {
+	lines := []string{
+		fmt.Sprintf("type %s struct {", u.name),
+		"\tTypename string `json:\"__typename\"`",
+	}
+	for _, possible := range u.possibleTypes {
+		lines = append(lines, fmt.Sprintf("\t%s *%s `json:\"-\"`", possible, possible))
+	}
+	lines = append(lines, "}", "")
+
+	lines = append(lines,
+		fmt.Sprintf("func (v *%s) UnmarshalJSON(data []byte) error {", u.name),
+		"\tvar base struct {",
+		"\t\tTypename string `json:\"__typename\"`",
+		"\t}",
+		"\tif err := json.Unmarshal(data, &base); err != nil {",
+		"\t\treturn err",
+		"\t}",
+		"\tif base.Typename == \"\" {",
+		fmt.Sprintf("\t\treturn errors.New(\"missing __typename for %s\")", u.name),
+		"\t}",
+		"\tv.Typename = base.Typename",
+		"\tswitch base.Typename {",
+	)
+	for _, possible := range u.possibleTypes {
+		lines = append(lines,
+			fmt.Sprintf("\tcase %q:", possible),
+			fmt.Sprintf("\t\tvar val %s", possible),
+			"\t\tif err := json.Unmarshal(data, &val); err != nil {",
+			"\t\t\treturn err",
+			"\t\t}",
+			fmt.Sprintf("\t\tv.%s = &val", possible),
+		)
+	}
+	lines = append(lines,
+		"\tdefault:",
+		fmt.Sprintf("\t\treturn fmt.Errorf(\"unsupported %s type: %%s\", base.Typename)", u.name),
+		"\t}",
+		"\treturn nil",
+		"}",
+		"",
+	)
+	return lines
+}
+
+// renderOperationDecoder renders a <GoName>Data struct wrapping a single root field plus a
+// DecodeXxx(map[string]any) (*XxxData, error) helper, the same shape DecodeCompassSearchComponents
+// already hand-implements for the one searchComponents operation.
+func renderOperationDecoder(op schemaOperation) []string {
+	goName := ToGoName(op.fieldName)
+	dataType := goName + "Data"
+
+	fieldType := op.goType
+	if op.list {
+		fieldType = "[]" + fieldType
+	} else if op.nullable {
+		fieldType = "*" + fieldType
+	}
+
+	lines := []string{
+		fmt.Sprintf("// %s wraps the %s.%s root field's decoded result.", dataType, op.rootLabel, op.fieldName),
+		fmt.Sprintf("type %s struct {", dataType),
+		fmt.Sprintf("\t%s %s `json:\"%s\"`", goName, fieldType, op.fieldName),
+		"}",
+		"",
+		fmt.Sprintf("// Decode%s decodes a %s.%s payload into a %s.", goName, op.rootLabel, op.fieldName, dataType),
+		fmt.Sprintf("func Decode%s(data map[string]any) (*%s, error) {", goName, dataType),
+		"\tb, err := json.Marshal(data)",
+		"\tif err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		fmt.Sprintf("\tvar out %s", dataType),
+		"\tif err := json.Unmarshal(b, &out); err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		"\treturn &out, nil",
+		"}",
+		"",
+	}
+	return lines
+}
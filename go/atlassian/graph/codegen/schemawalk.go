@@ -0,0 +1,295 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SchemaModel is the intermediate representation WalkSchema builds from a full GraphQL
+// introspection result: every Object, Interface, Union, Enum and Input Object the schema declares
+// (skipping introspection's own "__"-prefixed types), plus every root Query/Mutation field as an
+// Operation. RenderSchemaModel turns this into Go source without re-walking the schema - the same
+// resolve/render split ResolveOperations/Render already use for a single hand-picked operation,
+// just covering the whole schema instead of one OperationManifest's worth of fields.
+type SchemaModel struct {
+	Objects      []schemaObject
+	InputObjects []schemaObject
+	// Interfaces and Unions share the same discriminated-struct shape: a Typename field plus a
+	// pointer field per possible type, since both require an inline fragment and a __typename
+	// check to know which concrete fields a payload actually carries.
+	Interfaces []schemaUnion
+	Unions     []schemaUnion
+	Enums      []resolvedEnum
+	Operations []schemaOperation
+	// ExtraImports and SupportSnippets mirror ResolvedOperations' fields of the same name: every
+	// custom scalar the walk actually encountered (DateTime, URL, ...) can require an import and/or
+	// supporting MarshalJSON/UnmarshalJSON source, the same way a single hand-picked operation does.
+	ExtraImports    []string
+	SupportSnippets []string
+}
+
+type schemaObject struct {
+	name   string
+	fields []resolvedField
+}
+
+type schemaUnion struct {
+	name          string
+	possibleTypes []string
+}
+
+type schemaOperation struct {
+	// rootLabel is "Query" or "Mutation", recorded only for the doc comment on the generated
+	// DecodeXxx helper.
+	rootLabel string
+	fieldName string
+	goType    string
+	list      bool
+	nullable  bool
+}
+
+// WalkSchema classifies every named type in schema by kind and resolves its fields' Go types via
+// tm, producing a SchemaModel that covers every Object, Interface, Union, Enum and Input Object in
+// one pass - unlike ResolveOperations, which only resolves the handful of fields an
+// OperationManifest names. Every enum discovered gets a real generated Go enum type (unlike
+// ResolveFieldType's manifest-driven fallback to a plain string for enums tm hasn't registered),
+// since a full-schema walk has nowhere else to push that decision.
+func WalkSchema(schema map[string]any, tm *TypeMapper) (*SchemaModel, error) {
+	types, err := TypesMap(schema)
+	if err != nil {
+		return nil, err
+	}
+	queryTypeName := rootTypeName(schema, "queryType")
+	mutationTypeName := rootTypeName(schema, "mutationType")
+
+	model := &SchemaModel{}
+	scalarsUsed := map[string]bool{}
+	for _, name := range sortedTypeNames(types) {
+		if strings.HasPrefix(name, "__") {
+			continue
+		}
+		def := types[name]
+		kind, _ := def["kind"].(string)
+
+		switch kind {
+		case "OBJECT":
+			if name == queryTypeName || name == mutationTypeName {
+				label := "Mutation"
+				if name == queryTypeName {
+					label = "Query"
+				}
+				ops, err := walkOperations(def, types, tm, label, scalarsUsed)
+				if err != nil {
+					return nil, err
+				}
+				model.Operations = append(model.Operations, ops...)
+				continue
+			}
+			obj, err := walkFieldsDef(name, def["fields"], types, tm, scalarsUsed)
+			if err != nil {
+				return nil, err
+			}
+			model.Objects = append(model.Objects, obj)
+		case "INTERFACE":
+			model.Interfaces = append(model.Interfaces, walkPossibleTypes(name, def))
+		case "UNION":
+			model.Unions = append(model.Unions, walkPossibleTypes(name, def))
+		case "ENUM":
+			model.Enums = append(model.Enums, walkEnum(name, def))
+		case "INPUT_OBJECT":
+			obj, err := walkFieldsDef(name, def["inputFields"], types, tm, scalarsUsed)
+			if err != nil {
+				return nil, err
+			}
+			model.InputObjects = append(model.InputObjects, obj)
+		}
+	}
+
+	importSet := map[string]bool{}
+	var supportSnippets []string
+	for name := range scalarsUsed {
+		mapping, _ := tm.ScalarMapping(name)
+		if mapping.Import != "" {
+			importSet[mapping.Import] = true
+		}
+		if mapping.Support != "" {
+			supportSnippets = append(supportSnippets, mapping.Support)
+		}
+	}
+	for imp := range importSet {
+		model.ExtraImports = append(model.ExtraImports, imp)
+	}
+	sort.Strings(model.ExtraImports)
+	sort.Strings(supportSnippets)
+	model.SupportSnippets = supportSnippets
+
+	return model, nil
+}
+
+func rootTypeName(schema map[string]any, key string) string {
+	m, ok := schema[key].(map[string]any)
+	if !ok {
+		return ""
+	}
+	name, _ := m["name"].(string)
+	return name
+}
+
+func sortedTypeNames(types map[string]map[string]any) []string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func walkFieldsDef(typeName string, rawFields any, types map[string]map[string]any, tm *TypeMapper, scalarsUsed map[string]bool) (schemaObject, error) {
+	raw, _ := rawFields.([]any)
+	obj := schemaObject{name: typeName}
+	for _, f := range raw {
+		fieldDef, ok := f.(map[string]any)
+		if !ok {
+			continue
+		}
+		fieldName, _ := fieldDef["name"].(string)
+		if fieldName == "" {
+			continue
+		}
+		base, list, nullable, err := resolveSchemaFieldType(fieldDef["type"], tm, scalarsUsed)
+		if err != nil {
+			return schemaObject{}, fmt.Errorf("%s.%s: %w", typeName, fieldName, err)
+		}
+		goType := base
+		optional := false
+		if list {
+			goType = "[]" + base
+		} else if nullable {
+			optional = true
+		}
+		obj.fields = append(obj.fields, resolvedField{
+			Name:     fieldName,
+			GoName:   ToGoName(fieldName),
+			GoType:   goType,
+			Optional: optional,
+		})
+	}
+	return obj, nil
+}
+
+func walkPossibleTypes(name string, def map[string]any) schemaUnion {
+	raw, _ := def["possibleTypes"].([]any)
+	u := schemaUnion{name: name}
+	for _, p := range raw {
+		m, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		if n, _ := m["name"].(string); n != "" {
+			u.possibleTypes = append(u.possibleTypes, n)
+		}
+	}
+	return u
+}
+
+func walkEnum(name string, def map[string]any) resolvedEnum {
+	raw, _ := def["enumValues"].([]any)
+	e := resolvedEnum{graphQLName: name, goName: name}
+	for _, v := range raw {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if n, _ := m["name"].(string); n != "" {
+			e.values = append(e.values, n)
+		}
+	}
+	return e
+}
+
+func walkOperations(rootDef map[string]any, types map[string]map[string]any, tm *TypeMapper, label string, scalarsUsed map[string]bool) ([]schemaOperation, error) {
+	raw, _ := rootDef["fields"].([]any)
+	var ops []schemaOperation
+	for _, f := range raw {
+		fieldDef, ok := f.(map[string]any)
+		if !ok {
+			continue
+		}
+		fieldName, _ := fieldDef["name"].(string)
+		if fieldName == "" {
+			continue
+		}
+		base, list, nullable, err := resolveSchemaFieldType(fieldDef["type"], tm, scalarsUsed)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", label, fieldName, err)
+		}
+		ops = append(ops, schemaOperation{
+			rootLabel: label,
+			fieldName: fieldName,
+			goType:    base,
+			list:      list,
+			nullable:  nullable,
+		})
+	}
+	return ops, nil
+}
+
+// resolveSchemaFieldType resolves a field's typeRef to the Go type its generated struct field
+// should reference, unwrapped of NON_NULL/LIST wrappers: base is the bare Go type name (never
+// containing "[]" or a leading "*"), list reports whether the field is a GraphQL list (the caller
+// wraps base in "[]"), and nullable reports whether a non-list field may be null (the caller makes
+// it a pointer). Compound kinds (OBJECT/INTERFACE/UNION/INPUT_OBJECT) resolve to the GraphQL
+// type's own name directly, since WalkSchema generates a Go type of that exact name for every one
+// of them.
+func resolveSchemaFieldType(typeRef any, tm *TypeMapper, scalarsUsed map[string]bool) (base string, list bool, nullable bool, err error) {
+	name, kind, list, nullable := unwrapFieldType(typeRef)
+	if name == "" {
+		return "", false, false, fmt.Errorf("invalid typeRef")
+	}
+	switch kind {
+	case "SCALAR":
+		if mapping, ok := tm.ScalarMapping(name); ok {
+			scalarsUsed[name] = true
+			return mapping.GoType, list, nullable, nil
+		}
+		return "json.RawMessage", list, nullable, nil
+	case "ENUM":
+		if goName, ok := tm.EnumGoName(name); ok {
+			return goName, list, nullable, nil
+		}
+		return name, list, nullable, nil
+	case "OBJECT", "INTERFACE", "UNION", "INPUT_OBJECT":
+		return name, list, nullable, nil
+	default:
+		return "", false, false, fmt.Errorf("unsupported field type %s (%s)", name, kind)
+	}
+}
+
+// unwrapFieldType walks typeRef's NON_NULL/LIST wrappers, returning the innermost named type's
+// name and kind, whether a LIST wrapper appeared anywhere in the chain, and whether the field may
+// be null. A Go slice already represents "no list" as nil, so list-ness alone decides the "[]T"
+// wrapping; nullable only matters for a bare (non-list) field, where it decides whether the
+// generated struct field is a pointer - matching the goType/jsonTag convention the Compass
+// searchComponents generator already uses.
+func unwrapFieldType(typeRef any) (name string, kind string, list bool, nullable bool) {
+	nullable = true
+	cur, _ := typeRef.(map[string]any)
+	for i := 0; i < 16 && cur != nil; i++ {
+		k, _ := cur["kind"].(string)
+		switch k {
+		case "NON_NULL":
+			nullable = false
+			cur, _ = cur["ofType"].(map[string]any)
+		case "LIST":
+			list = true
+			nullable = true
+			cur, _ = cur["ofType"].(map[string]any)
+		default:
+			name, _ = cur["name"].(string)
+			kind = k
+			return
+		}
+	}
+	return
+}
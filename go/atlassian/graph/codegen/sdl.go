@@ -0,0 +1,347 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ParseSDL translates a (subset of) hand-authored GraphQL SDL into the same introspection-shaped
+// map[string]any LoadSchema returns from a live `__schema { types { ... } }` query, so every
+// ResolveXxx function in this package - and every ConnectionFieldManifest/MutationManifest -
+// derives its config the same way regardless of whether the schema came from a live introspection
+// result or a .graphql file checked into the repo. It supports scalar/type/input/union/enum
+// declarations, NON_NULL (!) and LIST ([]) type wrapping, field arguments, and a top-level `schema
+// { query: ... mutation: ... }` block; it does not support interfaces, directives, or descriptions
+// - nothing in this package's manifests needs them, and failing loudly on an unsupported construct
+// beats silently misreading one.
+//
+// A field manifest can still override what ParseSDL (or a live schema) derives - e.g.
+// FieldManifest.ForceOptional - for the cases where a hand-authored SDL file's nullability doesn't
+// match reality, or a caller wants a pointer regardless.
+func ParseSDL(src string) (map[string]any, error) {
+	p := &sdlParser{toks: tokenizeSDL(src)}
+	return p.parseDocument()
+}
+
+func tokenizeSDL(src string) []string {
+	const punct = "{}[]()!:=|"
+	var toks []string
+	runes := []rune(src)
+	n := len(runes)
+	i := 0
+	for i < n {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r) || r == ',':
+			i++
+		case r == '#':
+			for i < n && runes[i] != '\n' {
+				i++
+			}
+		case r == '"':
+			if i+2 < n && runes[i+1] == '"' && runes[i+2] == '"' {
+				i += 3
+				for i+2 < n && !(runes[i] == '"' && runes[i+1] == '"' && runes[i+2] == '"') {
+					i++
+				}
+				i += 3
+			} else {
+				i++
+				for i < n && runes[i] != '"' {
+					i++
+				}
+				i++
+			}
+		case strings.ContainsRune(punct, r):
+			toks = append(toks, string(r))
+			i++
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < n && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			toks = append(toks, string(runes[start:i]))
+		default:
+			i++
+		}
+	}
+	return toks
+}
+
+type sdlParser struct {
+	toks []string
+	pos  int
+}
+
+func (p *sdlParser) peek() string {
+	if p.pos >= len(p.toks) {
+		return ""
+	}
+	return p.toks[p.pos]
+}
+
+func (p *sdlParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *sdlParser) expect(tok string) error {
+	if p.peek() != tok {
+		return fmt.Errorf("SDL parse error: expected %q, got %q at token %d", tok, p.peek(), p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *sdlParser) parseDocument() (map[string]any, error) {
+	types := map[string]map[string]any{}
+	queryTypeName := "Query"
+	mutationTypeName := ""
+
+	for p.pos < len(p.toks) {
+		switch kw := p.next(); kw {
+		case "schema":
+			if err := p.expect("{"); err != nil {
+				return nil, err
+			}
+			for p.peek() != "}" && p.peek() != "" {
+				opName := p.next()
+				if err := p.expect(":"); err != nil {
+					return nil, err
+				}
+				typeName := p.next()
+				switch opName {
+				case "query":
+					queryTypeName = typeName
+				case "mutation":
+					mutationTypeName = typeName
+				case "subscription":
+					// Parsed but ignored - no ResolveXxx in this package reads a subscription root
+					// yet.
+				default:
+					return nil, fmt.Errorf("SDL parse error: unknown root operation %q", opName)
+				}
+			}
+			if err := p.expect("}"); err != nil {
+				return nil, err
+			}
+		case "scalar":
+			name := p.next()
+			types[name] = map[string]any{"kind": "SCALAR", "name": name}
+		case "type", "input":
+			def, err := p.parseObjectLike(kw)
+			if err != nil {
+				return nil, err
+			}
+			types[def["name"].(string)] = def
+		case "union":
+			def, err := p.parseUnion()
+			if err != nil {
+				return nil, err
+			}
+			types[def["name"].(string)] = def
+		case "enum":
+			def, err := p.parseEnum()
+			if err != nil {
+				return nil, err
+			}
+			types[def["name"].(string)] = def
+		default:
+			return nil, fmt.Errorf("SDL parse error: unsupported top-level keyword %q", kw)
+		}
+	}
+
+	resolveSDLTypeKinds(types)
+
+	rawTypes := make([]any, 0, len(types))
+	for _, t := range types {
+		rawTypes = append(rawTypes, t)
+	}
+	schema := map[string]any{
+		"queryType": map[string]any{"name": queryTypeName},
+		"types":     rawTypes,
+	}
+	if mutationTypeName != "" {
+		schema["mutationType"] = map[string]any{"name": mutationTypeName}
+	}
+	return schema, nil
+}
+
+// parseObjectLike parses `type Name { field(arg: T): T! }` (kw == "type") or `input Name { field:
+// T }` (kw == "input") - the two declarations that differ only in their introspection kind and
+// whether their field list is called "fields" or "inputFields".
+func (p *sdlParser) parseObjectLike(kw string) (map[string]any, error) {
+	name := p.next()
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var fields []any
+	for p.peek() != "}" && p.peek() != "" {
+		fieldName := p.next()
+		var args []any
+		if p.peek() == "(" {
+			p.pos++
+			for p.peek() != ")" && p.peek() != "" {
+				argName := p.next()
+				if err := p.expect(":"); err != nil {
+					return nil, err
+				}
+				argType, err := p.parseTypeRef()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, map[string]any{"name": argName, "type": argType})
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		fieldType, err := p.parseTypeRef()
+		if err != nil {
+			return nil, err
+		}
+		field := map[string]any{"name": fieldName, "type": fieldType}
+		if len(args) > 0 {
+			field["args"] = args
+		}
+		fields = append(fields, field)
+	}
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+
+	if kw == "input" {
+		return map[string]any{"kind": "INPUT_OBJECT", "name": name, "inputFields": fields}, nil
+	}
+	return map[string]any{"kind": "OBJECT", "name": name, "fields": fields}, nil
+}
+
+func (p *sdlParser) parseUnion() (map[string]any, error) {
+	name := p.next()
+	if err := p.expect("="); err != nil {
+		return nil, err
+	}
+	var possible []any
+	for {
+		possible = append(possible, map[string]any{"name": p.next()})
+		if p.peek() != "|" {
+			break
+		}
+		p.pos++
+	}
+	return map[string]any{"kind": "UNION", "name": name, "possibleTypes": possible}, nil
+}
+
+func (p *sdlParser) parseEnum() (map[string]any, error) {
+	name := p.next()
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var values []any
+	for p.peek() != "}" && p.peek() != "" {
+		values = append(values, map[string]any{"name": p.next()})
+	}
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+	return map[string]any{"kind": "ENUM", "name": name, "enumValues": values}, nil
+}
+
+// parseTypeRef parses a field/argument type reference, e.g. "[ID!]!", into the same
+// kind/name/ofType wrapper shape introspection JSON uses - minus the leaf's own "kind", which
+// isn't knowable from SDL syntax alone and is filled in afterwards by resolveSDLTypeKinds once
+// every type declaration in the document has been seen.
+func (p *sdlParser) parseTypeRef() (map[string]any, error) {
+	var inner map[string]any
+	if p.peek() == "[" {
+		p.pos++
+		elem, err := p.parseTypeRef()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		inner = map[string]any{"kind": "LIST", "ofType": elem}
+	} else {
+		name := p.next()
+		if name == "" {
+			return nil, fmt.Errorf("SDL parse error: expected a type name at token %d", p.pos)
+		}
+		inner = map[string]any{"name": name}
+	}
+	if p.peek() == "!" {
+		p.pos++
+		return map[string]any{"kind": "NON_NULL", "ofType": inner}, nil
+	}
+	return inner, nil
+}
+
+var sdlBuiltinScalars = map[string]bool{
+	"Int": true, "Float": true, "String": true, "Boolean": true, "ID": true,
+}
+
+// resolveSDLTypeKinds fills in the "kind" every leaf type reference is missing right after
+// parsing (SDL doesn't mark whether `Foo` names a scalar, object, enum, etc. at the reference
+// site) by looking the name up against every type this document declared, falling back to SCALAR
+// for GraphQL's built-in scalars and any other bareword the document never declared via `scalar` -
+// the same "unknown scalar" leniency ResolveFieldType's SCALAR branch already tolerates via the
+// caller's TypeMapper.
+func resolveSDLTypeKinds(types map[string]map[string]any) {
+	kindOf := func(name string) string {
+		if def, ok := types[name]; ok {
+			if k, _ := def["kind"].(string); k != "" {
+				return k
+			}
+		}
+		return "SCALAR"
+	}
+	var fillTypeRef func(tr map[string]any)
+	fillTypeRef = func(tr map[string]any) {
+		if tr == nil {
+			return
+		}
+		if ofType, ok := tr["ofType"].(map[string]any); ok {
+			fillTypeRef(ofType)
+			return
+		}
+		if _, has := tr["kind"]; has {
+			return
+		}
+		if name, _ := tr["name"].(string); name != "" {
+			tr["kind"] = kindOf(name)
+		}
+	}
+	fillFieldList := func(raw any) {
+		items, _ := raw.([]any)
+		for _, item := range items {
+			f, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			if tr, ok := f["type"].(map[string]any); ok {
+				fillTypeRef(tr)
+			}
+			argsRaw, _ := f["args"].([]any)
+			for _, a := range argsRaw {
+				arg, ok := a.(map[string]any)
+				if !ok {
+					continue
+				}
+				if tr, ok := arg["type"].(map[string]any); ok {
+					fillTypeRef(tr)
+				}
+			}
+		}
+	}
+	for _, def := range types {
+		fillFieldList(def["fields"])
+		fillFieldList(def["inputFields"])
+	}
+	_ = sdlBuiltinScalars // referenced by kindOf's fallback semantics; kept as named documentation
+}
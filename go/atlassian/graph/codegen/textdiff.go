@@ -0,0 +1,103 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnifiedDiff renders a minimal unified diff between oldText and newText, labeled fromFile/toFile,
+// for tooling like a -check/-diff flag that needs a human (and CI-log) readable diff without
+// pulling in an external diff library. Unlike `diff -u`, it doesn't trim the hunk down to a few
+// lines of context around each change - it reports the whole file as one hunk - which is plenty
+// readable for the modest generated files this compares and keeps the line-diffing itself simple
+// enough to trust without a test runner to check it against.
+func UnifiedDiff(fromFile, toFile, oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+	oldLines := splitLines(oldText)
+	newLines := splitLines(newText)
+	ops := diffLines(oldLines, newLines)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n", fromFile)
+	fmt.Fprintf(&b, "+++ %s\n", toFile)
+	fmt.Fprintf(&b, "@@ -1,%d +1,%d @@\n", len(oldLines), len(newLines))
+	for _, op := range ops {
+		switch op.kind {
+		case diffEqual:
+			b.WriteString(" " + op.text + "\n")
+		case diffDelete:
+			b.WriteString("-" + op.text + "\n")
+		case diffInsert:
+			b.WriteString("+" + op.text + "\n")
+		}
+	}
+	return b.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+type diffOpKind int
+
+const (
+	diffEqual diffOpKind = iota
+	diffDelete
+	diffInsert
+)
+
+type diffOp struct {
+	kind diffOpKind
+	text string
+}
+
+// diffLines computes a line-level diff via the standard LCS dynamic-programming table: fine for
+// the file sizes UnifiedDiff compares even at its O(len(a)*len(b)) cost.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{kind: diffEqual, text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{kind: diffDelete, text: a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: diffInsert, text: b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{kind: diffDelete, text: a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{kind: diffInsert, text: b[j]})
+	}
+	return ops
+}
@@ -0,0 +1,106 @@
+package codegen
+
+// ScalarMapping describes how a custom GraphQL scalar decodes into Go: the Go type used for the
+// field, the import path it needs (empty if none), and any supporting source — typically
+// MarshalJSON/UnmarshalJSON methods — that must be emitted once per generated file for that type
+// to round-trip through encoding/json. time.Time and json.RawMessage already implement the json
+// interfaces themselves, so most mappings leave Support empty.
+type ScalarMapping struct {
+	GoType  string
+	Import  string
+	Support string
+}
+
+// TypeMapper resolves GraphQL scalar and enum types to Go types for codegen, replacing the fixed
+// ID/String/Int/Float/Boolean-only handling goTypeForScalar used to have. Generators layer their
+// own scalars and enums on top of DefaultTypeMapper() in a small init file next to their main.go,
+// the same way generate_team_models builds teamManifest, instead of forking GoTypeForField.
+type TypeMapper struct {
+	scalars map[string]ScalarMapping
+	enums   map[string]string
+}
+
+// NewTypeMapper builds an empty TypeMapper. Most generators should start from DefaultTypeMapper
+// instead, which ships the scalar mappings common across Atlassian's GraphQL schemas.
+func NewTypeMapper() *TypeMapper {
+	return &TypeMapper{scalars: map[string]ScalarMapping{}, enums: map[string]string{}}
+}
+
+// DefaultTypeMapper ships the builtin GraphQL scalars plus the custom scalars generators run into
+// most often: DateTime decodes straight into time.Time (which already implements
+// MarshalJSON/UnmarshalJSON for RFC3339 strings, so it needs no Support), URL decodes into a
+// GraphQLURL wrapper (net/url.URL doesn't implement json.Unmarshaler on its own), JSON defers to
+// json.RawMessage, and Long maps to int64.
+func DefaultTypeMapper() *TypeMapper {
+	tm := NewTypeMapper()
+	tm.RegisterScalar("ID", ScalarMapping{GoType: "string"})
+	tm.RegisterScalar("String", ScalarMapping{GoType: "string"})
+	tm.RegisterScalar("ARI", ScalarMapping{GoType: "string"})
+	tm.RegisterScalar("Int", ScalarMapping{GoType: "int"})
+	tm.RegisterScalar("Float", ScalarMapping{GoType: "float64"})
+	tm.RegisterScalar("Boolean", ScalarMapping{GoType: "bool"})
+	tm.RegisterScalar("Long", ScalarMapping{GoType: "int64"})
+	tm.RegisterScalar("JSON", ScalarMapping{GoType: "json.RawMessage"})
+	tm.RegisterScalar("DateTime", ScalarMapping{GoType: "time.Time", Import: "time"})
+	tm.RegisterScalar("URL", ScalarMapping{
+		GoType: "GraphQLURL",
+		Import: "net/url",
+		Support: `// GraphQLURL decodes a GraphQL URL scalar (a JSON string) into a parsed *url.URL and
+// encodes it back the same way.
+type GraphQLURL struct {
+	*url.URL
+}
+
+func (u *GraphQLURL) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		u.URL = nil
+		return nil
+	}
+	parsed, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	u.URL = parsed
+	return nil
+}
+
+func (u GraphQLURL) MarshalJSON() ([]byte, error) {
+	if u.URL == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(u.URL.String())
+}`,
+	})
+	return tm
+}
+
+// RegisterScalar adds or overrides the mapping for a GraphQL scalar name, returning tm so calls
+// can be chained off DefaultTypeMapper().
+func (tm *TypeMapper) RegisterScalar(name string, mapping ScalarMapping) *TypeMapper {
+	tm.scalars[name] = mapping
+	return tm
+}
+
+// RegisterEnum opts a GraphQL enum type into generating a proper named Go string type (with typed
+// constants per enum value and a Values() method), instead of the default plain-string flattening.
+// goName is the Go type name to generate, e.g. RegisterEnum("TeamState", "TeamState").
+func (tm *TypeMapper) RegisterEnum(graphQLName, goName string) *TypeMapper {
+	tm.enums[graphQLName] = goName
+	return tm
+}
+
+// ScalarMapping looks up the registered mapping for a scalar name.
+func (tm *TypeMapper) ScalarMapping(name string) (ScalarMapping, bool) {
+	m, ok := tm.scalars[name]
+	return m, ok
+}
+
+// EnumGoName returns the Go type name registered for a GraphQL enum, if any.
+func (tm *TypeMapper) EnumGoName(graphQLName string) (string, bool) {
+	name, ok := tm.enums[graphQLName]
+	return name, ok
+}
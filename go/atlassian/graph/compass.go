@@ -0,0 +1,560 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/graph/gen"
+	"atlassian/atlassian/graph/mappers"
+	"atlassian/atlassian/pagination"
+)
+
+// compassPageRetryBackoff is how long the Compass iterators wait before retrying a page fetch
+// that failed (transport error or GraphQL error), before giving up and surfacing it; mirrors
+// worklogPageRetryBackoff in jira_worklogs.go.
+const compassPageRetryBackoff = 250 * time.Millisecond
+
+func (c *Client) ListComponents(ctx context.Context, cloudID string, pageSize int) ([]atlassian.CompassComponent, error) {
+	return c.ListComponentsWithOptions(ctx, cloudID, pageSize, gen.CompassPageOptions{})
+}
+
+// ListComponentsWithOptions is ListComponents with explicit timeout/deadline/resume control; see
+// IterateComponentsWithOptions for how opts is applied.
+func (c *Client) ListComponentsWithOptions(ctx context.Context, cloudID string, pageSize int, opts gen.CompassPageOptions) ([]atlassian.CompassComponent, error) {
+	var out []atlassian.CompassComponent
+	err := c.IterateComponentsWithOptions(ctx, cloudID, pageSize, opts, func(node gen.CompassComponentNode) error {
+		mapped, err := mappers.CompassComponentFromGraphQL(cloudID, &node)
+		if err != nil {
+			return err
+		}
+		out = append(out, mapped)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IterateComponents walks every Compass component on cloudID's Relay connection page by page,
+// calling yield for each raw gen.CompassComponentNode as soon as its page arrives instead of
+// buffering the whole connection like ListComponents does. Returning an error from yield stops
+// iteration and is propagated back to the caller.
+func (c *Client) IterateComponents(ctx context.Context, cloudID string, pageSize int, yield func(gen.CompassComponentNode) error) error {
+	return c.IterateComponentsWithOptions(ctx, cloudID, pageSize, gen.CompassPageOptions{}, yield)
+}
+
+func (c *Client) IterateComponentsWithOptions(ctx context.Context, cloudID string, pageSize int, opts gen.CompassPageOptions, yield func(gen.CompassComponentNode) error) error {
+	ctx, cancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Deadline: opts.Deadline})
+	defer cancel()
+
+	it, err := c.newComponentsIterator(cloudID, pageSize, opts)
+	if err != nil {
+		return err
+	}
+	for {
+		node, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if node == nil {
+			return nil
+		}
+		if err := yield(*node); err != nil {
+			return err
+		}
+	}
+}
+
+// StreamComponents is the channel-based counterpart to IterateComponents: the returned channel
+// yields one gen.CompassComponentNode per component and closes once the connection is exhausted,
+// ctx is canceled, or a page fetch ultimately fails; call the returned Err func afterwards to
+// check which (if either) of the latter two happened.
+func (c *Client) StreamComponents(ctx context.Context, cloudID string, pageSize int) (<-chan gen.CompassComponentNode, func() error) {
+	return c.StreamComponentsWithOptions(ctx, cloudID, pageSize, gen.CompassPageOptions{})
+}
+
+func (c *Client) StreamComponentsWithOptions(ctx context.Context, cloudID string, pageSize int, opts gen.CompassPageOptions) (<-chan gen.CompassComponentNode, func() error) {
+	ctx, cancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Deadline: opts.Deadline})
+	it, err := c.newComponentsIterator(cloudID, pageSize, opts)
+	if err != nil {
+		cancel()
+		out := make(chan gen.CompassComponentNode)
+		close(out)
+		return out, func() error { return err }
+	}
+	ch := it.Channel(ctx)
+	return ch, func() error {
+		defer cancel()
+		return it.Err()
+	}
+}
+
+// newComponentsIterator builds the pagination.CursorIterator shared by ListComponents,
+// IterateComponents, and StreamComponents, so the cursor-resolution and retry-with-backoff logic
+// around compass.components only needs to be written once.
+func (c *Client) newComponentsIterator(cloudID string, pageSize int, opts gen.CompassPageOptions) (*pagination.CursorIterator[gen.CompassComponentNode], error) {
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return nil, errors.New("cloudID is required")
+	}
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+
+	seenCursors := map[string]struct{}{}
+
+	fetch := func(ctx context.Context, after *string) ([]gen.CompassComponentNode, bool, *string, error) {
+		pageCtx, pageCancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Timeout: opts.Timeout})
+		defer pageCancel()
+
+		conn, err := c.fetchComponentsPage(pageCtx, cloud, pageSize, after)
+		if err != nil {
+			time.Sleep(compassPageRetryBackoff)
+			conn, err = c.fetchComponentsPage(pageCtx, cloud, pageSize, after)
+			if err != nil {
+				return nil, false, nil, err
+			}
+		}
+
+		nodes := make([]gen.CompassComponentNode, len(conn.Edges))
+		for i, edge := range conn.Edges {
+			nodes[i] = edge.Node
+		}
+
+		next, hasMore, err := nextAfterForCompassComponents(conn.PageInfo, conn.Edges)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		if !hasMore {
+			return nodes, false, nil, nil
+		}
+		if _, seen := seenCursors[next]; seen {
+			return nil, false, nil, errors.New("pagination cursor repeated; aborting to prevent infinite loop")
+		}
+		seenCursors[next] = struct{}{}
+		cursor := next
+		return nodes, true, &cursor, nil
+	}
+
+	return pagination.NewCursorIterator(fetch, pagination.CursorIteratorOptions{StartAfter: opts.StartAfter}), nil
+}
+
+func (c *Client) fetchComponentsPage(ctx context.Context, cloudID string, pageSize int, after *string) (*gen.CompassComponentConnection, error) {
+	if gen.CompassBackend == "rest" {
+		return gen.FetchCompassComponentsPageREST(ctx, c.HTTPClient, c.BaseURL, c.Auth, cloudID, pageSize, after)
+	}
+
+	vars := map[string]any{
+		"cloudId": cloudID,
+		"first":   pageSize,
+		"after":   after,
+	}
+	result, err := c.Execute(ctx, gen.CompassComponentsPageQuery, vars, "CompassComponentsPage", c.ExperimentalAPIs, 1)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Data == nil {
+		return nil, errors.New("missing data in CompassComponentsPage response")
+	}
+	conn, err := gen.DecodeCompassComponentsPage(result.Data)
+	if err != nil {
+		if len(result.Errors) > 0 {
+			return nil, &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+		}
+		return nil, fmt.Errorf("decode CompassComponentsPage: %w", err)
+	}
+	return conn, nil
+}
+
+func nextAfterForCompassComponents(pageInfo gen.CompassPageInfo, edges []gen.CompassComponentEdge) (string, bool, error) {
+	if !pageInfo.HasNextPage {
+		return "", false, nil
+	}
+	if pageInfo.EndCursor != nil && strings.TrimSpace(*pageInfo.EndCursor) != "" {
+		return strings.TrimSpace(*pageInfo.EndCursor), true, nil
+	}
+	for i := len(edges) - 1; i >= 0; i-- {
+		if edges[i].Cursor != nil && strings.TrimSpace(*edges[i].Cursor) != "" {
+			return strings.TrimSpace(*edges[i].Cursor), true, nil
+		}
+	}
+	return "", false, errors.New("pagination cursor missing for compass.components")
+}
+
+func (c *Client) ListRelationships(ctx context.Context, cloudID string, componentID string, pageSize int) ([]atlassian.CompassRelationship, error) {
+	return c.ListRelationshipsWithOptions(ctx, cloudID, componentID, pageSize, gen.CompassPageOptions{})
+}
+
+// ListRelationshipsWithOptions is ListRelationships with explicit timeout/deadline/resume
+// control; see IterateComponentsWithOptions for how opts is applied.
+func (c *Client) ListRelationshipsWithOptions(ctx context.Context, cloudID string, componentID string, pageSize int, opts gen.CompassPageOptions) ([]atlassian.CompassRelationship, error) {
+	var out []atlassian.CompassRelationship
+	err := c.IterateRelationshipsWithOptions(ctx, cloudID, componentID, pageSize, opts, func(node gen.CompassRelationshipNode) error {
+		mapped, err := mappers.CompassRelationshipFromGraphQL(&node)
+		if err != nil {
+			return err
+		}
+		out = append(out, mapped)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IterateRelationships walks every relationship on componentID's Relay connection page by page,
+// calling yield for each raw gen.CompassRelationshipNode as soon as its page arrives.
+func (c *Client) IterateRelationships(ctx context.Context, cloudID string, componentID string, pageSize int, yield func(gen.CompassRelationshipNode) error) error {
+	return c.IterateRelationshipsWithOptions(ctx, cloudID, componentID, pageSize, gen.CompassPageOptions{}, yield)
+}
+
+func (c *Client) IterateRelationshipsWithOptions(ctx context.Context, cloudID string, componentID string, pageSize int, opts gen.CompassPageOptions, yield func(gen.CompassRelationshipNode) error) error {
+	ctx, cancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Deadline: opts.Deadline})
+	defer cancel()
+
+	it, err := c.newRelationshipsIterator(cloudID, componentID, pageSize, opts)
+	if err != nil {
+		return err
+	}
+	for {
+		node, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if node == nil {
+			return nil
+		}
+		if err := yield(*node); err != nil {
+			return err
+		}
+	}
+}
+
+// StreamRelationships is the channel-based counterpart to IterateRelationships.
+func (c *Client) StreamRelationships(ctx context.Context, cloudID string, componentID string, pageSize int) (<-chan gen.CompassRelationshipNode, func() error) {
+	return c.StreamRelationshipsWithOptions(ctx, cloudID, componentID, pageSize, gen.CompassPageOptions{})
+}
+
+func (c *Client) StreamRelationshipsWithOptions(ctx context.Context, cloudID string, componentID string, pageSize int, opts gen.CompassPageOptions) (<-chan gen.CompassRelationshipNode, func() error) {
+	ctx, cancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Deadline: opts.Deadline})
+	it, err := c.newRelationshipsIterator(cloudID, componentID, pageSize, opts)
+	if err != nil {
+		cancel()
+		out := make(chan gen.CompassRelationshipNode)
+		close(out)
+		return out, func() error { return err }
+	}
+	ch := it.Channel(ctx)
+	return ch, func() error {
+		defer cancel()
+		return it.Err()
+	}
+}
+
+func (c *Client) newRelationshipsIterator(cloudID string, componentID string, pageSize int, opts gen.CompassPageOptions) (*pagination.CursorIterator[gen.CompassRelationshipNode], error) {
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return nil, errors.New("cloudID is required")
+	}
+	component := strings.TrimSpace(componentID)
+	if component == "" {
+		return nil, errors.New("componentID is required")
+	}
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+
+	seenCursors := map[string]struct{}{}
+
+	fetch := func(ctx context.Context, after *string) ([]gen.CompassRelationshipNode, bool, *string, error) {
+		pageCtx, pageCancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Timeout: opts.Timeout})
+		defer pageCancel()
+
+		conn, err := c.fetchRelationshipsPage(pageCtx, cloud, component, pageSize, after)
+		if err != nil {
+			time.Sleep(compassPageRetryBackoff)
+			conn, err = c.fetchRelationshipsPage(pageCtx, cloud, component, pageSize, after)
+			if err != nil {
+				return nil, false, nil, err
+			}
+		}
+
+		nodes := make([]gen.CompassRelationshipNode, len(conn.Edges))
+		for i, edge := range conn.Edges {
+			nodes[i] = edge.Node
+		}
+
+		next, hasMore, err := nextAfterForCompassRelationships(conn.PageInfo, conn.Edges)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		if !hasMore {
+			return nodes, false, nil, nil
+		}
+		if _, seen := seenCursors[next]; seen {
+			return nil, false, nil, errors.New("pagination cursor repeated; aborting to prevent infinite loop")
+		}
+		seenCursors[next] = struct{}{}
+		cursor := next
+		return nodes, true, &cursor, nil
+	}
+
+	return pagination.NewCursorIterator(fetch, pagination.CursorIteratorOptions{StartAfter: opts.StartAfter}), nil
+}
+
+func (c *Client) fetchRelationshipsPage(ctx context.Context, cloudID, componentID string, pageSize int, after *string) (*gen.CompassRelationshipConnection, error) {
+	if gen.CompassBackend == "rest" {
+		return gen.FetchCompassRelationshipsPageREST(ctx, c.HTTPClient, c.BaseURL, c.Auth, componentID, pageSize, after)
+	}
+
+	vars := map[string]any{
+		"cloudId":     cloudID,
+		"componentId": componentID,
+		"first":       pageSize,
+		"after":       after,
+	}
+	result, err := c.Execute(ctx, gen.CompassRelationshipsPageQuery, vars, "CompassRelationshipsPage", c.ExperimentalAPIs, 1)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Data == nil {
+		return nil, errors.New("missing data in CompassRelationshipsPage response")
+	}
+	conn, err := gen.DecodeCompassRelationshipsPage(result.Data)
+	if err != nil {
+		if len(result.Errors) > 0 {
+			return nil, &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+		}
+		return nil, fmt.Errorf("decode CompassRelationshipsPage: %w", err)
+	}
+	return conn, nil
+}
+
+func nextAfterForCompassRelationships(pageInfo gen.CompassPageInfo, edges []gen.CompassRelationshipEdge) (string, bool, error) {
+	if !pageInfo.HasNextPage {
+		return "", false, nil
+	}
+	if pageInfo.EndCursor != nil && strings.TrimSpace(*pageInfo.EndCursor) != "" {
+		return strings.TrimSpace(*pageInfo.EndCursor), true, nil
+	}
+	for i := len(edges) - 1; i >= 0; i-- {
+		if edges[i].Cursor != nil && strings.TrimSpace(*edges[i].Cursor) != "" {
+			return strings.TrimSpace(*edges[i].Cursor), true, nil
+		}
+	}
+	return "", false, errors.New("pagination cursor missing for compass.relationships")
+}
+
+func (c *Client) ListScorecardScores(ctx context.Context, cloudID string, componentID string, pageSize int) ([]atlassian.CompassScorecardScore, error) {
+	return c.ListScorecardScoresWithOptions(ctx, cloudID, componentID, pageSize, gen.CompassPageOptions{})
+}
+
+// ListScorecardScoresWithOptions is ListScorecardScores with explicit timeout/deadline/resume
+// control; see IterateComponentsWithOptions for how opts is applied.
+func (c *Client) ListScorecardScoresWithOptions(ctx context.Context, cloudID string, componentID string, pageSize int, opts gen.CompassPageOptions) ([]atlassian.CompassScorecardScore, error) {
+	var out []atlassian.CompassScorecardScore
+	err := c.IterateScorecardScoresWithOptions(ctx, cloudID, componentID, pageSize, opts, func(node gen.CompassScorecardNode) error {
+		mapped, err := mappers.CompassScorecardScoreFromGraphQL(componentID, &node)
+		if err != nil {
+			return err
+		}
+		out = append(out, mapped)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IterateScorecardScores walks every scorecard score on componentID's Relay connection page by
+// page, calling yield for each raw gen.CompassScorecardNode as soon as its page arrives.
+func (c *Client) IterateScorecardScores(ctx context.Context, cloudID string, componentID string, pageSize int, yield func(gen.CompassScorecardNode) error) error {
+	return c.IterateScorecardScoresWithOptions(ctx, cloudID, componentID, pageSize, gen.CompassPageOptions{}, yield)
+}
+
+func (c *Client) IterateScorecardScoresWithOptions(ctx context.Context, cloudID string, componentID string, pageSize int, opts gen.CompassPageOptions, yield func(gen.CompassScorecardNode) error) error {
+	ctx, cancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Deadline: opts.Deadline})
+	defer cancel()
+
+	it, err := c.newScorecardScoresIterator(cloudID, componentID, pageSize, opts)
+	if err != nil {
+		return err
+	}
+	for {
+		node, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if node == nil {
+			return nil
+		}
+		if err := yield(*node); err != nil {
+			return err
+		}
+	}
+}
+
+// StreamScorecardScores is the channel-based counterpart to IterateScorecardScores.
+func (c *Client) StreamScorecardScores(ctx context.Context, cloudID string, componentID string, pageSize int) (<-chan gen.CompassScorecardNode, func() error) {
+	return c.StreamScorecardScoresWithOptions(ctx, cloudID, componentID, pageSize, gen.CompassPageOptions{})
+}
+
+func (c *Client) StreamScorecardScoresWithOptions(ctx context.Context, cloudID string, componentID string, pageSize int, opts gen.CompassPageOptions) (<-chan gen.CompassScorecardNode, func() error) {
+	ctx, cancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Deadline: opts.Deadline})
+	it, err := c.newScorecardScoresIterator(cloudID, componentID, pageSize, opts)
+	if err != nil {
+		cancel()
+		out := make(chan gen.CompassScorecardNode)
+		close(out)
+		return out, func() error { return err }
+	}
+	ch := it.Channel(ctx)
+	return ch, func() error {
+		defer cancel()
+		return it.Err()
+	}
+}
+
+func (c *Client) newScorecardScoresIterator(cloudID string, componentID string, pageSize int, opts gen.CompassPageOptions) (*pagination.CursorIterator[gen.CompassScorecardNode], error) {
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return nil, errors.New("cloudID is required")
+	}
+	component := strings.TrimSpace(componentID)
+	if component == "" {
+		return nil, errors.New("componentID is required")
+	}
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+
+	seenCursors := map[string]struct{}{}
+
+	fetch := func(ctx context.Context, after *string) ([]gen.CompassScorecardNode, bool, *string, error) {
+		pageCtx, pageCancel := gen.ResolveCompassPageDeadline(ctx, gen.CompassPageOptions{Timeout: opts.Timeout})
+		defer pageCancel()
+
+		conn, err := c.fetchScorecardScoresPage(pageCtx, cloud, component, pageSize, after)
+		if err != nil {
+			time.Sleep(compassPageRetryBackoff)
+			conn, err = c.fetchScorecardScoresPage(pageCtx, cloud, component, pageSize, after)
+			if err != nil {
+				return nil, false, nil, err
+			}
+		}
+
+		nodes := make([]gen.CompassScorecardNode, len(conn.Edges))
+		for i, edge := range conn.Edges {
+			nodes[i] = edge.Node
+		}
+
+		next, hasMore, err := nextAfterForCompassScorecardScores(conn.PageInfo, conn.Edges)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		if !hasMore {
+			return nodes, false, nil, nil
+		}
+		if _, seen := seenCursors[next]; seen {
+			return nil, false, nil, errors.New("pagination cursor repeated; aborting to prevent infinite loop")
+		}
+		seenCursors[next] = struct{}{}
+		cursor := next
+		return nodes, true, &cursor, nil
+	}
+
+	return pagination.NewCursorIterator(fetch, pagination.CursorIteratorOptions{StartAfter: opts.StartAfter}), nil
+}
+
+func (c *Client) fetchScorecardScoresPage(ctx context.Context, cloudID, componentID string, pageSize int, after *string) (*gen.CompassScorecardConnection, error) {
+	if gen.CompassBackend == "rest" {
+		return gen.FetchCompassScorecardScoresPageREST(ctx, c.HTTPClient, c.BaseURL, c.Auth, componentID, pageSize, after)
+	}
+
+	vars := map[string]any{
+		"cloudId":     cloudID,
+		"componentId": componentID,
+		"first":       pageSize,
+		"after":       after,
+	}
+	result, err := c.Execute(ctx, gen.CompassScorecardScoresPageQuery, vars, "CompassScorecardScoresPage", c.ExperimentalAPIs, 1)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Data == nil {
+		return nil, errors.New("missing data in CompassScorecardScoresPage response")
+	}
+	conn, err := gen.DecodeCompassScorecardScoresPage(result.Data)
+	if err != nil {
+		if len(result.Errors) > 0 {
+			return nil, &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+		}
+		return nil, fmt.Errorf("decode CompassScorecardScoresPage: %w", err)
+	}
+	return conn, nil
+}
+
+func nextAfterForCompassScorecardScores(pageInfo gen.CompassPageInfo, edges []gen.CompassScorecardEdge) (string, bool, error) {
+	if !pageInfo.HasNextPage {
+		return "", false, nil
+	}
+	if pageInfo.EndCursor != nil && strings.TrimSpace(*pageInfo.EndCursor) != "" {
+		return strings.TrimSpace(*pageInfo.EndCursor), true, nil
+	}
+	for i := len(edges) - 1; i >= 0; i-- {
+		if edges[i].Cursor != nil && strings.TrimSpace(*edges[i].Cursor) != "" {
+			return strings.TrimSpace(*edges[i].Cursor), true, nil
+		}
+	}
+	return "", false, errors.New("pagination cursor missing for compass.scorecardScores")
+}
+
+// TriggerScorecardEvaluation forces Compass to re-evaluate scorecardID against componentID
+// immediately, instead of waiting for its next scheduled evaluation; useful after fixing
+// whatever caused a criterion to fail, to confirm the fix took effect without polling.
+func (c *Client) TriggerScorecardEvaluation(ctx context.Context, cloudID string, componentID string, scorecardID string) error {
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return errors.New("cloudID is required")
+	}
+	component := strings.TrimSpace(componentID)
+	if component == "" {
+		return errors.New("componentID is required")
+	}
+	scorecard := strings.TrimSpace(scorecardID)
+	if scorecard == "" {
+		return errors.New("scorecardID is required")
+	}
+
+	vars := map[string]any{
+		"cloudId":     cloud,
+		"componentId": component,
+		"scorecardId": scorecard,
+	}
+	result, err := c.Execute(ctx, gen.CompassTriggerScorecardEvaluationMutation, vars, "CompassTriggerScorecardEvaluation", c.ExperimentalAPIs, 1)
+	if err != nil {
+		return err
+	}
+	if result == nil || result.Data == nil {
+		return errors.New("missing data in CompassTriggerScorecardEvaluation response")
+	}
+
+	ok, err := gen.DecodeCompassTriggerScorecardEvaluation(result.Data)
+	if err != nil {
+		if len(result.Errors) > 0 {
+			return &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+		}
+		return fmt.Errorf("decode CompassTriggerScorecardEvaluation: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("compass: scorecard evaluation for component %s was not accepted", component)
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+// Package compass holds the full, schema-introspection-driven Compass GraphQL type declarations:
+// every Object, Interface, Union, Enum and Input Object the schema declares, plus a DecodeXxx
+// helper per root Query/Mutation field. compass_schema_api.go is entirely generated; hand-edit
+// go/tools/generate_compass_schema_models instead.
+//
+//go:generate go run ../../../tools/generate_compass_schema_models
+package compass
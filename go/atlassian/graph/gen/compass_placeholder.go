@@ -35,8 +35,20 @@ type CompassScorecardRef struct {
 }
 
 type CompassScorecardNode struct {
-	Scorecard   *CompassScorecardRef `json:"scorecard,omitempty"`
-	Score       float64              `json:"score"`
-	MaxScore    *float64             `json:"maxScore,omitempty"`
-	EvaluatedAt *string              `json:"evaluatedAt,omitempty"`
+	Scorecard   *CompassScorecardRef            `json:"scorecard,omitempty"`
+	Score       float64                         `json:"score"`
+	MaxScore    *float64                        `json:"maxScore,omitempty"`
+	EvaluatedAt *string                         `json:"evaluatedAt,omitempty"`
+	Criteria    []CompassScorecardCriterionNode `json:"criteria,omitempty"`
+}
+
+// CompassScorecardCriterionNode is the per-criterion detail behind a scorecard's overall score.
+type CompassScorecardCriterionNode struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Weight   *float64 `json:"weight,omitempty"`
+	Score    float64  `json:"score"`
+	MaxScore *float64 `json:"maxScore,omitempty"`
+	Status   *string  `json:"status,omitempty"`
+	Message  *string  `json:"message,omitempty"`
 }
@@ -12,6 +12,22 @@ import (
 )
 
 func (c *Client) GetIssueByKey(ctx context.Context, cloudID string, issueKey string) (*atlassian.JiraIssue, error) {
+	node, err := c.GetIssueByKeyWithOptions(ctx, cloudID, issueKey, gen.JiraIssueByKeyOptions{})
+	if err != nil {
+		return nil, err
+	}
+	mapped, err := mappers.JiraIssueFromGraphQL(strings.TrimSpace(cloudID), *node)
+	if err != nil {
+		return nil, err
+	}
+	return &mapped, nil
+}
+
+// GetIssueByKeyWithOptions is GetIssueByKey with control over which optional sections of the
+// issue opts requests (see gen.JiraIssueByKeyOptions). It returns the raw generated node rather
+// than the flattened atlassian.JiraIssue, since callers reaching for optional sections typically
+// want fields mappers.JiraIssueFromGraphQL doesn't carry over, e.g. summary or labels.
+func (c *Client) GetIssueByKeyWithOptions(ctx context.Context, cloudID string, issueKey string, opts gen.JiraIssueByKeyOptions) (*gen.JiraIssueNode, error) {
 	cloud := strings.TrimSpace(cloudID)
 	if cloud == "" {
 		return nil, errors.New("cloudID is required")
@@ -25,7 +41,8 @@ func (c *Client) GetIssueByKey(ctx context.Context, cloudID string, issueKey str
 		"cloudId": cloud,
 		"key":     key,
 	}
-	result, err := c.Execute(ctx, gen.JiraIssueByKeyQuery, vars, "JiraIssueByKey", c.ExperimentalAPIs, 1)
+	query := gen.BuildJiraIssueByKeyQuery(opts)
+	result, err := c.Execute(ctx, query, vars, "JiraIssueByKey", c.ExperimentalAPIs, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -42,9 +59,5 @@ func (c *Client) GetIssueByKey(ctx context.Context, cloudID string, issueKey str
 	if decoded.IssueByKey == nil {
 		return nil, errors.New("missing issueByKey in response")
 	}
-	mapped, err := mappers.JiraIssueFromGraphQL(cloud, *decoded.IssueByKey)
-	if err != nil {
-		return nil, err
-	}
-	return &mapped, nil
+	return decoded.IssueByKey, nil
 }
@@ -12,10 +12,31 @@ import (
 )
 
 func (c *Client) GetSprintByID(ctx context.Context, sprintID string) (*atlassian.JiraSprint, error) {
+	return c.GetSprintByIDWithOptions(ctx, sprintID, gen.JiraSprintByIdOptions{})
+}
+
+// GetSprintByIDWithOptions is GetSprintByID with explicit timeout/deadline control: opts.Deadline
+// bounds the call as a whole, opts.Timeout bounds it from the moment this method is called, and
+// the tighter of the two wins (see gen.ResolveJiraSprintByIdDeadline).
+func (c *Client) GetSprintByIDWithOptions(ctx context.Context, sprintID string, opts gen.JiraSprintByIdOptions) (*atlassian.JiraSprint, error) {
 	sprint := strings.TrimSpace(sprintID)
 	if sprint == "" {
 		return nil, errors.New("sprintID is required")
 	}
+	ctx, cancel := gen.ResolveJiraSprintByIdDeadline(ctx, opts)
+	defer cancel()
+
+	if gen.JiraSprintBackend == "rest" {
+		node, err := gen.FetchJiraSprintByIdREST(ctx, c.HTTPClient, c.BaseURL, c.Auth, sprint)
+		if err != nil {
+			return nil, err
+		}
+		mapped, err := mappers.JiraSprintFromGraphQL(*node)
+		if err != nil {
+			return nil, err
+		}
+		return &mapped, nil
+	}
 
 	result, err := c.Execute(ctx, gen.JiraSprintByIdQuery, map[string]any{"id": sprint}, "JiraSprintById", c.ExperimentalAPIs, 1)
 	if err != nil {
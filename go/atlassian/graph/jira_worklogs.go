@@ -5,13 +5,106 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"atlassian/atlassian"
 	"atlassian/atlassian/graph/gen"
 	"atlassian/atlassian/graph/mappers"
+	"atlassian/atlassian/pagination"
 )
 
+// worklogPageRetryBackoff is how long IterateIssueWorklogs waits before retrying a page fetch
+// that failed (transport error or GraphQL error), before giving up and surfacing it.
+const worklogPageRetryBackoff = 250 * time.Millisecond
+
 func (c *Client) ListIssueWorklogs(ctx context.Context, cloudID string, issueKey string, pageSize int) ([]atlassian.JiraWorklog, error) {
+	return c.ListIssueWorklogsWithOptions(ctx, cloudID, issueKey, pageSize, gen.JiraWorklogsPageOptions{})
+}
+
+// ListIssueWorklogsWithOptions is ListIssueWorklogs with explicit timeout/deadline control; see
+// IterateIssueWorklogsWithOptions for how opts.Deadline/opts.Timeout are applied.
+func (c *Client) ListIssueWorklogsWithOptions(ctx context.Context, cloudID string, issueKey string, pageSize int, opts gen.JiraWorklogsPageOptions) ([]atlassian.JiraWorklog, error) {
+	var out []atlassian.JiraWorklog
+	err := c.IterateIssueWorklogsWithOptions(ctx, cloudID, issueKey, pageSize, opts, func(node gen.JiraWorklogNode) error {
+		mapped, err := mappers.JiraWorklogFromGraphQL(issueKey, node)
+		if err != nil {
+			return err
+		}
+		out = append(out, mapped)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IterateIssueWorklogs walks every worklog on issueKey's Relay connection page by page, calling
+// yield for each raw gen.JiraWorklogNode as soon as its page arrives instead of buffering the
+// whole connection like ListIssueWorklogs does. Returning an error from yield stops iteration and
+// is propagated back to the caller.
+func (c *Client) IterateIssueWorklogs(ctx context.Context, cloudID string, issueKey string, pageSize int, yield func(gen.JiraWorklogNode) error) error {
+	return c.IterateIssueWorklogsWithOptions(ctx, cloudID, issueKey, pageSize, gen.JiraWorklogsPageOptions{}, yield)
+}
+
+// IterateIssueWorklogsWithOptions is IterateIssueWorklogs with explicit timeout/deadline control:
+// opts.Deadline bounds the walk as a whole, so it's applied once up front and carried through every
+// page fetch; opts.Timeout bounds each individual page fetch and is re-applied fresh for every
+// cursor the iterator advances to, so one slow page can't consume the next page's budget.
+func (c *Client) IterateIssueWorklogsWithOptions(ctx context.Context, cloudID string, issueKey string, pageSize int, opts gen.JiraWorklogsPageOptions, yield func(gen.JiraWorklogNode) error) error {
+	ctx, cancel := gen.ResolveJiraWorklogsPageDeadline(ctx, gen.JiraWorklogsPageOptions{Deadline: opts.Deadline})
+	defer cancel()
+
+	it, err := c.newIssueWorklogsIterator(cloudID, issueKey, pageSize, opts)
+	if err != nil {
+		return err
+	}
+	for {
+		node, err := it.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if node == nil {
+			return nil
+		}
+		if err := yield(*node); err != nil {
+			return err
+		}
+	}
+}
+
+// StreamIssueWorklogs is the channel-based counterpart to IterateIssueWorklogs: the returned
+// channel yields one gen.JiraWorklogNode per worklog and closes once the connection is exhausted,
+// ctx is canceled, or a page fetch ultimately fails; call the returned Err func afterwards to
+// check which (if either) of the latter two happened.
+func (c *Client) StreamIssueWorklogs(ctx context.Context, cloudID string, issueKey string, pageSize int) (<-chan gen.JiraWorklogNode, func() error) {
+	return c.StreamIssueWorklogsWithOptions(ctx, cloudID, issueKey, pageSize, gen.JiraWorklogsPageOptions{})
+}
+
+// StreamIssueWorklogsWithOptions is StreamIssueWorklogs with explicit timeout/deadline control;
+// see IterateIssueWorklogsWithOptions for how opts.Deadline/opts.Timeout are applied.
+func (c *Client) StreamIssueWorklogsWithOptions(ctx context.Context, cloudID string, issueKey string, pageSize int, opts gen.JiraWorklogsPageOptions) (<-chan gen.JiraWorklogNode, func() error) {
+	ctx, cancel := gen.ResolveJiraWorklogsPageDeadline(ctx, gen.JiraWorklogsPageOptions{Deadline: opts.Deadline})
+	it, err := c.newIssueWorklogsIterator(cloudID, issueKey, pageSize, opts)
+	if err != nil {
+		cancel()
+		out := make(chan gen.JiraWorklogNode)
+		close(out)
+		return out, func() error { return err }
+	}
+	ch := it.Channel(ctx)
+	return ch, func() error {
+		defer cancel()
+		return it.Err()
+	}
+}
+
+// newIssueWorklogsIterator builds the pagination.CursorIterator shared by ListIssueWorklogs,
+// IterateIssueWorklogs, and StreamIssueWorklogs, so the cursor-resolution and retry-with-backoff
+// logic around issue.worklogs only needs to be written once. opts.Timeout, if set, is applied
+// fresh to each page fetch (see gen.ResolveJiraWorklogsPageDeadline) so it resets between cursors
+// instead of accumulating across the whole walk.
+func (c *Client) newIssueWorklogsIterator(cloudID string, issueKey string, pageSize int, opts gen.JiraWorklogsPageOptions) (*pagination.CursorIterator[gen.JiraWorklogNode], error) {
 	cloud := strings.TrimSpace(cloudID)
 	if cloud == "" {
 		return nil, errors.New("cloudID is required")
@@ -24,55 +117,70 @@ func (c *Client) ListIssueWorklogs(ctx context.Context, cloudID string, issueKey
 		pageSize = 50
 	}
 
-	var out []atlassian.JiraWorklog
-	var after any = nil
-	seen := map[string]struct{}{}
+	seenCursors := map[string]struct{}{}
 
-	for {
-		vars := map[string]any{
-			"cloudId": cloud,
-			"key":     key,
-			"first":   pageSize,
-			"after":   after,
-		}
-		result, err := c.Execute(ctx, gen.JiraIssueWorklogsPageQuery, vars, "JiraIssueWorklogsPage", c.ExperimentalAPIs, 1)
-		if err != nil {
-			return nil, err
-		}
-		if result == nil || result.Data == nil {
-			return nil, errors.New("missing data in JiraIssueWorklogsPage response")
-		}
-		conn, err := gen.DecodeIssueWorklogsPage(result.Data)
+	fetch := func(ctx context.Context, after *string) ([]gen.JiraWorklogNode, bool, *string, error) {
+		pageCtx, pageCancel := gen.ResolveJiraWorklogsPageDeadline(ctx, gen.JiraWorklogsPageOptions{Timeout: opts.Timeout})
+		defer pageCancel()
+
+		conn, err := c.fetchIssueWorklogsPage(pageCtx, cloud, key, pageSize, after)
 		if err != nil {
-			if len(result.Errors) > 0 {
-				return nil, &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+			time.Sleep(worklogPageRetryBackoff)
+			conn, err = c.fetchIssueWorklogsPage(pageCtx, cloud, key, pageSize, after)
+			if err != nil {
+				return nil, false, nil, err
 			}
-			return nil, fmt.Errorf("decode JiraIssueWorklogsPage: %w", err)
 		}
 
-		for _, edge := range conn.Edges {
-			mapped, err := mappers.JiraWorklogFromGraphQL(key, edge.Node)
-			if err != nil {
-				return nil, err
-			}
-			out = append(out, mapped)
+		nodes := make([]gen.JiraWorklogNode, len(conn.Edges))
+		for i, edge := range conn.Edges {
+			nodes[i] = edge.Node
 		}
 
-		next, ok, err := nextAfterForWorklogs(conn.PageInfo, conn.Edges)
+		next, hasMore, err := nextAfterForWorklogs(conn.PageInfo, conn.Edges)
 		if err != nil {
-			return nil, err
+			return nil, false, nil, err
 		}
-		if !ok {
-			break
+		if !hasMore {
+			return nodes, false, nil, nil
 		}
-		if _, exists := seen[next]; exists {
-			return nil, errors.New("pagination cursor repeated; aborting to prevent infinite loop")
+		if _, seen := seenCursors[next]; seen {
+			return nil, false, nil, errors.New("pagination cursor repeated; aborting to prevent infinite loop")
 		}
-		seen[next] = struct{}{}
-		after = next
+		seenCursors[next] = struct{}{}
+		cursor := next
+		return nodes, true, &cursor, nil
 	}
 
-	return out, nil
+	return pagination.NewCursorIterator(fetch, pagination.CursorIteratorOptions{StartAfter: opts.StartAfter}), nil
+}
+
+func (c *Client) fetchIssueWorklogsPage(ctx context.Context, cloudID, issueKey string, pageSize int, after *string) (*gen.JiraWorklogConnection, error) {
+	if gen.WorklogsBackend == "rest" {
+		return gen.FetchIssueWorklogsPageREST(ctx, c.HTTPClient, c.BaseURL, c.Auth, issueKey, pageSize, after)
+	}
+
+	vars := map[string]any{
+		"cloudId": cloudID,
+		"key":     issueKey,
+		"first":   pageSize,
+		"after":   after,
+	}
+	result, err := c.Execute(ctx, gen.JiraIssueWorklogsPageQuery, vars, "JiraIssueWorklogsPage", c.ExperimentalAPIs, 1)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Data == nil {
+		return nil, errors.New("missing data in JiraIssueWorklogsPage response")
+	}
+	conn, err := gen.DecodeIssueWorklogsPage(result.Data)
+	if err != nil {
+		if len(result.Errors) > 0 {
+			return nil, &atlassian.GraphQLOperationError{Errors: result.Errors, PartialData: result.Data}
+		}
+		return nil, fmt.Errorf("decode JiraIssueWorklogsPage: %w", err)
+	}
+	return conn, nil
 }
 
 func nextAfterForWorklogs(pageInfo gen.WorklogPageInfo, edges []gen.JiraWorklogEdge) (string, bool, error) {
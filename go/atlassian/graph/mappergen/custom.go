@@ -0,0 +1,58 @@
+package mappergen
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// customDirectivePrefix is the directive a hand-written file in mappers/ carries to opt its type
+// out of generation, e.g. "// mappers:custom JiraSprintNode" anywhere in the file.
+const customDirectivePrefix = "// mappers:custom "
+
+// FindCustomTypes scans every *.go file directly under dir (non-recursively; mappers/ has no
+// subpackages) for a "// mappers:custom <Name>" directive and returns the set of gen type Names
+// that directive marks as hand-written, so Render skips emitting a conflicting *_gen.go for them.
+func FindCustomTypes(dir string) (map[string]bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	custom := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+		names, err := scanCustomDirectives(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			custom[name] = true
+		}
+	}
+	return custom, nil
+}
+
+func scanCustomDirectives(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if idx := strings.Index(line, customDirectivePrefix); idx != -1 {
+			name := strings.TrimSpace(line[idx+len(customDirectivePrefix):])
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names, scanner.Err()
+}
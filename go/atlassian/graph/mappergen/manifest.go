@@ -0,0 +1,90 @@
+// Package mappergen generates atlassian/graph/mappers's FromGraphQL functions from a small
+// manifest declaring which atlassian/graph/gen field maps to which domain field, instead of each
+// one being hand-mirrored - which is how atlassian/graph/mappers.MemberCount ended up hard-coded
+// to nil even though the schema exposes it. Generated functions are built on the same
+// mapperCollector/requireNonEmpty/optionalString/optionalInt primitives mapper_errors.go already
+// defines, so generated and hand-written mappers in the package read identically.
+//
+// The manifest is JSON, not YAML: this repo has no YAML dependency today (see
+// codegen.LoadManifest's doc comment for the same tradeoff), so only JSON is wired up.
+package mappergen
+
+import "encoding/json"
+
+// FieldPolicy selects which mapperCollector primitive (or nested mapper call) a FieldManifest
+// compiles to.
+type FieldPolicy string
+
+const (
+	// PolicyRequired reads the gen field via mapperCollector.requireNonEmpty.
+	PolicyRequired FieldPolicy = "required"
+	// PolicyOptionalString reads the gen field via mapperCollector.optionalString.
+	PolicyOptionalString FieldPolicy = "optionalString"
+	// PolicyOptionalInt reads the gen field via mapperCollector.optionalInt.
+	PolicyOptionalInt FieldPolicy = "optionalInt"
+	// PolicyNested maps a single nested gen node through another registered TypeManifest's
+	// generated FromGraphQL function.
+	PolicyNested FieldPolicy = "nested"
+	// PolicyList maps a paginated `edges { node { ... } }` connection field into a domain slice,
+	// by calling NestedType's generated FromGraphQL function once per edge's node.
+	PolicyList FieldPolicy = "list"
+)
+
+// FieldManifest declares one gen field -> domain field mapping.
+type FieldManifest struct {
+	// GenField is the atlassian/graph/gen struct field being read, e.g. "DisplayName".
+	GenField string `json:"genField"`
+	// DomainField is the destination field on DomainType, e.g. "DisplayName".
+	DomainField string `json:"domainField"`
+	// Policy selects how GenField is read. Defaults to PolicyRequired when empty.
+	Policy FieldPolicy `json:"policy,omitempty"`
+	// Path overrides the MapperError violation path for this field (e.g. "team.id"). Defaults
+	// to TypeManifest.GraphQLPath + "." + lowerFirst(GenField).
+	Path string `json:"path,omitempty"`
+	// NestedType names another Manifest.Types[].Name this field maps through. Required when
+	// Policy is PolicyNested or PolicyList.
+	NestedType string `json:"nestedType,omitempty"`
+}
+
+// TypeManifest declares how one atlassian/graph/gen node type maps to one domain type.
+type TypeManifest struct {
+	// Name is the gen type's name, e.g. "TeamNode" (matches gen.TeamNode).
+	Name string `json:"name"`
+	// File groups this type into go/atlassian/graph/mappers/{File}_gen.go alongside any other
+	// TypeManifest sharing the same File, mirroring how a hand-written file like teams.go holds
+	// more than one FromGraphQL function. Defaults to lowerFirst(Name) when empty.
+	File string `json:"file,omitempty"`
+	// DomainType is the fully-qualified destination type, e.g. "atlassian.AtlassianTeam".
+	DomainType string `json:"domainType"`
+	// GraphQLPath is the MapperError violation path prefix for this type, e.g. "team".
+	GraphQLPath string `json:"graphqlPath"`
+	// RequiresPointerArg is true when FromGraphQL takes *gen.{Name} (nil-checked up front, as
+	// mappers.TeamFromGraphQL does) rather than gen.{Name} by value (as
+	// mappers.JiraSprintFromGraphQL does). Defaults to false (by value).
+	RequiresPointerArg bool `json:"requiresPointerArg,omitempty"`
+	// ExtraArgs declares additional leading parameters FromGraphQL takes before the gen node,
+	// e.g. [{"name": "teamID", "type": "string"}] for mappers.TeamMemberFromGraphQL's teamID.
+	ExtraArgs []ExtraArg `json:"extraArgs,omitempty"`
+	Fields    []FieldManifest `json:"fields"`
+}
+
+// ExtraArg is a leading FromGraphQL parameter sourced from the caller rather than the gen node,
+// e.g. TeamMemberFromGraphQL(teamID string, member *gen.TeamMemberNode, ...).
+type ExtraArg struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Manifest is the top-level mappers.json document.
+type Manifest struct {
+	Types []TypeManifest `json:"types"`
+}
+
+// LoadManifest parses a JSON-encoded Manifest.
+func LoadManifest(raw []byte) (*Manifest, error) {
+	var m Manifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
@@ -0,0 +1,162 @@
+package mappergen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Render generates the mappers package source for every TypeManifest in m, except names present
+// in customTypes (populated from scanning mappers/ for a "// mappers:custom <Name>" directive -
+// see FindCustomTypes), which keep their hand-written implementation. TypeManifests sharing a
+// File are rendered into a single file, mirroring how a hand-written file like teams.go holds
+// more than one FromGraphQL function. The result maps each output File to its *_gen.go content.
+func Render(m *Manifest, customTypes map[string]bool) (map[string]string, error) {
+	byName := make(map[string]TypeManifest, len(m.Types))
+	for _, t := range m.Types {
+		byName[t.Name] = t
+	}
+
+	byFile := make(map[string][]TypeManifest)
+	var fileOrder []string
+	for _, t := range m.Types {
+		if customTypes[t.Name] {
+			continue
+		}
+		file := t.File
+		if file == "" {
+			file = lowerFirst(t.Name)
+		}
+		if _, ok := byFile[file]; !ok {
+			fileOrder = append(fileOrder, file)
+		}
+		byFile[file] = append(byFile[file], t)
+	}
+	sort.Strings(fileOrder)
+
+	out := make(map[string]string, len(byFile))
+	for _, file := range fileOrder {
+		source, err := renderFile(byFile[file], byName)
+		if err != nil {
+			return nil, fmt.Errorf("mappergen: %s: %w", file, err)
+		}
+		out[file] = source
+	}
+	return out, nil
+}
+
+func renderFile(types []TypeManifest, byName map[string]TypeManifest) (string, error) {
+	needsErrors := false
+	funcs := make([]string, 0, len(types))
+	for _, t := range types {
+		fn, err := renderType(t, byName)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", t.Name, err)
+		}
+		if t.RequiresPointerArg {
+			needsErrors = true
+		}
+		funcs = append(funcs, fn)
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by go/tools/generate_graph_mappers from mappers.json; DO NOT EDIT.\n\n")
+	b.WriteString("package mappers\n\n")
+	if needsErrors {
+		b.WriteString("import (\n\t\"errors\"\n\n\t\"atlassian/atlassian\"\n\t\"atlassian/atlassian/graph/gen\"\n)\n\n")
+	} else {
+		b.WriteString("import (\n\t\"atlassian/atlassian\"\n\t\"atlassian/atlassian/graph/gen\"\n)\n\n")
+	}
+	b.WriteString(strings.Join(funcs, "\n"))
+	return b.String(), nil
+}
+
+// renderType renders one FromGraphQL function body (no package clause or imports - renderFile
+// assembles those once per output file).
+func renderType(t TypeManifest, byName map[string]TypeManifest) (string, error) {
+	var b strings.Builder
+
+	argType := "gen." + t.Name
+	if t.RequiresPointerArg {
+		argType = "*gen." + t.Name
+	}
+
+	params := make([]string, 0, len(t.ExtraArgs)+2)
+	for _, a := range t.ExtraArgs {
+		params = append(params, a.Name+" "+a.Type)
+	}
+	params = append(params, "x "+argType)
+	params = append(params, "opts ...MapOption")
+
+	funcName := t.Name + "FromGraphQL"
+	fmt.Fprintf(&b, "func %s(%s) (%s, error) {\n", funcName, strings.Join(params, ", "), t.DomainType)
+	if t.RequiresPointerArg {
+		fmt.Fprintf(&b, "\tif x == nil {\n\t\treturn %s{}, errors.New(%q)\n\t}\n", t.DomainType, t.GraphQLPath+" is required")
+	}
+	b.WriteString("\tcfg := newMapConfig(opts)\n\n")
+	b.WriteString("\tvar c mapperCollector\n")
+
+	extraArgs := make(map[string]bool, len(t.ExtraArgs))
+	for _, a := range t.ExtraArgs {
+		extraArgs[a.Name] = true
+	}
+
+	var assigns []string
+	var pre []string
+	for _, f := range t.Fields {
+		path := f.Path
+		if path == "" {
+			path = t.GraphQLPath + "." + lowerFirst(f.GenField)
+		}
+		// source is how this field is read in the generated body: a parameter reference for
+		// ExtraArgs-backed fields (e.g. teamID), or x.<GenField> for fields read off the gen node.
+		source := "x." + f.GenField
+		if extraArgs[f.GenField] {
+			source = f.GenField
+		}
+		switch f.Policy {
+		case "", PolicyRequired:
+			assigns = append(assigns, fmt.Sprintf("\t\t%s: c.requireNonEmpty(%s, %q),", f.DomainField, source, path))
+		case PolicyOptionalString:
+			assigns = append(assigns, fmt.Sprintf("\t\t%s: c.optionalString(%s),", f.DomainField, source))
+		case PolicyOptionalInt:
+			assigns = append(assigns, fmt.Sprintf("\t\t%s: c.optionalInt(%s),", f.DomainField, source))
+		case PolicyNested:
+			nested, ok := byName[f.NestedType]
+			if !ok {
+				return "", fmt.Errorf("field %s: unknown nestedType %q", f.GenField, f.NestedType)
+			}
+			varName := lowerFirst(f.DomainField)
+			pre = append(pre, fmt.Sprintf("\tvar %s *%s\n\tif x.%s != nil {\n\t\tmapped, nestedErr := %sFromGraphQL(*x.%s)\n\t\tif nestedErr != nil {\n\t\t\tc.invalid(%q, \"%%s\", nestedErr)\n\t\t} else {\n\t\t\t%s = &mapped\n\t\t}\n\t}",
+				varName, nested.DomainType, f.GenField, nested.Name, f.GenField, path, varName))
+			assigns = append(assigns, fmt.Sprintf("\t\t%s: %s,", f.DomainField, varName))
+		case PolicyList:
+			nested, ok := byName[f.NestedType]
+			if !ok {
+				return "", fmt.Errorf("field %s: unknown nestedType %q", f.GenField, f.NestedType)
+			}
+			varName := lowerFirst(f.DomainField)
+			pre = append(pre, fmt.Sprintf("\t%s := make([]%s, 0, len(x.%s.Edges))\n\tfor i, edge := range x.%s.Edges {\n\t\tif edge.Node == nil {\n\t\t\tcontinue\n\t\t}\n\t\tmapped, nestedErr := %sFromGraphQL(*edge.Node)\n\t\tif nestedErr != nil {\n\t\t\tc.invalid(pathf(\"%s[%%d]\", i), \"%%s\", nestedErr)\n\t\t\tcontinue\n\t\t}\n\t\t%s = append(%s, mapped)\n\t}",
+				varName, nested.DomainType, f.GenField, f.GenField, nested.Name, path, varName, varName))
+			assigns = append(assigns, fmt.Sprintf("\t\t%s: %s,", f.DomainField, varName))
+		default:
+			return "", fmt.Errorf("field %s: unknown policy %q", f.GenField, f.Policy)
+		}
+	}
+
+	for _, p := range pre {
+		b.WriteString(p)
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "\tout := %s{\n%s\n\t}\n\n", t.DomainType, strings.Join(assigns, "\n"))
+	b.WriteString("\treturn resolve(cfg, out, &c)\n}\n")
+
+	return b.String(), nil
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
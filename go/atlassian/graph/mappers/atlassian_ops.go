@@ -0,0 +1,56 @@
+package mappers
+
+import (
+	"atlassian/atlassian"
+	"atlassian/atlassian/graph/gen"
+)
+
+func AtlassianOpsIncidentFromGraphQL(incident gen.OpsIncidentNode, opts ...MapOption) (atlassian.AtlassianOpsIncident, error) {
+	cfg := newMapConfig(opts)
+
+	var c mapperCollector
+	out := atlassian.AtlassianOpsIncident{
+		ID:        c.requireNonEmpty(incident.ID, "incident.id"),
+		IssueKey:  c.requireNonEmpty(incident.IssueKey, "incident.issueKey"),
+		Title:     c.requireNonEmpty(incident.Title, "incident.title"),
+		Status:    c.requireNonEmpty(incident.Status, "incident.status"),
+		Severity:  c.optionalString(incident.Severity),
+		URL:       c.optionalString(incident.URL),
+		CreatedAt: c.optionalString(incident.CreatedAt),
+	}
+
+	return resolve(cfg, out, &c)
+}
+
+func AtlassianOpsAlertFromGraphQL(alert gen.OpsAlertNode, opts ...MapOption) (atlassian.AtlassianOpsAlert, error) {
+	cfg := newMapConfig(opts)
+
+	var c mapperCollector
+	out := atlassian.AtlassianOpsAlert{
+		ID:         c.requireNonEmpty(alert.ID, "alert.id"),
+		ProjectKey: c.requireNonEmpty(alert.ProjectKey, "alert.projectKey"),
+		Message:    c.requireNonEmpty(alert.Message, "alert.message"),
+		Status:     c.requireNonEmpty(alert.Status, "alert.status"),
+		Priority:   c.optionalString(alert.Priority),
+		URL:        c.optionalString(alert.URL),
+		CreatedAt:  c.optionalString(alert.CreatedAt),
+	}
+
+	return resolve(cfg, out, &c)
+}
+
+func AtlassianOpsScheduleFromGraphQL(sched gen.OpsScheduleNode, opts ...MapOption) (atlassian.AtlassianOpsSchedule, error) {
+	cfg := newMapConfig(opts)
+
+	var c mapperCollector
+	out := atlassian.AtlassianOpsSchedule{
+		ID:           c.requireNonEmpty(sched.ID, "schedule.id"),
+		ProjectKey:   c.requireNonEmpty(sched.ProjectKey, "schedule.projectKey"),
+		Name:         c.requireNonEmpty(sched.Name, "schedule.name"),
+		Timezone:     c.optionalString(sched.Timezone),
+		OnCallUserID: c.optionalString(sched.OnCallUserID),
+		URL:          c.optionalString(sched.URL),
+	}
+
+	return resolve(cfg, out, &c)
+}
@@ -8,169 +8,143 @@ import (
 	"atlassian/atlassian/graph/gen"
 )
 
-func CompassComponentFromGraphQL(cloudID string, component *gen.CompassComponentNode) (atlassian.CompassComponent, error) {
+func CompassComponentFromGraphQL(cloudID string, component *gen.CompassComponentNode, opts ...MapOption) (atlassian.CompassComponent, error) {
 	if component == nil {
 		return atlassian.CompassComponent{}, errors.New("component is required")
 	}
+	cfg := newMapConfig(opts)
 
-	cloud := strings.TrimSpace(cloudID)
-	if cloud == "" {
-		return atlassian.CompassComponent{}, errors.New("cloudID is required")
-	}
-
-	id := strings.TrimSpace(component.ID)
-	if id == "" {
-		return atlassian.CompassComponent{}, errors.New("component.id is required")
-	}
-	name := strings.TrimSpace(component.Name)
-	if name == "" {
-		return atlassian.CompassComponent{}, errors.New("component.name is required")
-	}
-	componentType := strings.TrimSpace(string(component.Type))
-	if componentType == "" {
-		return atlassian.CompassComponent{}, errors.New("component.type is required")
-	}
-
-	var description *string
-	if component.Description != nil {
-		trimmed := strings.TrimSpace(*component.Description)
-		if trimmed != "" {
-			description = &trimmed
-		}
-	}
+	var c mapperCollector
 
 	var ownerTeamID *string
 	var ownerTeamName *string
 	if component.OwnerTeam != nil {
-		teamID := strings.TrimSpace(component.OwnerTeam.ID)
-		if teamID == "" {
-			return atlassian.CompassComponent{}, errors.New("component.ownerTeam.id is required")
-		}
-		teamName := strings.TrimSpace(component.OwnerTeam.Name)
-		if teamName == "" {
-			return atlassian.CompassComponent{}, errors.New("component.ownerTeam.name is required")
-		}
-		ownerTeamID = &teamID
-		ownerTeamName = &teamName
+		id := c.requireNonEmpty(component.OwnerTeam.ID, "component.ownerTeam.id")
+		name := c.requireNonEmpty(component.OwnerTeam.Name, "component.ownerTeam.name")
+		ownerTeamID = &id
+		ownerTeamName = &name
 	}
 
 	labels := make([]string, 0, len(component.Labels))
 	for _, raw := range component.Labels {
-		value := strings.TrimSpace(raw)
-		if value == "" {
-			continue
+		if value := strings.TrimSpace(raw); value != "" {
+			labels = append(labels, value)
 		}
-		labels = append(labels, value)
 	}
 
-	var createdAt *string
-	if component.CreatedAt != nil {
-		trimmed := strings.TrimSpace(*component.CreatedAt)
-		if trimmed != "" {
-			createdAt = &trimmed
-		}
-	}
-
-	var updatedAt *string
-	if component.UpdatedAt != nil {
-		trimmed := strings.TrimSpace(*component.UpdatedAt)
-		if trimmed != "" {
-			updatedAt = &trimmed
-		}
-	}
-
-	return atlassian.CompassComponent{
-		ID:            id,
-		CloudID:       cloud,
-		Name:          name,
-		Type:          componentType,
-		Description:   description,
+	out := atlassian.CompassComponent{
+		ID:            c.requireNonEmpty(component.ID, "component.id"),
+		CloudID:       c.requireNonEmpty(cloudID, "cloudID"),
+		Name:          c.requireNonEmpty(component.Name, "component.name"),
+		Type:          c.requireNonEmpty(string(component.Type), "component.type"),
+		Description:   c.optionalString(component.Description),
 		OwnerTeamID:   ownerTeamID,
 		OwnerTeamName: ownerTeamName,
 		Labels:        labels,
-		CreatedAt:     createdAt,
-		UpdatedAt:     updatedAt,
-	}, nil
+		CreatedAt:     c.optionalString(component.CreatedAt),
+		UpdatedAt:     c.optionalString(component.UpdatedAt),
+	}
+
+	return resolve(cfg, out, &c)
 }
 
-func CompassRelationshipFromGraphQL(rel *gen.CompassRelationshipNode) (atlassian.CompassRelationship, error) {
+func CompassRelationshipFromGraphQL(rel *gen.CompassRelationshipNode, opts ...MapOption) (atlassian.CompassRelationship, error) {
 	if rel == nil {
 		return atlassian.CompassRelationship{}, errors.New("relationship is required")
 	}
+	cfg := newMapConfig(opts)
 
-	id := strings.TrimSpace(rel.ID)
-	if id == "" {
-		return atlassian.CompassRelationship{}, errors.New("relationship.id is required")
-	}
-	relationshipType := strings.TrimSpace(rel.Type)
-	if relationshipType == "" {
-		return atlassian.CompassRelationship{}, errors.New("relationship.type is required")
-	}
+	var c mapperCollector
+	var startID, endID string
 	if rel.StartNode == nil {
-		return atlassian.CompassRelationship{}, errors.New("relationship.startNode is required")
-	}
-	startID := strings.TrimSpace(rel.StartNode.ID)
-	if startID == "" {
-		return atlassian.CompassRelationship{}, errors.New("relationship.startNode.id is required")
+		c.invalid("relationship.startNode", "is required")
+	} else {
+		startID = c.requireNonEmpty(rel.StartNode.ID, "relationship.startNode.id")
 	}
 	if rel.EndNode == nil {
-		return atlassian.CompassRelationship{}, errors.New("relationship.endNode is required")
-	}
-	endID := strings.TrimSpace(rel.EndNode.ID)
-	if endID == "" {
-		return atlassian.CompassRelationship{}, errors.New("relationship.endNode.id is required")
+		c.invalid("relationship.endNode", "is required")
+	} else {
+		endID = c.requireNonEmpty(rel.EndNode.ID, "relationship.endNode.id")
 	}
 
-	return atlassian.CompassRelationship{
-		ID:               id,
-		Type:             relationshipType,
+	out := atlassian.CompassRelationship{
+		ID:               c.requireNonEmpty(rel.ID, "relationship.id"),
+		Type:             c.requireNonEmpty(rel.Type, "relationship.type"),
 		StartComponentID: startID,
 		EndComponentID:   endID,
-	}, nil
+	}
+
+	return resolve(cfg, out, &c)
 }
 
-func CompassScorecardScoreFromGraphQL(componentID string, score *gen.CompassScorecardNode) (atlassian.CompassScorecardScore, error) {
-	component := strings.TrimSpace(componentID)
-	if component == "" {
-		return atlassian.CompassScorecardScore{}, errors.New("componentID is required")
-	}
+func CompassScorecardScoreFromGraphQL(componentID string, score *gen.CompassScorecardNode, opts ...MapOption) (atlassian.CompassScorecardScore, error) {
 	if score == nil {
 		return atlassian.CompassScorecardScore{}, errors.New("score is required")
 	}
+	cfg := newMapConfig(opts)
+
+	var c mapperCollector
+	var scorecardID string
+	var scorecardName *string
 	if score.Scorecard == nil {
-		return atlassian.CompassScorecardScore{}, errors.New("score.scorecard is required")
-	}
-	scorecardID := strings.TrimSpace(score.Scorecard.ID)
-	if scorecardID == "" {
-		return atlassian.CompassScorecardScore{}, errors.New("score.scorecard.id is required")
+		c.invalid("score.scorecard", "is required")
+	} else {
+		scorecardID = c.requireNonEmpty(score.Scorecard.ID, "score.scorecard.id")
+		scorecardName = c.optionalString(score.Scorecard.Name)
 	}
 
-	var scorecardName *string
-	if trimmed := strings.TrimSpace(score.Scorecard.Name); trimmed != "" {
-		scorecardName = &trimmed
+	out := atlassian.CompassScorecardScore{
+		ComponentID:   c.requireNonEmpty(componentID, "componentID"),
+		ScorecardID:   scorecardID,
+		ScorecardName: scorecardName,
+		Score:         score.Score,
+		MaxScore:      score.MaxScore,
+		EvaluatedAt:   c.optionalString(score.EvaluatedAt),
+		Criteria:      compassScorecardCriteriaFromGraphQL(&c, score.Criteria),
 	}
 
-	scoreValue := score.Score
+	return resolve(cfg, out, &c)
+}
 
-	var maxScore *float64
-	if score.MaxScore != nil {
-		maxScore = score.MaxScore
-	}
+// compassScorecardCriteriaFromGraphQL maps a scorecard's per-criterion nodes in order, coercing
+// a missing status into "unknown" and appending a violation into c if the criteria's weights
+// don't sum to a positive value (a scorecard where every criterion is weighted zero can't
+// produce a meaningful score).
+func compassScorecardCriteriaFromGraphQL(c *mapperCollector, nodes []gen.CompassScorecardCriterionNode) []atlassian.CompassScorecardCriterion {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	criteria := make([]atlassian.CompassScorecardCriterion, 0, len(nodes))
+	var weightTotal float64
+	for i, node := range nodes {
+		var weight float64
+		if node.Weight != nil {
+			weight = *node.Weight
+		}
+		weightTotal += weight
 
-	var evaluatedAt *string
-	if score.EvaluatedAt != nil {
-		trimmed := strings.TrimSpace(*score.EvaluatedAt)
-		if trimmed != "" {
-			evaluatedAt = &trimmed
+		status := "unknown"
+		if node.Status != nil {
+			if trimmed := strings.TrimSpace(*node.Status); trimmed != "" {
+				status = trimmed
+			}
 		}
+
+		criteria = append(criteria, atlassian.CompassScorecardCriterion{
+			ID:       c.requireNonEmpty(node.ID, pathf("score.criteria[%d].id", i)),
+			Name:     c.requireNonEmpty(node.Name, pathf("score.criteria[%d].name", i)),
+			Weight:   weight,
+			Score:    node.Score,
+			MaxScore: node.MaxScore,
+			Status:   status,
+			Message:  node.Message,
+		})
 	}
 
-	return atlassian.CompassScorecardScore{
-		ComponentID:   component,
-		ScorecardID:   scorecardID,
-		ScorecardName: scorecardName,
-		Score:         scoreValue,
-		MaxScore:      maxScore,
-		EvaluatedAt:   evaluatedAt,
-	}, nil
+	if weightTotal <= 0 {
+		c.invalid("score.criteria", "weights must sum to a positive value")
+	}
+
+	return criteria
 }
@@ -1,104 +1,54 @@
 package mappers
 
 import (
-	"errors"
-	"fmt"
-	"strings"
-
 	"atlassian/atlassian"
 	"atlassian/atlassian/graph/gen"
 )
 
-func mapGraphUser(user *gen.JiraUser, path string) (*atlassian.JiraUser, error) {
+func mapGraphUser(c *mapperCollector, user *gen.JiraUser, path string) *atlassian.JiraUser {
 	if user == nil {
-		return nil, nil
-	}
-	accountID := strings.TrimSpace(user.AccountID)
-	if accountID == "" {
-		return nil, fmt.Errorf("%s.accountId is required", path)
-	}
-	displayName := strings.TrimSpace(user.Name)
-	if displayName == "" {
-		return nil, fmt.Errorf("%s.name is required", path)
+		return nil
 	}
 	return &atlassian.JiraUser{
-		AccountID:   accountID,
-		DisplayName: displayName,
-	}, nil
-}
-
-func JiraIssueFromGraphQL(cloudID string, issue gen.JiraIssueNode) (atlassian.JiraIssue, error) {
-	cloud := strings.TrimSpace(cloudID)
-	if cloud == "" {
-		return atlassian.JiraIssue{}, errors.New("cloudID is required")
-	}
-	issueKey := strings.TrimSpace(issue.Key)
-	if issueKey == "" {
-		return atlassian.JiraIssue{}, errors.New("issue.key is required")
-	}
-	projectKey := strings.TrimSpace(issue.ProjectField.Project.Key)
-	if projectKey == "" {
-		return atlassian.JiraIssue{}, errors.New("issue.projectField.project.key is required")
-	}
-	projectCloud := strings.TrimSpace(issue.ProjectField.Project.CloudID)
-	if projectCloud == "" {
-		return atlassian.JiraIssue{}, errors.New("issue.projectField.project.cloudId is required")
-	}
-	if projectCloud != cloud {
-		return atlassian.JiraIssue{}, errors.New("issue.projectField.project.cloudId does not match cloudID")
-	}
-	issueType := strings.TrimSpace(issue.IssueType.Name)
-	if issueType == "" {
-		return atlassian.JiraIssue{}, errors.New("issue.issueType.name is required")
-	}
-	status := strings.TrimSpace(issue.Status.Name)
-	if status == "" {
-		return atlassian.JiraIssue{}, errors.New("issue.status.name is required")
-	}
-	if issue.CreatedField.DateTime == nil || strings.TrimSpace(*issue.CreatedField.DateTime) == "" {
-		return atlassian.JiraIssue{}, errors.New("issue.createdField.dateTime is required")
-	}
-	if issue.UpdatedField.DateTime == nil || strings.TrimSpace(*issue.UpdatedField.DateTime) == "" {
-		return atlassian.JiraIssue{}, errors.New("issue.updatedField.dateTime is required")
+		AccountID:   c.requireNonEmpty(user.AccountID, path+".accountId"),
+		DisplayName: c.requireNonEmpty(user.Name, path+".name"),
 	}
+}
 
-	createdAt := strings.TrimSpace(*issue.CreatedField.DateTime)
-	updatedAt := strings.TrimSpace(*issue.UpdatedField.DateTime)
+func JiraIssueFromGraphQL(cloudID string, issue gen.JiraIssueNode, opts ...MapOption) (atlassian.JiraIssue, error) {
+	cfg := newMapConfig(opts)
 
-	var resolvedAt *string
-	if issue.ResolutionDateField != nil && issue.ResolutionDateField.DateTime != nil {
-		if trimmed := strings.TrimSpace(*issue.ResolutionDateField.DateTime); trimmed != "" {
-			resolvedAt = &trimmed
-		}
+	var c mapperCollector
+	cloud := c.requireNonEmpty(cloudID, "cloudID")
+	projectCloud := c.requireNonEmpty(issue.ProjectField.Project.CloudID, "issue.projectField.project.cloudId")
+	if cloud != "" && projectCloud != "" && projectCloud != cloud {
+		c.invalid("issue.projectField.project.cloudId", "does not match cloudID")
 	}
 
 	var assignee *atlassian.JiraUser
 	if issue.AssigneeField != nil {
-		mapped, err := mapGraphUser(issue.AssigneeField.User, "issue.assigneeField.user")
-		if err != nil {
-			return atlassian.JiraIssue{}, err
-		}
-		assignee = mapped
-	}
-	reporter, err := mapGraphUser(issue.Reporter, "issue.reporter")
-	if err != nil {
-		return atlassian.JiraIssue{}, err
+		assignee = mapGraphUser(&c, issue.AssigneeField.User, "issue.assigneeField.user")
 	}
 
-	return atlassian.JiraIssue{
+	out := atlassian.JiraIssue{
 		CloudID:     projectCloud,
-		Key:         issueKey,
-		ProjectKey:  projectKey,
-		IssueType:   issueType,
-		Status:      status,
-		CreatedAt:   createdAt,
-		UpdatedAt:   updatedAt,
-		ResolvedAt:  resolvedAt,
+		Key:         c.requireNonEmpty(issue.Key, "issue.key"),
+		ProjectKey:  c.requireNonEmpty(issue.ProjectField.Project.Key, "issue.projectField.project.key"),
+		IssueType:   c.requireNonEmpty(issue.IssueType.Name, "issue.issueType.name"),
+		Status:      c.requireNonEmpty(issue.Status.Name, "issue.status.name"),
+		CreatedAt:   c.requireNonEmpty(issue.CreatedField.DateTime, "issue.createdField.dateTime"),
+		UpdatedAt:   c.requireNonEmpty(issue.UpdatedField.DateTime, "issue.updatedField.dateTime"),
+		ResolvedAt:  nil,
 		Assignee:    assignee,
-		Reporter:    reporter,
+		Reporter:    mapGraphUser(&c, issue.Reporter, "issue.reporter"),
 		Labels:      []string{},
 		Components:  []string{},
 		StoryPoints: nil,
 		SprintIDs:   []string{},
-	}, nil
+	}
+	if issue.ResolutionDateField != nil {
+		out.ResolvedAt = c.optionalString(issue.ResolutionDateField.DateTime)
+	}
+
+	return resolve(cfg, out, &c)
 }
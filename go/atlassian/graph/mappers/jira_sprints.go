@@ -1,57 +1,31 @@
 package mappers
 
-import (
-	"errors"
-	"strings"
+// mappers:custom JiraSprintNode
+//
+// Hand-written rather than generated: BoardID only pulls one field out of the nested Board node
+// (not a full Board domain mapping), which go/tools/generate_graph_mappers doesn't model.
 
+import (
 	"atlassian/atlassian"
 	"atlassian/atlassian/graph/gen"
 )
 
-func JiraSprintFromGraphQL(sprint gen.JiraSprintNode) (atlassian.JiraSprint, error) {
-	sprintID := strings.TrimSpace(sprint.SprintID)
-	if sprintID == "" {
-		return atlassian.JiraSprint{}, errors.New("sprint.sprintId is required")
-	}
-	if sprint.Name == nil || strings.TrimSpace(*sprint.Name) == "" {
-		return atlassian.JiraSprint{}, errors.New("sprint.name is required")
-	}
-	if sprint.State == nil || strings.TrimSpace(*sprint.State) == "" {
-		return atlassian.JiraSprint{}, errors.New("sprint.state is required")
-	}
+func JiraSprintFromGraphQL(sprint gen.JiraSprintNode, opts ...MapOption) (atlassian.JiraSprint, error) {
+	cfg := newMapConfig(opts)
 
-	startAt := ""
-	if sprint.StartDate != nil {
-		startAt = strings.TrimSpace(*sprint.StartDate)
-	}
-	endAt := ""
-	if sprint.EndDate != nil {
-		endAt = strings.TrimSpace(*sprint.EndDate)
-	}
-	completeAt := ""
-	if sprint.CompletionDate != nil {
-		completeAt = strings.TrimSpace(*sprint.CompletionDate)
-	}
-
-	var startAtPtr *string
-	if startAt != "" {
-		startAtPtr = &startAt
-	}
-	var endAtPtr *string
-	if endAt != "" {
-		endAtPtr = &endAt
-	}
-	var completeAtPtr *string
-	if completeAt != "" {
-		completeAtPtr = &completeAt
+	var c mapperCollector
+	out := atlassian.JiraSprint{
+		ID:         c.requireNonEmpty(sprint.SprintID, "sprint.sprintId"),
+		Name:       c.requireNonEmpty(sprint.Name, "sprint.name"),
+		State:      c.requireNonEmpty(sprint.State, "sprint.state"),
+		StartAt:    c.optionalString(sprint.StartDate),
+		EndAt:      c.optionalString(sprint.EndDate),
+		CompleteAt: c.optionalString(sprint.CompletionDate),
+		Goal:       c.optionalString(sprint.Goal),
+	}
+	if sprint.Board != nil {
+		out.BoardID = c.optionalString(sprint.Board.BoardID)
 	}
 
-	return atlassian.JiraSprint{
-		ID:         sprintID,
-		Name:       strings.TrimSpace(*sprint.Name),
-		State:      strings.TrimSpace(*sprint.State),
-		StartAt:    startAtPtr,
-		EndAt:      endAtPtr,
-		CompleteAt: completeAtPtr,
-	}, nil
+	return resolve(cfg, out, &c)
 }
@@ -1,74 +1,40 @@
 package mappers
 
 import (
-	"errors"
-	"fmt"
-	"strings"
-
 	"atlassian/atlassian"
 	"atlassian/atlassian/graph/gen"
 )
 
-func mapWorklogUser(user *gen.WorklogUser, path string) (*atlassian.JiraUser, error) {
+func mapWorklogUser(c *mapperCollector, user *gen.WorklogUser, path string) *atlassian.JiraUser {
 	if user == nil {
-		return nil, nil
-	}
-	accountID := strings.TrimSpace(user.AccountID)
-	if accountID == "" {
-		return nil, fmt.Errorf("%s.accountId is required", path)
-	}
-	displayName := strings.TrimSpace(user.Name)
-	if displayName == "" {
-		return nil, fmt.Errorf("%s.name is required", path)
+		return nil
 	}
 	return &atlassian.JiraUser{
-		AccountID:   accountID,
-		DisplayName: displayName,
-	}, nil
+		AccountID:   c.requireNonEmpty(user.AccountID, path+".accountId"),
+		DisplayName: c.requireNonEmpty(user.Name, path+".name"),
+	}
 }
 
-func JiraWorklogFromGraphQL(issueKey string, worklog gen.JiraWorklogNode) (atlassian.JiraWorklog, error) {
-	issue := strings.TrimSpace(issueKey)
-	if issue == "" {
-		return atlassian.JiraWorklog{}, errors.New("issueKey is required")
-	}
-	worklogID := strings.TrimSpace(worklog.WorklogID)
-	if worklogID == "" {
-		return atlassian.JiraWorklog{}, errors.New("worklog.worklogId is required")
-	}
-	if strings.TrimSpace(worklog.Created) == "" {
-		return atlassian.JiraWorklog{}, errors.New("worklog.created is required")
-	}
-	if worklog.Updated == nil || strings.TrimSpace(*worklog.Updated) == "" {
-		return atlassian.JiraWorklog{}, errors.New("worklog.updated is required")
-	}
-	if worklog.StartDate == nil || strings.TrimSpace(*worklog.StartDate) == "" {
-		return atlassian.JiraWorklog{}, errors.New("worklog.startDate is required")
-	}
-	if worklog.TimeSpent.TimeInSeconds == nil || *worklog.TimeSpent.TimeInSeconds < 0 {
-		return atlassian.JiraWorklog{}, errors.New("worklog.timeSpent.timeInSeconds is required and must be >= 0")
-	}
+func JiraWorklogFromGraphQL(issueKey string, worklog gen.JiraWorklogNode, opts ...MapOption) (atlassian.JiraWorklog, error) {
+	cfg := newMapConfig(opts)
 
-	author, err := mapWorklogUser(worklog.Author, "worklog.author")
-	if err != nil {
-		return atlassian.JiraWorklog{}, err
+	var c mapperCollector
+	var timeSpent int
+	if worklog.TimeSpent.TimeInSeconds == nil || *worklog.TimeSpent.TimeInSeconds < 0 {
+		c.invalid("worklog.timeSpent.timeInSeconds", "is required and must be >= 0")
+	} else {
+		timeSpent = *worklog.TimeSpent.TimeInSeconds
 	}
 
-	started := strings.TrimSpace(*worklog.StartDate)
-	updated := strings.TrimSpace(*worklog.Updated)
-	created := strings.TrimSpace(worklog.Created)
-	timeSpent := *worklog.TimeSpent.TimeInSeconds
-	if timeSpent < 0 {
-		return atlassian.JiraWorklog{}, fmt.Errorf("worklog.timeSpent.timeInSeconds must be >= 0")
+	out := atlassian.JiraWorklog{
+		IssueKey:         c.requireNonEmpty(issueKey, "issueKey"),
+		WorklogID:        c.requireNonEmpty(worklog.WorklogID, "worklog.worklogId"),
+		Author:           mapWorklogUser(&c, worklog.Author, "worklog.author"),
+		StartedAt:        c.requireNonEmpty(worklog.StartDate, "worklog.startDate"),
+		TimeSpentSeconds: timeSpent,
+		CreatedAt:        c.requireNonEmpty(worklog.Created, "worklog.created"),
+		UpdatedAt:        c.requireNonEmpty(worklog.Updated, "worklog.updated"),
 	}
 
-	return atlassian.JiraWorklog{
-		IssueKey:         issue,
-		WorklogID:        worklogID,
-		Author:           author,
-		StartedAt:        started,
-		TimeSpentSeconds: timeSpent,
-		CreatedAt:        created,
-		UpdatedAt:        updated,
-	}, nil
+	return resolve(cfg, out, &c)
 }
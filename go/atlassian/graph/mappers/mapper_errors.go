@@ -0,0 +1,173 @@
+package mappers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pathf builds an indexed GraphQL field path, e.g. pathf("score.criteria[%d].id", 3).
+func pathf(format string, args ...any) string {
+	return fmt.Sprintf(format, args...)
+}
+
+// MapperError accumulates every validation failure a GraphQL mapper in this package encounters
+// instead of returning on the first one, so a caller debugging a schema drift or a partial
+// Atlassian response sees every violation - each tagged with the GraphQL field path it came from
+// (e.g. "team.id", "member[3].accountId") - in one error instead of one per retry.
+type MapperError struct {
+	errs  []error
+	paths []string
+}
+
+func (e *MapperError) Error() string {
+	if e == nil || len(e.errs) == 0 {
+		return "no mapping errors"
+	}
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap exposes every accumulated violation via Go 1.20's multi-error Unwrap() []error form, so
+// errors.Is/errors.As can still find one specific underlying error without string-matching
+// MapperError.Error()'s joined message.
+func (e *MapperError) Unwrap() []error {
+	return e.errs
+}
+
+// Paths returns the GraphQL field path of every accumulated violation, in the order encountered.
+func (e *MapperError) Paths() []string {
+	return append([]string(nil), e.paths...)
+}
+
+// MapOption configures how a FromGraphQL mapper in this package handles accumulated MapperError
+// violations.
+type MapOption func(*mapConfig)
+
+type mapConfig struct {
+	partial bool
+}
+
+func newMapConfig(opts []MapOption) mapConfig {
+	var cfg mapConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithPartial makes a mapper return its best-effort populated struct alongside the aggregated
+// *MapperError instead of a zero-value struct, for a caller that would rather work with whatever
+// fields did decode than discard an entire response over one missing field.
+func WithPartial() MapOption {
+	return func(c *mapConfig) { c.partial = true }
+}
+
+// mapperCollector accumulates every violation a mapper function's requireNonEmpty calls
+// encounter, rather than each one returning on the first failure - resolving the final
+// *MapperError happens once, at the end of the mapper, not field by field. optionalString and
+// optionalInt are collector methods for the same reason even though neither can fail: every field
+// a mapper reads goes through the same collector, so adding a new required field later is a
+// one-line change rather than a new fail-fast branch.
+type mapperCollector struct {
+	errs  []error
+	paths []string
+}
+
+func (c *mapperCollector) requireNonEmpty(value any, path string) string {
+	switch v := value.(type) {
+	case string:
+		if trimmed := strings.TrimSpace(v); trimmed != "" {
+			return trimmed
+		}
+	case *string:
+		if v != nil {
+			if trimmed := strings.TrimSpace(*v); trimmed != "" {
+				return trimmed
+			}
+		}
+	}
+	c.errs = append(c.errs, fmt.Errorf("%s is required", path))
+	c.paths = append(c.paths, path)
+	return ""
+}
+
+func (c *mapperCollector) optionalString(value any) *string {
+	switch v := value.(type) {
+	case string:
+		if trimmed := strings.TrimSpace(v); trimmed != "" {
+			return &trimmed
+		}
+	case *string:
+		if v != nil {
+			if trimmed := strings.TrimSpace(*v); trimmed != "" {
+				return &trimmed
+			}
+		}
+	}
+	return nil
+}
+
+func (c *mapperCollector) optionalInt(value any) *int {
+	switch v := value.(type) {
+	case int:
+		return &v
+	case *int:
+		return v
+	case int32:
+		converted := int(v)
+		return &converted
+	case *int32:
+		if v == nil {
+			return nil
+		}
+		converted := int(*v)
+		return &converted
+	case int64:
+		converted := int(v)
+		return &converted
+	case *int64:
+		if v == nil {
+			return nil
+		}
+		converted := int(*v)
+		return &converted
+	}
+	return nil
+}
+
+// invalid records a violation that isn't a simple missing-value check - a cross-field consistency
+// rule, for example - at the given GraphQL field path.
+func (c *mapperCollector) invalid(path string, format string, args ...any) {
+	c.errs = append(c.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+	c.paths = append(c.paths, path)
+}
+
+// err returns the accumulated *MapperError, or nil if the collector saw no violations.
+func (c *mapperCollector) err() *MapperError {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	return &MapperError{
+		errs:  append([]error(nil), c.errs...),
+		paths: append([]string(nil), c.paths...),
+	}
+}
+
+// resolve applies cfg's WithPartial option to a mapper's accumulated collector result: with no
+// violations it's (out, nil); with violations and no WithPartial it's (the zero value, mapErr) to
+// preserve this package's original fail-closed behavior; with WithPartial it's (out, mapErr) so a
+// caller that opted in gets the best-effort struct alongside the full violation list.
+func resolve[T any](cfg mapConfig, out T, c *mapperCollector) (T, error) {
+	mapErr := c.err()
+	if mapErr == nil {
+		return out, nil
+	}
+	if !cfg.partial {
+		var zero T
+		return zero, mapErr
+	}
+	return out, mapErr
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SchemaCacheMeta records the provenance of a cached schema.introspection.json, so a later run
+// can decide whether it's safe to reuse the file on disk instead of re-fetching it.
+type SchemaCacheMeta struct {
+	SHA256           string    `json:"sha256"`
+	FetchedAt        time.Time `json:"fetchedAt"`
+	ExperimentalAPIs []string  `json:"experimentalApis,omitempty"`
+}
+
+// schemaCacheMetaPath is where SaveSchemaCacheMeta/LoadSchemaCacheMeta store metadata for the
+// introspection file at schemaPath, e.g. ".../schema.introspection.json.sha256".
+func schemaCacheMetaPath(schemaPath string) string {
+	return schemaPath + ".sha256"
+}
+
+// ComputeSchemaSHA256 returns a stable hex-encoded SHA-256 digest of introspection JSON.
+func ComputeSchemaSHA256(introspectionJSON []byte) string {
+	sum := sha256.Sum256(introspectionJSON)
+	return hex.EncodeToString(sum[:])
+}
+
+// SaveSchemaCacheMeta writes meta alongside schemaPath as schema.introspection.json.sha256.
+func SaveSchemaCacheMeta(schemaPath string, meta SchemaCacheMeta) error {
+	raw, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(schemaCacheMetaPath(schemaPath), raw, 0o644)
+}
+
+// LoadSchemaCacheMeta reads the metadata saved by SaveSchemaCacheMeta for schemaPath. It returns
+// (nil, nil) when no metadata file exists yet (e.g. a schema.introspection.json predating this
+// cache, or written by an older version of the generator).
+func LoadSchemaCacheMeta(schemaPath string) (*SchemaCacheMeta, error) {
+	raw, err := os.ReadFile(schemaCacheMetaPath(schemaPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var meta SchemaCacheMeta
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// experimentalAPIsDiffer reports whether two experimental-API opt-in lists differ, ignoring
+// order.
+func experimentalAPIsDiffer(a, b []string) bool {
+	if len(a) != len(b) {
+		return true
+	}
+	sortedA := append([]string{}, a...)
+	sortedB := append([]string{}, b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldRefetchSchema decides whether the cached schema at schemaPath should be re-fetched,
+// given the experimental APIs the caller wants opted into this run and maxAge (zero means no
+// age-based expiry; only experimental-API drift or a missing file force a refetch). forceRefresh
+// always returns true, matching a --force-refresh flag.
+func ShouldRefetchSchema(schemaPath string, experimentalAPIs []string, maxAge time.Duration, forceRefresh bool) (bool, error) {
+	if forceRefresh {
+		return true, nil
+	}
+	if _, err := os.Stat(schemaPath); err != nil {
+		if os.IsNotExist(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	meta, err := LoadSchemaCacheMeta(schemaPath)
+	if err != nil {
+		return false, err
+	}
+	if meta == nil {
+		// A schema file exists but predates cache metadata; keep today's behavior of
+		// trusting whatever's on disk rather than forcing an unexpected refetch.
+		return false, nil
+	}
+	if experimentalAPIsDiffer(meta.ExperimentalAPIs, experimentalAPIs) {
+		return true, nil
+	}
+	if maxAge > 0 && time.Since(meta.FetchedAt) > maxAge {
+		return true, nil
+	}
+	return false, nil
+}
+
+// RecordSchemaFetch computes and saves the cache metadata for a freshly fetched
+// schema.introspection.json, stamping fetchedAt as the current time.
+func RecordSchemaFetch(schemaPath string, experimentalAPIs []string, fetchedAt time.Time) error {
+	raw, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return err
+	}
+	return SaveSchemaCacheMeta(schemaPath, SchemaCacheMeta{
+		SHA256:           ComputeSchemaSHA256(raw),
+		FetchedAt:        fetchedAt,
+		ExperimentalAPIs: experimentalAPIs,
+	})
+}
+
+// ParseSchemaMaxAge parses the ATLASSIAN_GQL_SCHEMA_MAX_AGE env var (a Go duration string, e.g.
+// "24h"), returning 0 (no age-based expiry) when unset or invalid.
+func ParseSchemaMaxAge(raw string) time.Duration {
+	clean := strings.TrimSpace(raw)
+	if clean == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(clean)
+	if err != nil {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,30 @@
+package graphstore
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"atlassian/atlassian/graph/gen"
+)
+
+// Collect walks s to exhaustion, applying project to each node, and returns the projected
+// results in order. It stops and returns the first error Next or project produces, other than
+// the io.EOF that marks the end of the stream.
+func Collect[T any](ctx context.Context, s *CypherResultStream, project func(gen.GraphStoreCypherQueryV2Node) (T, error)) ([]T, error) {
+	var out []T
+	for {
+		node, err := s.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		item, err := project(node)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+}
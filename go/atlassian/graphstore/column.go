@@ -0,0 +1,155 @@
+package graphstore
+
+import (
+	"errors"
+	"fmt"
+
+	"atlassian/atlassian/graph/gen"
+)
+
+// ErrColumnMissing is returned by a Col accessor when the node has no column with that key.
+var ErrColumnMissing = errors.New("graphstore: column missing")
+
+// ErrColumnTypeMismatch is returned by a Col accessor when the column's value doesn't hold the
+// requested type.
+var ErrColumnTypeMismatch = errors.New("graphstore: column type mismatch")
+
+// Col is a typed accessor for one column of a decoded GraphStoreCypherQueryV2Node, returned by
+// the Col function. Its As* methods return a typed error instead of requiring the caller to
+// switch on gen.ColumnValue's Kind themselves.
+type Col struct {
+	key   string
+	value *gen.GraphStoreCypherQueryV2Value
+}
+
+// ColOf looks up the column named key on node, for typed access via Col's As* methods.
+func ColOf(node gen.GraphStoreCypherQueryV2Node, key string) *Col {
+	return &Col{key: key, value: columnValue(node, key)}
+}
+
+// columnValue returns the raw value of node's column named key, or nil if node has no such
+// column.
+func columnValue(node gen.GraphStoreCypherQueryV2Node, key string) *gen.GraphStoreCypherQueryV2Value {
+	for _, column := range node.Columns {
+		if column.Key == key {
+			return column.Value
+		}
+	}
+	return nil
+}
+
+func (c *Col) mismatch() error {
+	kind := gen.ColumnValueKindUnknown
+	if c.value != nil {
+		kind = c.value.Kind
+	}
+	return fmt.Errorf("%w: column %q is %s", ErrColumnTypeMismatch, c.key, kind)
+}
+
+func (c *Col) missing() error {
+	return fmt.Errorf("%w: column %q", ErrColumnMissing, c.key)
+}
+
+// AsAriNode returns the column's ARI node value, or an error if the column is missing or holds a
+// different type.
+func (c *Col) AsAriNode() (gen.GraphStoreCypherQueryV2AriNode, error) {
+	if c.value == nil {
+		return gen.GraphStoreCypherQueryV2AriNode{}, c.missing()
+	}
+	v, ok := c.value.AsAriNode()
+	if !ok {
+		return gen.GraphStoreCypherQueryV2AriNode{}, c.mismatch()
+	}
+	return v, nil
+}
+
+// AsNodeList returns the column's node list value, or an error if the column is missing or holds
+// a different type.
+func (c *Col) AsNodeList() (gen.GraphStoreCypherQueryV2NodeList, error) {
+	if c.value == nil {
+		return gen.GraphStoreCypherQueryV2NodeList{}, c.missing()
+	}
+	v, ok := c.value.AsNodeList()
+	if !ok {
+		return gen.GraphStoreCypherQueryV2NodeList{}, c.mismatch()
+	}
+	return v, nil
+}
+
+// AsPath returns the column's path value, or an error if the column is missing or holds a
+// different type.
+func (c *Col) AsPath() (gen.GraphStoreCypherQueryV2Path, error) {
+	if c.value == nil {
+		return gen.GraphStoreCypherQueryV2Path{}, c.missing()
+	}
+	v, ok := c.value.AsPath()
+	if !ok {
+		return gen.GraphStoreCypherQueryV2Path{}, c.mismatch()
+	}
+	return v, nil
+}
+
+// AsString returns the column's string value, or an error if the column is missing or holds a
+// different type.
+func (c *Col) AsString() (string, error) {
+	if c.value == nil {
+		return "", c.missing()
+	}
+	v, ok := c.value.AsString()
+	if !ok {
+		return "", c.mismatch()
+	}
+	return v, nil
+}
+
+// AsInt returns the column's int value, or an error if the column is missing or holds a
+// different type.
+func (c *Col) AsInt() (int, error) {
+	if c.value == nil {
+		return 0, c.missing()
+	}
+	v, ok := c.value.AsInt()
+	if !ok {
+		return 0, c.mismatch()
+	}
+	return v, nil
+}
+
+// AsFloat returns the column's float value, or an error if the column is missing or holds a
+// different type.
+func (c *Col) AsFloat() (float64, error) {
+	if c.value == nil {
+		return 0, c.missing()
+	}
+	v, ok := c.value.AsFloat()
+	if !ok {
+		return 0, c.mismatch()
+	}
+	return v, nil
+}
+
+// AsBool returns the column's bool value, or an error if the column is missing or holds a
+// different type.
+func (c *Col) AsBool() (bool, error) {
+	if c.value == nil {
+		return false, c.missing()
+	}
+	v, ok := c.value.AsBool()
+	if !ok {
+		return false, c.mismatch()
+	}
+	return v, nil
+}
+
+// AsTimestamp returns the column's epoch-millisecond timestamp value, or an error if the column
+// is missing or holds a different type.
+func (c *Col) AsTimestamp() (int64, error) {
+	if c.value == nil {
+		return 0, c.missing()
+	}
+	v, ok := c.value.AsTimestamp()
+	if !ok {
+		return 0, c.mismatch()
+	}
+	return v, nil
+}
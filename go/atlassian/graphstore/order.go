@@ -0,0 +1,124 @@
+package graphstore
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"atlassian/atlassian/graph/gen"
+)
+
+// ErrIncomparableColumnValues is returned by SortEdgesBy or MergePagesBy when a comparator
+// reports IncomparableComparison for a pair of column values.
+var ErrIncomparableColumnValues = errors.New("graphstore: incomparable column values")
+
+// IncomparableComparison is the sentinel a CypherValueComparator returns when a and b can't be
+// meaningfully ordered (different Kinds, a nil value, or a Kind with no natural order). It's
+// chosen well outside the -1/0/1 range so a comparator author can't produce it by accident.
+const IncomparableComparison = 1 << 30
+
+// CypherValueComparator orders two column values, returning a negative number if a < b, zero if
+// a == b, a positive number if a > b, or IncomparableComparison if they can't be compared.
+type CypherValueComparator func(a, b *gen.GraphStoreCypherQueryV2Value) int
+
+// DefaultCypherValueComparator orders the scalar arms of GraphStoreCypherQueryV2Value: strings
+// lexically, ints and floats numerically, bools false before true, and timestamps numerically.
+// It reports IncomparableComparison for a nil value, a mismatched pair of Kinds, or a Kind
+// (AriNode, NodeList, Path) with no natural order.
+func DefaultCypherValueComparator(a, b *gen.GraphStoreCypherQueryV2Value) int {
+	if a == nil || b == nil || a.Kind != b.Kind {
+		return IncomparableComparison
+	}
+	switch a.Kind {
+	case gen.ColumnValueKindString:
+		return strings.Compare(a.StringObject.Value, b.StringObject.Value)
+	case gen.ColumnValueKindInt:
+		return compareOrdered(a.IntObject.Value, b.IntObject.Value)
+	case gen.ColumnValueKindFloat:
+		return compareOrdered(a.FloatObject.Value, b.FloatObject.Value)
+	case gen.ColumnValueKindBool:
+		return compareOrdered(boolRank(a.BooleanObject.Value), boolRank(b.BooleanObject.Value))
+	case gen.ColumnValueKindTimestamp:
+		return compareOrdered(a.TimestampObject.Value, b.TimestampObject.Value)
+	default:
+		return IncomparableComparison
+	}
+}
+
+func compareOrdered[T int | int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// SortEdgesBy sorts edges in place by the value of their columnKey column, using cmp to order
+// pairs. The sort is stable, so edges with equal column values keep their relative order. If cmp
+// ever reports IncomparableComparison, SortEdgesBy returns ErrIncomparableColumnValues and edges
+// is left in a partially sorted, unspecified order.
+func SortEdgesBy(edges []gen.GraphStoreCypherQueryV2Edge, columnKey string, cmp CypherValueComparator) error {
+	var incomparable bool
+	sort.SliceStable(edges, func(i, j int) bool {
+		c := cmp(columnValue(edges[i].Node, columnKey), columnValue(edges[j].Node, columnKey))
+		if c == IncomparableComparison {
+			incomparable = true
+		}
+		return c < 0
+	})
+	if incomparable {
+		return fmt.Errorf("%w: column %q", ErrIncomparableColumnValues, columnKey)
+	}
+	return nil
+}
+
+// MergePagesBy k-way merges pages, each already sorted ascending by its columnKey column (for
+// example via SortEdgesBy), into a single slice ordered the same way. If cmp ever reports
+// IncomparableComparison, MergePagesBy returns ErrIncomparableColumnValues.
+func MergePagesBy(pages [][]gen.GraphStoreCypherQueryV2Edge, columnKey string, cmp CypherValueComparator) ([]gen.GraphStoreCypherQueryV2Edge, error) {
+	type cursor struct {
+		edges []gen.GraphStoreCypherQueryV2Edge
+		pos   int
+	}
+	var cursors []*cursor
+	total := 0
+	for _, page := range pages {
+		if len(page) == 0 {
+			continue
+		}
+		cursors = append(cursors, &cursor{edges: page})
+		total += len(page)
+	}
+
+	out := make([]gen.GraphStoreCypherQueryV2Edge, 0, total)
+	for len(cursors) > 0 {
+		best := 0
+		for i := 1; i < len(cursors); i++ {
+			c := cmp(columnValue(cursors[i].edges[cursors[i].pos].Node, columnKey), columnValue(cursors[best].edges[cursors[best].pos].Node, columnKey))
+			if c == IncomparableComparison {
+				return nil, fmt.Errorf("%w: column %q", ErrIncomparableColumnValues, columnKey)
+			}
+			if c < 0 {
+				best = i
+			}
+		}
+		cur := cursors[best]
+		out = append(out, cur.edges[cur.pos])
+		cur.pos++
+		if cur.pos == len(cur.edges) {
+			cursors = append(cursors[:best], cursors[best+1:]...)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,86 @@
+// Package graphstore streams GraphStoreCypherQueryV2 result sets page by page, for callers
+// whose Cypher queries return more ARI nodes or path elements than fit comfortably in memory.
+// The generated gen.DecodeXxx helpers materialize a whole connection by round-tripping it
+// through json.Marshal(map[string]any); CypherResultStream instead buffers one page at a time
+// and fetches the next only once the buffer drains.
+package graphstore
+
+import (
+	"context"
+	"io"
+
+	"atlassian/atlassian/graph/gen"
+)
+
+// CypherPageFetchFunc retrieves one page of a GraphStoreCypherQueryV2 connection, resuming after
+// the given cursor ("" for the first page).
+type CypherPageFetchFunc func(ctx context.Context, after string) (*gen.GraphStoreCypherQueryV2Connection, error)
+
+// CypherResultStream walks a GraphStoreCypherQueryV2 connection page by page via a
+// CypherPageFetchFunc, transparently issuing a follow-up fetch using the previous page's
+// PageInfo.EndCursor once the local node buffer drains.
+type CypherResultStream struct {
+	fetch CypherPageFetchFunc
+
+	buf      []gen.GraphStoreCypherQueryV2Node
+	after    string
+	hasAfter bool
+	done     bool
+	err      error
+}
+
+// NewCypherResultStream builds a CypherResultStream that walks pages via fetch.
+func NewCypherResultStream(fetch CypherPageFetchFunc) *CypherResultStream {
+	return &CypherResultStream{fetch: fetch}
+}
+
+// Next returns the next node in the result set, or io.EOF once the connection is exhausted. A
+// non-io.EOF error halts the stream permanently; subsequent calls return the same error.
+func (s *CypherResultStream) Next(ctx context.Context) (gen.GraphStoreCypherQueryV2Node, error) {
+	if s.err != nil {
+		return gen.GraphStoreCypherQueryV2Node{}, s.err
+	}
+	for len(s.buf) == 0 {
+		if s.done {
+			return gen.GraphStoreCypherQueryV2Node{}, io.EOF
+		}
+		if err := s.fetchNextPage(ctx); err != nil {
+			s.err = err
+			return gen.GraphStoreCypherQueryV2Node{}, err
+		}
+	}
+	node := s.buf[0]
+	s.buf = s.buf[1:]
+	return node, nil
+}
+
+func (s *CypherResultStream) fetchNextPage(ctx context.Context) error {
+	after := ""
+	if s.hasAfter {
+		after = s.after
+	}
+	conn, err := s.fetch(ctx, after)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		s.done = true
+		return nil
+	}
+	for _, edge := range conn.Edges {
+		s.buf = append(s.buf, edge.Node)
+	}
+	if conn.PageInfo.EndCursor != nil {
+		s.after = *conn.PageInfo.EndCursor
+		s.hasAfter = true
+	} else {
+		s.hasAfter = false
+	}
+	s.done = !conn.PageInfo.HasNextPage
+	return nil
+}
+
+// Err returns the error (if any, other than io.EOF) that halted the stream.
+func (s *CypherResultStream) Err() error {
+	return s.err
+}
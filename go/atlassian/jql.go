@@ -0,0 +1,150 @@
+package atlassian
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JQL is a single composable clause (or boolean combination of clauses) of a JQL query, as
+// produced by the field builders below (Project, Status, Assignee, ...) and composed with
+// And/Or/Not. String renders it as properly escaped JQL text.
+type JQL interface {
+	String() string
+}
+
+// JQLField is a field handle produced by Project/Status/Assignee/CustomField/etc, offering the
+// comparison operators valid for a JQL field.
+type JQLField struct{ name string }
+
+// Project is shorthand for JQLField{"project"}.Is(key), since filtering by project is the most
+// common single-field clause.
+func Project(key string) JQL { return JQLField{"project"}.Is(key) }
+
+func Status() JQLField             { return JQLField{"status"} }
+func Assignee() JQLField           { return JQLField{"assignee"} }
+func Reporter() JQLField           { return JQLField{"reporter"} }
+func Created() JQLField            { return JQLField{"created"} }
+func Updated() JQLField            { return JQLField{"updated"} }
+func Labels() JQLField             { return JQLField{"labels"} }
+func CustomField(id string) JQLField { return JQLField{id} }
+
+func (f JQLField) Is(value any) JQL         { return jqlClause{f.name, "=", []any{value}} }
+func (f JQLField) Not(value any) JQL        { return jqlClause{f.name, "!=", []any{value}} }
+func (f JQLField) In(values ...any) JQL     { return jqlClause{f.name, "in", values} }
+func (f JQLField) NotIn(values ...any) JQL  { return jqlClause{f.name, "not in", values} }
+func (f JQLField) Contains(values ...any) JQL { return jqlClause{f.name, "in", values} }
+func (f JQLField) GreaterThan(value any) JQL  { return jqlClause{f.name, ">", []any{value}} }
+func (f JQLField) LessThan(value any) JQL     { return jqlClause{f.name, "<", []any{value}} }
+func (f JQLField) After(t time.Time) JQL      { return jqlClause{f.name, ">", []any{t}} }
+func (f JQLField) Before(t time.Time) JQL     { return jqlClause{f.name, "<", []any{t}} }
+
+// jqlClause is a single "field operator value[, value...]" comparison, e.g. `project = "A"` or
+// `status in ("Done", "In Progress")`.
+type jqlClause struct {
+	field    string
+	operator string
+	values   []any
+}
+
+func (c jqlClause) String() string {
+	switch c.operator {
+	case "in", "not in":
+		rendered := make([]string, len(c.values))
+		for i, v := range c.values {
+			rendered[i] = jqlLiteral(v)
+		}
+		return fmt.Sprintf("%s %s (%s)", c.field, c.operator, strings.Join(rendered, ", "))
+	default:
+		return fmt.Sprintf("%s %s %s", c.field, c.operator, jqlLiteral(c.values[0]))
+	}
+}
+
+// jqlBoolean is an AND/OR combination of two or more clauses. A child clause that's itself a
+// jqlBoolean of the other operator is parenthesized, so And(Or(a, b), c) renders as "(a OR b) AND
+// c" rather than the ambiguous "a OR b AND c".
+type jqlBoolean struct {
+	operator string // "AND" or "OR"
+	clauses  []JQL
+}
+
+func And(clauses ...JQL) JQL { return jqlBoolean{operator: "AND", clauses: clauses} }
+func Or(clauses ...JQL) JQL  { return jqlBoolean{operator: "OR", clauses: clauses} }
+
+func (b jqlBoolean) String() string {
+	rendered := make([]string, len(b.clauses))
+	for i, c := range b.clauses {
+		s := c.String()
+		if nested, ok := c.(jqlBoolean); ok && nested.operator != b.operator {
+			s = "(" + s + ")"
+		}
+		rendered[i] = s
+	}
+	return strings.Join(rendered, " "+b.operator+" ")
+}
+
+// jqlNot negates a single clause, parenthesizing it first if it's a boolean combination.
+type jqlNot struct{ clause JQL }
+
+func Not(clause JQL) JQL { return jqlNot{clause} }
+
+func (n jqlNot) String() string {
+	s := n.clause.String()
+	if _, ok := n.clause.(jqlBoolean); ok {
+		s = "(" + s + ")"
+	}
+	return "NOT " + s
+}
+
+// JQLRaw is a JQL literal rendered verbatim, with no quoting or escaping - for the handful of
+// bare JQL function calls like currentUser() or membersOf("jira-developers") that must not be
+// treated as string values. Construct one via Func rather than passing a plain string to
+// Is/In/etc: jqlLiteral does not content-sniff caller-supplied strings for a function-call shape,
+// since a pattern like `^ident\(.*\)$` matches far more than the known literals it was meant for
+// - e.g. `x() OR priority = Highest OR y()` - and would let a value injected into any string
+// field render as unescaped JQL.
+type JQLRaw string
+
+// Func renders a bare JQL function call, e.g. Func("membersOf", "jira-developers") renders
+// `membersOf("jira-developers")`. Each arg is rendered via jqlLiteral, so string args are quoted
+// and escaped as usual; only the call shape itself is emitted unescaped.
+func Func(name string, args ...any) JQLRaw {
+	rendered := make([]string, len(args))
+	for i, a := range args {
+		rendered[i] = jqlLiteral(a)
+	}
+	return JQLRaw(name + "(" + strings.Join(rendered, ", ") + ")")
+}
+
+// jqlLiteral renders a Go value as a JQL literal: numbers and booleans unquoted, time.Time
+// normalized to UTC and rendered as a quoted "yyyy/MM/dd HH:mm" timestamp (JQL date literals
+// without an explicit zone are interpreted in the Jira instance's configured timezone, so a
+// non-UTC offset must be normalized here rather than printed as-is), JQLRaw emitted verbatim, and
+// every other string double-quoted with backslashes and embedded quotes escaped per the JQL spec.
+func jqlLiteral(v any) string {
+	switch val := v.(type) {
+	case JQLRaw:
+		return string(val)
+	case time.Time:
+		return strconv.Quote(val.UTC().Format("2006/01/02 15:04"))
+	case int:
+		return strconv.Itoa(val)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case string:
+		return jqlQuoteString(val)
+	default:
+		return jqlQuoteString(fmt.Sprintf("%v", val))
+	}
+}
+
+// jqlQuoteString double-quotes s, escaping backslashes and double quotes per the JQL spec.
+func jqlQuoteString(s string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+	return `"` + escaped + `"`
+}
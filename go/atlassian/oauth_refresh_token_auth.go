@@ -0,0 +1,199 @@
+package atlassian
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultOAuthTokenURL is used when OAuthRefreshTokenAuth.TokenURL is unset.
+const defaultOAuthTokenURL = "https://auth.atlassian.com/oauth/token"
+
+// tokenExpiryMargin is how far ahead of a cached access token's expiry OAuthRefreshTokenAuth
+// refreshes it, so a request starting just before expiry doesn't race a mid-flight 401.
+const tokenExpiryMargin = 60 * time.Second
+
+// OAuthRefreshTokenAuth implements AuthProvider via OAuth 2.0 three-legged authentication: it
+// exchanges RefreshToken for a short-lived access token against TokenURL, caches it until
+// tokenExpiryMargin before its expires_in elapses, and refreshes again transparently once that
+// margin is reached. Reauth forces an immediate unconditional refresh, regardless of the cached
+// token's age, so rest.Transport can retry a request exactly once after an unexpected 401 (e.g.
+// the token was revoked early).
+type OAuthRefreshTokenAuth struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	// TokenURL defaults to defaultOAuthTokenURL ("https://auth.atlassian.com/oauth/token") when
+	// empty.
+	TokenURL string
+	// Timeout bounds each token refresh request. Defaults to 30s when zero.
+	Timeout time.Duration
+	// OnRefresh, if set, is called with the new access token, refresh token, and expiry
+	// immediately after a successful refresh, so callers can persist them (e.g. back to an env
+	// file) without polling the cached values.
+	OnRefresh func(accessToken, refreshToken string, expiresAt time.Time)
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// Apply sets req's Authorization header to a cached access token, refreshing it first if it's
+// absent or within tokenExpiryMargin of expiry.
+func (o *OAuthRefreshTokenAuth) Apply(req *http.Request) error {
+	token, err := o.token(req.Context(), false)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Reauth discards any cached access token, refreshes unconditionally, and reapplies req's
+// Authorization header. It implements the reauthenticator interface rest.Transport looks for to
+// retry a 401 exactly once.
+func (o *OAuthRefreshTokenAuth) Reauth(req *http.Request) error {
+	token, err := o.token(req.Context(), true)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// token returns a valid access token, refreshing it when force is set or the cached token is
+// absent or within tokenExpiryMargin of expiry.
+func (o *OAuthRefreshTokenAuth) token(ctx context.Context, force bool) (string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !force && o.accessToken != "" && time.Now().Add(tokenExpiryMargin).Before(o.expiresAt) {
+		return o.accessToken, nil
+	}
+
+	tokenURL := strings.TrimSpace(o.TokenURL)
+	if tokenURL == "" {
+		tokenURL = defaultOAuthTokenURL
+	}
+	timeout := o.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     o.ClientID,
+		"client_secret": o.ClientSecret,
+		"refresh_token": o.RefreshToken,
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := (&http.Client{Timeout: timeout}).Do(req)
+	if err != nil {
+		return "", fmt.Errorf("refresh oauth token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("refresh oauth token: unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var parsed struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("decode oauth token response: %w", err)
+	}
+	if parsed.AccessToken == "" {
+		return "", errors.New("oauth token response missing access_token")
+	}
+
+	o.accessToken = parsed.AccessToken
+	o.expiresAt = time.Now().Add(time.Duration(parsed.ExpiresIn) * time.Second)
+	if parsed.RefreshToken != "" {
+		o.RefreshToken = parsed.RefreshToken
+	}
+	if o.OnRefresh != nil {
+		o.OnRefresh(o.accessToken, o.RefreshToken, o.expiresAt)
+	}
+	return o.accessToken, nil
+}
+
+// accessibleResourcesURL is the 3LO endpoint listing the Atlassian Cloud sites an access token
+// can reach.
+const accessibleResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+
+// AccessibleResource is one Atlassian Cloud site an OAuth token can reach, as returned by
+// GET accessibleResourcesURL.
+type AccessibleResource struct {
+	ID   string `json:"id"`
+	URL  string `json:"url"`
+	Name string `json:"name"`
+}
+
+// DiscoverCloudID resolves the Atlassian Cloud ID reachable by auth whose site URL matches
+// baseURL, via accessibleResourcesURL. When that endpoint returns exactly one resource, its ID is
+// used regardless of baseURL, since a token scoped to a single site is the common case; otherwise
+// the resource whose URL matches baseURL (ignoring a trailing slash) is used.
+func DiscoverCloudID(ctx context.Context, auth AuthProvider, baseURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, accessibleResourcesURL, nil)
+	if err != nil {
+		return "", err
+	}
+	if auth != nil {
+		if err := auth.Apply(req); err != nil {
+			return "", err
+		}
+	}
+
+	resp, err := (&http.Client{Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		return "", fmt.Errorf("list accessible resources: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("list accessible resources: unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var resources []AccessibleResource
+	if err := json.Unmarshal(body, &resources); err != nil {
+		return "", fmt.Errorf("decode accessible resources: %w", err)
+	}
+	if len(resources) == 0 {
+		return "", errors.New("no accessible resources returned for this token")
+	}
+	if len(resources) == 1 {
+		return resources[0].ID, nil
+	}
+
+	trimmedBase := strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	for _, r := range resources {
+		if strings.TrimRight(r.URL, "/") == trimmedBase {
+			return r.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no accessible resource matches base_url %q; set cloud_id explicitly", baseURL)
+}
@@ -0,0 +1,134 @@
+package pagination
+
+import "context"
+
+// CursorFetchFunc retrieves one page of a Relay-style GraphQL connection, resuming after the
+// given cursor (nil for the first page).
+type CursorFetchFunc[T any] func(ctx context.Context, after *string) (nodes []T, hasNextPage bool, endCursor *string, err error)
+
+// CursorErrorPolicy controls how a CursorIterator reacts to a failed page fetch.
+type CursorErrorPolicy int
+
+const (
+	// CursorErrorStop surfaces the fetch error from Next/Err and halts iteration. Default.
+	CursorErrorStop CursorErrorPolicy = iota
+	// CursorErrorRetry retries a failed page fetch once, with the same cursor, before halting.
+	CursorErrorRetry
+)
+
+// CursorIteratorOptions configures a CursorIterator.
+type CursorIteratorOptions struct {
+	// MaxPages bounds how many pages are fetched before Next stops (returning nil, nil as if
+	// the connection were exhausted); zero means unlimited.
+	MaxPages int
+	// ErrorPolicy governs retries of a failed page fetch.
+	ErrorPolicy CursorErrorPolicy
+	// StartAfter resumes the walk from a previously checkpointed cursor (see Cursor) instead of
+	// starting from the beginning of the connection; nil starts from the first page.
+	StartAfter *string
+}
+
+// CursorIterator walks a Relay-style GraphQL connection page by page via CursorFetchFunc,
+// buffering one page of nodes at a time. It's the cursor-based counterpart to Paginator[T],
+// which walks Jira's startAt/isLast/total REST connections instead.
+type CursorIterator[T any] struct {
+	fetch  CursorFetchFunc[T]
+	opts   CursorIteratorOptions
+	buf    []T
+	bufIdx int
+	after  *string
+	done   bool
+	closed bool
+	pages  int
+	err    error
+}
+
+// NewCursorIterator builds a CursorIterator with the given fetch function and options. If
+// opts.StartAfter is set, the first fetch resumes from that cursor instead of the start of the
+// connection.
+func NewCursorIterator[T any](fetch CursorFetchFunc[T], opts CursorIteratorOptions) *CursorIterator[T] {
+	return &CursorIterator[T]{fetch: fetch, opts: opts, after: opts.StartAfter}
+}
+
+// Next returns the next node, or (nil, nil) once the connection is exhausted (or Close has been
+// called). A non-nil error halts iteration permanently; subsequent calls return the same error.
+func (it *CursorIterator[T]) Next(ctx context.Context) (*T, error) {
+	for {
+		if it.closed || it.err != nil {
+			return nil, it.err
+		}
+		if it.bufIdx < len(it.buf) {
+			item := it.buf[it.bufIdx]
+			it.bufIdx++
+			return &item, nil
+		}
+		if it.done {
+			return nil, nil
+		}
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return nil, err
+		}
+		if it.opts.MaxPages > 0 && it.pages >= it.opts.MaxPages {
+			it.done = true
+			return nil, nil
+		}
+
+		nodes, hasNextPage, endCursor, err := it.fetch(ctx, it.after)
+		if err != nil && it.opts.ErrorPolicy == CursorErrorRetry {
+			nodes, hasNextPage, endCursor, err = it.fetch(ctx, it.after)
+		}
+		if err != nil {
+			it.err = err
+			return nil, err
+		}
+
+		it.pages++
+		it.buf = nodes
+		it.bufIdx = 0
+		it.after = endCursor
+		if !hasNextPage {
+			it.done = true
+		}
+	}
+}
+
+// Err returns the error (if any) that halted iteration.
+func (it *CursorIterator[T]) Err() error {
+	return it.err
+}
+
+// Cursor returns the cursor the iterator has most recently advanced past; before the first fetch
+// it is opts.StartAfter, which is nil unless the walk was resumed. Callers that need to resume a walk later (e.g. across process restarts) can
+// persist this value and pass it back as CursorIteratorOptions.StartAfter.
+func (it *CursorIterator[T]) Cursor() *string {
+	return it.after
+}
+
+// Close stops the iterator; subsequent Next calls return (nil, nil).
+func (it *CursorIterator[T]) Close() error {
+	it.closed = true
+	return nil
+}
+
+// Channel streams the remaining nodes on a channel, which is closed once the connection is
+// exhausted, ctx is done, or a fetch error occurs (check Err afterwards). The background
+// goroutine stops promptly if the channel isn't drained and ctx is canceled.
+func (it *CursorIterator[T]) Channel(ctx context.Context) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			item, err := it.Next(ctx)
+			if err != nil || item == nil {
+				return
+			}
+			select {
+			case out <- *item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,94 @@
+// Package pagination provides a generic startAt/isLast/total paginator shared by
+// the REST list endpoints (versions, boards, sprints, ...), replacing the
+// hand-rolled loops that used to reimplement the same loop-detection and
+// early-exit logic with subtle divergences between callers.
+package pagination
+
+import (
+	"context"
+	"fmt"
+)
+
+// FetchFunc retrieves one page of items starting at startAt. isLast and total mirror
+// the optional fields Jira's PageBean-style responses return: isLast is authoritative
+// when present, and total (when present) is used to detect the last page even if the
+// API never sets isLast.
+type FetchFunc[T any] func(ctx context.Context, startAt int) (items []T, isLast *bool, total *int, err error)
+
+// Paginator walks a FetchFunc page by page, handling loop detection and the
+// empty-page-with-isLast=false anomaly once for every caller.
+type Paginator[T any] struct {
+	Fetch    FetchFunc[T]
+	PageSize int
+}
+
+// New builds a Paginator with the given page size. A non-positive pageSize defaults to 50.
+func New[T any](fetch FetchFunc[T], pageSize int) *Paginator[T] {
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	return &Paginator[T]{Fetch: fetch, PageSize: pageSize}
+}
+
+// All buffers every page into a single slice.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var out []T
+	err := p.Iterate(ctx, func(item T) error {
+		out = append(out, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Iterate streams items page by page, calling yield for each one. Callers processing
+// large result sets should use this instead of All to avoid buffering everything.
+// Returning an error from yield stops iteration and propagates the error.
+func (p *Paginator[T]) Iterate(ctx context.Context, yield func(T) error) error {
+	startAt := 0
+	seenStartAt := map[int]struct{}{}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if _, ok := seenStartAt[startAt]; ok {
+			return fmt.Errorf("pagination startAt repeated; aborting to prevent infinite loop")
+		}
+		seenStartAt[startAt] = struct{}{}
+
+		items, isLast, total, err := p.Fetch(ctx, startAt)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := yield(item); err != nil {
+				return err
+			}
+		}
+
+		if isLast != nil && *isLast {
+			return nil
+		}
+
+		if len(items) == 0 {
+			if isLast != nil && !*isLast {
+				return fmt.Errorf("received empty page with isLast=false at startAt=%d", startAt)
+			}
+			return nil
+		}
+
+		if total != nil {
+			if startAt+len(items) >= *total {
+				return nil
+			}
+		} else if len(items) < p.PageSize {
+			return nil
+		}
+		startAt += len(items)
+	}
+}
@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// APIVersion selects which Jira REST API generation a JiraRESTClient talks to. Cloud supports
+// both v2 and v3; Server/Data Center instances are v2-only and don't understand ADF.
+type APIVersion string
+
+const (
+	APIVersionV2 APIVersion = "v2"
+	APIVersionV3 APIVersion = "v3"
+)
+
+// JiraFlavor distinguishes Jira Cloud from self-managed Server/Data Center instances, which
+// expose slightly different REST surfaces (e.g. no cloudID path segment, no webhooks API).
+type JiraFlavor string
+
+const (
+	JiraFlavorCloud      JiraFlavor = "cloud"
+	JiraFlavorServer     JiraFlavor = "server"
+	JiraFlavorDataCenter JiraFlavor = "datacenter"
+)
+
+// apiVersion returns c.APIVersion, defaulting to v3 (the Cloud default) when unset so existing
+// callers that never set the field keep their current behavior.
+func (c *JiraRESTClient) apiVersion() APIVersion {
+	if c.APIVersion != "" {
+		return c.APIVersion
+	}
+	return APIVersionV3
+}
+
+// apiPath builds a path under /rest/api/{version}, where version is resolved from
+// c.APIVersion (v2 or v3). format/args are Sprintf'd to produce the path suffix, e.g.
+// c.apiPath("/issue/%s", key) -> "/rest/api/3/issue/ABC-1" or "/rest/api/2/issue/ABC-1".
+func (c *JiraRESTClient) apiPath(format string, args ...any) string {
+	version := strings.TrimPrefix(string(c.apiVersion()), "v")
+	suffix := fmt.Sprintf(format, args...)
+	return fmt.Sprintf("/rest/api/%s%s", version, suffix)
+}
+
+// agilePath builds a path under /rest/agile/1.0. The Agile API is versioned independently of
+// the core REST API and doesn't vary between APIVersionV2 and APIVersionV3.
+func (c *JiraRESTClient) agilePath(format string, args ...any) string {
+	suffix := fmt.Sprintf(format, args...)
+	return fmt.Sprintf("/rest/agile/1.0%s", suffix)
+}
+
+// descriptionForWrite adapts a description value to the shape the configured APIVersion
+// expects: v3 wants an ADF document (map[string]any), v2 wants plain text. raw may already be
+// either shape (e.g. passed straight through from Terraform config or another v2/v3 read), or
+// nil, in which case it's returned unchanged.
+func (c *JiraRESTClient) descriptionForWrite(raw any) any {
+	if raw == nil {
+		return nil
+	}
+	switch c.apiVersion() {
+	case APIVersionV2:
+		if s, ok := raw.(string); ok {
+			return s
+		}
+		return adfToPlainText(raw)
+	default:
+		if _, ok := raw.(map[string]any); ok {
+			return raw
+		}
+		s, ok := raw.(string)
+		if !ok {
+			return raw
+		}
+		return plainTextToADF(s)
+	}
+}
+
+// plainTextToADF wraps plain text in the minimal single-paragraph ADF document v3 requires.
+func plainTextToADF(text string) map[string]any {
+	return map[string]any{
+		"type":    "doc",
+		"version": 1,
+		"content": []any{
+			map[string]any{
+				"type": "paragraph",
+				"content": []any{
+					map[string]any{"type": "text", "text": text},
+				},
+			},
+		},
+	}
+}
+
+// adfToPlainText extracts a best-effort plain-text rendering of an ADF document's text nodes,
+// for v2 instances that can't store structured descriptions. Full ADF rendering (marks,
+// mentions, media, etc.) is out of scope here.
+func adfToPlainText(doc any) string {
+	var b strings.Builder
+	var walk func(node any)
+	walk = func(node any) {
+		obj, ok := node.(map[string]any)
+		if !ok {
+			return
+		}
+		if text, ok := obj["text"].(string); ok {
+			b.WriteString(text)
+		}
+		if content, ok := obj["content"].([]any); ok {
+			for _, child := range content {
+				walk(child)
+			}
+			if obj["type"] == "paragraph" {
+				b.WriteString("\n")
+			}
+		}
+	}
+	walk(doc)
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// Convert round-trips item through JSON into out, so mappers written against one API
+// version's decoded shape (e.g. gen.IssueBean) can be reused across versions whose wire
+// payloads differ only in fields Convert's target type doesn't care about.
+func Convert(item any, out any) error {
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("convert: marshal: %w", err)
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("convert: unmarshal: %w", err)
+	}
+	return nil
+}
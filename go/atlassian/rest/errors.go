@@ -0,0 +1,33 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+)
+
+// HTTPError is the error type JiraRESTClient's GetJSON/PostJSON/PutJSON/Delete methods wrap
+// around non-2xx responses, so callers can branch on status code (e.g. to fall back to an
+// older API when a newer endpoint isn't available on a given Jira instance) via errors.As.
+type HTTPError struct {
+	StatusCode int
+	Path       string
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("jira API request to %s failed with status %d: %s", e.Path, e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is (or wraps) an HTTPError with a 404 status code.
+func IsNotFound(err error) bool {
+	var httpErr *HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == 404
+}
+
+// IsGone reports whether err is (or wraps) an HTTPError with a 410 status code, the status Jira
+// Cloud returns for endpoints it has sunset entirely (as opposed to 404, which it also uses for
+// "not found on this instance").
+func IsGone(err error) bool {
+	var httpErr *HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == 410
+}
@@ -46,6 +46,19 @@ type BoardPage struct {
 	Values     []Board `json:"values,omitempty"`
 }
 
+// DecodeSprint decodes a map into a Sprint struct.
+func DecodeSprint(data map[string]any) (*Sprint, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out Sprint
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // DecodeSprintPage decodes a map into a SprintPage struct.
 func DecodeSprintPage(data map[string]any) (*SprintPage, error) {
 	b, err := json.Marshal(data)
@@ -71,3 +84,16 @@ func DecodeBoardPage(data map[string]any) (*BoardPage, error) {
 	}
 	return &out, nil
 }
+
+// DecodeBoard decodes a map into a Board struct.
+func DecodeBoard(data map[string]any) (*Board, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out Board
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,46 @@
+// Jira core REST API models for issue changelog history.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}/changelog
+package gen
+
+import "encoding/json"
+
+// ChangelogItem represents a single field change within a ChangelogHistory entry.
+type ChangelogItem struct {
+	Field      *string `json:"field,omitempty"`
+	FieldType  *string `json:"fieldtype,omitempty"`
+	From       *string `json:"from,omitempty"`
+	FromString *string `json:"fromString,omitempty"`
+	To         *string `json:"to,omitempty"`
+	ToString   *string `json:"toString,omitempty"`
+}
+
+// ChangelogHistory represents one changelog entry: a timestamped batch of field changes made
+// in a single edit.
+type ChangelogHistory struct {
+	ID      *string         `json:"id,omitempty"`
+	Created *string         `json:"created,omitempty"`
+	Items   []ChangelogItem `json:"items,omitempty"`
+}
+
+// Changelog represents a paginated page of issue changelog history.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}/changelog
+type Changelog struct {
+	StartAt    *int               `json:"startAt,omitempty"`
+	MaxResults *int               `json:"maxResults,omitempty"`
+	Total      *int               `json:"total,omitempty"`
+	IsLast     *bool              `json:"isLast,omitempty"`
+	Histories  []ChangelogHistory `json:"histories,omitempty"`
+}
+
+// DecodeChangelog decodes a Changelog page from a raw JSON payload.
+func DecodeChangelog(data map[string]any) (*Changelog, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out Changelog
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
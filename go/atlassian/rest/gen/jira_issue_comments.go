@@ -0,0 +1,29 @@
+// Jira core REST API models for issue comments.
+// Ref: GET/POST/PUT/DELETE /rest/api/3/issue/{issueIdOrKey}/comment
+package gen
+
+import "encoding/json"
+
+// PageOfComments represents a paginated list of comments on an issue. Each entry is left as a
+// raw map (rather than a typed Comment struct) since mappers.JiraCommentFromREST already knows
+// how to parse a single comment object from that shape.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}/comment
+type PageOfComments struct {
+	StartAt    *int             `json:"startAt,omitempty"`
+	MaxResults *int             `json:"maxResults,omitempty"`
+	Total      *int             `json:"total,omitempty"`
+	Comments   []map[string]any `json:"comments,omitempty"`
+}
+
+// DecodePageOfComments decodes a PageOfComments from a raw JSON payload.
+func DecodePageOfComments(data map[string]any) (*PageOfComments, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var page PageOfComments
+	if err := json.Unmarshal(b, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
@@ -0,0 +1,27 @@
+// Jira core REST API models for the token-paginated Enhanced Search endpoint.
+// Ref: POST /rest/api/3/search/jql
+package gen
+
+import "encoding/json"
+
+// SearchResultsV2 represents a page of issues from the token-paginated Enhanced Search endpoint
+// (POST /rest/api/3/search/jql), which replaces the legacy startAt/total pagination with
+// nextPageToken/isLast.
+type SearchResultsV2 struct {
+	Issues        []IssueBean `json:"issues,omitempty"`
+	NextPageToken *string     `json:"nextPageToken,omitempty"`
+	IsLast        *bool       `json:"isLast,omitempty"`
+}
+
+// DecodeSearchResultsV2 decodes a SearchResultsV2 page from a raw JSON payload.
+func DecodeSearchResultsV2(data map[string]any) (*SearchResultsV2, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out SearchResultsV2
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
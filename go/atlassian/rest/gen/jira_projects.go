@@ -0,0 +1,46 @@
+// Jira core REST API models for projects.
+// Ref: GET/PUT /rest/api/3/project/{projectIdOrKey}
+package gen
+
+import "encoding/json"
+
+// ProjectLead represents the "lead" object nested in a Project payload.
+type ProjectLead struct {
+	AccountID *string `json:"accountId,omitempty"`
+}
+
+// ProjectCategory represents the "projectCategory" object nested in a Project payload.
+type ProjectCategory struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// Project represents a Jira project from the core REST API.
+// Ref: GET /rest/api/3/project/{projectIdOrKey}
+type Project struct {
+	ID                 *string          `json:"id,omitempty"`
+	Key                *string          `json:"key,omitempty"`
+	Name               *string          `json:"name,omitempty"`
+	Description        *string          `json:"description,omitempty"`
+	URL                *string          `json:"url,omitempty"`
+	ProjectTypeKey     *string          `json:"projectTypeKey,omitempty"`
+	ProjectTemplateKey *string          `json:"projectTemplateKey,omitempty"`
+	AssigneeType       *string          `json:"assigneeType,omitempty"`
+	Lead               *ProjectLead     `json:"lead,omitempty"`
+	ProjectCategory    *ProjectCategory `json:"projectCategory,omitempty"`
+	Archived           *bool            `json:"archived,omitempty"`
+	Deleted            *bool            `json:"deleted,omitempty"`
+}
+
+// DecodeProject decodes a single Project from a raw JSON payload.
+func DecodeProject(data map[string]any) (*Project, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var p Project
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,97 @@
+// Jira core REST API models for project versions.
+// Ref: GET/POST/PUT /rest/api/3/version
+package gen
+
+import "encoding/json"
+
+// Version represents a project version from the Jira core REST API.
+// Ref: GET /rest/api/3/version/{id}
+type Version struct {
+	ID              *string `json:"id,omitempty"`
+	ProjectID       *int    `json:"projectId,omitempty"`
+	Name            *string `json:"name,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	Released        *bool   `json:"released,omitempty"`
+	Archived        *bool   `json:"archived,omitempty"`
+	Overdue         *bool   `json:"overdue,omitempty"`
+	ReleaseDate     *string `json:"releaseDate,omitempty"`
+	StartDate       *string `json:"startDate,omitempty"`
+	UserStartDate   *string `json:"userStartDate,omitempty"`
+	UserReleaseDate *string `json:"userReleaseDate,omitempty"`
+	Self            *string `json:"self,omitempty"`
+}
+
+// PageBeanVersion represents a paginated list of versions.
+// Ref: GET /rest/api/3/project/{projectIdOrKey}/version
+type PageBeanVersion struct {
+	StartAt    *int      `json:"startAt,omitempty"`
+	MaxResults *int      `json:"maxResults,omitempty"`
+	Total      *int      `json:"total,omitempty"`
+	IsLast     *bool     `json:"isLast,omitempty"`
+	Values     []Version `json:"values,omitempty"`
+}
+
+// VersionRelatedIssuesCount represents the issue counts referencing a version.
+// Ref: GET /rest/api/3/version/{id}/relatedIssueCounts
+type VersionRelatedIssuesCount struct {
+	IssuesFixedCount    *int `json:"issuesFixedCount,omitempty"`
+	IssuesAffectedCount *int `json:"issuesAffectedCount,omitempty"`
+}
+
+// VersionUnresolvedIssueCount represents the unresolved issue count for a version.
+// Ref: GET /rest/api/3/version/{id}/unresolvedIssueCount
+type VersionUnresolvedIssueCount struct {
+	IssuesUnresolvedCount *int `json:"issuesUnresolvedCount,omitempty"`
+}
+
+// DecodeVersion decodes a single Version from a raw JSON payload.
+func DecodeVersion(data map[string]any) (*Version, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var v Version
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// DecodePageBeanVersion decodes a PageBeanVersion from a raw JSON payload.
+func DecodePageBeanVersion(data map[string]any) (*PageBeanVersion, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var page PageBeanVersion
+	if err := json.Unmarshal(b, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// DecodeVersionRelatedIssuesCount decodes a VersionRelatedIssuesCount from a raw JSON payload.
+func DecodeVersionRelatedIssuesCount(data map[string]any) (*VersionRelatedIssuesCount, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var counts VersionRelatedIssuesCount
+	if err := json.Unmarshal(b, &counts); err != nil {
+		return nil, err
+	}
+	return &counts, nil
+}
+
+// DecodeVersionUnresolvedIssueCount decodes a VersionUnresolvedIssueCount from a raw JSON payload.
+func DecodeVersionUnresolvedIssueCount(data map[string]any) (*VersionUnresolvedIssueCount, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var counts VersionUnresolvedIssueCount
+	if err := json.Unmarshal(b, &counts); err != nil {
+		return nil, err
+	}
+	return &counts, nil
+}
@@ -2,7 +2,9 @@ package rest
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"atlassian/atlassian"
 	"atlassian/atlassian/rest/gen"
@@ -28,7 +30,7 @@ func (c *JiraRESTClient) ListBoards(ctx context.Context, pageSize int) ([]atlass
 			"startAt":    fmt.Sprintf("%d", startAt),
 			"maxResults": fmt.Sprintf("%d", pageSize),
 		}
-		payload, err := c.GetJSON(ctx, "/rest/agile/1.0/board", params)
+		payload, err := c.GetJSON(ctx, c.agilePath("/board"), params)
 		if err != nil {
 			return nil, err
 		}
@@ -58,3 +60,76 @@ func (c *JiraRESTClient) ListBoards(ctx context.Context, pageSize int) ([]atlass
 
 	return out, nil
 }
+
+// CreateBoardInput configures CreateBoard.
+type CreateBoardInput struct {
+	Name string
+	// Type is one of "scrum" or "kanban".
+	Type string
+	// FilterID is the ID of the saved filter the board's issues are drawn from.
+	FilterID int
+}
+
+// CreateBoard creates a Jira Agile board backed by an existing saved filter.
+// Ref: POST /rest/agile/1.0/board
+//
+// The Agile API has no corresponding update endpoint, so board_resource.go requires replacement
+// on any attribute change rather than calling an UpdateBoard that doesn't exist.
+func (c *JiraRESTClient) CreateBoard(ctx context.Context, in CreateBoardInput) (atlassian.JiraBoard, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return atlassian.JiraBoard{}, errors.New("name is required")
+	}
+	boardType := strings.TrimSpace(in.Type)
+	if boardType == "" {
+		return atlassian.JiraBoard{}, errors.New("type is required")
+	}
+	if in.FilterID <= 0 {
+		return atlassian.JiraBoard{}, errors.New("filterID must be a positive integer")
+	}
+
+	body := map[string]any{
+		"name":     name,
+		"type":     boardType,
+		"filterId": in.FilterID,
+	}
+
+	payload, err := c.PostJSON(ctx, c.agilePath("/board"), body)
+	if err != nil {
+		return atlassian.JiraBoard{}, err
+	}
+	board, err := gen.DecodeBoard(payload)
+	if err != nil {
+		return atlassian.JiraBoard{}, fmt.Errorf("decode Board: %w", err)
+	}
+	return mappers.MapRESTBoard(*board), nil
+}
+
+// GetBoard fetches a single board by ID.
+// Ref: GET /rest/agile/1.0/board/{boardId}
+func (c *JiraRESTClient) GetBoard(ctx context.Context, boardID string) (atlassian.JiraBoard, error) {
+	id := strings.TrimSpace(boardID)
+	if id == "" {
+		return atlassian.JiraBoard{}, errors.New("boardID is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.agilePath("/board/%s", id), nil)
+	if err != nil {
+		return atlassian.JiraBoard{}, err
+	}
+	board, err := gen.DecodeBoard(payload)
+	if err != nil {
+		return atlassian.JiraBoard{}, fmt.Errorf("decode Board: %w", err)
+	}
+	return mappers.MapRESTBoard(*board), nil
+}
+
+// DeleteBoard deletes a board. It does not delete the sprints or issues that belonged to it.
+// Ref: DELETE /rest/agile/1.0/board/{boardId}
+func (c *JiraRESTClient) DeleteBoard(ctx context.Context, boardID string) error {
+	id := strings.TrimSpace(boardID)
+	if id == "" {
+		return errors.New("boardID is required")
+	}
+	return c.Delete(ctx, c.agilePath("/board/%s", id))
+}
@@ -0,0 +1,135 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest/gen"
+	"atlassian/atlassian/rest/mappers"
+)
+
+// AddIssueComment adds a comment to an issue. body may be a plain string or an ADF document
+// (map[string]any), matching the convention used for issue descriptions. Use AddComment instead
+// when the caller needs the created comment's ID back, e.g. to reconcile a Terraform resource.
+// Ref: POST /rest/api/3/issue/{issueIdOrKey}/comment
+func (c *JiraRESTClient) AddIssueComment(ctx context.Context, issueKey string, body any) error {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return errors.New("issueKey is required")
+	}
+	if body == nil {
+		return errors.New("body is required")
+	}
+	_, err := c.PostJSON(ctx, c.apiPath("/issue/%s/comment", key), map[string]any{"body": c.descriptionForWrite(body)})
+	return err
+}
+
+// ListComments fetches every comment on an issue.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}/comment
+func (c *JiraRESTClient) ListComments(ctx context.Context, cloudID string, issueKey string) ([]atlassian.JiraComment, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return nil, errors.New("issueKey is required")
+	}
+	if strings.TrimSpace(cloudID) == "" {
+		return nil, errors.New("cloudID is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/issue/%s/comment", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	page, err := gen.DecodePageOfComments(payload)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]atlassian.JiraComment, 0, len(page.Comments))
+	for _, raw := range page.Comments {
+		comment, err := mappers.JiraCommentFromREST(raw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, comment)
+	}
+	return out, nil
+}
+
+// AddComment adds a comment to an issue and returns it as Jira stored it, ID included, so callers
+// can reconcile it later (e.g. a Terraform resource keying its state on the returned ID). body may
+// be a plain string or an ADF document (map[string]any, such as one built with adf.NewDoc()).
+// Ref: POST /rest/api/3/issue/{issueIdOrKey}/comment
+func (c *JiraRESTClient) AddComment(ctx context.Context, cloudID string, issueKey string, body any) (atlassian.JiraComment, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return atlassian.JiraComment{}, errors.New("issueKey is required")
+	}
+	if strings.TrimSpace(cloudID) == "" {
+		return atlassian.JiraComment{}, errors.New("cloudID is required")
+	}
+	if body == nil {
+		return atlassian.JiraComment{}, errors.New("body is required")
+	}
+
+	payload, err := c.PostJSON(ctx, c.apiPath("/issue/%s/comment", key), map[string]any{"body": c.descriptionForWrite(body)})
+	if err != nil {
+		return atlassian.JiraComment{}, err
+	}
+	return mappers.JiraCommentFromREST(payload)
+}
+
+// UpdateComment replaces a comment's body.
+// Ref: PUT /rest/api/3/issue/{issueIdOrKey}/comment/{id}
+func (c *JiraRESTClient) UpdateComment(ctx context.Context, issueKey string, commentID string, body any) (atlassian.JiraComment, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return atlassian.JiraComment{}, errors.New("issueKey is required")
+	}
+	id := strings.TrimSpace(commentID)
+	if id == "" {
+		return atlassian.JiraComment{}, errors.New("commentID is required")
+	}
+	if body == nil {
+		return atlassian.JiraComment{}, errors.New("body is required")
+	}
+
+	payload, err := c.PutJSON(ctx, c.apiPath("/issue/%s/comment/%s", key, id), map[string]any{"body": c.descriptionForWrite(body)})
+	if err != nil {
+		return atlassian.JiraComment{}, err
+	}
+	return mappers.JiraCommentFromREST(payload)
+}
+
+// DeleteComment removes a comment from an issue.
+// Ref: DELETE /rest/api/3/issue/{issueIdOrKey}/comment/{id}
+func (c *JiraRESTClient) DeleteComment(ctx context.Context, issueKey string, commentID string) error {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return errors.New("issueKey is required")
+	}
+	id := strings.TrimSpace(commentID)
+	if id == "" {
+		return errors.New("commentID is required")
+	}
+	return c.Delete(ctx, c.apiPath("/issue/%s/comment/%s", key, id))
+}
+
+// AddIssueRemoteLink attaches a remote link (e.g. to a commit or pull request) to an issue.
+// Ref: POST /rest/api/3/issue/{issueIdOrKey}/remotelink
+func (c *JiraRESTClient) AddIssueRemoteLink(ctx context.Context, issueKey string, url string, title string) error {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return errors.New("issueKey is required")
+	}
+	cleanURL := strings.TrimSpace(url)
+	if cleanURL == "" {
+		return errors.New("url is required")
+	}
+	object := map[string]any{"url": cleanURL}
+	if clean := strings.TrimSpace(title); clean != "" {
+		object["title"] = clean
+	}
+	_, err := c.PostJSON(ctx, c.apiPath("/issue/%s/remotelink", key), map[string]any{"object": object})
+	return err
+}
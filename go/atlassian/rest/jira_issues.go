@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"atlassian/atlassian"
+	"atlassian/atlassian/pagination"
 	"atlassian/atlassian/rest/gen"
 	"atlassian/atlassian/rest/mappers"
 )
@@ -24,18 +25,112 @@ var defaultJiraSearchFields = []string{
 	"reporter",
 	"labels",
 	"components",
+	"description",
+	"comment",
 }
 
+// ListIssuesViaREST searches jql via SearchIssues, which attempts the token-paginated
+// /rest/api/3/search/jql endpoint first and falls back to the legacy startAt-paginated
+// /rest/api/3/search on Jira instances where the new endpoint 404s or 410s (Server/Data Center).
 func (c *JiraRESTClient) ListIssuesViaREST(ctx context.Context, cloudID string, jql string, pageSize int) ([]atlassian.JiraIssue, error) {
 	storyPointsField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_STORY_POINTS_FIELD"))
 	sprintIDsField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_SPRINT_IDS_FIELD"))
 	return c.ListIssuesViaRESTWithFields(ctx, cloudID, jql, pageSize, storyPointsField, sprintIDsField)
 }
 
-func buildJiraSearchFields(storyPointsField string, sprintIDsField string) ([]string, error) {
-	fields := make([]string, 0, len(defaultJiraSearchFields)+2)
+func (c *JiraRESTClient) ListIssuesViaRESTWithFields(
+	ctx context.Context,
+	cloudID string,
+	jql string,
+	pageSize int,
+	storyPointsField string,
+	sprintIDsField string,
+) ([]atlassian.JiraIssue, error) {
+	return c.SearchIssues(ctx, cloudID, jql, SearchOptions{
+		StoryPointsField: storyPointsField,
+		SprintIDsField:   sprintIDsField,
+		PageSize:         pageSize,
+	})
+}
+
+// ListIssuesViaRESTStream is the channel-based streaming counterpart to ListIssuesViaREST, for
+// Terraform data sources that need to process hundreds of thousands of issues without buffering
+// them all in memory. It honors ctx cancellation and mirrors the cursor-error-propagation pattern
+// established by nextAfterForWorklogs: the underlying page fetch's pagination errors (an empty
+// page that still carries a nextPageToken) are delivered on the returned error channel rather
+// than panicking or being swallowed. Both channels are closed once the search completes, fails, or ctx is done.
+func (c *JiraRESTClient) ListIssuesViaRESTStream(ctx context.Context, cloudID string, jql string, pageSize int) (<-chan atlassian.JiraIssue, <-chan error) {
+	storyPointsField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_STORY_POINTS_FIELD"))
+	sprintIDsField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_SPRINT_IDS_FIELD"))
+
+	issues := make(chan atlassian.JiraIssue)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(issues)
+		defer close(errs)
+
+		err := c.SearchIssuesStream(ctx, cloudID, jql, SearchOptions{
+			StoryPointsField: storyPointsField,
+			SprintIDsField:   sprintIDsField,
+			PageSize:         pageSize,
+		}, func(issue atlassian.JiraIssue) error {
+			select {
+			case issues <- issue:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return issues, errs
+}
+
+// CountIssuesViaREST returns Jira's approximate match count for jql via the lightweight
+// POST /rest/api/3/search/approximate-count endpoint, without fetching any issues. Useful for
+// sizing progress bars or deciding whether a search is worth streaming at all.
+// Ref: POST /rest/api/3/search/approximate-count
+func (c *JiraRESTClient) CountIssuesViaREST(ctx context.Context, jql string) (int, error) {
+	jqlClean := strings.TrimSpace(jql)
+	if jqlClean == "" {
+		return 0, errors.New("jql is required")
+	}
+
+	payload, err := c.PostJSON(ctx, c.apiPath("/search/approximate-count"), map[string]any{
+		"jql": jqlClean,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var parsed struct {
+		Count int `json:"count"`
+	}
+	if err := Convert(payload, &parsed); err != nil {
+		return 0, fmt.Errorf("decode search/approximate-count response: %w", err)
+	}
+	return parsed.Count, nil
+}
+
+// customFieldKeys extracts the Jira field IDs (spec.Key) from specs, for folding into a search
+// request's fields param alongside the story points / sprint IDs fields.
+func customFieldKeys(specs []mappers.CustomFieldSpec) []string {
+	keys := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		keys = append(keys, spec.Key)
+	}
+	return keys
+}
+
+func buildJiraSearchFields(storyPointsField string, sprintIDsField string, extra ...string) ([]string, error) {
+	fields := make([]string, 0, len(defaultJiraSearchFields)+2+len(extra))
 	fields = append(fields, defaultJiraSearchFields...)
-	for _, raw := range []string{storyPointsField, sprintIDsField} {
+	raws := append([]string{storyPointsField, sprintIDsField}, extra...)
+	for _, raw := range raws {
 		if raw == "" {
 			continue
 		}
@@ -57,13 +152,16 @@ func buildJiraSearchFields(storyPointsField string, sprintIDsField string) ([]st
 	return fields, nil
 }
 
-func (c *JiraRESTClient) ListIssuesViaRESTWithFields(
+// ListIssuesViaRESTWithSpec is ListIssuesViaRESTWithFields plus arbitrary custom-field
+// extraction via specs; see mappers.JiraIssueFromRESTWithSpec.
+func (c *JiraRESTClient) ListIssuesViaRESTWithSpec(
 	ctx context.Context,
 	cloudID string,
 	jql string,
 	pageSize int,
 	storyPointsField string,
 	sprintIDsField string,
+	specs []mappers.CustomFieldSpec,
 ) ([]atlassian.JiraIssue, error) {
 	cloud := strings.TrimSpace(cloudID)
 	if cloud == "" {
@@ -77,7 +175,7 @@ func (c *JiraRESTClient) ListIssuesViaRESTWithFields(
 		pageSize = 50
 	}
 
-	fieldList, err := buildJiraSearchFields(storyPointsField, sprintIDsField)
+	fieldList, err := buildJiraSearchFields(storyPointsField, sprintIDsField, customFieldKeys(specs)...)
 	if err != nil {
 		return nil, err
 	}
@@ -92,11 +190,12 @@ func (c *JiraRESTClient) ListIssuesViaRESTWithFields(
 		}
 		seenStart[startAt] = struct{}{}
 
-		payload, err := c.GetJSON(ctx, "/rest/api/3/search", map[string]string{
+		payload, err := c.GetJSON(ctx, c.apiPath("/search"), map[string]string{
 			"jql":        jqlClean,
 			"startAt":    strconv.Itoa(startAt),
 			"maxResults": strconv.Itoa(pageSize),
 			"fields":     fields,
+			"expand":     "renderedFields",
 		})
 		if err != nil {
 			return nil, err
@@ -107,7 +206,7 @@ func (c *JiraRESTClient) ListIssuesViaRESTWithFields(
 		}
 
 		for _, it := range page.Issues {
-			mapped, err := mappers.JiraIssueFromRESTWithFields(cloud, it, storyPointsField, sprintIDsField)
+			mapped, err := mappers.JiraIssueFromRESTWithSpec(cloud, it, storyPointsField, sprintIDsField, specs)
 			if err != nil {
 				return nil, err
 			}
@@ -130,3 +229,573 @@ func (c *JiraRESTClient) ListIssuesViaRESTWithFields(
 
 	return out, nil
 }
+
+// JQLSearchOptions configures ListIssuesViaRESTV2 / IterateIssuesViaREST, mirroring the request
+// body accepted by Jira Cloud's token-paginated Enhanced Search endpoint. Unlike SearchOptions,
+// it has no story-points/sprint/custom-field settings: Fields is sent as given, and callers may
+// request any field by ID or key.
+// Ref: POST /rest/api/3/search/jql
+type JQLSearchOptions struct {
+	// Fields lists the issue fields to return. Defaults to defaultJiraSearchFields when empty.
+	Fields []string
+	// Expand requests additional issue detail (e.g. "renderedFields", "changelog", "names",
+	// "schema", "transitions").
+	Expand []string
+	// Properties lists issue property keys to include on each returned issue.
+	Properties []string
+	// FieldsByKey, when true, tells Jira to resolve Fields entries as field keys rather than
+	// field IDs.
+	FieldsByKey bool
+}
+
+func (o JQLSearchOptions) fieldList() []string {
+	if len(o.Fields) > 0 {
+		return o.Fields
+	}
+	return defaultJiraSearchFields
+}
+
+// ListIssuesViaRESTV2 runs jql against the token-paginated Enhanced Search endpoint (POST
+// /rest/api/3/search/jql), buffering every page into a single slice. Prefer IterateIssuesViaREST
+// for result sets too large to hold in memory at once.
+func (c *JiraRESTClient) ListIssuesViaRESTV2(ctx context.Context, cloudID string, jql string, pageSize int, opts JQLSearchOptions) ([]atlassian.JiraIssue, error) {
+	var out []atlassian.JiraIssue
+	err := c.IterateIssuesViaREST(ctx, cloudID, jql, pageSize, opts, func(issue atlassian.JiraIssue) error {
+		out = append(out, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IterateIssuesViaREST is the streaming counterpart to ListIssuesViaRESTV2, for callers
+// processing hundreds of thousands of issues (e.g. the Terraform worklogs data source) without
+// holding the whole result set in memory.
+func (c *JiraRESTClient) IterateIssuesViaREST(ctx context.Context, cloudID string, jql string, pageSize int, opts JQLSearchOptions, yield func(atlassian.JiraIssue) error) error {
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return errors.New("cloudID is required")
+	}
+	jqlClean := strings.TrimSpace(jql)
+	if jqlClean == "" {
+		return errors.New("jql is required")
+	}
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+
+	nextPageToken := ""
+	for {
+		body := map[string]any{
+			"jql":          jqlClean,
+			"maxResults":   pageSize,
+			"fields":       opts.fieldList(),
+			"fieldsByKeys": opts.FieldsByKey,
+		}
+		if len(opts.Expand) > 0 {
+			body["expand"] = strings.Join(opts.Expand, ",")
+		}
+		if len(opts.Properties) > 0 {
+			body["properties"] = opts.Properties
+		}
+		if nextPageToken != "" {
+			body["nextPageToken"] = nextPageToken
+		}
+
+		payload, err := c.PostJSON(ctx, c.apiPath("/search/jql"), body)
+		if err != nil {
+			return err
+		}
+		page, err := gen.DecodeSearchResultsV2(payload)
+		if err != nil {
+			return fmt.Errorf("decode issue search response: %w", err)
+		}
+
+		for _, it := range page.Issues {
+			mapped, err := mappers.JiraIssueFromREST(cloud, it)
+			if err != nil {
+				return err
+			}
+			if err := yield(mapped); err != nil {
+				return err
+			}
+		}
+
+		if page.IsLast != nil && *page.IsLast {
+			return nil
+		}
+		if page.NextPageToken == nil || strings.TrimSpace(*page.NextPageToken) == "" {
+			return nil
+		}
+		if len(page.Issues) == 0 {
+			return errors.New("received empty page with a nextPageToken set; aborting to prevent infinite loop")
+		}
+		nextPageToken = strings.TrimSpace(*page.NextPageToken)
+	}
+}
+
+// SearchOptions configures SearchIssues / SearchIssuesStream.
+type SearchOptions struct {
+	// Fields lists the issue fields to return. Defaults to defaultJiraSearchFields plus
+	// StoryPointsField/SprintIDsField when set.
+	Fields []string
+	// Expand requests additional issue detail (e.g. "renderedFields", "changelog").
+	Expand []string
+	// StoryPointsField is the custom field ID (e.g. "customfield_10016") holding story points.
+	StoryPointsField string
+	// SprintIDsField is the custom field ID holding the serialized sprint array.
+	SprintIDsField string
+	// CustomFields lists arbitrary additional custom fields to request and coerce; see
+	// mappers.JiraIssueFromRESTWithSpec.
+	CustomFields []mappers.CustomFieldSpec
+	// SprintNameIndex resolves the sprint display names Jira reports in a Sprint changelog
+	// entry's fromString/toString to stable sprint IDs. Only consulted when Expand includes
+	// "changelog"; pass nil to keep sprint names as-is in SprintChange.Added/Removed.
+	SprintNameIndex map[string]string
+	// PageSize caps the number of issues requested per page. Defaults to 50.
+	PageSize int
+}
+
+func (o SearchOptions) fieldList() ([]string, error) {
+	if len(o.Fields) > 0 {
+		return o.Fields, nil
+	}
+	return buildJiraSearchFields(o.StoryPointsField, o.SprintIDsField, customFieldKeys(o.CustomFields)...)
+}
+
+// SearchIssues runs a JQL search against the new token-paginated /rest/api/3/search/jql
+// endpoint, falling back to the legacy startAt-paginated /rest/api/3/search when the new
+// endpoint is unavailable (e.g. older Jira Server/Data Center instances).
+func (c *JiraRESTClient) SearchIssues(ctx context.Context, cloudID string, jql string, opts SearchOptions) ([]atlassian.JiraIssue, error) {
+	var out []atlassian.JiraIssue
+	err := c.SearchIssuesStream(ctx, cloudID, jql, opts, func(issue atlassian.JiraIssue) error {
+		out = append(out, issue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchIssuesStream is the streaming counterpart to SearchIssues, for callers processing
+// large result sets without buffering every issue in memory.
+func (c *JiraRESTClient) SearchIssuesStream(ctx context.Context, cloudID string, jql string, opts SearchOptions, yield func(atlassian.JiraIssue) error) error {
+	cloud := strings.TrimSpace(cloudID)
+	if cloud == "" {
+		return errors.New("cloudID is required")
+	}
+	jqlClean := strings.TrimSpace(jql)
+	if jqlClean == "" {
+		return errors.New("jql is required")
+	}
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	fieldList, err := opts.fieldList()
+	if err != nil {
+		return err
+	}
+	fields := strings.Join(fieldList, ",")
+	expand := strings.Join(opts.Expand, ",")
+
+	nextPageToken := ""
+	for {
+		params := map[string]string{
+			"jql":        jqlClean,
+			"maxResults": strconv.Itoa(pageSize),
+			"fields":     fields,
+		}
+		if expand != "" {
+			params["expand"] = expand
+		}
+		if nextPageToken != "" {
+			params["nextPageToken"] = nextPageToken
+		}
+
+		payload, err := c.GetJSON(ctx, c.apiPath("/search/jql"), params)
+		if err != nil {
+			if !IsNotFound(err) && !IsGone(err) {
+				return err
+			}
+			// New search endpoint isn't available on this instance (404) or has been
+			// sunset outright (410); fall back to the legacy startAt-paginated search.
+			// The legacy endpoint doesn't support expand=changelog, so sprint/status/
+			// assignee history is unavailable here.
+			issues, err := c.ListIssuesViaRESTWithSpec(ctx, cloud, jqlClean, pageSize, opts.StoryPointsField, opts.SprintIDsField, opts.CustomFields)
+			if err != nil {
+				return err
+			}
+			for _, issue := range issues {
+				if err := yield(issue); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		page, err := gen.DecodeSearchResults(payload)
+		if err != nil {
+			return fmt.Errorf("decode issue search response: %w", err)
+		}
+
+		for _, it := range page.Issues {
+			mapped, err := mappers.JiraIssueFromRESTWithHistory(cloud, it, opts.StoryPointsField, opts.SprintIDsField, opts.CustomFields, opts.SprintNameIndex)
+			if err != nil {
+				return err
+			}
+			if err := yield(mapped); err != nil {
+				return err
+			}
+		}
+
+		if page.NextPageToken == nil || strings.TrimSpace(*page.NextPageToken) == "" {
+			return nil
+		}
+		if len(page.Issues) == 0 {
+			return fmt.Errorf("received empty page with a nextPageToken set; aborting to prevent infinite loop")
+		}
+		nextPageToken = strings.TrimSpace(*page.NextPageToken)
+	}
+}
+
+// CreateIssueInput describes the fields accepted when creating a Jira issue.
+type CreateIssueInput struct {
+	ProjectKey        string
+	Summary           string
+	Description       any // plain string or an ADF document as map[string]any
+	IssueType         string
+	AssigneeAccountID string
+	ReporterAccountID string
+	Priority          string
+	Labels            []string
+	Components        []string
+	FixVersions       []string
+	ParentKey         string
+	CustomFields      map[string]any
+}
+
+func (in CreateIssueInput) toFields() (map[string]any, error) {
+	project := strings.TrimSpace(in.ProjectKey)
+	if project == "" {
+		return nil, errors.New("projectKey is required")
+	}
+	summary := strings.TrimSpace(in.Summary)
+	if summary == "" {
+		return nil, errors.New("summary is required")
+	}
+	issueType := strings.TrimSpace(in.IssueType)
+	if issueType == "" {
+		return nil, errors.New("issueType is required")
+	}
+
+	fields := map[string]any{
+		"project":   map[string]any{"key": project},
+		"summary":   summary,
+		"issuetype": map[string]any{"name": issueType},
+	}
+	if in.Description != nil {
+		fields["description"] = in.Description
+	}
+	if in.AssigneeAccountID != "" {
+		fields["assignee"] = map[string]any{"accountId": in.AssigneeAccountID}
+	}
+	if in.ReporterAccountID != "" {
+		fields["reporter"] = map[string]any{"accountId": in.ReporterAccountID}
+	}
+	if in.Priority != "" {
+		fields["priority"] = map[string]any{"name": in.Priority}
+	}
+	if len(in.Labels) > 0 {
+		fields["labels"] = in.Labels
+	}
+	if len(in.Components) > 0 {
+		components := make([]map[string]any, 0, len(in.Components))
+		for _, name := range in.Components {
+			components = append(components, map[string]any{"name": name})
+		}
+		fields["components"] = components
+	}
+	if len(in.FixVersions) > 0 {
+		versions := make([]map[string]any, 0, len(in.FixVersions))
+		for _, name := range in.FixVersions {
+			versions = append(versions, map[string]any{"name": name})
+		}
+		fields["fixVersions"] = versions
+	}
+	if in.ParentKey != "" {
+		fields["parent"] = map[string]any{"key": in.ParentKey}
+	}
+	for id, value := range in.CustomFields {
+		fields[id] = value
+	}
+	return fields, nil
+}
+
+// CreateIssue creates a Jira issue and returns the freshly created issue.
+// Ref: POST /rest/api/3/issue
+func (c *JiraRESTClient) CreateIssue(ctx context.Context, cloudID string, in CreateIssueInput) (atlassian.JiraIssue, error) {
+	fields, err := in.toFields()
+	if err != nil {
+		return atlassian.JiraIssue{}, err
+	}
+	if desc, ok := fields["description"]; ok {
+		fields["description"] = c.descriptionForWrite(desc)
+	}
+
+	payload, err := c.PostJSON(ctx, c.apiPath("/issue"), map[string]any{"fields": fields})
+	if err != nil {
+		return atlassian.JiraIssue{}, err
+	}
+
+	created, err := gen.DecodeIssueBean(payload)
+	if err != nil {
+		return atlassian.JiraIssue{}, fmt.Errorf("decode IssueBean: %w", err)
+	}
+	if created.Key == nil || strings.TrimSpace(*created.Key) == "" {
+		return atlassian.JiraIssue{}, errors.New("create issue response missing key")
+	}
+
+	return c.GetIssue(ctx, cloudID, *created.Key)
+}
+
+// GetIssue fetches a single issue by key or ID.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}
+func (c *JiraRESTClient) GetIssue(ctx context.Context, cloudID string, issueKey string) (atlassian.JiraIssue, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return atlassian.JiraIssue{}, errors.New("issueKey is required")
+	}
+
+	storyPointsField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_STORY_POINTS_FIELD"))
+	sprintIDsField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_SPRINT_IDS_FIELD"))
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/issue/%s", key), map[string]string{
+		"expand": "renderedFields",
+	})
+	if err != nil {
+		return atlassian.JiraIssue{}, err
+	}
+
+	issue, err := gen.DecodeIssueBean(payload)
+	if err != nil {
+		return atlassian.JiraIssue{}, fmt.Errorf("decode IssueBean: %w", err)
+	}
+
+	return mappers.JiraIssueFromRESTWithFields(cloudID, *issue, storyPointsField, sprintIDsField)
+}
+
+// ListIssueChangelog fetches the full changelog history for an issue, paginating
+// /rest/api/3/issue/{issueIdOrKey}/changelog. Use this when an issue's inline changelog
+// (fetched via GetIssue/SearchIssues with expand=changelog) was truncated, since Jira caps how
+// many histories it inlines on the issue resource itself.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}/changelog
+func (c *JiraRESTClient) ListIssueChangelog(ctx context.Context, cloudID string, issueKey string) ([]gen.ChangelogHistory, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return nil, errors.New("issueKey is required")
+	}
+
+	path := c.apiPath("/issue/%s/changelog", key)
+	p := pagination.New(func(ctx context.Context, startAt int) ([]gen.ChangelogHistory, *bool, *int, error) {
+		payload, err := c.GetJSON(ctx, path, map[string]string{
+			"startAt":    strconv.Itoa(startAt),
+			"maxResults": "100",
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		page, err := gen.DecodeChangelog(payload)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("decode issue changelog response: %w", err)
+		}
+		return page.Histories, page.IsLast, page.Total, nil
+	}, 100)
+
+	return p.All(ctx)
+}
+
+// UpdateIssueInput describes the fields an UpdateIssue call may change. A nil pointer leaves
+// the corresponding field untouched.
+type UpdateIssueInput struct {
+	Summary           *string
+	Description       any
+	AssigneeAccountID *string
+	ReporterAccountID *string
+	Priority          *string
+	Labels            *[]string
+	Components        *[]string
+	FixVersions       *[]string
+	CustomFields      map[string]any
+}
+
+// UpdateIssue applies a partial update to an existing issue.
+// Ref: PUT /rest/api/3/issue/{issueIdOrKey}
+func (c *JiraRESTClient) UpdateIssue(ctx context.Context, issueKey string, in UpdateIssueInput) error {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return errors.New("issueKey is required")
+	}
+
+	fields := map[string]any{}
+	if in.Summary != nil {
+		fields["summary"] = *in.Summary
+	}
+	if in.Description != nil {
+		fields["description"] = c.descriptionForWrite(in.Description)
+	}
+	if in.AssigneeAccountID != nil {
+		fields["assignee"] = map[string]any{"accountId": *in.AssigneeAccountID}
+	}
+	if in.ReporterAccountID != nil {
+		fields["reporter"] = map[string]any{"accountId": *in.ReporterAccountID}
+	}
+	if in.Priority != nil {
+		fields["priority"] = map[string]any{"name": *in.Priority}
+	}
+	if in.Labels != nil {
+		fields["labels"] = *in.Labels
+	}
+	if in.Components != nil {
+		components := make([]map[string]any, 0, len(*in.Components))
+		for _, name := range *in.Components {
+			components = append(components, map[string]any{"name": name})
+		}
+		fields["components"] = components
+	}
+	if in.FixVersions != nil {
+		versions := make([]map[string]any, 0, len(*in.FixVersions))
+		for _, name := range *in.FixVersions {
+			versions = append(versions, map[string]any{"name": name})
+		}
+		fields["fixVersions"] = versions
+	}
+	for id, value := range in.CustomFields {
+		fields[id] = value
+	}
+
+	_, err := c.PutJSON(ctx, c.apiPath("/issue/%s", key), map[string]any{"fields": fields})
+	return err
+}
+
+// GetIssueTransitions lists the workflow transitions currently available for an issue,
+// keyed by the human-readable target status name.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}/transitions
+func (c *JiraRESTClient) GetIssueTransitions(ctx context.Context, issueKey string) (map[string]string, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return nil, errors.New("issueKey is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/issue/%s/transitions", key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := gen.DecodeIssueTransitions(payload)
+	if err != nil {
+		return nil, fmt.Errorf("decode IssueTransitions: %w", err)
+	}
+
+	byStatusName := make(map[string]string, len(transitions.Transitions))
+	for _, t := range transitions.Transitions {
+		if t.ID == nil || t.To == nil || t.To.Name == nil {
+			continue
+		}
+		byStatusName[*t.To.Name] = *t.ID
+	}
+	return byStatusName, nil
+}
+
+// TransitionIssue moves an issue to the workflow status named by targetStatusName, resolving
+// it to a transition ID via GetIssueTransitions.
+// Ref: POST /rest/api/3/issue/{issueIdOrKey}/transitions
+func (c *JiraRESTClient) TransitionIssue(ctx context.Context, issueKey string, targetStatusName string) error {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return errors.New("issueKey is required")
+	}
+	status := strings.TrimSpace(targetStatusName)
+	if status == "" {
+		return errors.New("targetStatusName is required")
+	}
+
+	available, err := c.GetIssueTransitions(ctx, key)
+	if err != nil {
+		return err
+	}
+	transitionID, ok := available[status]
+	if !ok {
+		return fmt.Errorf("no transition to status %q is available for issue %s", status, key)
+	}
+
+	data := map[string]any{
+		"transition": map[string]any{"id": transitionID},
+	}
+	_, err = c.PostJSON(ctx, c.apiPath("/issue/%s/transitions", key), data)
+	return err
+}
+
+// GetIssueWithExpansionsViaREST fetches an issue with an arbitrary set of `expand` sections
+// (e.g. "changelog", "renderedFields", "transitions"), returning the decoded IssueBean so
+// callers can pull out whichever expanded sections they asked for. Unlike GetIssue, this does not
+// hardcode expand=renderedFields, so callers control exactly what Jira includes in the response.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}?expand=...
+func (c *JiraRESTClient) GetIssueWithExpansionsViaREST(ctx context.Context, issueKey string, expansions []string) (*gen.IssueBean, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return nil, errors.New("issueKey is required")
+	}
+
+	var params map[string]string
+	if len(expansions) > 0 {
+		params = map[string]string{"expand": strings.Join(expansions, ",")}
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/issue/%s", key), params)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := gen.DecodeIssueBean(payload)
+	if err != nil {
+		return nil, fmt.Errorf("decode IssueBean: %w", err)
+	}
+	return issue, nil
+}
+
+// ListIssueTransitionsDetailed lists the workflow transitions currently available for an issue,
+// including each transition's own name and target status, unlike GetIssueTransitions which only
+// keys by the target status name.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}/transitions
+func (c *JiraRESTClient) ListIssueTransitionsDetailed(ctx context.Context, issueKey string) ([]atlassian.IssueTransition, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return nil, errors.New("issueKey is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/issue/%s/transitions", key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := gen.DecodeIssueTransitions(payload)
+	if err != nil {
+		return nil, fmt.Errorf("decode IssueTransitions: %w", err)
+	}
+
+	return mappers.IssueTransitionsFromGen(transitions)
+}
+
+// DeleteIssue deletes an issue by key or ID.
+// Ref: DELETE /rest/api/3/issue/{issueIdOrKey}
+func (c *JiraRESTClient) DeleteIssue(ctx context.Context, issueKey string) error {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return errors.New("issueKey is required")
+	}
+	return c.Delete(ctx, c.apiPath("/issue/%s", key))
+}
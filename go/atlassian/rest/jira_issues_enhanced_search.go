@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest/gen"
+	"atlassian/atlassian/rest/mappers"
+)
+
+// SearchJQLRequest configures SearchIssuesJQL, mirroring the request body accepted by Jira
+// Cloud's Enhanced Search endpoint.
+// Ref: POST /rest/api/3/search/jql
+type SearchJQLRequest struct {
+	// JQL is the query to run.
+	JQL string
+	// Fields lists the issue fields to return. Defaults to defaultJiraSearchFields when empty.
+	Fields []string
+	// Expand requests additional issue detail (e.g. "renderedFields", "changelog").
+	Expand []string
+	// PageSize caps the number of issues requested per page. Defaults to 50.
+	PageSize int
+	// ReconcileIssues lists issue keys/IDs whose latest state should be merged into results
+	// that may be stale due to search index lag, per Jira's reconciliation support.
+	ReconcileIssues []string
+}
+
+func (r SearchJQLRequest) fieldList() []string {
+	if len(r.Fields) > 0 {
+		return r.Fields
+	}
+	return defaultJiraSearchFields
+}
+
+// SearchIssuesJQL runs req against the Enhanced Search endpoint (POST /rest/api/3/search/jql),
+// following nextPageToken until a page omits it, lazily mapping and yielding one issue at a time
+// via JiraIssueFromRESTWithSpec. Unlike SearchIssues/SearchIssuesStream, this always targets the
+// token-paginated endpoint with no legacy startAt fallback, and yields pages as they're fetched
+// rather than buffering the full result set, so callers can stop early (e.g. break out of a
+// range loop) without paying for pages they never consume.
+func (c *JiraRESTClient) SearchIssuesJQL(ctx context.Context, cloudID string, req SearchJQLRequest) iter.Seq2[atlassian.JiraIssue, error] {
+	return func(yield func(atlassian.JiraIssue, error) bool) {
+		cloud := strings.TrimSpace(cloudID)
+		if cloud == "" {
+			yield(atlassian.JiraIssue{}, errors.New("cloudID is required"))
+			return
+		}
+		jqlClean := strings.TrimSpace(req.JQL)
+		if jqlClean == "" {
+			yield(atlassian.JiraIssue{}, errors.New("jql is required"))
+			return
+		}
+		pageSize := req.PageSize
+		if pageSize <= 0 {
+			pageSize = 50
+		}
+
+		nextPageToken := ""
+		for {
+			body := map[string]any{
+				"jql":        jqlClean,
+				"maxResults": pageSize,
+				"fields":     req.fieldList(),
+			}
+			if len(req.Expand) > 0 {
+				body["expand"] = strings.Join(req.Expand, ",")
+			}
+			if len(req.ReconcileIssues) > 0 {
+				body["reconcileIssues"] = req.ReconcileIssues
+			}
+			if nextPageToken != "" {
+				body["nextPageToken"] = nextPageToken
+			}
+
+			payload, err := c.PostJSON(ctx, c.apiPath("/search/jql"), body)
+			if err != nil {
+				yield(atlassian.JiraIssue{}, err)
+				return
+			}
+			page, err := gen.DecodeSearchResults(payload)
+			if err != nil {
+				yield(atlassian.JiraIssue{}, fmt.Errorf("decode issue search response: %w", err))
+				return
+			}
+
+			for _, it := range page.Issues {
+				mapped, mapErr := mappers.JiraIssueFromRESTWithSpec(cloud, it, "", "", nil)
+				if mapErr != nil {
+					if !yield(atlassian.JiraIssue{}, mapErr) {
+						return
+					}
+					continue
+				}
+				if !yield(mapped, nil) {
+					return
+				}
+			}
+
+			if page.NextPageToken == nil || strings.TrimSpace(*page.NextPageToken) == "" {
+				return
+			}
+			if len(page.Issues) == 0 {
+				yield(atlassian.JiraIssue{}, errors.New("received empty page with a nextPageToken set; aborting to prevent infinite loop"))
+				return
+			}
+			nextPageToken = strings.TrimSpace(*page.NextPageToken)
+		}
+	}
+}
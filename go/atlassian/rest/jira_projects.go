@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest/gen"
+	"atlassian/atlassian/rest/mappers"
+)
+
+// GetProject fetches a single Jira project by key or ID.
+// Ref: GET /rest/api/3/project/{projectIdOrKey}
+func (c *JiraRESTClient) GetProject(ctx context.Context, cloudID string, keyOrID string) (atlassian.JiraProject, error) {
+	key := strings.TrimSpace(keyOrID)
+	if key == "" {
+		return atlassian.JiraProject{}, fmt.Errorf("project key or ID is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/project/%s", key), nil)
+	if err != nil {
+		return atlassian.JiraProject{}, err
+	}
+
+	p, err := gen.DecodeProject(payload)
+	if err != nil {
+		return atlassian.JiraProject{}, fmt.Errorf("decode Project: %w", err)
+	}
+
+	return mappers.MapRESTProject(cloudID, *p), nil
+}
+
+func projectUpdateBody(p atlassian.JiraProject) map[string]any {
+	data := map[string]any{}
+	if p.Name != "" {
+		data["name"] = p.Name
+	}
+	if p.Description != nil {
+		data["description"] = *p.Description
+	}
+	if p.LeadAccountID != nil {
+		data["leadAccountId"] = *p.LeadAccountID
+	}
+	if p.AssigneeType != nil {
+		data["assigneeType"] = *p.AssigneeType
+	}
+	if p.ProjectTypeKey != nil {
+		data["projectTypeKey"] = *p.ProjectTypeKey
+	}
+	if p.CategoryID != nil {
+		data["categoryId"] = *p.CategoryID
+	}
+	if p.URL != nil {
+		data["url"] = *p.URL
+	}
+	if p.AvatarID != nil {
+		data["avatarId"] = *p.AvatarID
+	}
+	return data
+}
+
+// UpdateProject applies an in-place update to a Jira project. Only the fields populated on p are
+// sent, so callers can update a subset (e.g. just Description) without clobbering the rest.
+// Ref: PUT /rest/api/3/project/{projectIdOrKey}
+func (c *JiraRESTClient) UpdateProject(ctx context.Context, cloudID string, p atlassian.JiraProject) (atlassian.JiraProject, error) {
+	key := strings.TrimSpace(p.Key)
+	if key == "" {
+		return atlassian.JiraProject{}, fmt.Errorf("project key is required for update")
+	}
+
+	payload, err := c.PutJSON(ctx, c.apiPath("/project/%s", key), projectUpdateBody(p))
+	if err != nil {
+		return atlassian.JiraProject{}, err
+	}
+
+	updated, err := gen.DecodeProject(payload)
+	if err != nil {
+		return atlassian.JiraProject{}, fmt.Errorf("decode Project: %w", err)
+	}
+
+	return mappers.MapRESTProject(cloudID, *updated), nil
+}
+
+// ArchiveProject archives a Jira project. Archived projects remain in Jira but are hidden from
+// most UI and search surfaces; use RestoreProject to bring one back.
+// Ref: POST /rest/api/3/project/{projectIdOrKey}/archive
+func (c *JiraRESTClient) ArchiveProject(ctx context.Context, keyOrID string) error {
+	key := strings.TrimSpace(keyOrID)
+	if key == "" {
+		return fmt.Errorf("project key or ID is required")
+	}
+	_, err := c.PostJSON(ctx, c.apiPath("/project/%s/archive", key), nil)
+	return err
+}
+
+// RestoreProject restores a previously archived Jira project.
+// Ref: POST /rest/api/3/project/{projectIdOrKey}/restore
+func (c *JiraRESTClient) RestoreProject(ctx context.Context, keyOrID string) error {
+	key := strings.TrimSpace(keyOrID)
+	if key == "" {
+		return fmt.Errorf("project key or ID is required")
+	}
+	_, err := c.PostJSON(ctx, c.apiPath("/project/%s/restore", key), nil)
+	return err
+}
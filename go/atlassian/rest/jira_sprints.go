@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"atlassian/atlassian"
+	"atlassian/atlassian/pagination"
 	"atlassian/atlassian/rest/gen"
 	"atlassian/atlassian/rest/mappers"
 )
@@ -15,12 +16,200 @@ import (
 // ListBoardSprintsViaREST fetches all sprints for a Jira Agile board.
 // The state parameter is optional and can be "future", "active", or "closed".
 func (c *JiraRESTClient) ListBoardSprintsViaREST(ctx context.Context, boardID int, state string, pageSize int) ([]atlassian.JiraSprint, error) {
+	p, err := c.boardSprintsPaginator(boardID, state, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return p.All(ctx)
+}
+
+// ListBoardSprintsStream streams sprints for a board page by page instead of buffering
+// the whole result set, for callers iterating over boards with tens of thousands of sprints.
+func (c *JiraRESTClient) ListBoardSprintsStream(ctx context.Context, boardID int, state string, pageSize int, yield func(atlassian.JiraSprint) error) error {
+	p, err := c.boardSprintsPaginator(boardID, state, pageSize)
+	if err != nil {
+		return err
+	}
+	return p.Iterate(ctx, yield)
+}
+
+// GetSprintViaREST fetches a single sprint via the Jira Agile REST API. Terraform resources
+// should prefer graph.Client.GetSprintByID for the richer GraphQL-mapped fields, falling back to
+// this when ExperimentalAPIs is disabled.
+// Ref: GET /rest/agile/1.0/sprint/{sprintId}
+func (c *JiraRESTClient) GetSprintViaREST(ctx context.Context, sprintID string) (atlassian.JiraSprint, error) {
+	id := strings.TrimSpace(sprintID)
+	if id == "" {
+		return atlassian.JiraSprint{}, errors.New("sprintID is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.agilePath("/sprint/%s", id), nil)
+	if err != nil {
+		return atlassian.JiraSprint{}, err
+	}
+	sprint, err := gen.DecodeSprint(payload)
+	if err != nil {
+		return atlassian.JiraSprint{}, fmt.Errorf("decode Sprint: %w", err)
+	}
+	return mappers.JiraSprintFromREST(*sprint)
+}
+
+// CreateSprintInput configures CreateSprint.
+type CreateSprintInput struct {
+	Name          string
+	OriginBoardID int
+	Goal          string
+	StartDate     string
+	EndDate       string
+}
+
+// CreateSprint creates a sprint on a board, in the "future" state.
+// Ref: POST /rest/agile/1.0/sprint
+func (c *JiraRESTClient) CreateSprint(ctx context.Context, in CreateSprintInput) (atlassian.JiraSprint, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return atlassian.JiraSprint{}, errors.New("name is required")
+	}
+	if in.OriginBoardID <= 0 {
+		return atlassian.JiraSprint{}, errors.New("originBoardID must be a positive integer")
+	}
+
+	body := map[string]any{
+		"name":          name,
+		"originBoardId": in.OriginBoardID,
+	}
+	if in.Goal != "" {
+		body["goal"] = in.Goal
+	}
+	if in.StartDate != "" {
+		body["startDate"] = in.StartDate
+	}
+	if in.EndDate != "" {
+		body["endDate"] = in.EndDate
+	}
+
+	payload, err := c.PostJSON(ctx, c.agilePath("/sprint"), body)
+	if err != nil {
+		return atlassian.JiraSprint{}, err
+	}
+	sprint, err := gen.DecodeSprint(payload)
+	if err != nil {
+		return atlassian.JiraSprint{}, fmt.Errorf("decode Sprint: %w", err)
+	}
+	return mappers.JiraSprintFromREST(*sprint)
+}
+
+// UpdateSprintInput configures UpdateSprint. Only populated fields are sent, so callers can
+// update a subset (e.g. just State, to transition the sprint) without clobbering the rest.
+type UpdateSprintInput struct {
+	Name      *string
+	Goal      *string
+	StartDate *string
+	EndDate   *string
+	State     *string
+}
+
+// UpdateSprint applies an in-place update to a sprint, including the "future" -> "active" ->
+// "closed" state transitions, which Jira's Agile API exposes through this same endpoint.
+// Ref: PUT /rest/agile/1.0/sprint/{sprintId}
+func (c *JiraRESTClient) UpdateSprint(ctx context.Context, sprintID string, in UpdateSprintInput) (atlassian.JiraSprint, error) {
+	id := strings.TrimSpace(sprintID)
+	if id == "" {
+		return atlassian.JiraSprint{}, errors.New("sprintID is required")
+	}
+
+	body := map[string]any{}
+	if in.Name != nil {
+		body["name"] = *in.Name
+	}
+	if in.Goal != nil {
+		body["goal"] = *in.Goal
+	}
+	if in.StartDate != nil {
+		body["startDate"] = *in.StartDate
+	}
+	if in.EndDate != nil {
+		body["endDate"] = *in.EndDate
+	}
+	if in.State != nil {
+		body["state"] = *in.State
+	}
+
+	payload, err := c.PutJSON(ctx, c.agilePath("/sprint/%s", id), body)
+	if err != nil {
+		return atlassian.JiraSprint{}, err
+	}
+	sprint, err := gen.DecodeSprint(payload)
+	if err != nil {
+		return atlassian.JiraSprint{}, fmt.Errorf("decode Sprint: %w", err)
+	}
+	return mappers.JiraSprintFromREST(*sprint)
+}
+
+// DeleteSprint deletes a sprint. Issues in the sprint are moved to the board's backlog.
+// Ref: DELETE /rest/agile/1.0/sprint/{sprintId}
+func (c *JiraRESTClient) DeleteSprint(ctx context.Context, sprintID string) error {
+	id := strings.TrimSpace(sprintID)
+	if id == "" {
+		return errors.New("sprintID is required")
+	}
+	return c.Delete(ctx, c.agilePath("/sprint/%s", id))
+}
+
+// MoveIssuesToSprint moves the given issues into the sprint, up to 50 at a time per Jira's
+// Agile API limit on this endpoint.
+// Ref: POST /rest/agile/1.0/sprint/{sprintId}/issue
+func (c *JiraRESTClient) MoveIssuesToSprint(ctx context.Context, sprintID string, issueKeys []string) error {
+	id := strings.TrimSpace(sprintID)
+	if id == "" {
+		return errors.New("sprintID is required")
+	}
+	if len(issueKeys) == 0 {
+		return nil
+	}
+	const maxPerCall = 50
+	for start := 0; start < len(issueKeys); start += maxPerCall {
+		end := start + maxPerCall
+		if end > len(issueKeys) {
+			end = len(issueKeys)
+		}
+		_, err := c.PostJSON(ctx, c.agilePath("/sprint/%s/issue", id), map[string]any{
+			"issues": issueKeys[start:end],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MoveIssuesToBacklog moves the given issues out of whichever sprint they're currently in and
+// back onto their board's backlog, up to 50 at a time per Jira's Agile API limit on this endpoint.
+// Ref: POST /rest/agile/1.0/backlog/issue
+func (c *JiraRESTClient) MoveIssuesToBacklog(ctx context.Context, issueKeys []string) error {
+	if len(issueKeys) == 0 {
+		return nil
+	}
+	const maxPerCall = 50
+	for start := 0; start < len(issueKeys); start += maxPerCall {
+		end := start + maxPerCall
+		if end > len(issueKeys) {
+			end = len(issueKeys)
+		}
+		_, err := c.PostJSON(ctx, c.agilePath("/backlog/issue"), map[string]any{
+			"issues": issueKeys[start:end],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *JiraRESTClient) boardSprintsPaginator(boardID int, state string, pageSize int) (*pagination.Paginator[atlassian.JiraSprint], error) {
 	if boardID <= 0 {
 		return nil, errors.New("boardID must be a positive integer")
 	}
-	if pageSize <= 0 {
-		pageSize = 50
-	}
 
 	var stateClean string
 	if state != "" {
@@ -30,16 +219,8 @@ func (c *JiraRESTClient) ListBoardSprintsViaREST(ctx context.Context, boardID in
 		}
 	}
 
-	startAt := 0
-	seenStart := map[int]struct{}{}
-	var out []atlassian.JiraSprint
-
-	for {
-		if _, ok := seenStart[startAt]; ok {
-			return nil, errors.New("pagination startAt repeated; aborting to prevent infinite loop")
-		}
-		seenStart[startAt] = struct{}{}
-
+	path := c.agilePath("/board/%d/sprint", boardID)
+	return pagination.New(func(ctx context.Context, startAt int) ([]atlassian.JiraSprint, *bool, *int, error) {
 		params := map[string]string{
 			"startAt":    strconv.Itoa(startAt),
 			"maxResults": strconv.Itoa(pageSize),
@@ -48,46 +229,23 @@ func (c *JiraRESTClient) ListBoardSprintsViaREST(ctx context.Context, boardID in
 			params["state"] = stateClean
 		}
 
-		payload, err := c.GetJSON(ctx, fmt.Sprintf("/rest/agile/1.0/board/%d/sprint", boardID), params)
+		payload, err := c.GetJSON(ctx, path, params)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 		page, err := gen.DecodeSprintPage(payload)
 		if err != nil {
-			return nil, fmt.Errorf("decode sprint page response: %w", err)
+			return nil, nil, nil, fmt.Errorf("decode sprint page response: %w", err)
 		}
 
-		values := page.Values
-		for _, item := range values {
+		items := make([]atlassian.JiraSprint, 0, len(page.Values))
+		for _, item := range page.Values {
 			sprint, err := mappers.JiraSprintFromREST(item)
 			if err != nil {
-				return nil, err
-			}
-			out = append(out, sprint)
-		}
-
-		hasIsLast := false
-		isLast := false
-		if page.IsLast != nil {
-			hasIsLast = true
-			isLast = *page.IsLast
-		}
-		if hasIsLast && isLast {
-			break
-		}
-
-		if len(values) < pageSize {
-			break
-		}
-
-		if len(values) == 0 {
-			if hasIsLast && !isLast {
-				return nil, fmt.Errorf("received empty page with isLast=false at startAt=%d", startAt)
+				return nil, nil, nil, err
 			}
-			break
+			items = append(items, sprint)
 		}
-		startAt += len(values)
-	}
-
-	return out, nil
+		return items, page.IsLast, nil, nil
+	}, pageSize), nil
 }
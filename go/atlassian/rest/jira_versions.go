@@ -2,11 +2,11 @@ package rest
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"atlassian/atlassian"
+	"atlassian/atlassian/pagination"
 	"atlassian/atlassian/rest/gen"
 	"atlassian/atlassian/rest/mappers"
 )
@@ -16,82 +16,95 @@ func (c *JiraRESTClient) ListVersions(ctx context.Context, projectKeyOrID string
 	if project == "" {
 		return nil, fmt.Errorf("projectKeyOrID is required")
 	}
-	if pageSize <= 0 {
-		pageSize = 50
-	}
-
-	var out []atlassian.JiraVersion
-	startAt := 0
-	seenStartAt := map[int]struct{}{}
-
-	for {
-		if _, ok := seenStartAt[startAt]; ok {
-			return nil, fmt.Errorf("pagination startAt repeated; aborting to prevent infinite loop")
-		}
-		seenStartAt[startAt] = struct{}{}
 
+	path := c.apiPath("/project/%s/version", project)
+	p := pagination.New(func(ctx context.Context, startAt int) ([]atlassian.JiraVersion, *bool, *int, error) {
 		params := map[string]string{
 			"startAt":    fmt.Sprintf("%d", startAt),
 			"maxResults": fmt.Sprintf("%d", pageSize),
 		}
-		path := fmt.Sprintf("/rest/api/3/project/%s/version", project)
 		payload, err := c.GetJSON(ctx, path, params)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 
 		page, err := gen.DecodePageBeanVersion(payload)
 		if err != nil {
-			return nil, fmt.Errorf("decode PageBeanVersion: %w", err)
+			return nil, nil, nil, fmt.Errorf("decode PageBeanVersion: %w", err)
 		}
 
+		items := make([]atlassian.JiraVersion, 0, len(page.Values))
 		for _, item := range page.Values {
-			out = append(out, mappers.MapRESTVersion(project, item))
+			items = append(items, mappers.MapRESTVersion(project, item))
 		}
+		return items, page.IsLast, page.Total, nil
+	}, pageSize)
 
-		if page.IsLast != nil && *page.IsLast {
-			break
-		}
+	return p.All(ctx)
+}
 
-		if page.Total != nil {
-			if startAt+len(page.Values) >= *page.Total {
-				break
-			}
-		} else {
-			if len(page.Values) < pageSize {
-				break
-			}
-		}
+// GetVersion fetches a single Jira version by its ID.
+// Ref: GET /rest/api/3/version/{id}
+func (c *JiraRESTClient) GetVersion(ctx context.Context, versionID string) (atlassian.JiraVersion, error) {
+	id := strings.TrimSpace(versionID)
+	if id == "" {
+		return atlassian.JiraVersion{}, fmt.Errorf("version ID is required")
+	}
 
-		if len(page.Values) == 0 {
-			break
-		}
-		startAt += len(page.Values)
+	payload, err := c.GetJSON(ctx, c.apiPath("/version/%s", id), nil)
+	if err != nil {
+		return atlassian.JiraVersion{}, err
 	}
 
-	return out, nil
+	genV, err := gen.DecodeVersion(payload)
+	if err != nil {
+		return atlassian.JiraVersion{}, fmt.Errorf("decode Version: %w", err)
+	}
+
+	projectKey := ""
+	if genV.ProjectID != nil {
+		projectKey = fmt.Sprintf("%d", *genV.ProjectID)
+	}
+
+	return mappers.MapRESTVersion(projectKey, *genV), nil
 }
 
-func (c *JiraRESTClient) CreateVersion(ctx context.Context, projectKey string, v atlassian.JiraVersion) (atlassian.JiraVersion, error) {
+func versionRequestBody(projectKey string, v atlassian.JiraVersion) map[string]any {
 	data := map[string]any{
 		"name":     v.Name,
-		"project":  projectKey,
 		"released": v.Released,
+		"archived": v.Archived,
+	}
+	if projectKey != "" {
+		data["project"] = projectKey
+	}
+	if v.Description != nil {
+		data["description"] = *v.Description
 	}
 	if v.ReleaseDate != nil {
 		data["releaseDate"] = *v.ReleaseDate
 	}
+	if v.StartDate != nil {
+		data["startDate"] = *v.StartDate
+	}
+	if v.UserReleaseDate != nil {
+		data["userReleaseDate"] = *v.UserReleaseDate
+	}
+	return data
+}
 
-	payload, err := c.PostJSON(ctx, "/rest/api/3/version", data)
+func (c *JiraRESTClient) CreateVersion(ctx context.Context, projectKey string, v atlassian.JiraVersion) (atlassian.JiraVersion, error) {
+	payload, err := c.PostJSON(ctx, c.apiPath("/version"), versionRequestBody(projectKey, v))
 	if err != nil {
 		return atlassian.JiraVersion{}, err
 	}
 
-	var genV gen.Version
-	b, _ := json.Marshal(payload)
-	json.Unmarshal(b, &genV)
+	genV, err := gen.DecodeVersion(payload)
+	if err != nil {
+		return atlassian.JiraVersion{}, fmt.Errorf("decode Version: %w", err)
+	}
 
-	return mappers.MapRESTVersion(projectKey, genV), nil
+	return mappers.MapRESTVersion(projectKey, *genV), nil
 }
 
 func (c *JiraRESTClient) UpdateVersion(ctx context.Context, projectKey string, v atlassian.JiraVersion) (atlassian.JiraVersion, error) {
@@ -99,30 +112,212 @@ func (c *JiraRESTClient) UpdateVersion(ctx context.Context, projectKey string, v
 		return atlassian.JiraVersion{}, fmt.Errorf("version ID is required for update")
 	}
 
-	data := map[string]any{
-		"name":     v.Name,
-		"released": v.Released,
-	}
-	if v.ReleaseDate != nil {
-		data["releaseDate"] = *v.ReleaseDate
-	}
-
-	path := fmt.Sprintf("/rest/api/3/version/%s", v.ID)
-	payload, err := c.PutJSON(ctx, path, data)
+	path := c.apiPath("/version/%s", v.ID)
+	payload, err := c.PutJSON(ctx, path, versionRequestBody("", v))
 	if err != nil {
 		return atlassian.JiraVersion{}, err
 	}
 
-	var genV gen.Version
-	b, _ := json.Marshal(payload)
-	json.Unmarshal(b, &genV)
+	genV, err := gen.DecodeVersion(payload)
+	if err != nil {
+		return atlassian.JiraVersion{}, fmt.Errorf("decode Version: %w", err)
+	}
 
-	return mappers.MapRESTVersion(projectKey, genV), nil
+	return mappers.MapRESTVersion(projectKey, *genV), nil
 }
 
 func (c *JiraRESTClient) DeleteVersion(ctx context.Context, versionID string) error {
 	if versionID == "" {
 		return fmt.Errorf("version ID is required for delete")
 	}
-	return c.Delete(ctx, fmt.Sprintf("/rest/api/3/version/%s", versionID))
+	return c.Delete(ctx, c.apiPath("/version/%s", versionID))
+}
+
+// MoveVersion reorders a version within its project's version list, either by absolute
+// position ("First", "Last", "Earlier", "Later") or by placing it directly after another
+// version. Exactly one of position or afterVersionID should be set.
+// Ref: POST /rest/api/3/version/{id}/move
+func (c *JiraRESTClient) MoveVersion(ctx context.Context, versionID string, position string, afterVersionID string) (atlassian.JiraVersion, error) {
+	id := strings.TrimSpace(versionID)
+	if id == "" {
+		return atlassian.JiraVersion{}, fmt.Errorf("version ID is required")
+	}
+	pos := strings.TrimSpace(position)
+	after := strings.TrimSpace(afterVersionID)
+	if pos == "" && after == "" {
+		return atlassian.JiraVersion{}, fmt.Errorf("either position or afterVersionID is required")
+	}
+	if pos != "" && after != "" {
+		return atlassian.JiraVersion{}, fmt.Errorf("position and afterVersionID are mutually exclusive")
+	}
+
+	data := map[string]any{}
+	if pos != "" {
+		data["position"] = pos
+	} else {
+		data["after"] = c.apiPath("/version/%s", after)
+	}
+
+	payload, err := c.PostJSON(ctx, c.apiPath("/version/%s/move", id), data)
+	if err != nil {
+		return atlassian.JiraVersion{}, err
+	}
+
+	genV, err := gen.DecodeVersion(payload)
+	if err != nil {
+		return atlassian.JiraVersion{}, fmt.Errorf("decode Version: %w", err)
+	}
+
+	projectKey := ""
+	if genV.ProjectID != nil {
+		projectKey = fmt.Sprintf("%d", *genV.ProjectID)
+	}
+
+	return mappers.MapRESTVersion(projectKey, *genV), nil
+}
+
+// ArchiveVersion archives a version. Unlike most version fields, archived status is not honored
+// by the generic PUT /rest/api/3/version/{id} update and must go through this dedicated endpoint.
+// Ref: PUT /rest/api/3/version/{id}/archive
+func (c *JiraRESTClient) ArchiveVersion(ctx context.Context, versionID string) (atlassian.JiraVersion, error) {
+	return c.setVersionArchived(ctx, versionID, true)
+}
+
+// UnarchiveVersion restores a previously archived version to its prior released/unreleased
+// state. See ArchiveVersion for why this is a dedicated endpoint rather than a field on Update.
+// Ref: PUT /rest/api/3/version/{id}/archive
+func (c *JiraRESTClient) UnarchiveVersion(ctx context.Context, versionID string) (atlassian.JiraVersion, error) {
+	return c.setVersionArchived(ctx, versionID, false)
+}
+
+func (c *JiraRESTClient) setVersionArchived(ctx context.Context, versionID string, archived bool) (atlassian.JiraVersion, error) {
+	id := strings.TrimSpace(versionID)
+	if id == "" {
+		return atlassian.JiraVersion{}, fmt.Errorf("version ID is required")
+	}
+
+	payload, err := c.PutJSON(ctx, c.apiPath("/version/%s/archive", id), map[string]any{"archived": archived})
+	if err != nil {
+		return atlassian.JiraVersion{}, err
+	}
+
+	genV, err := gen.DecodeVersion(payload)
+	if err != nil {
+		return atlassian.JiraVersion{}, fmt.Errorf("decode Version: %w", err)
+	}
+
+	projectKey := ""
+	if genV.ProjectID != nil {
+		projectKey = fmt.Sprintf("%d", *genV.ProjectID)
+	}
+
+	return mappers.MapRESTVersion(projectKey, *genV), nil
+}
+
+// MoveVersionAfter places versionID immediately after otherID in its project's version order; a
+// thin convenience wrapper over MoveVersion for the common after-another-version case.
+func (c *JiraRESTClient) MoveVersionAfter(ctx context.Context, versionID string, otherID string) (atlassian.JiraVersion, error) {
+	return c.MoveVersion(ctx, versionID, "", otherID)
+}
+
+// MergeVersionInto moves every issue from versionID onto otherID and then deletes versionID; an
+// alias for MergeVersion with the source/target order spelled out at the call site.
+func (c *JiraRESTClient) MergeVersionInto(ctx context.Context, versionID string, otherID string) error {
+	return c.MergeVersion(ctx, versionID, otherID)
+}
+
+// MergeVersion moves every issue from sourceID onto targetID and then deletes sourceID.
+// Ref: PUT /rest/api/3/version/{id}/mergeto/{moveIssuesTo}
+func (c *JiraRESTClient) MergeVersion(ctx context.Context, sourceID string, targetID string) error {
+	source := strings.TrimSpace(sourceID)
+	target := strings.TrimSpace(targetID)
+	if source == "" {
+		return fmt.Errorf("sourceID is required")
+	}
+	if target == "" {
+		return fmt.Errorf("targetID is required")
+	}
+
+	_, err := c.PutJSON(ctx, c.apiPath("/version/%s/mergeto/%s", source, target), nil)
+	return err
+}
+
+// GetVersionRelatedIssuesCount returns the number of issues referencing the version, whether
+// as fix version or affects version.
+// Ref: GET /rest/api/3/version/{id}/relatedIssueCounts
+func (c *JiraRESTClient) GetVersionRelatedIssuesCount(ctx context.Context, versionID string) (int, error) {
+	id := strings.TrimSpace(versionID)
+	if id == "" {
+		return 0, fmt.Errorf("version ID is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/version/%s/relatedIssueCounts", id), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	counts, err := gen.DecodeVersionRelatedIssuesCount(payload)
+	if err != nil {
+		return 0, fmt.Errorf("decode VersionRelatedIssuesCount: %w", err)
+	}
+	total := 0
+	if counts.IssuesFixedCount != nil {
+		total += *counts.IssuesFixedCount
+	}
+	if counts.IssuesAffectedCount != nil {
+		total += *counts.IssuesAffectedCount
+	}
+	return total, nil
+}
+
+// GetVersionRelatedIssueCounts returns the fixed/affected issue counts referencing the version
+// as a breakdown, unlike GetVersionRelatedIssuesCount which only returns their sum.
+// Ref: GET /rest/api/3/version/{id}/relatedIssueCounts
+func (c *JiraRESTClient) GetVersionRelatedIssueCounts(ctx context.Context, versionID string) (atlassian.JiraVersionRelatedIssueCounts, error) {
+	id := strings.TrimSpace(versionID)
+	if id == "" {
+		return atlassian.JiraVersionRelatedIssueCounts{}, fmt.Errorf("version ID is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/version/%s/relatedIssueCounts", id), nil)
+	if err != nil {
+		return atlassian.JiraVersionRelatedIssueCounts{}, err
+	}
+
+	counts, err := gen.DecodeVersionRelatedIssuesCount(payload)
+	if err != nil {
+		return atlassian.JiraVersionRelatedIssueCounts{}, fmt.Errorf("decode VersionRelatedIssuesCount: %w", err)
+	}
+
+	out := atlassian.JiraVersionRelatedIssueCounts{}
+	if counts.IssuesFixedCount != nil {
+		out.Fixed = *counts.IssuesFixedCount
+	}
+	if counts.IssuesAffectedCount != nil {
+		out.Affected = *counts.IssuesAffectedCount
+	}
+	return out, nil
+}
+
+// GetVersionUnresolvedIssueCount returns the number of unresolved issues for the version.
+// Ref: GET /rest/api/3/version/{id}/unresolvedIssueCount
+func (c *JiraRESTClient) GetVersionUnresolvedIssueCount(ctx context.Context, versionID string) (int, error) {
+	id := strings.TrimSpace(versionID)
+	if id == "" {
+		return 0, fmt.Errorf("version ID is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/version/%s/unresolvedIssueCount", id), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	counts, err := gen.DecodeVersionUnresolvedIssueCount(payload)
+	if err != nil {
+		return 0, fmt.Errorf("decode VersionUnresolvedIssueCount: %w", err)
+	}
+	if counts.IssuesUnresolvedCount == nil {
+		return 0, nil
+	}
+	return *counts.IssuesUnresolvedCount, nil
 }
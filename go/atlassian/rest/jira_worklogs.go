@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest/mappers"
+)
+
+// CreateWorklogInput describes the fields accepted when logging work against an issue.
+type CreateWorklogInput struct {
+	StartedAt        string
+	TimeSpentSeconds int
+	Comment          any // plain string or an ADF document as map[string]any
+}
+
+// CreateWorklog logs work against an issue and returns the created worklog.
+// Ref: POST /rest/api/3/issue/{issueIdOrKey}/worklog
+func (c *JiraRESTClient) CreateWorklog(ctx context.Context, issueKey string, in CreateWorklogInput) (atlassian.JiraWorklog, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return atlassian.JiraWorklog{}, errors.New("issueKey is required")
+	}
+	started := strings.TrimSpace(in.StartedAt)
+	if started == "" {
+		return atlassian.JiraWorklog{}, errors.New("startedAt is required")
+	}
+	if in.TimeSpentSeconds <= 0 {
+		return atlassian.JiraWorklog{}, errors.New("timeSpentSeconds must be a positive integer")
+	}
+
+	body := map[string]any{
+		"started":          started,
+		"timeSpentSeconds": in.TimeSpentSeconds,
+	}
+	if in.Comment != nil {
+		body["comment"] = c.descriptionForWrite(in.Comment)
+	}
+
+	payload, err := c.PostJSON(ctx, c.apiPath("/issue/%s/worklog", key), body)
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	return mappers.JiraWorklogFromREST(key, payload)
+}
+
+// GetWorklog fetches a single worklog by ID.
+// Ref: GET /rest/api/3/issue/{issueIdOrKey}/worklog/{id}
+func (c *JiraRESTClient) GetWorklog(ctx context.Context, issueKey string, worklogID string) (atlassian.JiraWorklog, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return atlassian.JiraWorklog{}, errors.New("issueKey is required")
+	}
+	id := strings.TrimSpace(worklogID)
+	if id == "" {
+		return atlassian.JiraWorklog{}, errors.New("worklogID is required")
+	}
+
+	payload, err := c.GetJSON(ctx, c.apiPath("/issue/%s/worklog/%s", key, id), nil)
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	return mappers.JiraWorklogFromREST(key, payload)
+}
+
+// UpdateWorklogInput describes the fields an UpdateWorklog call may change. A nil pointer leaves
+// the corresponding field untouched.
+type UpdateWorklogInput struct {
+	StartedAt        *string
+	TimeSpentSeconds *int
+	Comment          any
+}
+
+// UpdateWorklog applies a partial update to an existing worklog.
+// Ref: PUT /rest/api/3/issue/{issueIdOrKey}/worklog/{id}
+func (c *JiraRESTClient) UpdateWorklog(ctx context.Context, issueKey string, worklogID string, in UpdateWorklogInput) (atlassian.JiraWorklog, error) {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return atlassian.JiraWorklog{}, errors.New("issueKey is required")
+	}
+	id := strings.TrimSpace(worklogID)
+	if id == "" {
+		return atlassian.JiraWorklog{}, errors.New("worklogID is required")
+	}
+	if in.TimeSpentSeconds != nil && *in.TimeSpentSeconds <= 0 {
+		return atlassian.JiraWorklog{}, errors.New("timeSpentSeconds must be a positive integer")
+	}
+
+	body := map[string]any{}
+	if in.StartedAt != nil {
+		body["started"] = *in.StartedAt
+	}
+	if in.TimeSpentSeconds != nil {
+		body["timeSpentSeconds"] = *in.TimeSpentSeconds
+	}
+	if in.Comment != nil {
+		body["comment"] = c.descriptionForWrite(in.Comment)
+	}
+
+	payload, err := c.PutJSON(ctx, c.apiPath("/issue/%s/worklog/%s", key, id), body)
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	return mappers.JiraWorklogFromREST(key, payload)
+}
+
+// DeleteWorklog deletes a worklog from an issue.
+// Ref: DELETE /rest/api/3/issue/{issueIdOrKey}/worklog/{id}
+func (c *JiraRESTClient) DeleteWorklog(ctx context.Context, issueKey string, worklogID string) error {
+	key := strings.TrimSpace(issueKey)
+	if key == "" {
+		return errors.New("issueKey is required")
+	}
+	id := strings.TrimSpace(worklogID)
+	if id == "" {
+		return errors.New("worklogID is required")
+	}
+	return c.Delete(ctx, c.apiPath("/issue/%s/worklog/%s", key, id))
+}
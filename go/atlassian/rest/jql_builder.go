@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"atlassian/atlassian"
+)
+
+// JQLBuilder composes an atlassian.JQL clause tree with ORDER BY keys into a JQL query string, so
+// callers of ListIssuesViaREST/ListIssuesViaRESTWithFields and the jira_issues data source don't
+// need to hand-concatenate JQL.
+type JQLBuilder struct {
+	where   atlassian.JQL
+	orderBy []jqlOrderKey
+}
+
+type jqlOrderKey struct {
+	field string
+	desc  bool
+}
+
+// NewJQLBuilder starts a builder from a where clause, typically composed from atlassian.Project,
+// atlassian.Status(), atlassian.And, etc. where may be nil to build an ORDER BY-only query.
+func NewJQLBuilder(where atlassian.JQL) *JQLBuilder {
+	return &JQLBuilder{where: where}
+}
+
+// OrderBy appends a sort key. Call it multiple times for multi-key ordering; keys are emitted in
+// the order added.
+func (b *JQLBuilder) OrderBy(field string, descending bool) *JQLBuilder {
+	b.orderBy = append(b.orderBy, jqlOrderKey{field: field, desc: descending})
+	return b
+}
+
+// String renders the builder's where clause and ORDER BY keys as a JQL query string.
+func (b *JQLBuilder) String() string {
+	var sb strings.Builder
+	if b.where != nil {
+		sb.WriteString(b.where.String())
+	}
+	if len(b.orderBy) > 0 {
+		if sb.Len() > 0 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString("ORDER BY ")
+		parts := make([]string, len(b.orderBy))
+		for i, k := range b.orderBy {
+			dir := "ASC"
+			if k.desc {
+				dir = "DESC"
+			}
+			parts[i] = fmt.Sprintf("%s %s", k.field, dir)
+		}
+		sb.WriteString(strings.Join(parts, ", "))
+	}
+	return sb.String()
+}
+
+// Validate renders the builder and submits it to client.ValidateJQL, surfacing any Jira-side
+// parse error before the query is ever used to search.
+func (b *JQLBuilder) Validate(ctx context.Context, client *JiraRESTClient) error {
+	return client.ValidateJQL(ctx, b.String())
+}
+
+// ValidateJQL submits jql to Jira's parser without executing it, surfacing parse errors early.
+// Ref: POST /rest/api/3/jql/parse
+func (c *JiraRESTClient) ValidateJQL(ctx context.Context, jql string) error {
+	trimmed := strings.TrimSpace(jql)
+	if trimmed == "" {
+		return errors.New("jql is required")
+	}
+
+	payload, err := c.PostJSON(ctx, c.apiPath("/jql/parse"), map[string]any{
+		"queries": []string{trimmed},
+	})
+	if err != nil {
+		return err
+	}
+
+	var parsed struct {
+		Queries []struct {
+			Query  string   `json:"query"`
+			Errors []string `json:"errors"`
+		} `json:"queries"`
+	}
+	if err := Convert(payload, &parsed); err != nil {
+		return fmt.Errorf("decode jql/parse response: %w", err)
+	}
+	if len(parsed.Queries) == 0 {
+		return errors.New("jql/parse response missing queries")
+	}
+	if errs := parsed.Queries[0].Errors; len(errs) > 0 {
+		return fmt.Errorf("invalid JQL %q: %s", trimmed, strings.Join(errs, "; "))
+	}
+	return nil
+}
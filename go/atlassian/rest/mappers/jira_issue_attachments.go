@@ -0,0 +1,93 @@
+package mappers
+
+import (
+	"errors"
+	"fmt"
+
+	"atlassian/atlassian"
+)
+
+// parseAttachments maps issue.fields.attachment[] (present whenever the attachment field is
+// requested or returned by default) into atlassian.JiraAttachment values.
+func parseAttachments(fields map[string]any) ([]atlassian.JiraAttachment, error) {
+	raw, ok := fields["attachment"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	arr, ok := raw.([]any)
+	if !ok {
+		return nil, errors.New("issue.fields.attachment must be a list when present")
+	}
+
+	out := make([]atlassian.JiraAttachment, 0, len(arr))
+	for idx, item := range arr {
+		path := fmt.Sprintf("issue.fields.attachment[%d]", idx)
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", path)
+		}
+		attachment, err := parseAttachment(obj, path)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, attachment)
+	}
+	return out, nil
+}
+
+// JiraAttachmentFromREST maps a single attachment object, such as the entries Jira returns from
+// the attachment upload endpoint, into an atlassian.JiraAttachment.
+func JiraAttachmentFromREST(obj map[string]any) (atlassian.JiraAttachment, error) {
+	return parseAttachment(obj, "attachment")
+}
+
+func parseAttachment(obj map[string]any, path string) (atlassian.JiraAttachment, error) {
+	id, err := requireStringField(obj, "id", path)
+	if err != nil {
+		return atlassian.JiraAttachment{}, err
+	}
+	filename, err := requireStringField(obj, "filename", path)
+	if err != nil {
+		return atlassian.JiraAttachment{}, err
+	}
+	mimeType, err := optionalStringField(obj, "mimeType")
+	if err != nil {
+		return atlassian.JiraAttachment{}, fmt.Errorf("%s.mimeType: %w", path, err)
+	}
+	created, err := optionalStringField(obj, "created")
+	if err != nil {
+		return atlassian.JiraAttachment{}, fmt.Errorf("%s.created: %w", path, err)
+	}
+	author, err := optionalUser(obj, "author", path)
+	if err != nil {
+		return atlassian.JiraAttachment{}, err
+	}
+	contentURL, err := optionalStringField(obj, "content")
+	if err != nil {
+		return atlassian.JiraAttachment{}, fmt.Errorf("%s.content: %w", path, err)
+	}
+	thumbnailURL, err := optionalStringField(obj, "thumbnail")
+	if err != nil {
+		return atlassian.JiraAttachment{}, fmt.Errorf("%s.thumbnail: %w", path, err)
+	}
+
+	var size int64
+	if rawSize, ok := obj["size"]; ok && rawSize != nil {
+		n, ok := rawSize.(float64)
+		if !ok {
+			return atlassian.JiraAttachment{}, fmt.Errorf("%s.size must be a number", path)
+		}
+		size = int64(n)
+	}
+
+	return atlassian.JiraAttachment{
+		ID:           id,
+		Filename:     filename,
+		MimeType:     mimeType,
+		Size:         size,
+		Created:      created,
+		Author:       author,
+		ContentURL:   contentURL,
+		ThumbnailURL: thumbnailURL,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package mappers
+
+import (
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest/gen"
+)
+
+// parseIssueHistory walks changelog.Histories in order and splits their field changes into the
+// three history slices tracked on atlassian.JiraIssue. sprintNameIndex maps sprint display names
+// (as Jira reports them in a Sprint field's fromString/toString) to stable sprint IDs; a name
+// with no entry in the index is kept as-is, since callers that don't have a board's sprint list
+// handy would otherwise lose the change entirely.
+func parseIssueHistory(changelog *gen.Changelog, sprintNameIndex map[string]string) ([]atlassian.StatusChange, []atlassian.AssigneeChange, []atlassian.SprintChange, error) {
+	if changelog == nil {
+		return nil, nil, nil, nil
+	}
+
+	var statusHistory []atlassian.StatusChange
+	var assigneeHistory []atlassian.AssigneeChange
+	var sprintsHistory []atlassian.SprintChange
+
+	for _, history := range changelog.Histories {
+		at := ""
+		if history.Created != nil {
+			at = *history.Created
+		}
+		for _, item := range history.Items {
+			if item.Field == nil {
+				continue
+			}
+			switch strings.ToLower(*item.Field) {
+			case "status":
+				statusHistory = append(statusHistory, atlassian.StatusChange{
+					At:   at,
+					From: stringOrEmpty(item.FromString),
+					To:   stringOrEmpty(item.ToString),
+				})
+			case "assignee":
+				assigneeHistory = append(assigneeHistory, atlassian.AssigneeChange{
+					At:            at,
+					FromAccountID: stringOrEmpty(item.From),
+					ToAccountID:   stringOrEmpty(item.To),
+				})
+			case "sprint":
+				added, removed := diffSprintNames(stringOrEmpty(item.FromString), stringOrEmpty(item.ToString), sprintNameIndex)
+				if len(added) == 0 && len(removed) == 0 {
+					continue
+				}
+				sprintsHistory = append(sprintsHistory, atlassian.SprintChange{
+					At:      at,
+					Added:   added,
+					Removed: removed,
+				})
+			}
+		}
+	}
+
+	return statusHistory, assigneeHistory, sprintsHistory, nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// diffSprintNames reconciles the comma-separated sprint name lists Jira sends in a Sprint
+// changelog item's fromString/toString into the IDs removed from and added to the issue,
+// resolving each name to its stable sprint ID via sprintNameIndex when available.
+func diffSprintNames(fromString string, toString string, sprintNameIndex map[string]string) ([]string, []string) {
+	before := splitSprintNames(fromString)
+	after := splitSprintNames(toString)
+
+	beforeSet := make(map[string]struct{}, len(before))
+	for _, name := range before {
+		beforeSet[name] = struct{}{}
+	}
+	afterSet := make(map[string]struct{}, len(after))
+	for _, name := range after {
+		afterSet[name] = struct{}{}
+	}
+
+	var added []string
+	for _, name := range after {
+		if _, ok := beforeSet[name]; !ok {
+			added = append(added, resolveSprintID(name, sprintNameIndex))
+		}
+	}
+	var removed []string
+	for _, name := range before {
+		if _, ok := afterSet[name]; !ok {
+			removed = append(removed, resolveSprintID(name, sprintNameIndex))
+		}
+	}
+	return added, removed
+}
+
+func splitSprintNames(raw string) []string {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ",")
+	out := make([]string, 0, len(parts))
+	for _, part := range parts {
+		name := strings.TrimSpace(part)
+		if name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func resolveSprintID(name string, sprintNameIndex map[string]string) string {
+	if id, ok := sprintNameIndex[name]; ok {
+		return id
+	}
+	return name
+}
@@ -0,0 +1,112 @@
+package mappers
+
+import (
+	"errors"
+	"fmt"
+
+	"atlassian/atlassian"
+)
+
+// parseComments maps issue.fields.comment.comments[] (present when the issue was fetched with
+// expand=comment or the comment field was requested) into atlassian.JiraComment values.
+func parseComments(fields map[string]any) ([]atlassian.JiraComment, error) {
+	raw, ok := fields["comment"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	commentObj, ok := raw.(map[string]any)
+	if !ok {
+		return nil, errors.New("issue.fields.comment must be an object when present")
+	}
+	rawComments, ok := commentObj["comments"]
+	if !ok || rawComments == nil {
+		return nil, nil
+	}
+	arr, ok := rawComments.([]any)
+	if !ok {
+		return nil, errors.New("issue.fields.comment.comments must be a list when present")
+	}
+
+	out := make([]atlassian.JiraComment, 0, len(arr))
+	for idx, item := range arr {
+		path := fmt.Sprintf("issue.fields.comment.comments[%d]", idx)
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", path)
+		}
+		comment, err := parseComment(obj, path)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, comment)
+	}
+	return out, nil
+}
+
+// JiraCommentFromREST maps a single comment object, such as the body of a create/update comment
+// response, into an atlassian.JiraComment.
+func JiraCommentFromREST(obj map[string]any) (atlassian.JiraComment, error) {
+	return parseComment(obj, "comment")
+}
+
+func parseComment(obj map[string]any, path string) (atlassian.JiraComment, error) {
+	id, err := requireStringField(obj, "id", path)
+	if err != nil {
+		return atlassian.JiraComment{}, err
+	}
+	author, err := optionalUser(obj, "author", path)
+	if err != nil {
+		return atlassian.JiraComment{}, err
+	}
+	created, err := optionalStringField(obj, "created")
+	if err != nil {
+		return atlassian.JiraComment{}, fmt.Errorf("%s.created: %w", path, err)
+	}
+	updated, err := optionalStringField(obj, "updated")
+	if err != nil {
+		return atlassian.JiraComment{}, fmt.Errorf("%s.updated: %w", path, err)
+	}
+	body, bodyText, err := parseADFOrPlainText(obj["body"], path+".body")
+	if err != nil {
+		return atlassian.JiraComment{}, err
+	}
+	bodyRendered := bodyText
+	if body != nil {
+		bodyRendered = body.Markdown()
+	}
+	visibility, err := optionalCommentVisibility(obj, path)
+	if err != nil {
+		return atlassian.JiraComment{}, err
+	}
+
+	return atlassian.JiraComment{
+		ID:           id,
+		Author:       author,
+		Created:      created,
+		Updated:      updated,
+		Body:         body,
+		BodyText:     bodyText,
+		BodyRendered: bodyRendered,
+		Visibility:   visibility,
+	}, nil
+}
+
+func optionalCommentVisibility(obj map[string]any, path string) (*atlassian.CommentVisibility, error) {
+	raw, ok := obj["visibility"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	m, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s.visibility must be an object when present", path)
+	}
+	visType, err := requireStringField(m, "type", path+".visibility")
+	if err != nil {
+		return nil, err
+	}
+	value, err := requireStringField(m, "value", path+".visibility")
+	if err != nil {
+		return nil, err
+	}
+	return &atlassian.CommentVisibility{Type: visType, Value: value}, nil
+}
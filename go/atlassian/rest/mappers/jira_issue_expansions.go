@@ -0,0 +1,78 @@
+package mappers
+
+import (
+	"errors"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest/gen"
+)
+
+// IssueHistoriesFromGen flattens a changelog's histories into the generic shape an
+// IssueChangelogDataSource exposes, keeping every field change row (unlike parseIssueHistory,
+// which buckets only the status/assignee/sprint fields it recognizes). A history or item with
+// missing optional fields (e.g. a nil FromString on an item that only sets a value for the first
+// time) is kept, with the missing field rendered as an empty string, rather than dropped.
+func IssueHistoriesFromGen(changelog *gen.Changelog) []atlassian.IssueHistory {
+	if changelog == nil {
+		return nil
+	}
+
+	histories := make([]atlassian.IssueHistory, 0, len(changelog.Histories))
+	for _, history := range changelog.Histories {
+		h := atlassian.IssueHistory{
+			CreatedAt: stringOrEmpty(history.Created),
+			Author:    changelogAuthor(history.Author),
+			Items:     make([]atlassian.IssueHistoryItem, 0, len(history.Items)),
+		}
+		for _, item := range history.Items {
+			h.Items = append(h.Items, atlassian.IssueHistoryItem{
+				Field:      stringOrEmpty(item.Field),
+				FromString: stringOrEmpty(item.FromString),
+				ToString:   stringOrEmpty(item.ToString),
+			})
+		}
+		histories = append(histories, h)
+	}
+	return histories
+}
+
+func changelogAuthor(author *gen.ChangelogAuthor) *atlassian.JiraUser {
+	if author == nil {
+		return nil
+	}
+	accountID := stringOrEmpty(author.AccountID)
+	displayName := stringOrEmpty(author.DisplayName)
+	if accountID == "" && displayName == "" {
+		return nil
+	}
+	return &atlassian.JiraUser{
+		AccountID:   accountID,
+		DisplayName: displayName,
+	}
+}
+
+// IssueTransitionsFromGen maps a decoded IssueTransitions response into the generic
+// (id, name, target status) shape an IssueChangelogDataSource exposes.
+func IssueTransitionsFromGen(transitions *gen.IssueTransitions) ([]atlassian.IssueTransition, error) {
+	if transitions == nil {
+		return nil, nil
+	}
+
+	out := make([]atlassian.IssueTransition, 0, len(transitions.Transitions))
+	for _, t := range transitions.Transitions {
+		if t.ID == nil || strings.TrimSpace(*t.ID) == "" {
+			return nil, errors.New("transition.id is required")
+		}
+		toStatus := ""
+		if t.To != nil {
+			toStatus = stringOrEmpty(t.To.Name)
+		}
+		out = append(out, atlassian.IssueTransition{
+			ID:           strings.TrimSpace(*t.ID),
+			Name:         stringOrEmpty(t.Name),
+			ToStatusName: toStatus,
+		})
+	}
+	return out, nil
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"atlassian/atlassian"
+	"atlassian/atlassian/adf"
 	"atlassian/atlassian/rest/gen"
 )
 
@@ -59,6 +60,28 @@ func optionalStringField(obj map[string]any, key string) (*string, error) {
 	return &clean, nil
 }
 
+// parseADFOrPlainText handles a Jira rich-text field that may come back as a plain string (older
+// instances, or fields that were never migrated to ADF) or as an ADF document tree. It returns the
+// parsed tree (nil for a plain string) alongside the flattened plaintext rendering either way, so
+// callers always have a plaintext value to fall back on.
+func parseADFOrPlainText(raw any, path string) (*adf.Node, string, error) {
+	if raw == nil {
+		return nil, "", nil
+	}
+	switch v := raw.(type) {
+	case string:
+		return nil, strings.TrimSpace(v), nil
+	case map[string]any:
+		node, err := adf.Parse(v)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", path, err)
+		}
+		return node, node.PlainText(), nil
+	default:
+		return nil, "", fmt.Errorf("%s must be a string or an ADF document", path)
+	}
+}
+
 func optionalUser(obj map[string]any, key string, path string) (*atlassian.JiraUser, error) {
 	raw, ok := obj[key]
 	if !ok || raw == nil {
@@ -123,6 +146,20 @@ func parseStoryPoints(fields map[string]any, fieldName string) (*float64, error)
 	}
 }
 
+// ParseStoryPointsValue coerces a single already-decoded issue.fields value (as opposed to the
+// fields map parseStoryPoints reads from) using the same permissive rules: numeric JSON values
+// pass through, numeric strings are parsed, and nil/empty means "no story points set".
+func ParseStoryPointsValue(raw any) (*float64, error) {
+	return parseStoryPoints(map[string]any{"value": raw}, "value")
+}
+
+// ParseSprintIDsValue coerces a single already-decoded issue.fields value (the raw sprint
+// custom field payload) into the stable sprint IDs it references, using the same rules as
+// parseSprintIDs.
+func ParseSprintIDsValue(raw any) ([]string, error) {
+	return parseSprintIDs(map[string]any{"value": raw}, "value")
+}
+
 func coerceSprintID(value any, path string) (string, error) {
 	if value == nil {
 		return "", fmt.Errorf("%s is required", path)
@@ -187,11 +224,270 @@ func parseSprintIDs(fields map[string]any, fieldName string) ([]string, error) {
 	return out, nil
 }
 
+// CustomFieldKind selects how JiraIssueFromRESTWithSpec coerces a raw issue.fields[spec.Key]
+// value before storing it under spec.Alias on atlassian.JiraIssue.CustomFields.
+type CustomFieldKind string
+
+const (
+	CustomFieldString    CustomFieldKind = "string"
+	CustomFieldNumber    CustomFieldKind = "number"
+	CustomFieldBool      CustomFieldKind = "bool"
+	CustomFieldDate      CustomFieldKind = "date"
+	CustomFieldUser      CustomFieldKind = "user"
+	CustomFieldOption    CustomFieldKind = "option"
+	CustomFieldOptions   CustomFieldKind = "options"
+	CustomFieldCascading CustomFieldKind = "cascading"
+	CustomFieldRichText  CustomFieldKind = "richtext"
+	CustomFieldArray     CustomFieldKind = "array<string>"
+)
+
+// CustomFieldSpec names one Jira custom field to extract from issue.fields, the Kind to coerce
+// its raw JSON value into, and the Alias it's stored under in atlassian.JiraIssue.CustomFields.
+// Alias defaults to Key when empty.
+type CustomFieldSpec struct {
+	Key   string
+	Alias string
+	Kind  CustomFieldKind
+}
+
+// CascadingOption is the coerced value of a Jira cascading-select custom field: a parent option
+// value plus an optional child option value.
+type CascadingOption struct {
+	Value string
+	Child *string
+}
+
+// extractCustomFields walks fields[spec.Key] for each spec, coercing per spec.Kind. A spec whose
+// key is absent or null from the payload still gets an entry in the result, set to nil, so
+// callers can distinguish "field not returned" from "spec list empty".
+func extractCustomFields(fields map[string]any, specs []CustomFieldSpec) (map[string]any, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]any, len(specs))
+	for _, spec := range specs {
+		key := strings.TrimSpace(spec.Key)
+		if key == "" {
+			return nil, errors.New("custom field spec key is required")
+		}
+		alias := strings.TrimSpace(spec.Alias)
+		if alias == "" {
+			alias = key
+		}
+		raw, ok := fields[key]
+		if !ok || raw == nil {
+			out[alias] = nil
+			continue
+		}
+		value, err := coerceCustomFieldValue(raw, spec.Kind, fmt.Sprintf("issue.fields.%s", key))
+		if err != nil {
+			return nil, err
+		}
+		out[alias] = value
+	}
+	return out, nil
+}
+
+func coerceCustomFieldValue(raw any, kind CustomFieldKind, path string) (any, error) {
+	switch kind {
+	case CustomFieldString:
+		return coerceCustomString(raw, path)
+	case CustomFieldNumber:
+		return coerceCustomNumber(raw, path)
+	case CustomFieldBool:
+		return coerceCustomBool(raw, path)
+	case CustomFieldDate:
+		// ISO date/date-time strings pass straight through; Jira already serializes these.
+		return coerceCustomString(raw, path)
+	case CustomFieldUser:
+		return coerceCustomUser(raw, path)
+	case CustomFieldOption:
+		return coerceCustomOption(raw, path)
+	case CustomFieldOptions:
+		return coerceCustomOptionList(raw, path)
+	case CustomFieldCascading:
+		return coerceCustomCascading(raw, path)
+	case CustomFieldRichText:
+		_, text, err := parseADFOrPlainText(raw, path)
+		return text, err
+	case CustomFieldArray:
+		return coerceCustomStringArray(raw, path)
+	default:
+		return nil, fmt.Errorf("%s: unsupported custom field kind %q", path, kind)
+	}
+}
+
+func coerceCustomString(raw any, path string) (string, error) {
+	s, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", path)
+	}
+	return strings.TrimSpace(s), nil
+}
+
+func coerceCustomNumber(raw any, path string) (float64, error) {
+	switch v := raw.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case string:
+		clean := strings.TrimSpace(v)
+		if clean == "" {
+			return 0, fmt.Errorf("%s must be a non-empty number", path)
+		}
+		parsed, err := strconv.ParseFloat(clean, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s must be a number", path)
+		}
+		return parsed, nil
+	default:
+		return 0, fmt.Errorf("%s must be a number", path)
+	}
+}
+
+func coerceCustomBool(raw any, path string) (bool, error) {
+	switch v := raw.(type) {
+	case bool:
+		return v, nil
+	case string:
+		parsed, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, fmt.Errorf("%s must be a boolean", path)
+		}
+		return parsed, nil
+	default:
+		return false, fmt.Errorf("%s must be a boolean", path)
+	}
+}
+
+func coerceCustomUser(raw any, path string) (*atlassian.JiraUser, error) {
+	obj, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", path)
+	}
+	accountID, err := requireStringField(obj, "accountId", path)
+	if err != nil {
+		return nil, err
+	}
+	displayName, err := requireStringField(obj, "displayName", path)
+	if err != nil {
+		return nil, err
+	}
+	email, err := optionalStringField(obj, "emailAddress")
+	if err != nil {
+		return nil, fmt.Errorf("%s.emailAddress: %w", path, err)
+	}
+	return &atlassian.JiraUser{AccountID: accountID, DisplayName: displayName, Email: email}, nil
+}
+
+// coerceCustomOptionValue extracts the display value from a Jira option-field object: {"value":
+// "..."} for single-select fields, or {"name": "..."} for the option shapes (e.g. priority,
+// resolution) that use "name" instead.
+func coerceCustomOptionValue(obj map[string]any, path string) (string, error) {
+	if v, err := optionalStringField(obj, "value"); err != nil {
+		return "", fmt.Errorf("%s.value: %w", path, err)
+	} else if v != nil {
+		return *v, nil
+	}
+	if v, err := optionalStringField(obj, "name"); err != nil {
+		return "", fmt.Errorf("%s.name: %w", path, err)
+	} else if v != nil {
+		return *v, nil
+	}
+	return "", fmt.Errorf("%s must have a value or name", path)
+}
+
+func coerceCustomOption(raw any, path string) (string, error) {
+	switch v := raw.(type) {
+	case string:
+		return strings.TrimSpace(v), nil
+	case map[string]any:
+		return coerceCustomOptionValue(v, path)
+	default:
+		return "", fmt.Errorf("%s must be a string or an option object", path)
+	}
+}
+
+func coerceCustomOptionList(raw any, path string) ([]string, error) {
+	arr, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", path)
+	}
+	out := make([]string, 0, len(arr))
+	for idx, item := range arr {
+		value, err := coerceCustomOption(item, fmt.Sprintf("%s[%d]", path, idx))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+func coerceCustomStringArray(raw any, path string) ([]string, error) {
+	arr, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", path)
+	}
+	out := make([]string, 0, len(arr))
+	for idx, item := range arr {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be a string", path, idx)
+		}
+		out = append(out, strings.TrimSpace(s))
+	}
+	return out, nil
+}
+
+func coerceCustomCascading(raw any, path string) (CascadingOption, error) {
+	obj, ok := raw.(map[string]any)
+	if !ok {
+		return CascadingOption{}, fmt.Errorf("%s must be an object", path)
+	}
+	value, err := coerceCustomOptionValue(obj, path)
+	if err != nil {
+		return CascadingOption{}, err
+	}
+	out := CascadingOption{Value: value}
+	if childRaw, ok := obj["child"]; ok && childRaw != nil {
+		childObj, ok := childRaw.(map[string]any)
+		if !ok {
+			return CascadingOption{}, fmt.Errorf("%s.child must be an object", path)
+		}
+		child, err := coerceCustomOptionValue(childObj, path+".child")
+		if err != nil {
+			return CascadingOption{}, err
+		}
+		out.Child = &child
+	}
+	return out, nil
+}
+
 func JiraIssueFromREST(cloudID string, issue gen.IssueBean) (atlassian.JiraIssue, error) {
 	return JiraIssueFromRESTWithFields(cloudID, issue, "", "")
 }
 
 func JiraIssueFromRESTWithFields(cloudID string, issue gen.IssueBean, storyPointsField string, sprintIDsField string) (atlassian.JiraIssue, error) {
+	return JiraIssueFromRESTWithSpec(cloudID, issue, storyPointsField, sprintIDsField, nil)
+}
+
+// JiraIssueFromRESTWithSpec is JiraIssueFromRESTWithFields plus arbitrary custom-field
+// extraction: each spec in specs is coerced per its Kind and stored under its Alias in the
+// returned issue's CustomFields.
+func JiraIssueFromRESTWithSpec(cloudID string, issue gen.IssueBean, storyPointsField string, sprintIDsField string, specs []CustomFieldSpec) (atlassian.JiraIssue, error) {
+	return JiraIssueFromRESTWithHistory(cloudID, issue, storyPointsField, sprintIDsField, specs, nil)
+}
+
+// JiraIssueFromRESTWithHistory is JiraIssueFromRESTWithSpec plus changelog-derived status,
+// assignee, and sprint history: when issue.Changelog is populated (i.e. the issue was fetched
+// with expand=changelog), its histories are split into StatusHistory/AssigneeHistory/
+// SprintsHistory. sprintNameIndex resolves the sprint display names Jira reports in changelog
+// entries to stable sprint IDs; pass nil to keep names as-is.
+func JiraIssueFromRESTWithHistory(cloudID string, issue gen.IssueBean, storyPointsField string, sprintIDsField string, specs []CustomFieldSpec, sprintNameIndex map[string]string) (atlassian.JiraIssue, error) {
 	cloud := strings.TrimSpace(cloudID)
 	if cloud == "" {
 		return atlassian.JiraIssue{}, errors.New("cloudID is required")
@@ -286,6 +582,86 @@ func JiraIssueFromRESTWithFields(cloudID string, issue gen.IssueBean, storyPoint
 		}
 	}
 
+	fixVersions := []string{}
+	if raw, ok := fields["fixVersions"]; ok && raw != nil {
+		arr, ok := raw.([]any)
+		if !ok {
+			return atlassian.JiraIssue{}, errors.New("issue.fields.fixVersions must be a list when present")
+		}
+		for idx, item := range arr {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				return atlassian.JiraIssue{}, fmt.Errorf("issue.fields.fixVersions[%d] must be an object", idx)
+			}
+			name, err := requireStringField(obj, "name", fmt.Sprintf("issue.fields.fixVersions[%d]", idx))
+			if err != nil {
+				return atlassian.JiraIssue{}, err
+			}
+			fixVersions = append(fixVersions, name)
+		}
+	}
+
+	var parentKey *string
+	if raw, ok := fields["parent"]; ok && raw != nil {
+		obj, ok := raw.(map[string]any)
+		if !ok {
+			return atlassian.JiraIssue{}, errors.New("issue.fields.parent must be an object when present")
+		}
+		key, err := requireStringField(obj, "key", "issue.fields.parent")
+		if err != nil {
+			return atlassian.JiraIssue{}, err
+		}
+		parentKey = &key
+	}
+
+	summary := ""
+	if raw, ok := fields["summary"]; ok && raw != nil {
+		s, ok := raw.(string)
+		if !ok {
+			return atlassian.JiraIssue{}, errors.New("issue.fields.summary must be a string when present")
+		}
+		summary = s
+	}
+
+	description := fields["description"]
+	descriptionADF, descriptionText, err := parseADFOrPlainText(description, "issue.fields.description")
+	if err != nil {
+		return atlassian.JiraIssue{}, err
+	}
+
+	var environment *string
+	if raw, ok := fields["environment"]; ok && raw != nil {
+		_, envText, err := parseADFOrPlainText(raw, "issue.fields.environment")
+		if err != nil {
+			return atlassian.JiraIssue{}, err
+		}
+		if envText != "" {
+			environment = &envText
+		}
+	}
+
+	var descriptionHTML *string
+	if raw, ok := fields["renderedFields"]; ok && raw != nil {
+		rendered, ok := raw.(map[string]any)
+		if !ok {
+			return atlassian.JiraIssue{}, errors.New("issue.fields.renderedFields must be an object when present")
+		}
+		descriptionHTML, err = optionalStringField(rendered, "description")
+		if err != nil {
+			return atlassian.JiraIssue{}, fmt.Errorf("issue.fields.renderedFields.description: %w", err)
+		}
+	}
+
+	comments, err := parseComments(fields)
+	if err != nil {
+		return atlassian.JiraIssue{}, err
+	}
+
+	attachments, err := parseAttachments(fields)
+	if err != nil {
+		return atlassian.JiraIssue{}, err
+	}
+
 	assignee, err := optionalUser(fields, "assignee", "issue.fields")
 	if err != nil {
 		return atlassian.JiraIssue{}, err
@@ -295,6 +671,18 @@ func JiraIssueFromRESTWithFields(cloudID string, issue gen.IssueBean, storyPoint
 		return atlassian.JiraIssue{}, err
 	}
 
+	var priority *string
+	if raw, ok := fields["priority"]; ok && raw != nil {
+		obj, ok := raw.(map[string]any)
+		if !ok {
+			return atlassian.JiraIssue{}, errors.New("issue.fields.priority must be an object when present")
+		}
+		priority, err = optionalStringField(obj, "name")
+		if err != nil {
+			return atlassian.JiraIssue{}, fmt.Errorf("issue.fields.priority: %w", err)
+		}
+	}
+
 	storyPoints, err := parseStoryPoints(fields, storyPointsField)
 	if err != nil {
 		return atlassian.JiraIssue{}, err
@@ -303,21 +691,51 @@ func JiraIssueFromRESTWithFields(cloudID string, issue gen.IssueBean, storyPoint
 	if err != nil {
 		return atlassian.JiraIssue{}, err
 	}
+	customFields, err := extractCustomFields(fields, specs)
+	if err != nil {
+		return atlassian.JiraIssue{}, err
+	}
+	statusHistory, assigneeHistory, sprintsHistory, err := parseIssueHistory(issue.Changelog, sprintNameIndex)
+	if err != nil {
+		return atlassian.JiraIssue{}, err
+	}
+
+	id := ""
+	if issue.ID != nil {
+		id = *issue.ID
+	}
 
 	return atlassian.JiraIssue{
-		CloudID:     cloud,
-		Key:         issueKey,
-		ProjectKey:  projectKey,
-		IssueType:   issueType,
-		Status:      status,
-		CreatedAt:   createdAt,
-		UpdatedAt:   updatedAt,
-		ResolvedAt:  resolvedAt,
-		Assignee:    assignee,
-		Reporter:    reporter,
-		Labels:      labels,
-		Components:  components,
-		StoryPoints: storyPoints,
-		SprintIDs:   sprintIDs,
+		ID:              id,
+		CloudID:         cloud,
+		Key:             issueKey,
+		ProjectKey:      projectKey,
+		Summary:         summary,
+		Description:     description,
+		DescriptionADF:  descriptionADF,
+		DescriptionText: descriptionText,
+		DescriptionHTML: descriptionHTML,
+		Environment:     environment,
+		Comments:        comments,
+		IssueType:       issueType,
+		Status:          status,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+		ResolvedAt:      resolvedAt,
+		Assignee:        assignee,
+		Reporter:        reporter,
+		Priority:        priority,
+		Labels:          labels,
+		Components:      components,
+		FixVersions:     fixVersions,
+		ParentKey:       parentKey,
+		StoryPoints:     storyPoints,
+		SprintIDs:       sprintIDs,
+		CustomFields:    customFields,
+		StatusHistory:   statusHistory,
+		AssigneeHistory: assigneeHistory,
+		SprintsHistory:  sprintsHistory,
+		RawFields:       fields,
+		Attachments:     attachments,
 	}, nil
 }
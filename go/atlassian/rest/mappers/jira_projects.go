@@ -0,0 +1,44 @@
+package mappers
+
+import (
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest/gen"
+)
+
+// MapRESTProject maps a decoded gen.Project onto the ghost atlassian.JiraProject shape used by
+// the Terraform provider, carrying the cloudID through since the REST payload itself doesn't
+// echo it back.
+func MapRESTProject(cloudID string, p gen.Project) atlassian.JiraProject {
+	key := ""
+	if p.Key != nil {
+		key = *p.Key
+	}
+	name := ""
+	if p.Name != nil {
+		name = *p.Name
+	}
+	archived := false
+	if p.Archived != nil {
+		archived = *p.Archived
+	}
+
+	out := atlassian.JiraProject{
+		CloudID:            cloudID,
+		Key:                key,
+		Name:               name,
+		Type:               p.ProjectTypeKey,
+		Description:        p.Description,
+		AssigneeType:       p.AssigneeType,
+		ProjectTemplateKey: p.ProjectTemplateKey,
+		ProjectTypeKey:     p.ProjectTypeKey,
+		URL:                p.URL,
+		Archived:           archived,
+	}
+	if p.Lead != nil {
+		out.LeadAccountID = p.Lead.AccountID
+	}
+	if p.ProjectCategory != nil {
+		out.CategoryID = p.ProjectCategory.ID
+	}
+	return out
+}
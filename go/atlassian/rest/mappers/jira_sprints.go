@@ -49,6 +49,18 @@ func JiraSprintFromREST(sprint gen.Sprint) (atlassian.JiraSprint, error) {
 		completeAt = &c
 	}
 
+	var goal *string
+	if sprint.Goal != nil && strings.TrimSpace(*sprint.Goal) != "" {
+		g := strings.TrimSpace(*sprint.Goal)
+		goal = &g
+	}
+
+	var boardID *string
+	if sprint.OriginBoardID != nil {
+		b := strconv.Itoa(*sprint.OriginBoardID)
+		boardID = &b
+	}
+
 	return atlassian.JiraSprint{
 		ID:         strconv.Itoa(*sprint.ID),
 		Name:       name,
@@ -56,5 +68,7 @@ func JiraSprintFromREST(sprint gen.Sprint) (atlassian.JiraSprint, error) {
 		StartAt:    startAt,
 		EndAt:      endAt,
 		CompleteAt: completeAt,
+		Goal:       goal,
+		BoardID:    boardID,
 	}, nil
 }
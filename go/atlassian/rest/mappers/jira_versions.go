@@ -18,16 +18,38 @@ func MapRESTVersion(projectKey string, v gen.Version) atlassian.JiraVersion {
 	if v.Released != nil {
 		released = *v.Released
 	}
+	archived := false
+	if v.Archived != nil {
+		archived = *v.Archived
+	}
 	var releaseDate *string
 	if v.ReleaseDate != nil {
 		releaseDate = v.ReleaseDate
 	}
 
+	overdue := false
+	if v.Overdue != nil {
+		overdue = *v.Overdue
+	}
+
+	var projectID *int
+	if v.ProjectID != nil {
+		projectID = v.ProjectID
+	}
+
 	return atlassian.JiraVersion{
-		ID:          id,
-		Name:        name,
-		ProjectKey:  projectKey,
-		Released:    released,
-		ReleaseDate: releaseDate,
+		ID:              id,
+		Name:            name,
+		ProjectKey:      projectKey,
+		ProjectID:       projectID,
+		Description:     v.Description,
+		Released:        released,
+		Archived:        archived,
+		Overdue:         overdue,
+		ReleaseDate:     releaseDate,
+		StartDate:       v.StartDate,
+		UserStartDate:   v.UserStartDate,
+		UserReleaseDate: v.UserReleaseDate,
+		Self:            v.Self,
 	}
 }
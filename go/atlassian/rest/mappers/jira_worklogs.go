@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"fmt"
+
+	"atlassian/atlassian"
+)
+
+// JiraWorklogFromREST maps a single worklog object, such as the body of a create/update/get
+// worklog response, into an atlassian.JiraWorklog.
+func JiraWorklogFromREST(issueKey string, obj map[string]any) (atlassian.JiraWorklog, error) {
+	path := "worklog"
+	id, err := requireStringField(obj, "id", path)
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	author, err := optionalUser(obj, "author", path)
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	started, err := requireStringField(obj, "started", path)
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	created, err := requireStringField(obj, "created", path)
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	updated, err := requireStringField(obj, "updated", path)
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+
+	timeSpentRaw, ok := obj["timeSpentSeconds"]
+	if !ok || timeSpentRaw == nil {
+		return atlassian.JiraWorklog{}, fmt.Errorf("%s.timeSpentSeconds is required", path)
+	}
+	timeSpent, err := coerceCustomNumber(timeSpentRaw, path+".timeSpentSeconds")
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	if timeSpent < 0 {
+		return atlassian.JiraWorklog{}, fmt.Errorf("%s.timeSpentSeconds must be >= 0", path)
+	}
+
+	comment, commentText, err := parseADFOrPlainText(obj["comment"], path+".comment")
+	if err != nil {
+		return atlassian.JiraWorklog{}, err
+	}
+	var commentRendered *string
+	if comment != nil {
+		rendered := comment.Markdown()
+		commentRendered = &rendered
+	} else if commentText != "" {
+		commentRendered = &commentText
+	}
+
+	return atlassian.JiraWorklog{
+		IssueKey:         issueKey,
+		WorklogID:        id,
+		Author:           author,
+		StartedAt:        started,
+		TimeSpentSeconds: int(timeSpent),
+		CreatedAt:        created,
+		UpdatedAt:        updated,
+		Comment:          commentRendered,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package recorder
+
+import (
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+)
+
+// ClientConfig configures NewJiraRESTClient.
+type ClientConfig struct {
+	// BaseURL is the JiraRESTClient.BaseURL. Defaults to "http://example.com" (only the path and
+	// query matter for cassette matching, so a placeholder is fine in ModeReplay).
+	BaseURL string
+	// Auth is the JiraRESTClient.Auth. Defaults to a no-op AuthProvider.
+	Auth atlassian.AuthProvider
+	// Base is the real RoundTripper to hit when mode is ModeRecord/ModeRecordNewOnly/
+	// ModeDisabled. Required for those modes; ignored in ModeReplay.
+	Base http.RoundTripper
+	// Sanitize overrides DefaultSanitizer for this client's cassette.
+	Sanitize Sanitizer
+}
+
+// NewJiraRESTClient spins up a rest.JiraRESTClient backed by a cassette named name under
+// testdata/cassettes (or dir, if non-empty), in the mode envVar selects (see ModeFromEnv). It
+// registers a t.Cleanup that saves any newly recorded interactions when the test finishes, so a
+// data-source test needs exactly one call to get a client:
+//
+//	client := recorder.NewJiraRESTClient(t, "", "sprints_data_source", "JIRA_RECORD_MODE", recorder.ClientConfig{})
+func NewJiraRESTClient(t *testing.T, dir, name, envVar string, cfg ClientConfig) *rest.JiraRESTClient {
+	t.Helper()
+
+	mode := ModeFromEnv(envVar)
+	cassette, err := New(dir, name, mode, cfg.Base)
+	if err != nil {
+		t.Fatalf("recorder: %v", err)
+	}
+	if cfg.Sanitize != nil {
+		cassette.Sanitize = cfg.Sanitize
+	}
+	t.Cleanup(func() {
+		if err := cassette.Save(); err != nil {
+			t.Errorf("recorder: saving cassette %q: %v", name, err)
+		}
+	})
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "http://example.com"
+	}
+	auth := cfg.Auth
+	if auth == nil {
+		auth = noopAuth{}
+	}
+
+	return &rest.JiraRESTClient{
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Transport: cassette},
+		Auth:       auth,
+	}
+}
+
+// noopAuth is the default atlassian.AuthProvider for a recorded/replayed client: real credentials
+// never need to reach a replayed request, and Cassette.Sanitize already strips Authorization from
+// anything recorded for real.
+type noopAuth struct{}
+
+func (noopAuth) Apply(req *http.Request) error { return nil }
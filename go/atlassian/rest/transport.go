@@ -0,0 +1,432 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"atlassian/atlassian"
+)
+
+// Observer receives rest.Transport's operational events, so callers (e.g. the Terraform
+// provider, via tflog) can surface retries, circuit-breaker trips, and throttling without
+// Transport depending on any particular logging or metrics library.
+type Observer interface {
+	// OnRetry is called before a request is retried, for the attempt (1-indexed) about to run.
+	OnRetry(host string, attempt int, err error)
+	// OnCircuitOpen is called when a request is rejected because host's circuit breaker is open.
+	OnCircuitOpen(host string)
+	// OnThrottled is called when a request was delayed waiting for a token-bucket slot.
+	OnThrottled(host string)
+	// OnRequest is called once per RoundTrip, after the retry loop and any 401 reauthentication
+	// have settled on a final outcome, so implementations can emit one structured log line per
+	// logical request rather than one per attempt. req is the original request (its headers
+	// should be sanitized before logging, since Authorization/Cookie may carry credentials); resp
+	// is nil if err is non-nil.
+	OnRequest(req *http.Request, resp *http.Response, err error, elapsed time.Duration)
+}
+
+// NoopObserver implements Observer with no-ops. It's the default when ClientOptions.Observer is
+// left nil.
+type NoopObserver struct{}
+
+func (NoopObserver) OnRetry(host string, attempt int, err error) {}
+func (NoopObserver) OnCircuitOpen(host string)                   {}
+func (NoopObserver) OnThrottled(host string)                     {}
+func (NoopObserver) OnRequest(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+}
+
+// ClientOptions configures the retry, rate-limiting, and circuit-breaker behavior installed by
+// NewJiraRESTClientWithOptions.
+type ClientOptions struct {
+	// MaxRetries is the number of additional attempts after the first, for 429/5xx responses
+	// and transient transport errors. Defaults to 3 when zero.
+	MaxRetries int
+	// MaxQPS is the token-bucket refill rate, in requests per second, per host. Zero (the
+	// default) disables rate limiting.
+	MaxQPS float64
+	// Burst is the token-bucket capacity. Defaults to 1 when zero.
+	Burst int
+	// CircuitThreshold is the number of consecutive failures (5xx, 429, or transport errors) on
+	// a host before its circuit opens. Zero (the default) disables the breaker.
+	CircuitThreshold int
+	// CircuitCooldown is how long an open circuit stays open before letting a trial request
+	// through. Defaults to 30s when zero.
+	CircuitCooldown time.Duration
+	// Observer receives retry/throttle/circuit-breaker events. Defaults to NoopObserver.
+	Observer Observer
+	// Base is the underlying RoundTripper Transport wraps. Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+	// Auth is consulted once on a 401 response: if it implements reauthenticator, Transport
+	// calls Reauth and retries the request exactly once with the freshly applied credentials
+	// before giving up. Leave nil to treat 401 as terminal, as before.
+	Auth atlassian.AuthProvider
+	// Timeout bounds the whole logical request, including every retry attempt, since it's
+	// applied as http.Client.Timeout around Transport's entire RoundTrip. Zero (the default)
+	// leaves requests unbounded beyond context cancellation.
+	Timeout time.Duration
+}
+
+// NewJiraRESTClientWithOptions builds a JiraRESTClient whose HTTPClient is wrapped in a
+// Transport configured from opts, so every request issued through it shares the same
+// retry/backoff, circuit-breaker, and rate-limiting behavior instead of each caller wiring that
+// up by hand.
+func NewJiraRESTClientWithOptions(baseURL string, auth atlassian.AuthProvider, opts ClientOptions) *JiraRESTClient {
+	return &JiraRESTClient{
+		BaseURL: baseURL,
+		Auth:    auth,
+		HTTPClient: &http.Client{
+			Transport: NewTransport(opts),
+			Timeout:   opts.Timeout,
+		},
+	}
+}
+
+// Transport is an http.RoundTripper that wraps another RoundTripper with a per-host token-bucket
+// QPS ceiling, exponential backoff with jitter on 429/5xx responses (honoring Retry-After when
+// present), and a per-host circuit breaker. Every retry, throttle, and breaker trip is reported
+// to an Observer.
+type Transport struct {
+	base       http.RoundTripper
+	maxRetries int
+	observer   Observer
+	auth       atlassian.AuthProvider
+
+	maxQPS           float64
+	burst            int
+	circuitThreshold int
+	circuitCooldown  time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*tokenBucket
+	breakers map[string]*circuitBreaker
+}
+
+// reauthenticator is implemented by AuthProviders that can force a fresh credential and reapply
+// it to a request, letting Transport retry a 401 exactly once instead of treating it as terminal.
+// atlassian.OAuthRefreshTokenAuth implements this via its Reauth method.
+type reauthenticator interface {
+	Reauth(req *http.Request) error
+}
+
+// NewTransport builds a Transport from opts, applying the same defaults as
+// NewJiraRESTClientWithOptions.
+func NewTransport(opts ClientOptions) *Transport {
+	base := opts.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	observer := opts.Observer
+	if observer == nil {
+		observer = NoopObserver{}
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 3
+	}
+	burst := opts.Burst
+	if burst == 0 {
+		burst = 1
+	}
+	circuitCooldown := opts.CircuitCooldown
+	if circuitCooldown == 0 {
+		circuitCooldown = 30 * time.Second
+	}
+
+	return &Transport{
+		base:             base,
+		maxRetries:       maxRetries,
+		observer:         observer,
+		auth:             opts.Auth,
+		maxQPS:           opts.MaxQPS,
+		burst:            burst,
+		circuitThreshold: opts.CircuitThreshold,
+		circuitCooldown:  circuitCooldown,
+		limiters:         make(map[string]*tokenBucket),
+		breakers:         make(map[string]*circuitBreaker),
+	}
+}
+
+// RoundTrip enforces the circuit breaker and rate limiter for req's host, then performs req,
+// retrying on 429/5xx responses and transient transport errors with exponential backoff (or the
+// server's Retry-After, when given) up to maxRetries times. A 401 response is handled separately,
+// outside that retry budget: if Auth implements reauthenticator, RoundTrip calls Reauth once and
+// retries the whole attempt loop, in case the cached credential was merely stale rather than
+// actually invalid.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	start := time.Now()
+
+	if t.circuitThreshold > 0 {
+		if !t.breakerFor(host).allow(time.Now()) {
+			t.observer.OnCircuitOpen(host)
+			err := fmt.Errorf("rest: circuit breaker open for %s: %w", host, atlassian.ErrCircuitOpen)
+			t.observer.OnRequest(req, nil, err, time.Since(start))
+			return nil, err
+		}
+	}
+
+	if t.maxQPS > 0 {
+		if t.limiterFor(host).take(req.Context()) {
+			t.observer.OnThrottled(host)
+		}
+	}
+
+	resp, err := t.attempt(req, host)
+
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		if ra, ok := t.auth.(reauthenticator); ok {
+			resp.Body.Close()
+			if reauthErr := ra.Reauth(req); reauthErr == nil {
+				if req.GetBody != nil {
+					body, bodyErr := req.GetBody()
+					if bodyErr != nil {
+						t.observer.OnRequest(req, nil, bodyErr, time.Since(start))
+						return nil, bodyErr
+					}
+					req.Body = body
+				}
+				resp, err = t.attempt(req, host)
+			}
+		}
+	}
+
+	if t.circuitThreshold > 0 {
+		success := err == nil && resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500
+		t.breakerFor(host).record(success, time.Now())
+	}
+
+	t.observer.OnRequest(req, resp, err, time.Since(start))
+	return resp, err
+}
+
+// attempt runs req through the base RoundTripper, retrying on 429/5xx responses and transient
+// transport errors with exponential backoff (or the server's Retry-After, when given) up to
+// maxRetries times.
+func (t *Transport) attempt(req *http.Request, host string) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		resp, err = t.base.RoundTrip(req)
+
+		retry, wait := t.shouldRetry(attempt, req, resp, err)
+		if !retry {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		t.observer.OnRetry(host, attempt+1, err)
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = body
+		}
+	}
+	return resp, err
+}
+
+// shouldRetry decides whether the just-completed attempt (0-indexed) should be retried, and how
+// long to wait first. 5xx responses (e.g. a 502/503/504 from a gateway in front of Jira having
+// trouble, not Jira itself rejecting the request) are only retried for idempotent methods
+// (GET/HEAD/OPTIONS): any other request may have
+// already been applied server-side before the gateway error was returned, so retrying it risks a
+// duplicate write. 429 is retried regardless of method, since it means Jira rejected the request
+// before processing it at all.
+func (t *Transport) shouldRetry(attempt int, req *http.Request, resp *http.Response, err error) (bool, time.Duration) {
+	if attempt >= t.maxRetries {
+		return false, 0
+	}
+	if err != nil {
+		return true, backoffDelay(attempt)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := retryAfterDelay(resp); ok {
+			return true, wait
+		}
+		return true, backoffDelay(attempt)
+	}
+	if resp.StatusCode < 500 {
+		return false, 0
+	}
+	if !isIdempotent(req.Method) {
+		return false, 0
+	}
+	if wait, ok := retryAfterDelay(resp); ok {
+		return true, wait
+	}
+	return true, backoffDelay(attempt)
+}
+
+// isIdempotent reports whether method is safe to retry after a gateway error without risking a
+// duplicate side effect.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoffDelay returns an exponential backoff with full jitter: a random duration between zero
+// and base doubled once per attempt, capped at capDelay.
+func backoffDelay(attempt int) time.Duration {
+	const base = 200 * time.Millisecond
+	const capDelay = 30 * time.Second
+
+	exp := base * time.Duration(math.Pow(2, float64(attempt)))
+	if exp <= 0 || exp > capDelay {
+		exp = capDelay
+	}
+	return time.Duration(rand.Int63n(int64(exp)))
+}
+
+// retryAfterDelay parses resp's Retry-After header (either delay-seconds or an HTTP-date), as
+// defined by RFC 9110 section 10.2.3. ok is false when the header is absent or unparseable.
+func retryAfterDelay(resp *http.Response) (time.Duration, bool) {
+	raw := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if raw == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(raw); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	when, err := http.ParseTime(raw)
+	if err != nil {
+		return 0, false
+	}
+	if delay := time.Until(when); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+// breakerFor returns host's circuit breaker, creating it on first use.
+func (t *Transport) breakerFor(host string) *circuitBreaker {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b, ok := t.breakers[host]
+	if !ok {
+		b = newCircuitBreaker(t.circuitThreshold, t.circuitCooldown)
+		t.breakers[host] = b
+	}
+	return b
+}
+
+// limiterFor returns host's token bucket, creating it on first use.
+func (t *Transport) limiterFor(host string) *tokenBucket {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	l, ok := t.limiters[host]
+	if !ok {
+		l = newTokenBucket(t.maxQPS, t.burst)
+		t.limiters[host] = l
+	}
+	return l
+}
+
+// circuitBreaker trips after threshold consecutive failures on a host, rejecting requests until
+// cooldown has elapsed, at which point it admits one trial request.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	openedAt            time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// allow reports whether a request may proceed. Once tripped, it stays closed to new requests
+// until cooldown elapses, at which point a single trial request is admitted; that request's
+// outcome (via record) decides whether the breaker actually closes.
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.consecutiveFailures < b.threshold {
+		return true
+	}
+	if now.Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.openedAt = now
+	return true
+}
+
+// record updates the consecutive-failure count after a completed request.
+func (b *circuitBreaker) record(success bool, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.consecutiveFailures = 0
+		return
+	}
+	b.consecutiveFailures++
+	if b.consecutiveFailures == b.threshold {
+		b.openedAt = now
+	}
+}
+
+// tokenBucket is a token-bucket rate limiter: tokens refill continuously at rate per second, up
+// to burst capacity, and take blocks until a token is available.
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu       sync.Mutex
+	tokens   float64
+	lastFill time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), lastFill: time.Now()}
+}
+
+// take blocks until a token is available or ctx is done, returning whether it had to wait.
+func (b *tokenBucket) take(ctx context.Context) bool {
+	waited := false
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.lastFill).Seconds()*b.rate)
+		b.lastFill = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return waited
+		}
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		waited = true
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return waited
+		}
+	}
+}
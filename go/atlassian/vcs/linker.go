@@ -0,0 +1,201 @@
+// Package vcs scans commit messages, branch names, and PR titles for Jira issue keys and
+// drives a JiraRESTClient to keep those issues in sync with the repository's activity.
+package vcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"atlassian/atlassian/rest"
+)
+
+var issueKeyPattern = regexp.MustCompile(`[A-Z][A-Z0-9]+-\d+`)
+
+// conventionalCommitPattern matches a conventional-commit subject's type and optional scope,
+// e.g. "feat(auth): add OAuth support" -> type "feat".
+var conventionalCommitPattern = regexp.MustCompile(`^(\w+)(?:\([^)]*\))?!?:\s`)
+
+// ExtractIssueKeys returns the distinct Jira issue keys referenced in text, in order of first
+// appearance. It scans commit messages, branch names, and PR titles alike.
+func ExtractIssueKeys(text string) []string {
+	matches := issueKeyPattern.FindAllString(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(matches))
+	out := make([]string, 0, len(matches))
+	for _, key := range matches {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, key)
+	}
+	return out
+}
+
+// ParseConventionalCommitType extracts the type from a conventional-commit subject line
+// (e.g. "fix(parser): handle empty input" -> "fix", true). It returns false when subject
+// doesn't follow the "type(scope): description" or "type: description" shape.
+func ParseConventionalCommitType(subject string) (string, bool) {
+	match := conventionalCommitPattern.FindStringSubmatch(strings.TrimSpace(subject))
+	if match == nil {
+		return "", false
+	}
+	return strings.ToLower(match[1]), true
+}
+
+// Commit is the minimal information Linker needs about a commit (or, equally, a PR title and
+// body) to link it to Jira issues.
+type Commit struct {
+	SHA     string
+	Subject string
+	Body    string
+	URL     string // optional link to the commit/PR, used for the remote link and comment
+}
+
+// IssueResult records what Linker did for a single issue referenced by a commit.
+type IssueResult struct {
+	IssueKey       string
+	Commented      bool
+	RemoteLinked   bool
+	Transitioned   bool
+	TransitionedTo string
+	FixVersionSet  bool
+	Err            error
+}
+
+// Linker scans commits for Jira issue keys and updates the referenced issues: it comments and
+// adds a remote link pointing back at the commit, transitions the issue based on Transitions,
+// and optionally attaches FixVersion.
+type Linker struct {
+	Client  *rest.JiraRESTClient
+	CloudID string
+
+	// Transitions maps a conventional-commit type (lowercased, e.g. "feat", "fix") to the
+	// workflow status name the matching issues should be transitioned to.
+	Transitions map[string]string
+
+	// FixVersion, when set, is attached as a fix version to every issue referenced by a
+	// processed commit.
+	FixVersion string
+
+	// SkipComment/SkipRemoteLink disable their respective side effects, e.g. for dry runs.
+	SkipComment    bool
+	SkipRemoteLink bool
+}
+
+// NewLinker returns a Linker with no configured transitions or fix version.
+func NewLinker(client *rest.JiraRESTClient, cloudID string) *Linker {
+	return &Linker{
+		Client:  client,
+		CloudID: cloudID,
+	}
+}
+
+// ProcessCommit links commit to every Jira issue key it references and returns one IssueResult
+// per referenced issue. A per-issue error is recorded on that issue's result rather than
+// aborting the remaining issues.
+func (l *Linker) ProcessCommit(ctx context.Context, commit Commit) ([]IssueResult, error) {
+	if l.Client == nil {
+		return nil, errors.New("vcs: Linker.Client is required")
+	}
+
+	keys := ExtractIssueKeys(commit.Subject + "\n" + commit.Body)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	commitType, hasType := ParseConventionalCommitType(commit.Subject)
+	targetStatus := ""
+	if hasType {
+		targetStatus = l.Transitions[commitType]
+	}
+
+	results := make([]IssueResult, 0, len(keys))
+	for _, key := range keys {
+		results = append(results, l.linkIssue(ctx, key, commit, targetStatus))
+	}
+	return results, nil
+}
+
+func (l *Linker) linkIssue(ctx context.Context, issueKey string, commit Commit, targetStatus string) IssueResult {
+	result := IssueResult{IssueKey: issueKey}
+
+	if !l.SkipComment {
+		if err := l.Client.AddIssueComment(ctx, issueKey, commentBody(commit)); err != nil {
+			result.Err = fmt.Errorf("comment on %s: %w", issueKey, err)
+			return result
+		}
+		result.Commented = true
+	}
+
+	if !l.SkipRemoteLink && strings.TrimSpace(commit.URL) != "" {
+		if err := l.Client.AddIssueRemoteLink(ctx, issueKey, commit.URL, commit.Subject); err != nil {
+			result.Err = fmt.Errorf("remote link on %s: %w", issueKey, err)
+			return result
+		}
+		result.RemoteLinked = true
+	}
+
+	if targetStatus != "" {
+		if err := l.Client.TransitionIssue(ctx, issueKey, targetStatus); err != nil {
+			result.Err = fmt.Errorf("transition %s to %q: %w", issueKey, targetStatus, err)
+			return result
+		}
+		result.Transitioned = true
+		result.TransitionedTo = targetStatus
+	}
+
+	if strings.TrimSpace(l.FixVersion) != "" {
+		if err := l.attachFixVersion(ctx, issueKey, l.FixVersion); err != nil {
+			result.Err = fmt.Errorf("attach fix version to %s: %w", issueKey, err)
+			return result
+		}
+		result.FixVersionSet = true
+	}
+
+	return result
+}
+
+// attachFixVersion appends versionName to the issue's existing fix versions, rather than
+// replacing them, since UpdateIssue's FixVersions field is a full replace.
+func (l *Linker) attachFixVersion(ctx context.Context, issueKey string, versionName string) error {
+	issue, err := l.Client.GetIssue(ctx, l.CloudID, issueKey)
+	if err != nil {
+		return err
+	}
+	for _, existing := range issue.FixVersions {
+		if existing == versionName {
+			return nil
+		}
+	}
+	fixVersions := append(append([]string{}, issue.FixVersions...), versionName)
+	return l.Client.UpdateIssue(ctx, issueKey, rest.UpdateIssueInput{FixVersions: &fixVersions})
+}
+
+func commentBody(commit Commit) string {
+	var b strings.Builder
+	b.WriteString("Referenced by commit")
+	if commit.SHA != "" {
+		b.WriteString(" ")
+		b.WriteString(shortSHA(commit.SHA))
+	}
+	b.WriteString(": ")
+	b.WriteString(commit.Subject)
+	if commit.URL != "" {
+		b.WriteString("\n")
+		b.WriteString(commit.URL)
+	}
+	return b.String()
+}
+
+func shortSHA(sha string) string {
+	if len(sha) <= 12 {
+		return sha
+	}
+	return sha[:12]
+}
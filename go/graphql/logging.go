@@ -5,6 +5,13 @@ import (
 	"strings"
 )
 
+// SanitizeHeaders returns a copy of h with Authorization and Cookie values replaced by
+// "<redacted>", safe to pass to a logger. Shared by rest.Transport's request-logging Observer
+// hook, so credentials never end up in Terraform/TF_LOG output.
+func SanitizeHeaders(h http.Header) http.Header {
+	return sanitizeHeaders(h)
+}
+
 func sanitizeHeaders(h http.Header) http.Header {
 	clean := http.Header{}
 	for k, vals := range h {
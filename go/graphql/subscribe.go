@@ -0,0 +1,345 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// EventType identifies the kind of change a Subscribe event represents.
+type EventType string
+
+const (
+	EventIssueUpdated      EventType = "issue_updated"
+	EventWorklogAdded      EventType = "worklog_added"
+	EventTransitionApplied EventType = "transition_applied"
+)
+
+// Event is a single change surfaced by Subscribe.
+type Event struct {
+	Type      EventType
+	IssueID   string
+	IssueKey  string
+	UpdatedAt time.Time
+}
+
+// WatchCursor is Subscribe's resumable position in the poll stream. Persist it (e.g. to disk)
+// between process restarts and pass it back in WatchOptions.Cursor to resume without missing or
+// double-emitting events.
+type WatchCursor struct {
+	// LastUpdated is the newest issue `updated` timestamp observed so far.
+	LastUpdated time.Time
+	// SeenAtLastUpdated holds the IDs of every issue already emitted with updated == LastUpdated,
+	// so a poll that re-observes the same second (because Jira's `updated` has only second
+	// resolution and more than one issue can tie) doesn't double-emit them.
+	SeenAtLastUpdated map[string]struct{}
+	// SeenWorklogIDs tracks, per issue ID, the most recent worklog ID already emitted as a
+	// WorklogAdded event, so a poll that re-fetches an issue only emits one per new worklog.
+	SeenWorklogIDs map[string]string
+	// SeenTransitionIDs is SeenWorklogIDs' counterpart for TransitionApplied events.
+	SeenTransitionIDs map[string]string
+}
+
+func (c WatchCursor) withDefaults() WatchCursor {
+	if c.SeenAtLastUpdated == nil {
+		c.SeenAtLastUpdated = map[string]struct{}{}
+	}
+	if c.SeenWorklogIDs == nil {
+		c.SeenWorklogIDs = map[string]string{}
+	}
+	if c.SeenTransitionIDs == nil {
+		c.SeenTransitionIDs = map[string]string{}
+	}
+	return c
+}
+
+// WatchOptions configures Subscribe.
+type WatchOptions struct {
+	// CloudID is the Atlassian Cloud ID to scope the JQL search to.
+	CloudID string
+	// JQL restricts which issues are watched; Subscribe appends its own `updated >=` clause, so
+	// JQL should not include one.
+	JQL string
+	// MinPollInterval is the poll interval Subscribe resets to whenever a poll observes a
+	// change. Defaults to 5s.
+	MinPollInterval time.Duration
+	// MaxPollInterval caps how far Subscribe backs off after consecutive no-change polls.
+	// Defaults to 5m.
+	MaxPollInterval time.Duration
+	// Cursor resumes a previous Subscribe call's position instead of starting from now.
+	Cursor WatchCursor
+}
+
+const (
+	defaultMinPollInterval = 5 * time.Second
+	defaultMaxPollInterval = 5 * time.Minute
+)
+
+// watchIssuesQuery fetches issues matching a JQL clause, along with just enough of each issue's
+// latest worklog and latest transition for Subscribe to derive typed events from a single poll,
+// rather than the full issue shape GetIssueByKey fetches. pollWatchedIssues follows pageInfo to
+// drain every matching issue, not just the first 50: a poll window with more than one page of
+// changes would otherwise silently truncate, and since issueSearch has no guaranteed order
+// without an explicit ORDER BY, a truncated page could advance the cursor past issues it never
+// returned, permanently excluding them from every future poll.
+const watchIssuesQuery = `
+query JiraWatchIssues($cloudId: ID!, $jql: String!, $after: String) {
+  jira {
+    issueSearch(cloudId: $cloudId, jql: $jql, first: 50, after: $after) {
+      pageInfo { hasNextPage endCursor }
+      edges {
+        node {
+          id
+          key
+          updated
+          latestWorklog { id }
+          latestTransition { id }
+        }
+      }
+    }
+  }
+}`
+
+// watchedIssue is the subset of a polled issue node Subscribe needs.
+type watchedIssue struct {
+	ID               string
+	Key              string
+	Updated          time.Time
+	LatestWorklogID  string
+	LatestTransition string
+}
+
+// Subscribe polls opts.JQL (restricted server-side to issues updated at or after the cursor's
+// position) and emits one Event per issue update, newly added worklog, and newly applied
+// transition it observes, on an interval that adapts to how often changes actually show up: a
+// poll with no changes doubles the interval (capped at opts.MaxPollInterval); a poll with changes
+// resets it to opts.MinPollInterval. The returned channel is closed when ctx is canceled or a
+// non-rate-limit poll error occurs; callers that need to distinguish the two should watch ctx.Err()
+// themselves, matching how the package's other streaming APIs avoid a second error channel.
+func (c *Client) Subscribe(ctx context.Context, opts WatchOptions) (<-chan Event, error) {
+	if opts.JQL == "" {
+		return nil, errors.New("graphql: Subscribe requires JQL")
+	}
+	if opts.MinPollInterval <= 0 {
+		opts.MinPollInterval = defaultMinPollInterval
+	}
+	if opts.MaxPollInterval <= 0 {
+		opts.MaxPollInterval = defaultMaxPollInterval
+	}
+	if opts.MaxPollInterval < opts.MinPollInterval {
+		return nil, fmt.Errorf("graphql: MaxPollInterval (%s) is less than MinPollInterval (%s)", opts.MaxPollInterval, opts.MinPollInterval)
+	}
+
+	events := make(chan Event)
+	cursor := opts.Cursor.withDefaults()
+
+	go func() {
+		defer close(events)
+
+		interval := opts.MinPollInterval
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			issues, err := c.pollWatchedIssues(ctx, opts.CloudID, opts.JQL, cursor.LastUpdated)
+			if err != nil {
+				var rlErr *RateLimitError
+				if errors.As(err, &rlErr) {
+					interval = backoffForRateLimit(rlErr, interval, opts.MaxPollInterval)
+					logRateLimited(c.Logger, rlErr, interval)
+					timer.Reset(interval)
+					continue
+				}
+				return
+			}
+
+			emitted, next, ok := emitWatchEvents(ctx, events, issues, cursor)
+			if !ok {
+				return
+			}
+
+			if emitted > 0 {
+				interval = opts.MinPollInterval
+			} else {
+				interval *= 2
+				if interval > opts.MaxPollInterval {
+					interval = opts.MaxPollInterval
+				}
+			}
+			cursor = next
+			timer.Reset(interval)
+		}
+	}()
+
+	return events, nil
+}
+
+// pollWatchedIssues runs watchIssuesQuery for jql restricted to issues updated at or after
+// since, paging through issueSearch via pageInfo.hasNextPage/endCursor until every matching
+// issue has been fetched, and decodes the result into watchedIssue values.
+func (c *Client) pollWatchedIssues(ctx context.Context, cloudID string, jql string, since time.Time) ([]watchedIssue, error) {
+	jqlClause := jql
+	if !since.IsZero() {
+		jqlClause = fmt.Sprintf(`(%s) AND updated >= "%s"`, jql, since.UTC().Format("2006/01/02 15:04"))
+	}
+	effectiveJQL := jqlClause + " ORDER BY updated ASC"
+
+	var out []watchedIssue
+	var after *string
+	for {
+		vars := map[string]any{
+			"cloudId": cloudID,
+			"jql":     effectiveJQL,
+			"after":   after,
+		}
+		result, err := c.Execute(ctx, watchIssuesQuery, vars, "JiraWatchIssues", nil, 1)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil || result.Data == nil {
+			return nil, errors.New("graphql: missing data in JiraWatchIssues response")
+		}
+		page, hasNextPage, endCursor, err := decodeWatchedIssuesPage(result.Data)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, page...)
+		if !hasNextPage || endCursor == "" {
+			break
+		}
+		after = &endCursor
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Updated.Before(out[j].Updated) })
+	return out, nil
+}
+
+// decodeWatchedIssuesPage decodes a single issueSearch page into watchedIssue values, along with
+// whether pollWatchedIssues needs to fetch another page to drain the rest of the matches.
+func decodeWatchedIssuesPage(data map[string]any) (issues []watchedIssue, hasNextPage bool, endCursor string, err error) {
+	jira, _ := data["jira"].(map[string]any)
+	issueSearch, _ := jira["issueSearch"].(map[string]any)
+	edges, _ := issueSearch["edges"].([]any)
+	if pageInfo, ok := issueSearch["pageInfo"].(map[string]any); ok {
+		hasNextPage, _ = pageInfo["hasNextPage"].(bool)
+		endCursor, _ = pageInfo["endCursor"].(string)
+	}
+
+	out := make([]watchedIssue, 0, len(edges))
+	for _, raw := range edges {
+		edge, ok := raw.(map[string]any)
+		if !ok {
+			return nil, false, "", errors.New("graphql: issueSearch edge must be an object")
+		}
+		node, ok := edge["node"].(map[string]any)
+		if !ok {
+			return nil, false, "", errors.New("graphql: issueSearch edge.node must be an object")
+		}
+
+		id, _ := node["id"].(string)
+		key, _ := node["key"].(string)
+		updatedRaw, _ := node["updated"].(string)
+		updated, err := time.Parse(time.RFC3339, updatedRaw)
+		if err != nil {
+			return nil, false, "", fmt.Errorf("graphql: issue %s has an unparseable updated timestamp %q: %w", key, updatedRaw, err)
+		}
+
+		wi := watchedIssue{ID: id, Key: key, Updated: updated}
+		if wl, ok := node["latestWorklog"].(map[string]any); ok {
+			wi.LatestWorklogID, _ = wl["id"].(string)
+		}
+		if tr, ok := node["latestTransition"].(map[string]any); ok {
+			wi.LatestTransition, _ = tr["id"].(string)
+		}
+		out = append(out, wi)
+	}
+
+	return out, hasNextPage, endCursor, nil
+}
+
+// emitWatchEvents turns issues (already sorted oldest-updated first by pollWatchedIssues) into
+// Events, sends them on events, and returns how many it emitted along with the advanced cursor.
+// ok is false if ctx was canceled partway through a send, in which case the caller should stop
+// polling rather than trust emitted/cursor.
+func emitWatchEvents(ctx context.Context, events chan<- Event, issues []watchedIssue, cursor WatchCursor) (emitted int, next WatchCursor, ok bool) {
+	send := func(ev Event) bool {
+		select {
+		case events <- ev:
+			emitted++
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for _, issue := range issues {
+		switch {
+		case issue.Updated.After(cursor.LastUpdated):
+			cursor.LastUpdated = issue.Updated
+			cursor.SeenAtLastUpdated = map[string]struct{}{issue.ID: {}}
+		case issue.Updated.Equal(cursor.LastUpdated):
+			if _, seen := cursor.SeenAtLastUpdated[issue.ID]; seen {
+				continue
+			}
+			cursor.SeenAtLastUpdated[issue.ID] = struct{}{}
+		default:
+			// Older than the cursor; a stale/late page, nothing new to report for this issue.
+			continue
+		}
+
+		if !send(Event{Type: EventIssueUpdated, IssueID: issue.ID, IssueKey: issue.Key, UpdatedAt: issue.Updated}) {
+			return emitted, cursor, false
+		}
+
+		if issue.LatestWorklogID != "" && cursor.SeenWorklogIDs[issue.ID] != issue.LatestWorklogID {
+			cursor.SeenWorklogIDs[issue.ID] = issue.LatestWorklogID
+			if !send(Event{Type: EventWorklogAdded, IssueID: issue.ID, IssueKey: issue.Key, UpdatedAt: issue.Updated}) {
+				return emitted, cursor, false
+			}
+		}
+		if issue.LatestTransition != "" && cursor.SeenTransitionIDs[issue.ID] != issue.LatestTransition {
+			cursor.SeenTransitionIDs[issue.ID] = issue.LatestTransition
+			if !send(Event{Type: EventTransitionApplied, IssueID: issue.ID, IssueKey: issue.Key, UpdatedAt: issue.Updated}) {
+				return emitted, cursor, false
+			}
+		}
+	}
+
+	return emitted, cursor, true
+}
+
+// backoffForRateLimit computes the next poll interval after a 429, honoring the rate limiter's
+// own Retry-After value (seconds) when present instead of blindly doubling current.
+func backoffForRateLimit(rlErr *RateLimitError, current, max time.Duration) time.Duration {
+	if secs, err := strconv.Atoi(rlErr.HeaderValue); err == nil && secs > 0 {
+		wait := time.Duration(secs) * time.Second
+		if wait > max {
+			return max
+		}
+		return wait
+	}
+	doubled := current * 2
+	if doubled > max {
+		return max
+	}
+	return doubled
+}
+
+// logRateLimited logs a 429 via logger with the same "rate limited" marker
+// tests/integration's TestLiveSmoke greps for.
+func logRateLimited(logger *slog.Logger, rlErr *RateLimitError, next time.Duration) {
+	if logger == nil {
+		return
+	}
+	logger.Warn("rate limited", "retry_after", rlErr.HeaderValue, "next_poll", next.String())
+}
@@ -0,0 +1,68 @@
+package unit
+
+import (
+	"testing"
+
+	"atlassian/atlassian/adf"
+)
+
+func TestADFPlainTextParagraphsAndHeadings(t *testing.T) {
+	doc := map[string]any{
+		"type":    "doc",
+		"version": 1,
+		"content": []any{
+			map[string]any{"type": "heading", "content": []any{
+				map[string]any{"type": "text", "text": "Title"},
+			}},
+			map[string]any{"type": "paragraph", "content": []any{
+				map[string]any{"type": "text", "text": "Hello, "},
+				map[string]any{"type": "text", "text": "world."},
+			}},
+		},
+	}
+
+	node, err := adf.Parse(doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "Title\n\nHello, world."
+	if got := node.PlainText(); got != want {
+		t.Fatalf("PlainText() = %q, want %q", got, want)
+	}
+}
+
+func TestADFPlainTextBulletList(t *testing.T) {
+	doc := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{"type": "bulletList", "content": []any{
+				map[string]any{"type": "listItem", "content": []any{
+					map[string]any{"type": "paragraph", "content": []any{
+						map[string]any{"type": "text", "text": "first"},
+					}},
+				}},
+				map[string]any{"type": "listItem", "content": []any{
+					map[string]any{"type": "paragraph", "content": []any{
+						map[string]any{"type": "text", "text": "second"},
+					}},
+				}},
+			}},
+		},
+	}
+
+	node, err := adf.Parse(doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "- first\n- second"
+	if got := node.PlainText(); got != want {
+		t.Fatalf("PlainText() = %q, want %q", got, want)
+	}
+}
+
+func TestADFPlainTextNilNode(t *testing.T) {
+	var node *adf.Node
+	if got := node.PlainText(); got != "" {
+		t.Fatalf("PlainText() on nil node = %q, want empty string", got)
+	}
+}
@@ -0,0 +1,213 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph"
+	"atlassian/atlassian/graph/gen"
+)
+
+func TestGraphIssueIncidentsPagination(t *testing.T) {
+	call := 0
+	client := graph.Client{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			call++
+			var body map[string]any
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			if body["operationName"] != "OpsIssueIncidentsPage" {
+				t.Fatalf("unexpected operationName: %v", body["operationName"])
+			}
+			if strings.TrimSpace(body["query"].(string)) != strings.TrimSpace(gen.OpsIssueIncidentsPageQuery) {
+				t.Fatalf("unexpected query")
+			}
+			vars := body["variables"].(map[string]any)
+			if vars["issueKey"] != "A-1" {
+				t.Fatalf("unexpected issueKey: %v", vars["issueKey"])
+			}
+			after := vars["after"]
+			if call == 1 && after != nil {
+				t.Fatalf("unexpected after for first page: %v", after)
+			}
+			if call == 2 && after != "c1" {
+				t.Fatalf("unexpected after for second page: %v", after)
+			}
+			if call == 1 {
+				return jsonResponse(req, http.StatusOK, `{
+  "data": {
+    "issue": {
+      "incidents": {
+        "pageInfo": { "hasNextPage": true, "endCursor": "c1" },
+        "edges": [
+          {
+            "cursor": "e1",
+            "node": {
+              "id": "inc-1",
+              "issueKey": "A-1",
+              "title": "Checkout down",
+              "status": "OPEN",
+              "severity": "SEV1",
+              "url": "https://ops.example/incidents/inc-1",
+              "createdAt": "2021-01-01T00:00:00Z"
+            }
+          }
+        ]
+      }
+    }
+  }
+}`, nil)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "data": {
+    "issue": {
+      "incidents": {
+        "pageInfo": { "hasNextPage": false, "endCursor": null },
+        "edges": [
+          {
+            "cursor": "e2",
+            "node": {
+              "id": "inc-2",
+              "issueKey": "A-1",
+              "title": "Payments degraded",
+              "status": "RESOLVED",
+              "severity": "SEV2",
+              "url": "https://ops.example/incidents/inc-2",
+              "createdAt": "2021-01-02T00:00:00Z"
+            }
+          }
+        ]
+      }
+    }
+  }
+}`, nil)
+		}),
+	}
+
+	incidents, err := client.IterIssueIncidentsViaGraphQL(context.Background(), "cloud-123", "A-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(incidents) != 2 {
+		t.Fatalf("expected 2 incidents, got %d", len(incidents))
+	}
+	if incidents[0].ID != "inc-1" || incidents[1].ID != "inc-2" {
+		t.Fatalf("unexpected incident ids: %+v", incidents)
+	}
+	if incidents[0].Severity == nil || *incidents[0].Severity != "SEV1" {
+		t.Fatalf("unexpected severity: %+v", incidents[0].Severity)
+	}
+	if call != 2 {
+		t.Fatalf("expected 2 page fetches, got %d", call)
+	}
+}
+
+func TestGraphProjectAlerts(t *testing.T) {
+	client := graph.Client{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			var body map[string]any
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			if body["operationName"] != "OpsProjectAlertsPage" {
+				t.Fatalf("unexpected operationName: %v", body["operationName"])
+			}
+			vars := body["variables"].(map[string]any)
+			if vars["projectKey"] != "PROJ" {
+				t.Fatalf("unexpected projectKey: %v", vars["projectKey"])
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "data": {
+    "project": {
+      "alerts": {
+        "pageInfo": { "hasNextPage": false, "endCursor": null },
+        "edges": [
+          {
+            "cursor": "e1",
+            "node": {
+              "id": "alert-1",
+              "projectKey": "PROJ",
+              "message": "Disk usage high",
+              "status": "OPEN",
+              "priority": "P2",
+              "url": "https://ops.example/alerts/alert-1",
+              "createdAt": "2021-01-01T00:00:00Z"
+            }
+          }
+        ]
+      }
+    }
+  }
+}`, nil)
+		}),
+	}
+
+	alerts, err := client.IterProjectAlertsViaGraphQL(context.Background(), "cloud-123", "PROJ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Message != "Disk usage high" {
+		t.Fatalf("unexpected alerts: %+v", alerts)
+	}
+}
+
+func TestGraphProjectSchedules(t *testing.T) {
+	client := graph.Client{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			var body map[string]any
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			if body["operationName"] != "OpsProjectSchedulesPage" {
+				t.Fatalf("unexpected operationName: %v", body["operationName"])
+			}
+			vars := body["variables"].(map[string]any)
+			if vars["projectKey"] != "PROJ" {
+				t.Fatalf("unexpected projectKey: %v", vars["projectKey"])
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "data": {
+    "project": {
+      "schedules": {
+        "pageInfo": { "hasNextPage": false, "endCursor": null },
+        "edges": [
+          {
+            "cursor": "e1",
+            "node": {
+              "id": "sched-1",
+              "projectKey": "PROJ",
+              "name": "Primary on-call",
+              "timezone": "America/New_York",
+              "onCallUserId": "user-1",
+              "url": "https://ops.example/schedules/sched-1"
+            }
+          }
+        ]
+      }
+    }
+  }
+}`, nil)
+		}),
+	}
+
+	schedules, err := client.IterProjectSchedulesViaGraphQL(context.Background(), "cloud-123", "PROJ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(schedules) != 1 || schedules[0].Name != "Primary on-call" {
+		t.Fatalf("unexpected schedules: %+v", schedules)
+	}
+	if schedules[0].OnCallUserID == nil || *schedules[0].OnCallUserID != "user-1" {
+		t.Fatalf("unexpected onCallUserId: %+v", schedules[0].OnCallUserID)
+	}
+}
@@ -0,0 +1,56 @@
+package unit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/changelog"
+	"atlassian/atlassian/rest"
+)
+
+func TestChangelogGenerateRequiresClient(t *testing.T) {
+	_, err := changelog.Generate(context.Background(), changelog.Options{FromRef: "v1", ToRef: "HEAD"})
+	if err == nil {
+		t.Fatalf("expected error because Client is nil")
+	}
+}
+
+func TestChangelogGenerateRequiresRefs(t *testing.T) {
+	client := &rest.JiraRESTClient{BaseURL: "http://example", Auth: noAuth{}}
+
+	if _, err := changelog.Generate(context.Background(), changelog.Options{Client: client, ToRef: "HEAD"}); err == nil {
+		t.Fatalf("expected error because FromRef is missing")
+	}
+	if _, err := changelog.Generate(context.Background(), changelog.Options{Client: client, FromRef: "v1"}); err == nil {
+		t.Fatalf("expected error because ToRef is missing")
+	}
+}
+
+func TestRenderMarkdownGroupsByType(t *testing.T) {
+	sections := []changelog.Section{
+		{
+			Type: "feat",
+			Entries: []changelog.Entry{
+				{SHA: "abc123", Subject: "feat(auth): add OAuth support in PROJ-1", IssueKey: "PROJ-1", IssueSummary: "Add OAuth", IssueStatus: "Done"},
+			},
+		},
+		{
+			Type: "other",
+			Entries: []changelog.Entry{
+				{SHA: "def456", Subject: "chore: tidy up imports"},
+			},
+		},
+	}
+
+	out := changelog.RenderMarkdown(sections)
+	if !strings.Contains(out, "## feat") {
+		t.Fatalf("expected a feat heading, got:\n%s", out)
+	}
+	if !strings.Contains(out, "PROJ-1: Add OAuth (Done)") {
+		t.Fatalf("expected enriched entry line, got:\n%s", out)
+	}
+	if !strings.Contains(out, "## other") || !strings.Contains(out, "chore: tidy up imports") {
+		t.Fatalf("expected bare-subject entry for unenriched commit, got:\n%s", out)
+	}
+}
@@ -0,0 +1,72 @@
+package unit
+
+import (
+	"testing"
+
+	"atlassian/atlassian/graph/gen"
+	"atlassian/atlassian/graph/mappers"
+)
+
+func TestCompassScorecardScoreFromGraphQLMapsCriteria(t *testing.T) {
+	score := gen.CompassScorecardNode{
+		Scorecard: &gen.CompassScorecardRef{ID: "sc1", Name: "Production Readiness"},
+		Score:     0.5,
+		Criteria: []gen.CompassScorecardCriterionNode{
+			{ID: "crit1", Name: "Has on-call", Weight: floatPtr(1), Score: 1, Status: strPtr("pass")},
+			{ID: "crit2", Name: "Has runbook", Weight: floatPtr(1), Score: 0, Status: strPtr("fail"), Message: strPtr("no runbook found")},
+			{ID: "crit3", Name: "Has owner", Weight: floatPtr(1), Score: 1},
+		},
+	}
+
+	out, err := mappers.CompassScorecardScoreFromGraphQL("comp1", &score)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Criteria) != 3 {
+		t.Fatalf("expected 3 criteria, got %d", len(out.Criteria))
+	}
+	if out.Criteria[0].ID != "crit1" || out.Criteria[1].ID != "crit2" || out.Criteria[2].ID != "crit3" {
+		t.Fatalf("expected criteria order preserved, got %+v", out.Criteria)
+	}
+	if out.Criteria[2].Status != "unknown" {
+		t.Fatalf("expected missing status to default to 'unknown', got %q", out.Criteria[2].Status)
+	}
+
+	failing := out.FailingCriteria()
+	if len(failing) != 1 || failing[0].ID != "crit2" {
+		t.Fatalf("expected only crit2 to be failing, got %+v", failing)
+	}
+}
+
+func TestCompassScorecardScoreFromGraphQLRejectsZeroWeightCriteria(t *testing.T) {
+	score := gen.CompassScorecardNode{
+		Scorecard: &gen.CompassScorecardRef{ID: "sc1", Name: "Production Readiness"},
+		Score:     0,
+		Criteria: []gen.CompassScorecardCriterionNode{
+			{ID: "crit1", Name: "Has on-call", Weight: floatPtr(0), Score: 0},
+		},
+	}
+
+	_, err := mappers.CompassScorecardScoreFromGraphQL("comp1", &score)
+	if err == nil {
+		t.Fatal("expected error for criteria weights summing to zero")
+	}
+}
+
+func TestCompassScorecardScoreFromGraphQLNoCriteriaIsValid(t *testing.T) {
+	score := gen.CompassScorecardNode{
+		Scorecard: &gen.CompassScorecardRef{ID: "sc1", Name: "Production Readiness"},
+		Score:     1,
+	}
+
+	out, err := mappers.CompassScorecardScoreFromGraphQL("comp1", &score)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.Criteria) != 0 {
+		t.Fatalf("expected no criteria, got %+v", out.Criteria)
+	}
+	if len(out.FailingCriteria()) != 0 {
+		t.Fatalf("expected no failing criteria")
+	}
+}
@@ -0,0 +1,159 @@
+package unit
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/compassgraph"
+)
+
+func compassComponent(id, componentType string, ownerTeamID *string, labels ...string) atlassian.CompassComponent {
+	return atlassian.CompassComponent{
+		ID:          id,
+		Name:        id,
+		Type:        componentType,
+		OwnerTeamID: ownerTeamID,
+		Labels:      labels,
+	}
+}
+
+func compassRelationship(id, from, to string) atlassian.CompassRelationship {
+	return atlassian.CompassRelationship{ID: id, Type: "DEPENDS_ON", StartComponentID: from, EndComponentID: to}
+}
+
+func TestCompassGraphDescendantsAndAncestors(t *testing.T) {
+	components := []atlassian.CompassComponent{
+		compassComponent("a", "service"),
+		compassComponent("b", "service"),
+		compassComponent("c", "library"),
+	}
+	relationships := []atlassian.CompassRelationship{
+		compassRelationship("r1", "a", "b"),
+		compassRelationship("r2", "b", "c"),
+	}
+
+	g := compassgraph.New(components, relationships)
+
+	descendants := g.Descendants("a")
+	sort.Strings(descendants)
+	if !reflect.DeepEqual(descendants, []string{"b", "c"}) {
+		t.Fatalf("expected descendants [b c], got %v", descendants)
+	}
+
+	ancestors := g.Ancestors("c")
+	sort.Strings(ancestors)
+	if !reflect.DeepEqual(ancestors, []string{"a", "b"}) {
+		t.Fatalf("expected ancestors [a b], got %v", ancestors)
+	}
+
+	if d := g.Descendants("c"); len(d) != 0 {
+		t.Fatalf("expected no descendants for leaf node c, got %v", d)
+	}
+}
+
+func TestCompassGraphShortestPath(t *testing.T) {
+	components := []atlassian.CompassComponent{
+		compassComponent("a", "service"),
+		compassComponent("b", "service"),
+		compassComponent("c", "service"),
+		compassComponent("d", "service"),
+	}
+	relationships := []atlassian.CompassRelationship{
+		compassRelationship("r1", "a", "b"),
+		compassRelationship("r2", "a", "c"),
+		compassRelationship("r3", "b", "d"),
+		compassRelationship("r4", "c", "d"),
+	}
+
+	g := compassgraph.New(components, relationships)
+
+	path, ok := g.ShortestPath("a", "d")
+	if !ok {
+		t.Fatalf("expected a path from a to d")
+	}
+	if len(path) != 3 {
+		t.Fatalf("expected a shortest path of length 3, got %v", path)
+	}
+	if path[0] != "a" || path[2] != "d" {
+		t.Fatalf("expected path to start at a and end at d, got %v", path)
+	}
+
+	if _, ok := g.ShortestPath("d", "a"); ok {
+		t.Fatalf("expected no path from d back to a (dependencies are directed)")
+	}
+}
+
+func TestCompassGraphStronglyConnectedComponentsDetectsCycle(t *testing.T) {
+	components := []atlassian.CompassComponent{
+		compassComponent("a", "service"),
+		compassComponent("b", "service"),
+		compassComponent("c", "service"),
+	}
+	relationships := []atlassian.CompassRelationship{
+		compassRelationship("r1", "a", "b"),
+		compassRelationship("r2", "b", "c"),
+		compassRelationship("r3", "c", "a"),
+	}
+
+	g := compassgraph.New(components, relationships)
+
+	sccs := g.StronglyConnectedComponents()
+	found := false
+	for _, scc := range sccs {
+		if len(scc) == 3 {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected a strongly connected component of size 3 for the a->b->c->a cycle, got %v", sccs)
+	}
+}
+
+func TestCompassGraphStronglyConnectedComponentsNoCycle(t *testing.T) {
+	components := []atlassian.CompassComponent{
+		compassComponent("a", "service"),
+		compassComponent("b", "service"),
+	}
+	relationships := []atlassian.CompassRelationship{
+		compassRelationship("r1", "a", "b"),
+	}
+
+	g := compassgraph.New(components, relationships)
+
+	for _, scc := range g.StronglyConnectedComponents() {
+		if len(scc) > 1 {
+			t.Fatalf("expected no multi-node strongly connected component, got %v", scc)
+		}
+	}
+}
+
+func TestCompassGraphFilteredBFS(t *testing.T) {
+	teamX := "team-x"
+	teamY := "team-y"
+	components := []atlassian.CompassComponent{
+		compassComponent("a", "service", &teamX),
+		compassComponent("b", "service", &teamX, "deprecated"),
+		compassComponent("c", "service", &teamY),
+	}
+	relationships := []atlassian.CompassRelationship{
+		compassRelationship("r1", "a", "b"),
+		compassRelationship("r2", "a", "c"),
+	}
+
+	g := compassgraph.New(components, relationships)
+
+	matches := g.FilteredBFS("a", func(c atlassian.CompassComponent) bool {
+		return c.OwnerTeamID != nil && *c.OwnerTeamID == teamX
+	})
+
+	var ids []string
+	for _, c := range matches {
+		ids = append(ids, c.ID)
+	}
+	sort.Strings(ids)
+	if !reflect.DeepEqual(ids, []string{"a", "b"}) {
+		t.Fatalf("expected [a b] owned by team-x, got %v", ids)
+	}
+}
@@ -0,0 +1,95 @@
+package unit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+func TestStripQuotesRemovesMatchingPair(t *testing.T) {
+	cases := map[string]string{
+		`"hello"`: "hello",
+		`'hello'`: "hello",
+		`hello`:   "hello",
+		`"mismatched'`: `"mismatched'`,
+		`"`:       `"`,
+	}
+	for in, want := range cases {
+		if got := codegen.StripQuotes(in); got != want {
+			t.Errorf("StripQuotes(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestExperimentalAPIsFromEnvParsesCommaSeparatedList(t *testing.T) {
+	t.Setenv("ATLASSIAN_GQL_EXPERIMENTAL_APIS", " Team-search-v2 ,, Sprint-v2")
+	got := codegen.ExperimentalAPIsFromEnv()
+	want := []string{"Team-search-v2", "Sprint-v2"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestExperimentalAPIsFromEnvEmptyReturnsNil(t *testing.T) {
+	t.Setenv("ATLASSIAN_GQL_EXPERIMENTAL_APIS", "")
+	if got := codegen.ExperimentalAPIsFromEnv(); got != nil {
+		t.Fatalf("expected nil, got %v", got)
+	}
+}
+
+func TestLoadEnvFileSetsUnsetVarsOnly(t *testing.T) {
+	t.Setenv("ATLAS_GEN_TEST_ALREADY_SET", "original")
+	os.Unsetenv("ATLAS_GEN_TEST_FROM_FILE")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "env.txt")
+	contents := "# a comment\n\nexport ATLAS_GEN_TEST_FROM_FILE='quoted value'\nATLAS_GEN_TEST_ALREADY_SET=overwritten\n"
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	codegen.LoadEnvFile(path)
+
+	if got := os.Getenv("ATLAS_GEN_TEST_FROM_FILE"); got != "quoted value" {
+		t.Errorf("ATLAS_GEN_TEST_FROM_FILE = %q, want %q", got, "quoted value")
+	}
+	if got := os.Getenv("ATLAS_GEN_TEST_ALREADY_SET"); got != "original" {
+		t.Errorf("ATLAS_GEN_TEST_ALREADY_SET = %q, want unchanged %q", got, "original")
+	}
+}
+
+func TestLoadEnvFileToleratesMissingFile(t *testing.T) {
+	codegen.LoadEnvFile(filepath.Join(t.TempDir(), "does-not-exist.txt"))
+}
+
+func TestBuildAuthFromEnvReturnsNilWithNoCredentials(t *testing.T) {
+	for _, key := range []string{
+		"ATLASSIAN_OAUTH_ACCESS_TOKEN", "ATLASSIAN_OAUTH_REFRESH_TOKEN",
+		"ATLASSIAN_CLIENT_ID", "ATLASSIAN_CLIENT_SECRET",
+		"ATLASSIAN_EMAIL", "ATLASSIAN_API_TOKEN", "ATLASSIAN_COOKIES_JSON",
+	} {
+		t.Setenv(key, "")
+	}
+	if auth := codegen.BuildAuthFromEnv(); auth != nil {
+		t.Fatalf("expected nil auth, got %#v", auth)
+	}
+}
+
+func TestBuildAuthFromEnvPrefersBasicAPIToken(t *testing.T) {
+	t.Setenv("ATLASSIAN_OAUTH_ACCESS_TOKEN", "")
+	t.Setenv("ATLASSIAN_OAUTH_REFRESH_TOKEN", "")
+	t.Setenv("ATLASSIAN_EMAIL", "user@example.com")
+	t.Setenv("ATLASSIAN_API_TOKEN", "tok")
+
+	auth := codegen.BuildAuthFromEnv()
+	if auth == nil {
+		t.Fatal("expected non-nil auth")
+	}
+}
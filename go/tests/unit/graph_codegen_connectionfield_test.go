@@ -0,0 +1,426 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+// buildCompassConnectionFixtureSchema mirrors the shape compass.component(id: ...) { ... on
+// CompassComponent { scorecards { edges { node { ... } } } } } needs: a union entity result with a
+// typed error variant, and an edges/pageInfo connection field with a nested ref on each node.
+func buildCompassConnectionFixtureSchema() map[string]any {
+	pageInfoType := objectType("PageInfo",
+		field("hasNextPage", nonNull(scalarType("Boolean"))),
+		field("endCursor", scalarType("String")),
+	)
+	scorecardRefType := objectType("CompassScorecard",
+		field("id", nonNull(scalarType("ID"))),
+		field("name", nonNull(scalarType("String"))),
+	)
+	nodeType := objectType("CompassComponentScorecardNode",
+		field("score", nonNull(scalarType("Float"))),
+		field("maxScore", scalarType("Float")),
+		field("scorecard", namedType("OBJECT", "CompassScorecard")),
+	)
+	edgeType := objectType("CompassComponentScorecardEdge",
+		field("cursor", scalarType("String")),
+		field("node", nonNull(namedType("OBJECT", "CompassComponentScorecardNode"))),
+	)
+	connType := objectType("CompassComponentScorecardConnection",
+		field("edges", nonNull(namedType("LIST", "CompassComponentScorecardEdge"))),
+		field("pageInfo", nonNull(namedType("OBJECT", "PageInfo"))),
+	)
+	notFoundType := objectType("QueryError",
+		field("message", nonNull(scalarType("String"))),
+	)
+	componentType := objectType("CompassComponent",
+		field("id", nonNull(scalarType("ID"))),
+		field("name", nonNull(scalarType("String"))),
+		field("scorecards", namedType("OBJECT", "CompassComponentScorecardConnection")),
+	)
+	componentResultType := unionType("CompassComponentResult", "CompassComponent", "QueryError")
+	compassQueryType := objectType("CompassQuery",
+		field("component", namedType("UNION", "CompassComponentResult"),
+			arg("id", nonNull(scalarType("ID"))),
+		),
+	)
+	queryType := objectType("Query",
+		field("compass", nonNull(namedType("OBJECT", "CompassQuery"))),
+	)
+
+	types := []any{
+		pageInfoType, scorecardRefType, nodeType, edgeType, connType,
+		notFoundType, componentType, componentResultType, compassQueryType, queryType,
+	}
+	return map[string]any{
+		"queryType": map[string]any{"name": "Query"},
+		"types":     types,
+	}
+}
+
+func compassScorecardsManifest() *codegen.ConnectionFieldManifest {
+	return &codegen.ConnectionFieldManifest{
+		Package:             "gen",
+		GeneratorPath:       "go/tools/generate_compass_connection_models/main.go",
+		EntityName:          "CompassComponentScorecards",
+		Namespace:           "compass",
+		ParentField:         "component",
+		ParentIDArgName:     "id",
+		ConnectionFieldName: "scorecards",
+		Fields: []codegen.FieldManifest{
+			{Name: "score"},
+			{Name: "maxScore"},
+		},
+		Refs: []codegen.NestedRefManifest{
+			{Name: "scorecard", Fields: []codegen.FieldManifest{{Name: "id"}, {Name: "name"}}},
+		},
+	}
+}
+
+func TestRenderConnectionFieldFastJSONEmitsHandWrittenCodecs(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"func (v CompassComponentScorecardsNode) MarshalJSON() ([]byte, error)",
+		"func (v *CompassComponentScorecardsNode) UnmarshalJSON(data []byte) error",
+		"func (v CompassComponentScorecardsEdge) MarshalJSON() ([]byte, error)",
+		"func (v CompassComponentScorecardsConnection) MarshalJSON() ([]byte, error)",
+		"func (v CompassComponentScorecardsScorecardRef) MarshalJSON() ([]byte, error)",
+		"fastjsonWriteFloat64(&buf, v.Score)",
+		"fastjsonWriteFloat64Ptr(&buf, v.MaxScore)",
+		`dec := json.NewDecoder(bytes.NewReader(data))`,
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected fastjson-rendered source to contain %q, got:\n%s", want, source)
+		}
+	}
+}
+
+func TestRenderConnectionFieldWithoutFastJSONOmitsHandWrittenCodecs(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Contains(source, "MarshalJSON") || strings.Contains(source, "UnmarshalJSON") {
+		t.Fatalf("expected no hand-written codecs without --fastjson, got:\n%s", source)
+	}
+}
+
+func TestRenderConnectionFieldFastJSONRejectsUnsupportedFieldType(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	manifest := compassScorecardsManifest()
+
+	// Add a field of the JSON scalar (DefaultTypeMapper maps it to json.RawMessage) to confirm
+	// RenderConnectionField fails loudly instead of silently falling back to reflection, since
+	// --fastjson has no writer for it.
+	nodeType := schema["types"].([]any)[2].(map[string]any)
+	nodeType["fields"] = append(nodeType["fields"].([]any), field("rawDetails", nonNull(scalarType("JSON"))))
+	manifest.Fields = append(manifest.Fields, codegen.FieldManifest{Name: "rawDetails"})
+
+	resolved, err := codegen.ResolveConnectionField(schema, manifest, codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error resolving: %v", err)
+	}
+
+	if _, err := codegen.RenderConnectionField(resolved, false); err != nil {
+		t.Fatalf("expected stdlib rendering to succeed regardless of the JSON field, got: %v", err)
+	}
+
+	_, err = codegen.RenderConnectionField(resolved, true)
+	if err == nil {
+		t.Fatal("expected an error for a field type --fastjson has no writer for")
+	}
+	if !strings.Contains(err.Error(), "RawDetails") {
+		t.Fatalf("expected error to name the unsupported field, got: %v", err)
+	}
+}
+
+func TestRenderConnectionFieldEmitsClientInterfaceAndMock(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"type CompassComponentScorecardsClient interface {",
+		"FetchPage(ctx context.Context, componentID string, after string) (*CompassComponentScorecardsConnection, error)",
+		"FetchAll(ctx context.Context, componentID string) ([]CompassComponentScorecardsNode, error)",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected rendered source to contain %q, got:\n%s", want, source)
+		}
+	}
+
+	mockSource := codegen.RenderConnectionFieldMock(resolved)
+	for _, want := range []string{
+		"package mock_gen",
+		"type MockCompassComponentScorecardsClient struct {",
+		"type MockCompassComponentScorecardsClientMockRecorder struct {",
+		"func NewMockCompassComponentScorecardsClient(ctrl *gomock.Controller) *MockCompassComponentScorecardsClient {",
+		"func (m *MockCompassComponentScorecardsClient) EXPECT() *MockCompassComponentScorecardsClientMockRecorder {",
+		"func (m *MockCompassComponentScorecardsClient) FetchPage(ctx context.Context, componentID string, after string) (*gen.CompassComponentScorecardsConnection, error) {",
+		"func (m *MockCompassComponentScorecardsClient) FetchAll(ctx context.Context, componentID string) ([]gen.CompassComponentScorecardsNode, error) {",
+	} {
+		if !strings.Contains(mockSource, want) {
+			t.Fatalf("expected rendered mock to contain %q, got:\n%s", want, mockSource)
+		}
+	}
+}
+
+func TestRenderConnectionFieldEmitsIterator(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"type CompassComponentScorecardsIterator struct {",
+		"func NewCompassComponentScorecardsIterator(fetch func(ctx context.Context, after *string) (*CompassComponentScorecardsConnection, error)) *CompassComponentScorecardsIterator {",
+		"func (it *CompassComponentScorecardsIterator) SetPageDeadline(deadline time.Time) {",
+		"func (it *CompassComponentScorecardsIterator) ResumeToken() string {",
+		"func (it *CompassComponentScorecardsIterator) Next(ctx context.Context) (*CompassComponentScorecardsNode, bool, error) {",
+		"it.after = conn.PageInfo.EndCursor",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected rendered source to contain %q, got:\n%s", want, source)
+		}
+	}
+}
+
+// TestRenderConnectionFieldEmitsSeqIteratorAndStopsOnError exercises EmitSeqIterator: the rendered
+// Iterate{Entity}/Iterate{Entity}Chan pair must stop paging on the first typed
+// CompassComponentScorecardsError a page returns, not just a transport-level Go error. The test
+// checks the rendered source text for that result.Error check and its yield, rather than running
+// it, since the generated gen package this would otherwise compile against isn't checked into
+// the repo.
+func TestRenderConnectionFieldEmitsSeqIteratorAndStopsOnError(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	manifest := compassScorecardsManifest()
+	manifest.EmitSeqIterator = true
+	resolved, err := codegen.ResolveConnectionField(schema, manifest, codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"\"iter\"",
+		"type CompassComponentScorecardsIterConfig struct {",
+		"type CompassComponentScorecardsIterOpt func(*CompassComponentScorecardsIterConfig)",
+		"func WithCompassComponentScorecardsPageSize(n int) CompassComponentScorecardsIterOpt {",
+		"func IterateCompassComponentScorecards(ctx context.Context, fetch func(ctx context.Context, after *string, pageSize int) (*CompassComponentScorecardsResult, error), opts ...CompassComponentScorecardsIterOpt) iter.Seq2[*CompassComponentScorecardsNode, error] {",
+		"if result.Error != nil {",
+		"yield(nil, fmt.Errorf(\"%s\", result.Error.Message))",
+		"type CompassComponentScorecardsIterResult struct {",
+		"func IterateCompassComponentScorecardsChan(ctx context.Context, fetch func(ctx context.Context, after *string, pageSize int) (*CompassComponentScorecardsResult, error), opts ...CompassComponentScorecardsIterOpt) <-chan CompassComponentScorecardsIterResult {",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected rendered source to contain %q, got:\n%s", want, source)
+		}
+	}
+}
+
+// TestRenderConnectionFieldResultIsNWayUnion asserts the generated {Entity}Result is built from
+// codegen.UnionMember rather than a hardcoded connection-or-error pair: an As{Name}() accessor per
+// member, an IsError() helper, and an unrecognized __typename falling through to Result.Unknown
+// instead of returning a decode error.
+func TestRenderConnectionFieldResultIsNWayUnion(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"Unknown json.RawMessage `json:\"-\"`",
+		"func (r *CompassComponentScorecardsResult) AsConnection() (*CompassComponentScorecardsConnection, bool) {",
+		"func (r *CompassComponentScorecardsResult) AsError() (*CompassComponentScorecardsError, bool) {",
+		"func (r *CompassComponentScorecardsResult) IsError() bool {",
+		"case r.Error != nil:",
+		"r.Unknown = append(json.RawMessage(nil), data...)",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected rendered source to contain %q, got:\n%s", want, source)
+		}
+	}
+	if strings.Contains(source, "unsupported entity type") {
+		t.Fatalf("unrecognized __typename should fall through to Unknown, not return a decode error:\n%s", source)
+	}
+}
+
+// TestRenderConnectionFieldEmitsFetchFunction asserts the generated Fetch{Entity} request function
+// next to Decode{Entity}: a typed Vars struct, retry/deadline option plumbing, and a retry loop
+// scoped to *graphql.RateLimitError specifically (mirroring subscribe.go's established retry
+// idiom) rather than a broader and unprecedented "retry on any error" policy.
+func TestRenderConnectionFieldEmitsFetchFunction(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"\"atlassian-graphql/graphql\"",
+		"type CompassComponentScorecardsVars struct {",
+		"func (c *Client) FetchCompassComponentScorecards(ctx context.Context, vars CompassComponentScorecardsVars, opts ...CallOpt) (*CompassComponentScorecardsData, error) {",
+		"ctx, cancel := resolveCallDeadline(ctx, cfg)",
+		"var rlErr *graphql.RateLimitError",
+		"if !errors.As(err, &rlErr) || attempt > cfg.maxRetries {",
+		"return DecodeCompassComponentScorecards(result.Data)",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected rendered source to contain %q, got:\n%s", want, source)
+		}
+	}
+
+	common := codegen.RenderConnectionFieldCommon(&codegen.ResolvedPageInfoType{}, "go/tools/generate_compass_connection_models/main.go", "gen", false, false)
+	for _, want := range []string{
+		"type CallOpt func(*callConfig)",
+		"func WithMaxRetries(n int) CallOpt {",
+		"func WithTimeout(d time.Duration) CallOpt {",
+		"func WithDeadline(t time.Time) CallOpt {",
+		"func retryDelay(attempt int, rlErr *graphql.RateLimitError) time.Duration {",
+		"if secs, err := strconv.Atoi(rlErr.HeaderValue); err == nil && secs > 0 {",
+	} {
+		if !strings.Contains(common, want) {
+			t.Fatalf("expected rendered common source to contain %q, got:\n%s", want, common)
+		}
+	}
+}
+
+// TestRenderConnectionFieldEmitHelpersCloneEqualIsZero asserts EmitHelpers renders Clone/Equal on
+// every generated struct (a ref, {Entity}Node, {Entity}Edge, {Entity}Connection) plus IsZero on
+// {Entity}Result, with nil-safe pointer handling for optional node fields and nested refs -
+// without EmitHelpers set, none of this should be rendered at all.
+func TestRenderConnectionFieldEmitHelpersCloneEqualIsZero(t *testing.T) {
+	schema := buildCompassConnectionFixtureSchema()
+	manifest := compassScorecardsManifest()
+	manifest.EmitHelpers = true
+	resolved, err := codegen.ResolveConnectionField(schema, manifest, codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"func (n CompassComponentScorecardsScorecardRef) Clone() CompassComponentScorecardsScorecardRef {",
+		"func (n CompassComponentScorecardsScorecardRef) Equal(other CompassComponentScorecardsScorecardRef) bool {",
+		"func (n CompassComponentScorecardsNode) Clone() CompassComponentScorecardsNode {",
+		"if n.MaxScore != nil {",
+		"if n.Scorecard != nil {",
+		"v := n.Scorecard.Clone()",
+		"func (n CompassComponentScorecardsNode) Equal(other CompassComponentScorecardsNode) bool {",
+		"if (n.Scorecard == nil) != (other.Scorecard == nil) {",
+		"func (e CompassComponentScorecardsEdge) Clone() CompassComponentScorecardsEdge {",
+		"out.Node = e.Node.Clone()",
+		"func (c CompassComponentScorecardsConnection) Clone() CompassComponentScorecardsConnection {",
+		"out.Edges[i] = e.Clone()",
+		"func (c CompassComponentScorecardsConnection) Equal(other CompassComponentScorecardsConnection) bool {",
+		"func (r CompassComponentScorecardsResult) IsZero() bool {",
+		"r.Connection == nil &&",
+		"r.Error == nil &&",
+		"r.Unknown == nil",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected rendered source to contain %q, got:\n%s", want, source)
+		}
+	}
+
+	common := codegen.RenderConnectionFieldCommon(&codegen.ResolvedPageInfoType{}, "go/tools/generate_compass_connection_models/main.go", "gen", false, true)
+	for _, want := range []string{
+		"func (p PageInfo) Clone() PageInfo {",
+		"func (p PageInfo) Equal(other PageInfo) bool {",
+		"func (p PageInfo) IsZero() bool {",
+	} {
+		if !strings.Contains(common, want) {
+			t.Fatalf("expected rendered common source to contain %q, got:\n%s", want, common)
+		}
+	}
+
+	plainResolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	plainSource, err := codegen.RenderConnectionField(plainResolved, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(plainSource, "func (n CompassComponentScorecardsNode) Clone()") {
+		t.Fatalf("expected Clone to be omitted without EmitHelpers, got:\n%s", plainSource)
+	}
+}
+
+// BenchmarkRenderConnectionField_FastJSON and BenchmarkRenderConnectionField_Stdlib compare the
+// codegen cost of the two render paths the --fastjson flag chooses between; the produced source's
+// runtime marshal cost is what the flag is actually for, but that source only exists once the
+// generator is run and compiled, which isn't something a unit test can do for generated output
+// that isn't checked into the repo.
+func BenchmarkRenderConnectionField_FastJSON(b *testing.B) {
+	schema := buildCompassConnectionFixtureSchema()
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := codegen.RenderConnectionField(resolved, true); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func BenchmarkRenderConnectionField_Stdlib(b *testing.B) {
+	schema := buildCompassConnectionFixtureSchema()
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := codegen.RenderConnectionField(resolved, false); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
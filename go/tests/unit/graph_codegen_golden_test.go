@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+// These are the golden query texts codegen.Render emits for the Team manifest against
+// buildFixtureSchema, with insignificant whitespace collapsed (GraphQL doesn't care about it, but
+// a byte-for-byte const comparison would make this test fail on every cosmetic formatting tweak).
+// If a schema or manifest change alters either query's actual content, this test fails with a
+// visible diff instead of the drift only surfacing once go:generate runs and a downstream caller
+// starts sending a different query over the wire.
+const goldenTeamByIdQuery = `query TeamV2($id: ID!, $siteId: ID!) { team { teamV2(id: $id, siteId: $siteId) { id displayName smallAvatarImageUrl state } } }`
+
+const goldenTeamSearchV2Query = `query TeamSearchV2($organizationId: ID!, $siteId: ID!, $first: Int!, $query: String!) { team { teamSearchV2( organizationId: $organizationId, siteId: $siteId, first: $first, filter: { query: $query } ) @optIn(to: "Team-search-v2") { pageInfo { hasNextPage endCursor } nodes { team { id displayName smallAvatarImageUrl state } } } } }`
+
+func TestGoldenTeamQueries(t *testing.T) {
+	schema := buildFixtureSchema()
+	resolved, err := codegen.ResolveOperations(schema, fixtureManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	source, err := codegen.Render(resolved)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertGoldenQuery(t, source, "TeamV2Query", goldenTeamByIdQuery)
+	assertGoldenQuery(t, source, "TeamSearchV2Query", goldenTeamSearchV2Query)
+}
+
+// assertGoldenQuery extracts the backtick-quoted value of `const <name> = \`...\`` from source and
+// compares its whitespace-collapsed form against want.
+func assertGoldenQuery(t *testing.T, source, name, want string) {
+	t.Helper()
+	marker := "const " + name + " = `"
+	start := strings.Index(source, marker)
+	if start < 0 {
+		t.Fatalf("generated source missing %s", marker)
+	}
+	start += len(marker)
+	end := strings.Index(source[start:], "`")
+	if end < 0 {
+		t.Fatalf("unterminated %s in generated source", name)
+	}
+	got := collapseWhitespace(source[start : start+end])
+	if got != want {
+		t.Fatalf("%s drifted from golden value.\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
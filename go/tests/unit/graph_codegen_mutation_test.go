@@ -0,0 +1,130 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+// buildCompassCreateScorecardFixtureSchema mirrors the shape of compass.createScorecard(input:
+// ...) { ... on CreateScorecardPayload { id name } ... on MutationError { message extensions {
+// statusCode } } }: a single INPUT_OBJECT argument and a union result with a typed error variant.
+func buildCompassCreateScorecardFixtureSchema() map[string]any {
+	inputType := inputObjectType("CreateScorecardInput",
+		field("componentId", nonNull(scalarType("ID"))),
+		field("name", nonNull(scalarType("String"))),
+	)
+	payloadType := objectType("CreateScorecardPayload",
+		field("id", nonNull(scalarType("ID"))),
+		field("name", nonNull(scalarType("String"))),
+	)
+	extensionsType := objectType("MutationErrorExtensions",
+		field("statusCode", scalarType("Int")),
+	)
+	errorType := objectType("MutationError",
+		field("message", nonNull(scalarType("String"))),
+		field("extensions", namedType("OBJECT", "MutationErrorExtensions")),
+	)
+	resultType := unionType("CreateScorecardResult", "CreateScorecardPayload", "MutationError")
+	compassMutationType := objectType("CompassMutation",
+		field("createScorecard", namedType("UNION", "CreateScorecardResult"),
+			arg("input", nonNull(namedType("INPUT_OBJECT", "CreateScorecardInput"))),
+		),
+	)
+	mutationType := objectType("Mutation",
+		field("compass", nonNull(namedType("OBJECT", "CompassMutation"))),
+	)
+
+	return map[string]any{
+		"mutationType": map[string]any{"name": "Mutation"},
+		"types": []any{
+			inputType, payloadType, extensionsType, errorType, resultType, compassMutationType, mutationType,
+		},
+	}
+}
+
+func compassCreateScorecardManifest() *codegen.MutationManifest {
+	return &codegen.MutationManifest{
+		Package:       "gen",
+		GeneratorPath: "go/tools/generate_compass_connection_models/main.go",
+		EntityName:    "CompassCreateScorecard",
+		Namespace:     "compass",
+		FieldName:     "createScorecard",
+		InputArgName:  "input",
+		ResultFields: []codegen.FieldManifest{
+			{Name: "id"},
+			{Name: "name"},
+		},
+	}
+}
+
+func TestResolveMutationResolvesInputAndResultFields(t *testing.T) {
+	schema := buildCompassCreateScorecardFixtureSchema()
+	resolved, err := codegen.ResolveMutation(schema, compassCreateScorecardManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderMutation(resolved)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"type CompassCreateScorecardInput struct {",
+		"ComponentID string `json:\"componentId,omitempty\"`",
+		"type CompassCreateScorecardPayload struct {",
+		"type CompassCreateScorecardError struct {",
+		"CompassCreateScorecardPayloadTypename = \"CreateScorecardPayload\"",
+		"CompassCreateScorecardErrorTypename = \"MutationError\"",
+		"type CompassCreateScorecardResult struct {",
+		"func (r *CompassCreateScorecardResult) UnmarshalJSON(data []byte) error {",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected rendered source to contain %q, got:\n%s", want, source)
+		}
+	}
+}
+
+func TestRenderMutationEmitsClientInterfaceAndMock(t *testing.T) {
+	schema := buildCompassCreateScorecardFixtureSchema()
+	resolved, err := codegen.ResolveMutation(schema, compassCreateScorecardManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	source, err := codegen.RenderMutation(resolved)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(source, "type CompassCreateScorecardClient interface {") {
+		t.Fatalf("expected rendered source to declare the client interface, got:\n%s", source)
+	}
+	if !strings.Contains(source, "Run(ctx context.Context, input CompassCreateScorecardInput) (*CompassCreateScorecardResult, error)") {
+		t.Fatalf("expected rendered source to declare the Run method, got:\n%s", source)
+	}
+
+	mockSource := codegen.RenderMutationMock(resolved)
+	for _, want := range []string{
+		"package mock_gen",
+		"type MockCompassCreateScorecardClient struct {",
+		"func NewMockCompassCreateScorecardClient(ctrl *gomock.Controller) *MockCompassCreateScorecardClient {",
+		"func (m *MockCompassCreateScorecardClient) EXPECT() *MockCompassCreateScorecardClientMockRecorder {",
+		"func (m *MockCompassCreateScorecardClient) Run(ctx context.Context, input gen.CompassCreateScorecardInput) (*gen.CompassCreateScorecardResult, error) {",
+	} {
+		if !strings.Contains(mockSource, want) {
+			t.Fatalf("expected mock source to contain %q, got:\n%s", want, mockSource)
+		}
+	}
+}
+
+func TestResolveMutationRejectsNonInputObjectArgument(t *testing.T) {
+	schema := buildCompassCreateScorecardFixtureSchema()
+	manifest := compassCreateScorecardManifest()
+	manifest.InputArgName = "missing"
+
+	if _, err := codegen.ResolveMutation(schema, manifest, codegen.DefaultTypeMapper()); err == nil {
+		t.Fatal("expected an error for a missing input argument")
+	}
+}
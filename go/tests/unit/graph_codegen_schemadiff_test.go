@@ -0,0 +1,107 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+func buildDriftFixtureSchema(widgetFields ...map[string]any) map[string]any {
+	widgetType := objectType("Widget", widgetFields...)
+	queryType := objectType("Query",
+		field("widget", namedType("OBJECT", "Widget")),
+	)
+	return map[string]any{
+		"queryType": map[string]any{"name": "Query"},
+		"types":     []any{widgetType, queryType},
+	}
+}
+
+func TestDiffSchemaIntrospectionDetectsFieldAddRemove(t *testing.T) {
+	oldSchema := buildDriftFixtureSchema(
+		field("id", nonNull(scalarType("ID"))),
+		field("name", scalarType("String")),
+	)
+	newSchema := buildDriftFixtureSchema(
+		field("id", nonNull(scalarType("ID"))),
+		field("status", scalarType("String")),
+	)
+
+	diffs, err := codegen.DiffSchemaIntrospection(oldSchema, newSchema, []string{"Query"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(diffs) != 1 || diffs[0].TypeName != "Widget" {
+		t.Fatalf("expected a single Widget diff, got: %+v", diffs)
+	}
+	widget := diffs[0]
+	if len(widget.AddedFields) != 1 || widget.AddedFields[0] != "status" {
+		t.Fatalf("expected status to be reported as added, got: %+v", widget.AddedFields)
+	}
+	if len(widget.RemovedFields) != 1 || widget.RemovedFields[0] != "name" {
+		t.Fatalf("expected name to be reported as removed, got: %+v", widget.RemovedFields)
+	}
+}
+
+func TestDiffSchemaIntrospectionDetectsNullabilityAndArgChange(t *testing.T) {
+	oldSchema := buildDriftFixtureSchema(
+		field("id", nonNull(scalarType("ID"))),
+		field("name", scalarType("String"), arg("locale", scalarType("String"))),
+	)
+	newSchema := buildDriftFixtureSchema(
+		field("id", nonNull(scalarType("ID"))),
+		field("name", nonNull(scalarType("String")), arg("locale", nonNull(scalarType("String")))),
+	)
+
+	diffs, err := codegen.DiffSchemaIntrospection(oldSchema, newSchema, []string{"Query"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(diffs) != 1 {
+		t.Fatalf("expected a single Widget diff, got: %+v", diffs)
+	}
+	if len(diffs[0].ChangedFields) != 1 {
+		t.Fatalf("expected one changed field, got: %+v", diffs[0].ChangedFields)
+	}
+	changed := diffs[0].ChangedFields[0]
+	if changed.FieldName != "name" || changed.OldType != "String" || changed.NewType != "String!" {
+		t.Fatalf("expected name's nullability to be reported, got: %+v", changed)
+	}
+	if len(changed.ArgTypeDiffs) != 1 || changed.ArgTypeDiffs[0] != "locale: String -> String!" {
+		t.Fatalf("expected locale's argument type change to be reported, got: %+v", changed.ArgTypeDiffs)
+	}
+}
+
+func TestDiffSchemaIntrospectionNoChangesReportsNothing(t *testing.T) {
+	schema := buildDriftFixtureSchema(field("id", nonNull(scalarType("ID"))))
+	diffs, err := codegen.DiffSchemaIntrospection(schema, schema, []string{"Query"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Fatalf("expected no diffs for an unchanged schema, got: %+v", diffs)
+	}
+}
+
+func TestUnifiedDiffReportsAddedAndRemovedLines(t *testing.T) {
+	oldText := "package gen\n\nfunc Foo() {}\n"
+	newText := "package gen\n\nfunc Foo() {}\n\nfunc Bar() {}\n"
+
+	diff := codegen.UnifiedDiff("gen/foo.go", "gen/foo.go", oldText, newText)
+	if diff == "" {
+		t.Fatal("expected a non-empty diff for changed text")
+	}
+	for _, want := range []string{"--- gen/foo.go", "+++ gen/foo.go", "+func Bar() {}"} {
+		if !strings.Contains(diff, want) {
+			t.Fatalf("expected diff to contain %q, got:\n%s", want, diff)
+		}
+	}
+}
+
+func TestUnifiedDiffReturnsEmptyForIdenticalText(t *testing.T) {
+	text := "package gen\n"
+	if diff := codegen.UnifiedDiff("gen/foo.go", "gen/foo.go", text, text); diff != "" {
+		t.Fatalf("expected no diff for identical text, got:\n%s", diff)
+	}
+}
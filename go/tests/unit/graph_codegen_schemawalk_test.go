@@ -0,0 +1,100 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+func unionType(name string, possibleTypes ...string) map[string]any {
+	raw := make([]any, len(possibleTypes))
+	for i, p := range possibleTypes {
+		raw[i] = map[string]any{"name": p}
+	}
+	return map[string]any{"kind": "UNION", "name": name, "possibleTypes": raw}
+}
+
+func enumType(name string, values ...string) map[string]any {
+	raw := make([]any, len(values))
+	for i, v := range values {
+		raw[i] = map[string]any{"name": v}
+	}
+	return map[string]any{"kind": "ENUM", "name": name, "enumValues": raw}
+}
+
+// buildFixtureSchemaWalk mirrors buildFixtureSchema's style but adds an enum, a union and an
+// input object so WalkSchema has every kind to classify in one pass.
+func buildFixtureSchemaWalk() map[string]any {
+	widgetType := objectType("Widget",
+		field("id", nonNull(scalarType("ID"))),
+		field("name", scalarType("String")),
+		field("status", nonNull(namedType("ENUM", "WidgetStatus"))),
+	)
+	gadgetType := objectType("Gadget",
+		field("id", nonNull(scalarType("ID"))),
+	)
+	searchResultType := unionType("SearchResult", "Widget", "Gadget")
+	widgetStatusType := enumType("WidgetStatus", "ACTIVE", "INACTIVE")
+	widgetFilterType := inputObjectType("WidgetFilterInput",
+		map[string]any{"name": "query", "type": nonNull(scalarType("String"))},
+	)
+	queryType := objectType("Query",
+		field("widget", namedType("OBJECT", "Widget"), arg("id", nonNull(scalarType("ID")))),
+		field("searchResults", namedType("LIST", "SearchResult")),
+	)
+
+	types := []any{widgetType, gadgetType, searchResultType, widgetStatusType, widgetFilterType, queryType}
+	return map[string]any{
+		"queryType": map[string]any{"name": "Query"},
+		"types":     types,
+	}
+}
+
+func TestWalkAndRenderSchemaModel(t *testing.T) {
+	schema := buildFixtureSchemaWalk()
+	model, err := codegen.WalkSchema(schema, codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	source, err := codegen.RenderSchemaModel(model, "compass", "go/tools/generate_compass_schema_models/main.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"type Widget struct",
+		"ID string `json:\"id\"`",
+		"Name *string `json:\"name\"`",
+		"type WidgetStatus string",
+		"type SearchResult struct",
+		"Typename string `json:\"__typename\"`",
+		"Widget *Widget `json:\"-\"`",
+		"Gadget *Gadget `json:\"-\"`",
+		"func (v *SearchResult) UnmarshalJSON(data []byte) error",
+		"type WidgetFilterInput struct",
+		"type WidgetData struct",
+		"func DecodeWidget(data map[string]any) (*WidgetData, error)",
+		"type SearchResultsData struct",
+		"func DecodeSearchResults(data map[string]any) (*SearchResultsData, error)",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected generated source to contain %q, got:\n%s", want, source)
+		}
+	}
+}
+
+func TestWalkSchemaUnsupportedFieldType(t *testing.T) {
+	schema := buildFixtureSchemaWalk()
+	queryDef := schema["types"].([]any)[len(schema["types"].([]any))-1].(map[string]any)
+	fields := queryDef["fields"].([]any)
+	fields[0].(map[string]any)["type"] = map[string]any{"kind": "BOGUS"}
+
+	_, err := codegen.WalkSchema(schema, codegen.DefaultTypeMapper())
+	if err == nil {
+		t.Fatal("expected error for unsupported field type")
+	}
+	if !strings.Contains(err.Error(), "widget") {
+		t.Fatalf("expected error to name the widget field, got: %v", err)
+	}
+}
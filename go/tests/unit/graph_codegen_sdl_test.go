@@ -0,0 +1,221 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+// compassScorecardsSDL is a hand-authored equivalent of buildCompassConnectionFixtureSchema's
+// introspection JSON, used to confirm ParseSDL feeds the same downstream pipeline.
+const compassScorecardsSDL = `
+schema {
+  query: Query
+  mutation: Mutation
+}
+
+type Query {
+  compass: CompassQuery!
+}
+
+type Mutation {
+  compass: CompassMutation!
+}
+
+type CompassMutation {
+  createScorecard(input: CreateScorecardInput!): CreateScorecardResult
+}
+
+type CompassQuery {
+  component(id: ID!): CompassComponentResult
+}
+
+union CompassComponentResult = CompassComponent | QueryError
+
+type QueryError {
+  message: String!
+}
+
+type CompassComponent {
+  id: ID!
+  name: String!
+  scorecards: CompassComponentScorecardConnection
+}
+
+type CompassComponentScorecardConnection {
+  edges: [CompassComponentScorecardEdge]!
+  pageInfo: PageInfo!
+}
+
+type CompassComponentScorecardEdge {
+  cursor: String
+  node: CompassComponentScorecardNode!
+}
+
+type CompassComponentScorecardNode {
+  score: Float!
+  maxScore: Float
+  scorecard: CompassScorecard
+}
+
+type CompassScorecard {
+  id: ID!
+  name: String!
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+  endCursor: String
+}
+
+union CreateScorecardResult = CreateScorecardPayload | MutationError
+
+input CreateScorecardInput {
+  componentId: ID!
+  name: String!
+}
+
+type CreateScorecardPayload {
+  id: ID!
+  name: String!
+}
+
+type MutationErrorExtensions {
+  statusCode: Int
+}
+
+type MutationError {
+  message: String!
+  extensions: MutationErrorExtensions
+}
+`
+
+func TestParseSDLProducesIntrospectionShapedSchema(t *testing.T) {
+	schema, err := codegen.ParseSDL(compassScorecardsSDL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	types, err := codegen.TypesMap(schema)
+	if err != nil {
+		t.Fatalf("TypesMap: %v", err)
+	}
+
+	queryDef, err := codegen.QueryTypeDef(schema, types)
+	if err != nil {
+		t.Fatalf("QueryTypeDef: %v", err)
+	}
+	if name, _ := queryDef["name"].(string); name != "Query" {
+		t.Fatalf("queryDef name = %q, want Query", name)
+	}
+
+	mutationDef, err := codegen.MutationTypeDef(schema, types)
+	if err != nil {
+		t.Fatalf("MutationTypeDef: %v", err)
+	}
+	if name, _ := mutationDef["name"].(string); name != "Mutation" {
+		t.Fatalf("mutationDef name = %q, want Mutation", name)
+	}
+
+	scorecardNode := types["CompassComponentScorecardNode"]
+	if scorecardNode == nil {
+		t.Fatalf("types missing CompassComponentScorecardNode")
+	}
+	scoreField := codegen.GetField(scorecardNode, "score")
+	if scoreField == nil {
+		t.Fatalf("CompassComponentScorecardNode missing score field")
+	}
+	if !codegen.IsNonNull(scoreField["type"]) {
+		t.Fatalf("score field should be NON_NULL per the `!` marker")
+	}
+	maxScoreField := codegen.GetField(scorecardNode, "maxScore")
+	if maxScoreField == nil {
+		t.Fatalf("CompassComponentScorecardNode missing maxScore field")
+	}
+	if codegen.IsNonNull(maxScoreField["type"]) {
+		t.Fatalf("maxScore field should be nullable (no `!` marker)")
+	}
+
+	scorecardRef := codegen.GetField(scorecardNode, "scorecard")
+	name, kind := codegen.UnwrapNamedType(scorecardRef["type"])
+	if name != "CompassScorecard" || kind != "OBJECT" {
+		t.Fatalf("scorecard ref = (%q, %q), want (CompassScorecard, OBJECT)", name, kind)
+	}
+}
+
+// TestParseSDLInteropsWithResolveConnectionField confirms a schema parsed from SDL text resolves
+// through the same ConnectionFieldManifest pipeline as introspection JSON, with no changes to
+// either the manifest or ResolveConnectionField itself.
+func TestParseSDLInteropsWithResolveConnectionField(t *testing.T) {
+	schema, err := codegen.ParseSDL(compassScorecardsSDL)
+	if err != nil {
+		t.Fatalf("ParseSDL: %v", err)
+	}
+
+	resolved, err := codegen.ResolveConnectionField(schema, compassScorecardsManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("ResolveConnectionField: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("RenderConnectionField: %v", err)
+	}
+	if !strings.Contains(source, "CompassComponentScorecardsNode") {
+		t.Fatalf("rendered source missing CompassComponentScorecardsNode:\n%s", source)
+	}
+}
+
+// TestParseSDLInteropsWithResolveMutation mirrors the above for the mutation pipeline, using the
+// Mutation root the same SDL document declares.
+func TestParseSDLInteropsWithResolveMutation(t *testing.T) {
+	schema, err := codegen.ParseSDL(compassScorecardsSDL)
+	if err != nil {
+		t.Fatalf("ParseSDL: %v", err)
+	}
+
+	manifest := compassCreateScorecardManifest()
+	resolved, err := codegen.ResolveMutation(schema, manifest, codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("ResolveMutation: %v", err)
+	}
+
+	source, err := codegen.RenderMutation(resolved)
+	if err != nil {
+		t.Fatalf("RenderMutation: %v", err)
+	}
+	if !strings.Contains(source, "CompassCreateScorecardInput") {
+		t.Fatalf("rendered source missing CompassCreateScorecardInput:\n%s", source)
+	}
+}
+
+// TestFieldManifestForceOptionalOverridesSchemaNullability confirms ForceOptional wins over
+// whatever nullability ParseSDL (or live introspection) derived - the override this request's
+// hand-config fallback path depends on for pinning a field's Go type regardless of the schema.
+func TestFieldManifestForceOptionalOverridesSchemaNullability(t *testing.T) {
+	schema, err := codegen.ParseSDL(compassScorecardsSDL)
+	if err != nil {
+		t.Fatalf("ParseSDL: %v", err)
+	}
+
+	forceOptional := true
+	manifest := compassScorecardsManifest()
+	manifest.Fields = []codegen.FieldManifest{
+		{Name: "score", ForceOptional: &forceOptional},
+		{Name: "maxScore"},
+	}
+
+	resolved, err := codegen.ResolveConnectionField(schema, manifest, codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("ResolveConnectionField: %v", err)
+	}
+
+	source, err := codegen.RenderConnectionField(resolved, false)
+	if err != nil {
+		t.Fatalf("RenderConnectionField: %v", err)
+	}
+	if !strings.Contains(source, "Score *float64") {
+		t.Fatalf("expected ForceOptional to render Score as a pointer despite the schema's NON_NULL `!`:\n%s", source)
+	}
+}
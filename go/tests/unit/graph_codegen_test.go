@@ -0,0 +1,256 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+func scalarType(name string) map[string]any {
+	return map[string]any{"kind": "SCALAR", "name": name}
+}
+
+func nonNull(inner map[string]any) map[string]any {
+	return map[string]any{"kind": "NON_NULL", "ofType": inner}
+}
+
+func namedType(kind, name string) map[string]any {
+	return map[string]any{"kind": kind, "name": name}
+}
+
+func field(name string, typeRef map[string]any, args ...map[string]any) map[string]any {
+	m := map[string]any{"name": name, "type": typeRef}
+	if len(args) > 0 {
+		raw := make([]any, len(args))
+		for i, a := range args {
+			raw[i] = a
+		}
+		m["args"] = raw
+	}
+	return m
+}
+
+func arg(name string, typeRef map[string]any) map[string]any {
+	return map[string]any{"name": name, "type": typeRef}
+}
+
+func objectType(name string, fields ...map[string]any) map[string]any {
+	raw := make([]any, len(fields))
+	for i, f := range fields {
+		raw[i] = f
+	}
+	return map[string]any{"kind": "OBJECT", "name": name, "fields": raw}
+}
+
+func inputObjectType(name string, inputFields ...map[string]any) map[string]any {
+	raw := make([]any, len(inputFields))
+	for i, f := range inputFields {
+		raw[i] = f
+	}
+	return map[string]any{"kind": "INPUT_OBJECT", "name": name, "inputFields": raw}
+}
+
+// buildFixtureSchema mirrors the shape of Team/TeamSearchV2 in the real Teamwork Graph schema, at
+// just enough depth to exercise codegen.ResolveOperations and codegen.Render end to end.
+func buildFixtureSchema() map[string]any {
+	teamType := objectType("Team",
+		field("id", nonNull(scalarType("ID"))),
+		field("displayName", nonNull(scalarType("String"))),
+		field("smallAvatarImageUrl", scalarType("String")),
+		field("state", nonNull(namedType("ENUM", "TeamState"))),
+	)
+	teamSearchResultType := objectType("TeamSearchResultNode",
+		field("team", nonNull(namedType("OBJECT", "Team"))),
+	)
+	pageInfoType := objectType("PageInfo",
+		field("hasNextPage", nonNull(scalarType("Boolean"))),
+		field("endCursor", scalarType("String")),
+	)
+	teamSearchConnType := objectType("TeamSearchV2Connection",
+		field("nodes", namedType("LIST", "TeamSearchResultNode")),
+		field("pageInfo", nonNull(namedType("OBJECT", "PageInfo"))),
+	)
+	teamFilterType := inputObjectType("TeamSearchFilterInput",
+		map[string]any{"name": "query", "type": nonNull(scalarType("String"))},
+	)
+	teamQueryType := objectType("TeamQuery",
+		field("teamV2", namedType("OBJECT", "Team"),
+			arg("id", nonNull(scalarType("ID"))),
+			arg("siteId", nonNull(scalarType("ID"))),
+		),
+		field("teamSearchV2", namedType("OBJECT", "TeamSearchV2Connection"),
+			arg("organizationId", nonNull(scalarType("ID"))),
+			arg("siteId", nonNull(scalarType("ID"))),
+			arg("filter", namedType("INPUT_OBJECT", "TeamSearchFilterInput")),
+			arg("first", nonNull(scalarType("Int"))),
+		),
+	)
+	queryType := objectType("Query",
+		field("team", nonNull(namedType("OBJECT", "TeamQuery"))),
+	)
+
+	types := []any{teamType, teamSearchResultType, pageInfoType, teamSearchConnType, teamFilterType, teamQueryType, queryType}
+	return map[string]any{
+		"queryType":  map[string]any{"name": "Query"},
+		"types":      types,
+		"directives": []any{map[string]any{"name": "optIn"}},
+	}
+}
+
+func fixtureManifest() *codegen.OperationManifest {
+	return &codegen.OperationManifest{
+		Package:       "gen",
+		EntityName:    "Team",
+		GeneratorPath: "go/tools/generate_team_models/main.go",
+		Lookup: &codegen.LookupManifest{
+			Namespace:             "team",
+			LookupFieldCandidates: []string{"teamV2", "team"},
+			ArgNames:              []string{"id", "siteId"},
+			Fields: []codegen.FieldManifest{
+				{Name: "id"},
+				{Name: "displayName"},
+				{Name: "smallAvatarImageUrl"},
+				{Name: "state"},
+			},
+		},
+		Connection: &codegen.ConnectionManifest{
+			Namespace:            "team",
+			FieldName:            "teamSearchV2",
+			ArgNames:             []string{"organizationId", "siteId", "first"},
+			FilterArgName:        "filter",
+			FilterInputFieldName: "query",
+			OptInDirective:       "Team-search-v2",
+			NodeFieldPath:        []string{"team"},
+			Fields: []codegen.FieldManifest{
+				{Name: "id"},
+				{Name: "displayName"},
+				{Name: "smallAvatarImageUrl"},
+				{Name: "state"},
+			},
+		},
+	}
+}
+
+func TestResolveAndRenderOperations(t *testing.T) {
+	schema := buildFixtureSchema()
+	resolved, err := codegen.ResolveOperations(schema, fixtureManifest(), codegen.DefaultTypeMapper())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	source, err := codegen.Render(resolved)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"type TeamNode struct",
+		"ID string `json:\"id\"`",
+		"DisplayName string `json:\"displayName\"`",
+		"func DecodeTeam(data map[string]any) (*TeamNode, error)",
+		"type TeamSearchConnection struct",
+		"func DecodeTeamSearchV2(data map[string]any) (*TeamSearchConnection, error)",
+		`@optIn(to: "Team-search-v2")`,
+		"type TeamSearchIterator struct",
+		"type TeamSearchIteratorOptions struct",
+		"type TeamSearchErrorPolicy int",
+		"TeamSearchErrorPolicySkipNull",
+		"type TeamSearchPageFetcher func(ctx context.Context, after *string, first int) (*TeamSearchConnection, error)",
+		"func NewTeamSearchIterator(fetch TeamSearchPageFetcher, opts TeamSearchIteratorOptions) *TeamSearchIterator",
+		"func (it *TeamSearchIterator) Next(ctx context.Context) (*TeamNode, error)",
+		"func (it *TeamSearchIterator) Channel(ctx context.Context) <-chan TeamSearchResultNode",
+		"pagination.CursorIterator[TeamSearchResultNode]",
+		"type TeamClient interface",
+		"TeamV2(ctx context.Context, args map[string]any) (*TeamNode, error)",
+		"TeamSearchV2(ctx context.Context, args map[string]any) (*TeamSearchConnection, error)",
+		"type TeamMockClient struct",
+		"func NewTeamMockClient() *TeamMockClient",
+		"func (m *TeamMockClient) WithTeams(teams []TeamNode) *TeamMockClient",
+		"func (m *TeamMockClient) WithSearchResults(query string, results []TeamSearchResultNode) *TeamMockClient",
+		"func (m *TeamMockClient) TeamV2(ctx context.Context, args map[string]any) (*TeamNode, error)",
+		"func (m *TeamMockClient) TeamSearchV2(ctx context.Context, args map[string]any) (*TeamSearchConnection, error)",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected generated source to contain %q, got:\n%s", want, source)
+		}
+	}
+}
+
+func TestResolveOperationsMissingField(t *testing.T) {
+	schema := buildFixtureSchema()
+	manifest := fixtureManifest()
+	manifest.Lookup.Fields = append(manifest.Lookup.Fields, codegen.FieldManifest{Name: "missingField"})
+
+	_, err := codegen.ResolveOperations(schema, manifest, codegen.DefaultTypeMapper())
+	if err == nil {
+		t.Fatal("expected error for missing field")
+	}
+	if !strings.Contains(err.Error(), "missingField") {
+		t.Fatalf("expected error to name missingField, got: %v", err)
+	}
+}
+
+func TestResolveOperationsMissingOptInDirective(t *testing.T) {
+	schema := buildFixtureSchema()
+	schema["directives"] = []any{}
+	manifest := fixtureManifest()
+
+	_, err := codegen.ResolveOperations(schema, manifest, codegen.DefaultTypeMapper())
+	if err == nil {
+		t.Fatal("expected error for missing optIn directive")
+	}
+}
+
+func TestResolveOperationsMissingArg(t *testing.T) {
+	schema := buildFixtureSchema()
+	manifest := fixtureManifest()
+	manifest.Connection.ArgNames = append(manifest.Connection.ArgNames, "after")
+
+	_, err := codegen.ResolveOperations(schema, manifest, codegen.DefaultTypeMapper())
+	if err == nil {
+		t.Fatal("expected error for missing after arg")
+	}
+	if !strings.Contains(err.Error(), "after") {
+		t.Fatalf("expected error to name after, got: %v", err)
+	}
+}
+
+func TestDefaultScalarMapperCustomScalars(t *testing.T) {
+	cases := map[string]string{
+		"DateTime": "string",
+		"URL":      "string",
+		"ARI":      "string",
+		"JSON":     "json.RawMessage",
+		"Int":      "int",
+		"Boolean":  "bool",
+	}
+	for gqlType, want := range cases {
+		got, ok := codegen.DefaultScalarMapper(gqlType)
+		if !ok {
+			t.Fatalf("expected DefaultScalarMapper to handle %s", gqlType)
+		}
+		if got != want {
+			t.Fatalf("DefaultScalarMapper(%s) = %s, want %s", gqlType, got, want)
+		}
+	}
+	if _, ok := codegen.DefaultScalarMapper("Unknown"); ok {
+		t.Fatal("expected DefaultScalarMapper to reject unknown scalar")
+	}
+}
+
+func TestChainScalarMappers(t *testing.T) {
+	custom := func(name string) (string, bool) {
+		if name == "ADF" {
+			return "any", true
+		}
+		return "", false
+	}
+	chained := codegen.ChainScalarMappers(custom, codegen.DefaultScalarMapper)
+
+	if got, ok := chained("ADF"); !ok || got != "any" {
+		t.Fatalf("expected custom mapper to win for ADF, got %s, %v", got, ok)
+	}
+	if got, ok := chained("String"); !ok || got != "string" {
+		t.Fatalf("expected fallback to DefaultScalarMapper for String, got %s, %v", got, ok)
+	}
+}
@@ -0,0 +1,92 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"atlassian/atlassian/graph/gen"
+	"atlassian/atlassian/graph/mappers"
+)
+
+func TestTeamFromGraphQLAccumulatesAllViolations(t *testing.T) {
+	team := &gen.TeamNode{}
+
+	_, err := mappers.TeamFromGraphQL(team)
+	if err == nil {
+		t.Fatal("expected error for missing required fields")
+	}
+
+	var mapErr *mappers.MapperError
+	if !errors.As(err, &mapErr) {
+		t.Fatalf("expected *mappers.MapperError, got %T: %v", err, err)
+	}
+	paths := mapErr.Paths()
+	if len(paths) != 3 {
+		t.Fatalf("expected 3 accumulated violations (id, displayName, state), got %d: %v", len(paths), paths)
+	}
+}
+
+func TestTeamFromGraphQLWithPartialReturnsBestEffort(t *testing.T) {
+	team := &gen.TeamNode{
+		ID:          "team-1",
+		DisplayName: "",
+		State:       "active",
+	}
+
+	out, err := mappers.TeamFromGraphQL(team, mappers.WithPartial())
+	if err == nil {
+		t.Fatal("expected error for missing displayName even with WithPartial")
+	}
+	if out.ID != "team-1" || out.State != "active" {
+		t.Fatalf("expected WithPartial to keep the fields that did resolve, got %+v", out)
+	}
+}
+
+func TestTeamFromGraphQLNoViolationsReturnsNilError(t *testing.T) {
+	team := &gen.TeamNode{
+		ID:          "team-1",
+		DisplayName: "Platform",
+		State:       "active",
+	}
+
+	out, err := mappers.TeamFromGraphQL(team)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ID != "team-1" || out.DisplayName != "Platform" {
+		t.Fatalf("unexpected output: %+v", out)
+	}
+}
+
+func TestJiraIssueFromGraphQLRejectsCloudIDMismatch(t *testing.T) {
+	issue := gen.JiraIssueNode{
+		Key: "PROJ-1",
+	}
+	issue.ProjectField.Project.Key = "PROJ"
+	issue.ProjectField.Project.CloudID = "cloud-b"
+	issue.IssueType.Name = "Bug"
+	issue.Status.Name = "Open"
+	created := "2021-01-01T00:00:00.000Z"
+	updated := "2021-01-02T00:00:00.000Z"
+	issue.CreatedField.DateTime = &created
+	issue.UpdatedField.DateTime = &updated
+
+	_, err := mappers.JiraIssueFromGraphQL("cloud-a", issue)
+	if err == nil {
+		t.Fatal("expected error for mismatched cloudID")
+	}
+
+	var mapErr *mappers.MapperError
+	if !errors.As(err, &mapErr) {
+		t.Fatalf("expected *mappers.MapperError, got %T: %v", err, err)
+	}
+	found := false
+	for _, p := range mapErr.Paths() {
+		if p == "issue.projectField.project.cloudId" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected a violation at issue.projectField.project.cloudId, got %v", mapErr.Paths())
+	}
+}
@@ -0,0 +1,73 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"atlassian/atlassian/graph/gen"
+	"atlassian/atlassian/graph/mappers"
+)
+
+// goldenTeamNode is a fixture of the shape go/tools/generate_graph_mappers/mappers.json declares
+// for TeamNode - a round-trip check that mappers.TeamFromGraphQL (generated into teams_gen.go)
+// still reads every field the manifest promises, not just the ones a hand-written mapper
+// remembered to wire up.
+const goldenTeamNode = `{
+	"id": "team-1",
+	"displayName": "Platform",
+	"state": "active",
+	"smallAvatarImageUrl": "https://example.com/avatar.png",
+	"description": "Owns the platform",
+	"memberCount": 5
+}`
+
+const goldenTeamMemberNode = `{
+	"accountId": "acct-1",
+	"displayName": "Ada Lovelace",
+	"role": "lead"
+}`
+
+func TestTeamFromGraphQLGoldenFixture(t *testing.T) {
+	var node gen.TeamNode
+	if err := json.Unmarshal([]byte(goldenTeamNode), &node); err != nil {
+		t.Fatalf("unmarshal golden fixture: %v", err)
+	}
+
+	out, err := mappers.TeamFromGraphQL(&node)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.ID != "team-1" || out.DisplayName != "Platform" || out.State != "active" {
+		t.Fatalf("unexpected output: %+v", out)
+	}
+	if out.AvatarURL == nil || *out.AvatarURL != "https://example.com/avatar.png" {
+		t.Fatalf("expected avatarURL to round-trip, got %+v", out.AvatarURL)
+	}
+	if out.Description == nil || *out.Description != "Owns the platform" {
+		t.Fatalf("expected description to round-trip, got %+v", out.Description)
+	}
+	if out.MemberCount == nil || *out.MemberCount != 5 {
+		t.Fatalf("expected memberCount to round-trip, got %+v", out.MemberCount)
+	}
+}
+
+func TestTeamMemberFromGraphQLGoldenFixture(t *testing.T) {
+	var node gen.TeamMemberNode
+	if err := json.Unmarshal([]byte(goldenTeamMemberNode), &node); err != nil {
+		t.Fatalf("unmarshal golden fixture: %v", err)
+	}
+
+	out, err := mappers.TeamMemberFromGraphQL("team-1", &node)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.TeamID != "team-1" || out.AccountID != "acct-1" {
+		t.Fatalf("unexpected output: %+v", out)
+	}
+	if out.DisplayName == nil || *out.DisplayName != "Ada Lovelace" {
+		t.Fatalf("expected displayName to round-trip, got %+v", out.DisplayName)
+	}
+	if out.Role == nil || *out.Role != "lead" {
+		t.Fatalf("expected role to round-trip, got %+v", out.Role)
+	}
+}
@@ -0,0 +1,103 @@
+package unit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"atlassian/atlassian/graph"
+)
+
+func writeSchemaFixture(t *testing.T, dir string) string {
+	t.Helper()
+	path := filepath.Join(dir, "schema.introspection.json")
+	if err := os.WriteFile(path, []byte(`{"__schema":{}}`), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+	return path
+}
+
+func TestShouldRefetchSchemaMissingFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schema.introspection.json")
+	refetch, err := graph.ShouldRefetchSchema(path, nil, 0, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !refetch {
+		t.Fatalf("expected refetch when schema file is missing")
+	}
+}
+
+func TestShouldRefetchSchemaForceRefresh(t *testing.T) {
+	dir := t.TempDir()
+	path := writeSchemaFixture(t, dir)
+	if err := graph.RecordSchemaFetch(path, nil, time.Now()); err != nil {
+		t.Fatalf("record fetch: %v", err)
+	}
+	refetch, err := graph.ShouldRefetchSchema(path, nil, time.Hour, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !refetch {
+		t.Fatalf("expected refetch when forceRefresh is set")
+	}
+}
+
+func TestShouldRefetchSchemaFreshCacheIsReused(t *testing.T) {
+	dir := t.TempDir()
+	path := writeSchemaFixture(t, dir)
+	if err := graph.RecordSchemaFetch(path, []string{"experimentalWorklogs"}, time.Now()); err != nil {
+		t.Fatalf("record fetch: %v", err)
+	}
+	refetch, err := graph.ShouldRefetchSchema(path, []string{"experimentalWorklogs"}, time.Hour, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if refetch {
+		t.Fatalf("expected cached schema to be reused")
+	}
+}
+
+func TestShouldRefetchSchemaStaleByAge(t *testing.T) {
+	dir := t.TempDir()
+	path := writeSchemaFixture(t, dir)
+	if err := graph.RecordSchemaFetch(path, nil, time.Now().Add(-2*time.Hour)); err != nil {
+		t.Fatalf("record fetch: %v", err)
+	}
+	refetch, err := graph.ShouldRefetchSchema(path, nil, time.Hour, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !refetch {
+		t.Fatalf("expected refetch once cache is older than maxAge")
+	}
+}
+
+func TestShouldRefetchSchemaExperimentalAPIDrift(t *testing.T) {
+	dir := t.TempDir()
+	path := writeSchemaFixture(t, dir)
+	if err := graph.RecordSchemaFetch(path, []string{"experimentalWorklogs"}, time.Now()); err != nil {
+		t.Fatalf("record fetch: %v", err)
+	}
+	refetch, err := graph.ShouldRefetchSchema(path, []string{"experimentalWorklogs", "experimentalSprints"}, time.Hour, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !refetch {
+		t.Fatalf("expected refetch when experimental API opt-in list changed")
+	}
+}
+
+func TestComputeSchemaSHA256Stable(t *testing.T) {
+	a := graph.ComputeSchemaSHA256([]byte(`{"__schema":{}}`))
+	b := graph.ComputeSchemaSHA256([]byte(`{"__schema":{}}`))
+	if a != b {
+		t.Fatalf("expected stable hash, got %q and %q", a, b)
+	}
+	c := graph.ComputeSchemaSHA256([]byte(`{"__schema":{"x":1}}`))
+	if a == c {
+		t.Fatalf("expected different hashes for different content")
+	}
+}
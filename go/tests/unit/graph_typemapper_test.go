@@ -0,0 +1,144 @@
+package unit
+
+import (
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+func TestResolveFieldTypeBuiltinScalars(t *testing.T) {
+	tm := codegen.DefaultTypeMapper()
+	types := map[string]map[string]any{}
+
+	cases := []struct {
+		name     string
+		typeRef  map[string]any
+		wantType string
+	}{
+		{"DateTime", scalarType("DateTime"), "time.Time"},
+		{"Long", scalarType("Long"), "int64"},
+		{"JSON", scalarType("JSON"), "json.RawMessage"},
+		{"URL", scalarType("URL"), "GraphQLURL"},
+	}
+	for _, c := range cases {
+		res, err := codegen.ResolveFieldType(c.typeRef, types, tm)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if res.GoType != c.wantType {
+			t.Fatalf("%s: GoType = %s, want %s", c.name, res.GoType, c.wantType)
+		}
+		if res.ScalarName != c.name {
+			t.Fatalf("%s: ScalarName = %s, want %s", c.name, res.ScalarName, c.name)
+		}
+	}
+}
+
+func TestResolveFieldTypeUnregisteredEnumFlattensToString(t *testing.T) {
+	tm := codegen.DefaultTypeMapper()
+	res, err := codegen.ResolveFieldType(namedType("ENUM", "Mood"), map[string]map[string]any{}, tm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.GoType != "string" {
+		t.Fatalf("GoType = %s, want string", res.GoType)
+	}
+	if res.EnumName != "" {
+		t.Fatalf("expected no EnumName for an unregistered enum, got %s", res.EnumName)
+	}
+}
+
+func TestResolveFieldTypeRegisteredEnum(t *testing.T) {
+	tm := codegen.DefaultTypeMapper()
+	tm.RegisterEnum("Mood", "Mood")
+	res, err := codegen.ResolveFieldType(namedType("ENUM", "Mood"), map[string]map[string]any{}, tm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.GoType != "Mood" || res.EnumName != "Mood" {
+		t.Fatalf("got GoType=%s EnumName=%s, want GoType=Mood EnumName=Mood", res.GoType, res.EnumName)
+	}
+}
+
+func TestResolveFieldTypeUnsupportedScalarErrors(t *testing.T) {
+	tm := codegen.DefaultTypeMapper()
+	if _, err := codegen.ResolveFieldType(scalarType("ADF"), map[string]map[string]any{}, tm); err == nil {
+		t.Fatal("expected error for unregistered scalar ADF")
+	}
+}
+
+// widgetFixtureSchema exercises a URL-typed scalar field and an ENUM field the caller opts into
+// full enum codegen for, alongside the plain string/ID fields buildFixtureSchema already covers.
+func widgetFixtureSchema() map[string]any {
+	widgetStatusType := map[string]any{
+		"kind": "ENUM",
+		"name": "WidgetStatus",
+		"enumValues": []any{
+			map[string]any{"name": "ACTIVE"},
+			map[string]any{"name": "ON_HOLD"},
+		},
+	}
+	widgetType := objectType("Widget",
+		field("id", nonNull(scalarType("ID"))),
+		field("homePage", scalarType("URL")),
+		field("status", nonNull(namedType("ENUM", "WidgetStatus"))),
+	)
+	queryType := objectType("Query",
+		field("widget", namedType("OBJECT", "Widget"), arg("id", nonNull(scalarType("ID")))),
+	)
+	return map[string]any{
+		"queryType":  map[string]any{"name": "Query"},
+		"types":      []any{widgetStatusType, widgetType, queryType},
+		"directives": []any{},
+	}
+}
+
+func widgetFixtureManifest() *codegen.OperationManifest {
+	return &codegen.OperationManifest{
+		Package:       "gen",
+		EntityName:    "Widget",
+		GeneratorPath: "go/tools/generate_widget_models/main.go",
+		Lookup: &codegen.LookupManifest{
+			LookupFieldCandidates: []string{"widget"},
+			ArgNames:              []string{"id"},
+			Fields: []codegen.FieldManifest{
+				{Name: "id"},
+				{Name: "homePage"},
+				{Name: "status"},
+			},
+		},
+	}
+}
+
+func TestRenderCustomScalarAndEnum(t *testing.T) {
+	tm := codegen.DefaultTypeMapper()
+	tm.RegisterEnum("WidgetStatus", "WidgetStatus")
+
+	resolved, err := codegen.ResolveOperations(widgetFixtureSchema(), widgetFixtureManifest(), tm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	source, err := codegen.Render(resolved)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"\"net/url\"",
+		"type GraphQLURL struct",
+		"func (u *GraphQLURL) UnmarshalJSON(data []byte) error",
+		"func (u GraphQLURL) MarshalJSON() ([]byte, error)",
+		"HomePage *GraphQLURL `json:\"homePage\"`",
+		"type WidgetStatus string",
+		"WidgetStatusActive WidgetStatus = \"ACTIVE\"",
+		"WidgetStatusOnHold WidgetStatus = \"ON_HOLD\"",
+		"func (WidgetStatus) Values() []WidgetStatus",
+		"return []WidgetStatus{WidgetStatusActive, WidgetStatusOnHold}",
+		"Status WidgetStatus `json:\"status\"`",
+	} {
+		if !strings.Contains(source, want) {
+			t.Fatalf("expected generated source to contain %q, got:\n%s", want, source)
+		}
+	}
+}
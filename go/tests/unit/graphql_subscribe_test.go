@@ -0,0 +1,296 @@
+package unit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"atlassian-graphql/graphql"
+)
+
+func watchIssuesResponse(edges ...string) string {
+	return watchIssuesResponsePaged(false, "", edges...)
+}
+
+// watchIssuesResponsePaged is watchIssuesResponse with an explicit pageInfo, for exercising
+// pollWatchedIssues' multi-page drain.
+func watchIssuesResponsePaged(hasNextPage bool, endCursor string, edges ...string) string {
+	body := `{"data":{"jira":{"issueSearch":{"pageInfo":{"hasNextPage":` + strconv.FormatBool(hasNextPage) + `,"endCursor":"` + endCursor + `"},"edges":[`
+	for i, e := range edges {
+		if i > 0 {
+			body += ","
+		}
+		body += e
+	}
+	return body + `]}}}}`
+}
+
+func watchIssueEdge(id, key, updated, worklogID, transitionID string) string {
+	wl := "null"
+	if worklogID != "" {
+		wl = `{"id":"` + worklogID + `"}`
+	}
+	tr := "null"
+	if transitionID != "" {
+		tr = `{"id":"` + transitionID + `"}`
+	}
+	return `{"node":{"id":"` + id + `","key":"` + key + `","updated":"` + updated + `","latestWorklog":` + wl + `,"latestTransition":` + tr + `}}`
+}
+
+// sequencedClient returns a *http.Client serving responses[0], responses[1], ... for successive
+// requests (repeating the last one once exhausted), recording the wall-clock time of each call.
+func sequencedClient(responses []string) (*http.Client, func() []time.Time) {
+	var mu sync.Mutex
+	var callTimes []time.Time
+	var calls int32
+
+	client := newHTTPClient(func(req *http.Request) *http.Response {
+		mu.Lock()
+		callTimes = append(callTimes, time.Now())
+		mu.Unlock()
+
+		idx := int(atomic.AddInt32(&calls, 1)) - 1
+		if idx >= len(responses) {
+			idx = len(responses) - 1
+		}
+		return jsonResponse(req, http.StatusOK, responses[idx], nil)
+	})
+
+	return client, func() []time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]time.Time{}, callTimes...)
+	}
+}
+
+func TestSubscribeOrdersDedupesTieBoundaryAndBacksOffAdaptively(t *testing.T) {
+	const t1 = "2024-01-01T00:00:00Z"
+	const t2 = "2024-01-01T00:05:00Z"
+
+	responses := []string{
+		// Two issues tie at t1: both are new, so both are emitted.
+		watchIssuesResponse(
+			watchIssueEdge("1", "A-1", t1, "", ""),
+			watchIssueEdge("2", "A-2", t1, "", ""),
+		),
+		// Stale repeat of the same tie: no-change poll, nothing should be emitted.
+		watchIssuesResponse(
+			watchIssueEdge("1", "A-1", t1, "", ""),
+			watchIssueEdge("2", "A-2", t1, "", ""),
+		),
+		// Still no change.
+		watchIssuesResponse(
+			watchIssueEdge("1", "A-1", t1, "", ""),
+			watchIssueEdge("2", "A-2", t1, "", ""),
+		),
+		// A-1 advances to t2 and picks up a new worklog: one IssueUpdated, one WorklogAdded.
+		watchIssuesResponse(
+			watchIssueEdge("1", "A-1", t2, "w-1", ""),
+		),
+	}
+	httpClient, callTimes := sequencedClient(responses)
+
+	client := graphql.Client{
+		BaseURL:    "http://example",
+		Auth:       noAuth{},
+		HTTPClient: httpClient,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := client.Subscribe(ctx, graphql.WatchOptions{
+		CloudID:         "cloud-1",
+		JQL:             "project = A",
+		MinPollInterval: 15 * time.Millisecond,
+		MaxPollInterval: 120 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []graphql.Event
+	timeout := time.After(3 * time.Second)
+collect:
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				break collect
+			}
+			got = append(got, ev)
+			if len(got) == 4 {
+				cancel()
+			}
+		case <-timeout:
+			cancel()
+			t.Fatalf("timed out waiting for events, got so far: %+v", got)
+		}
+	}
+
+	if len(got) != 4 {
+		t.Fatalf("expected 4 events, got %d: %+v", len(got), got)
+	}
+	if got[0].IssueKey != "A-1" || got[0].Type != graphql.EventIssueUpdated {
+		t.Fatalf("unexpected first event: %+v", got[0])
+	}
+	if got[1].IssueKey != "A-2" || got[1].Type != graphql.EventIssueUpdated {
+		t.Fatalf("unexpected second event: %+v", got[1])
+	}
+	if got[2].IssueKey != "A-1" || got[2].Type != graphql.EventIssueUpdated {
+		t.Fatalf("expected A-1's t2 update third, got: %+v", got[2])
+	}
+	if got[3].IssueKey != "A-1" || got[3].Type != graphql.EventWorklogAdded {
+		t.Fatalf("expected a WorklogAdded event fourth, got: %+v", got[3])
+	}
+
+	times := callTimes()
+	if len(times) < 4 {
+		t.Fatalf("expected at least 4 polls, got %d", len(times))
+	}
+	noChangeGap := times[2].Sub(times[1])
+	firstGap := times[1].Sub(times[0])
+	if noChangeGap <= firstGap {
+		t.Fatalf("expected the poll interval to grow after a no-change poll: firstGap=%s noChangeGap=%s", firstGap, noChangeGap)
+	}
+}
+
+func TestSubscribeResumesFromCursorWithoutDoubleEmitting(t *testing.T) {
+	const t1 = "2024-01-01T00:00:00Z"
+	const t2 = "2024-01-01T00:05:00Z"
+
+	responses := []string{
+		// A-1 is already reflected in the resumed cursor; only A-2's later update is new.
+		watchIssuesResponse(
+			watchIssueEdge("1", "A-1", t1, "", ""),
+			watchIssueEdge("2", "A-2", t2, "", ""),
+		),
+	}
+	httpClient, _ := sequencedClient(responses)
+
+	client := graphql.Client{
+		BaseURL:    "http://example",
+		Auth:       noAuth{},
+		HTTPClient: httpClient,
+	}
+
+	resumeAt, err := time.Parse(time.RFC3339, t1)
+	if err != nil {
+		t.Fatalf("bad fixture time: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := client.Subscribe(ctx, graphql.WatchOptions{
+		CloudID:         "cloud-1",
+		JQL:             "project = A",
+		MinPollInterval: 15 * time.Millisecond,
+		MaxPollInterval: 120 * time.Millisecond,
+		Cursor: graphql.WatchCursor{
+			LastUpdated:       resumeAt,
+			SeenAtLastUpdated: map[string]struct{}{"1": {}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case ev := <-ch:
+		if ev.IssueKey != "A-2" {
+			t.Fatalf("expected only A-2's new update to be emitted, got: %+v", ev)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the resumed subscription to emit A-2's update")
+	}
+	cancel()
+}
+
+func TestSubscribeRejectsEmptyJQL(t *testing.T) {
+	client := graphql.Client{BaseURL: "http://example", Auth: noAuth{}}
+	if _, err := client.Subscribe(context.Background(), graphql.WatchOptions{CloudID: "cloud-1"}); err == nil {
+		t.Fatal("expected an error for missing JQL")
+	}
+}
+
+func TestSubscribeRejectsInvertedPollIntervals(t *testing.T) {
+	client := graphql.Client{BaseURL: "http://example", Auth: noAuth{}}
+	_, err := client.Subscribe(context.Background(), graphql.WatchOptions{
+		CloudID:         "cloud-1",
+		JQL:             "project = A",
+		MinPollInterval: time.Minute,
+		MaxPollInterval: time.Second,
+	})
+	if err == nil {
+		t.Fatal("expected an error when MaxPollInterval < MinPollInterval")
+	}
+}
+
+// TestSubscribeDrainsMultiplePagesInASinglePoll is a regression test for a poll window matching
+// more than 50 issues: without following pageInfo, the overflow issues would be silently dropped
+// and the cursor could advance past issues that were never returned, permanently excluding them.
+func TestSubscribeDrainsMultiplePagesInASinglePoll(t *testing.T) {
+	const updated = "2024-01-01T00:00:00Z"
+
+	const pageSize = 50
+	const total = 51
+	edges := make([]string, total)
+	for i := 0; i < total; i++ {
+		id := fmt.Sprintf("%d", i+1)
+		edges[i] = watchIssueEdge(id, "A-"+id, updated, "", "")
+	}
+
+	responses := []string{
+		watchIssuesResponsePaged(true, "cursor-1", edges[:pageSize]...),
+		watchIssuesResponsePaged(false, "", edges[pageSize:]...),
+	}
+	httpClient, _ := sequencedClient(responses)
+
+	client := graphql.Client{
+		BaseURL:    "http://example",
+		Auth:       noAuth{},
+		HTTPClient: httpClient,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := client.Subscribe(ctx, graphql.WatchOptions{
+		CloudID:         "cloud-1",
+		JQL:             "project = A",
+		MinPollInterval: 15 * time.Millisecond,
+		MaxPollInterval: 120 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	seen := map[string]bool{}
+	timeout := time.After(3 * time.Second)
+collect:
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				break collect
+			}
+			seen[ev.IssueKey] = true
+			if len(seen) == total {
+				cancel()
+			}
+		case <-timeout:
+			cancel()
+			t.Fatalf("timed out waiting for events, got %d of %d unique issues", len(seen), total)
+		}
+	}
+
+	if len(seen) != total {
+		t.Fatalf("expected all %d issues across both pages to be emitted, got %d: %+v", total, len(seen), seen)
+	}
+}
@@ -0,0 +1,201 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"atlassian/atlassian/graph/gen"
+	"atlassian/atlassian/graphstore"
+)
+
+func stringColumnValue(s string) *gen.GraphStoreCypherQueryV2Value {
+	return &gen.GraphStoreCypherQueryV2Value{
+		Kind:         gen.ColumnValueKindString,
+		StringObject: &gen.GraphStoreCypherQueryV2StringObject{Value: s},
+	}
+}
+
+func TestCypherResultStreamWalksPagesUntilEOF(t *testing.T) {
+	calls := 0
+	stream := graphstore.NewCypherResultStream(func(ctx context.Context, after string) (*gen.GraphStoreCypherQueryV2Connection, error) {
+		calls++
+		if after == "" {
+			endCursor := "cursor-1"
+			return &gen.GraphStoreCypherQueryV2Connection{
+				PageInfo: gen.GraphStoreCypherQueryV2PageInfo{HasNextPage: true, EndCursor: &endCursor},
+				Edges: []gen.GraphStoreCypherQueryV2Edge{
+					{Node: gen.GraphStoreCypherQueryV2Node{Columns: []gen.GraphStoreCypherQueryV2Column{{Key: "name", Value: stringColumnValue("a")}}}},
+					{Node: gen.GraphStoreCypherQueryV2Node{Columns: []gen.GraphStoreCypherQueryV2Column{{Key: "name", Value: stringColumnValue("b")}}}},
+				},
+			}, nil
+		}
+		if after != "cursor-1" {
+			t.Fatalf("unexpected cursor %q", after)
+		}
+		return &gen.GraphStoreCypherQueryV2Connection{
+			PageInfo: gen.GraphStoreCypherQueryV2PageInfo{HasNextPage: false},
+			Edges: []gen.GraphStoreCypherQueryV2Edge{
+				{Node: gen.GraphStoreCypherQueryV2Node{Columns: []gen.GraphStoreCypherQueryV2Column{{Key: "name", Value: stringColumnValue("c")}}}},
+			},
+		}, nil
+	})
+
+	var got []string
+	for {
+		node, err := stream.Next(context.Background())
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		name, err := graphstore.ColOf(node, "name").AsString()
+		if err != nil {
+			t.Fatalf("unexpected error reading column: %v", err)
+		}
+		got = append(got, name)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 page fetches, got %d", calls)
+	}
+	if len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Fatalf("expected [a b c], got %v", got)
+	}
+}
+
+func TestCypherResultStreamStopsOnFetchError(t *testing.T) {
+	sentinel := errors.New("boom")
+	stream := graphstore.NewCypherResultStream(func(ctx context.Context, after string) (*gen.GraphStoreCypherQueryV2Connection, error) {
+		return nil, sentinel
+	})
+
+	_, err := stream.Next(context.Background())
+	if !errors.Is(err, sentinel) {
+		t.Fatalf("expected sentinel error, got %v", err)
+	}
+	if !errors.Is(stream.Err(), sentinel) {
+		t.Fatalf("expected Err() to return sentinel, got %v", stream.Err())
+	}
+
+	// Once halted, further Next calls keep returning the same error rather than re-fetching.
+	_, err = stream.Next(context.Background())
+	if !errors.Is(err, sentinel) {
+		t.Fatalf("expected sticky error, got %v", err)
+	}
+}
+
+func TestColOfMissingColumn(t *testing.T) {
+	node := gen.GraphStoreCypherQueryV2Node{}
+	_, err := graphstore.ColOf(node, "missing").AsString()
+	if !errors.Is(err, graphstore.ErrColumnMissing) {
+		t.Fatalf("expected ErrColumnMissing, got %v", err)
+	}
+}
+
+func TestColOfTypeMismatch(t *testing.T) {
+	node := gen.GraphStoreCypherQueryV2Node{
+		Columns: []gen.GraphStoreCypherQueryV2Column{{Key: "name", Value: stringColumnValue("a")}},
+	}
+	_, err := graphstore.ColOf(node, "name").AsInt()
+	if !errors.Is(err, graphstore.ErrColumnTypeMismatch) {
+		t.Fatalf("expected ErrColumnTypeMismatch, got %v", err)
+	}
+}
+
+func TestCollectAppliesProjection(t *testing.T) {
+	stream := graphstore.NewCypherResultStream(func(ctx context.Context, after string) (*gen.GraphStoreCypherQueryV2Connection, error) {
+		if after != "" {
+			return &gen.GraphStoreCypherQueryV2Connection{}, nil
+		}
+		return &gen.GraphStoreCypherQueryV2Connection{
+			PageInfo: gen.GraphStoreCypherQueryV2PageInfo{HasNextPage: false},
+			Edges: []gen.GraphStoreCypherQueryV2Edge{
+				{Node: gen.GraphStoreCypherQueryV2Node{Columns: []gen.GraphStoreCypherQueryV2Column{{Key: "name", Value: stringColumnValue("a")}}}},
+				{Node: gen.GraphStoreCypherQueryV2Node{Columns: []gen.GraphStoreCypherQueryV2Column{{Key: "name", Value: stringColumnValue("b")}}}},
+			},
+		}, nil
+	})
+
+	names, err := graphstore.Collect(context.Background(), stream, func(node gen.GraphStoreCypherQueryV2Node) (string, error) {
+		return graphstore.ColOf(node, "name").AsString()
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(names) != 2 || names[0] != "a" || names[1] != "b" {
+		t.Fatalf("expected [a b], got %v", names)
+	}
+}
+
+func TestCollectPropagatesProjectionError(t *testing.T) {
+	stream := graphstore.NewCypherResultStream(func(ctx context.Context, after string) (*gen.GraphStoreCypherQueryV2Connection, error) {
+		return &gen.GraphStoreCypherQueryV2Connection{
+			PageInfo: gen.GraphStoreCypherQueryV2PageInfo{HasNextPage: false},
+			Edges: []gen.GraphStoreCypherQueryV2Edge{
+				{Node: gen.GraphStoreCypherQueryV2Node{}},
+			},
+		}, nil
+	})
+
+	_, err := graphstore.Collect(context.Background(), stream, func(node gen.GraphStoreCypherQueryV2Node) (string, error) {
+		return graphstore.ColOf(node, "name").AsString()
+	})
+	if !errors.Is(err, graphstore.ErrColumnMissing) {
+		t.Fatalf("expected ErrColumnMissing, got %v", err)
+	}
+}
+
+func nameEdge(name string) gen.GraphStoreCypherQueryV2Edge {
+	return gen.GraphStoreCypherQueryV2Edge{
+		Node: gen.GraphStoreCypherQueryV2Node{Columns: []gen.GraphStoreCypherQueryV2Column{{Key: "name", Value: stringColumnValue(name)}}},
+	}
+}
+
+func TestSortEdgesByOrdersUsingDefaultComparator(t *testing.T) {
+	edges := []gen.GraphStoreCypherQueryV2Edge{nameEdge("c"), nameEdge("a"), nameEdge("b")}
+	if err := graphstore.SortEdgesBy(edges, "name", graphstore.DefaultCypherValueComparator); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	names := []string{}
+	for _, e := range edges {
+		name, _ := graphstore.ColOf(e.Node, "name").AsString()
+		names = append(names, name)
+	}
+	if names[0] != "a" || names[1] != "b" || names[2] != "c" {
+		t.Fatalf("expected [a b c], got %v", names)
+	}
+}
+
+func TestSortEdgesByReportsIncomparableValues(t *testing.T) {
+	edges := []gen.GraphStoreCypherQueryV2Edge{
+		nameEdge("a"),
+		{Node: gen.GraphStoreCypherQueryV2Node{Columns: []gen.GraphStoreCypherQueryV2Column{{Key: "name", Value: &gen.GraphStoreCypherQueryV2Value{Kind: gen.ColumnValueKindInt, IntObject: &gen.GraphStoreCypherQueryV2IntObject{Value: 1}}}}}},
+	}
+	err := graphstore.SortEdgesBy(edges, "name", graphstore.DefaultCypherValueComparator)
+	if !errors.Is(err, graphstore.ErrIncomparableColumnValues) {
+		t.Fatalf("expected ErrIncomparableColumnValues, got %v", err)
+	}
+}
+
+func TestMergePagesByMergesSortedPages(t *testing.T) {
+	pageA := []gen.GraphStoreCypherQueryV2Edge{nameEdge("a"), nameEdge("c")}
+	pageB := []gen.GraphStoreCypherQueryV2Edge{nameEdge("b"), nameEdge("d")}
+
+	merged, err := graphstore.MergePagesBy([][]gen.GraphStoreCypherQueryV2Edge{pageA, pageB}, "name", graphstore.DefaultCypherValueComparator)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for _, e := range merged {
+		name, _ := graphstore.ColOf(e.Node, "name").AsString()
+		names = append(names, name)
+	}
+	want := []string{"a", "b", "c", "d"}
+	for i, name := range names {
+		if name != want[i] {
+			t.Fatalf("expected %v, got %v", want, names)
+		}
+	}
+}
@@ -23,7 +23,8 @@ func TestGraphIssueByKey(t *testing.T) {
 			if body["operationName"] != "JiraIssueByKey" {
 				t.Fatalf("unexpected operationName: %v", body["operationName"])
 			}
-			if strings.TrimSpace(body["query"].(string)) != strings.TrimSpace(gen.JiraIssueByKeyQuery) {
+			wantQuery := gen.BuildJiraIssueByKeyQuery(gen.JiraIssueByKeyOptions{})
+			if strings.TrimSpace(body["query"].(string)) != strings.TrimSpace(wantQuery) {
 				t.Fatalf("unexpected query")
 			}
 			vars := body["variables"].(map[string]any)
@@ -3,6 +3,7 @@ package unit
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 	"testing"
@@ -99,3 +100,153 @@ func TestGraphWorklogsPagination(t *testing.T) {
 		t.Fatalf("unexpected time spent: %+v", worklogs[1].TimeSpentSeconds)
 	}
 }
+
+func TestGraphIterateIssueWorklogsStopsOnYieldError(t *testing.T) {
+	call := 0
+	client := graph.Client{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			call++
+			return jsonResponse(req, http.StatusOK, `{
+  "data": {
+    "issue": {
+      "worklogs": {
+        "pageInfo": { "hasNextPage": true, "endCursor": "c1" },
+        "edges": [
+          {
+            "cursor": "e1",
+            "node": {
+              "worklogId": "w1",
+              "author": { "accountId": "u1", "name": "User One" },
+              "timeSpent": { "timeInSeconds": 60 },
+              "created": "2021-01-01T00:00:00Z",
+              "updated": "2021-01-01T01:00:00Z",
+              "startDate": "2021-01-01T00:00:00Z"
+            }
+          }
+        ]
+      }
+    }
+  }
+}`, nil)
+		}),
+	}
+
+	stop := errors.New("stop after first")
+	seen := 0
+	err := client.IterateIssueWorklogs(context.Background(), "cloud-123", "A-1", 1, func(node gen.JiraWorklogNode) error {
+		seen++
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("expected stop error, got %v", err)
+	}
+	if seen != 1 {
+		t.Fatalf("expected yield called once before stopping, got %d", seen)
+	}
+	if call != 1 {
+		t.Fatalf("expected only the first page to be fetched, got %d calls", call)
+	}
+}
+
+func TestGraphStreamIssueWorklogsYieldsAllNodes(t *testing.T) {
+	client := graph.Client{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			var body map[string]any
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			vars := body["variables"].(map[string]any)
+			if vars["after"] == nil {
+				return jsonResponse(req, http.StatusOK, `{
+  "data": {
+    "issue": {
+      "worklogs": {
+        "pageInfo": { "hasNextPage": true, "endCursor": "c1" },
+        "edges": [
+          { "cursor": "e1", "node": {
+              "worklogId": "w1",
+              "author": { "accountId": "u1", "name": "User One" },
+              "timeSpent": { "timeInSeconds": 60 },
+              "created": "2021-01-01T00:00:00Z",
+              "updated": "2021-01-01T01:00:00Z",
+              "startDate": "2021-01-01T00:00:00Z"
+          } }
+        ]
+      }
+    }
+  }
+}`, nil)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "data": {
+    "issue": {
+      "worklogs": {
+        "pageInfo": { "hasNextPage": false, "endCursor": null },
+        "edges": [
+          { "cursor": "e2", "node": {
+              "worklogId": "w2",
+              "author": { "accountId": "u2", "name": "User Two" },
+              "timeSpent": { "timeInSeconds": 120 },
+              "created": "2021-01-02T00:00:00Z",
+              "updated": "2021-01-02T01:00:00Z",
+              "startDate": "2021-01-02T00:00:00Z"
+          } }
+        ]
+      }
+    }
+  }
+}`, nil)
+		}),
+	}
+
+	ch, errFn := client.StreamIssueWorklogs(context.Background(), "cloud-123", "A-1", 1)
+	var ids []string
+	for node := range ch {
+		ids = append(ids, node.WorklogID)
+	}
+	if err := errFn(); err != nil {
+		t.Fatalf("unexpected stream error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "w1" || ids[1] != "w2" {
+		t.Fatalf("unexpected streamed worklog ids: %v", ids)
+	}
+}
+
+func TestGraphIterateIssueWorklogsDetectsRepeatedCursor(t *testing.T) {
+	client := graph.Client{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			return jsonResponse(req, http.StatusOK, `{
+  "data": {
+    "issue": {
+      "worklogs": {
+        "pageInfo": { "hasNextPage": true, "endCursor": "c1" },
+        "edges": [
+          { "cursor": "e1", "node": {
+              "worklogId": "w1",
+              "author": { "accountId": "u1", "name": "User One" },
+              "timeSpent": { "timeInSeconds": 60 },
+              "created": "2021-01-01T00:00:00Z",
+              "updated": "2021-01-01T01:00:00Z",
+              "startDate": "2021-01-01T00:00:00Z"
+          } }
+        ]
+      }
+    }
+  }
+}`, nil)
+		}),
+	}
+
+	err := client.IterateIssueWorklogs(context.Background(), "cloud-123", "A-1", 1, func(node gen.JiraWorklogNode) error {
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "cursor repeated") {
+		t.Fatalf("expected cursor-repeated error, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian/rest"
+	"atlassian/atlassian/rest/gen"
+	"atlassian/atlassian/rest/mappers"
+)
+
+func TestGetIssueWithExpansionsThreadsExpandParam(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/rest/api/3/issue/PROJ-1" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			if expand := req.URL.Query().Get("expand"); expand != "changelog,renderedFields" {
+				t.Fatalf("unexpected expand param: %q", expand)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "key": "PROJ-1",
+  "fields": {},
+  "changelog": {
+    "histories": [
+      {
+        "created": "2021-01-01T00:00:00.000Z",
+        "author": { "accountId": "acc-1", "displayName": "Ada Lovelace" },
+        "items": [
+          { "field": "status", "fromString": null, "toString": "In Progress" }
+        ]
+      }
+    ]
+  }
+}`, nil)
+		}),
+	}
+
+	issue, err := client.GetIssueWithExpansionsViaREST(context.Background(), "PROJ-1", []string{"changelog", "renderedFields"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	histories := mappers.IssueHistoriesFromGen(issue.Changelog)
+	if len(histories) != 1 {
+		t.Fatalf("expected 1 history, got %d", len(histories))
+	}
+	if len(histories[0].Items) != 1 {
+		t.Fatalf("expected history to keep its item despite a nil fromString, got %d items", len(histories[0].Items))
+	}
+	if histories[0].Items[0].FromString != "" {
+		t.Fatalf("expected empty FromString for a nil field, got %q", histories[0].Items[0].FromString)
+	}
+	if histories[0].Items[0].ToString != "In Progress" {
+		t.Fatalf("unexpected ToString: %q", histories[0].Items[0].ToString)
+	}
+	if histories[0].Author == nil || histories[0].Author.AccountID != "acc-1" {
+		t.Fatalf("unexpected Author: %+v", histories[0].Author)
+	}
+}
+
+func TestIssueTransitionsFromGen(t *testing.T) {
+	transitions := &gen.IssueTransitions{
+		Transitions: []gen.IssueTransition{
+			{
+				ID:   strPtr("11"),
+				Name: strPtr("Start Progress"),
+				To:   &gen.IssueTransitionStatus{Name: strPtr("In Progress")},
+			},
+		},
+	}
+
+	out, err := mappers.IssueTransitionsFromGen(transitions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected 1 transition, got %d", len(out))
+	}
+	if out[0].ID != "11" || out[0].Name != "Start Progress" || out[0].ToStatusName != "In Progress" {
+		t.Fatalf("unexpected transition: %+v", out[0])
+	}
+}
@@ -0,0 +1,136 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian/rest"
+)
+
+func TestGetIssueUsesConfiguredAPIVersion(t *testing.T) {
+	cases := []struct {
+		name       string
+		apiVersion rest.APIVersion
+		wantPath   string
+	}{
+		{"default to v3", "", "/rest/api/3/issue/A-1"},
+		{"explicit v3", rest.APIVersionV3, "/rest/api/3/issue/A-1"},
+		{"explicit v2", rest.APIVersionV2, "/rest/api/2/issue/A-1"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var seenPath string
+			client := rest.JiraRESTClient{
+				BaseURL:    "http://example",
+				Auth:       noAuth{},
+				APIVersion: tc.apiVersion,
+				HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+					seenPath = req.URL.Path
+					return jsonResponse(req, http.StatusOK, `{
+  "id": "1",
+  "key": "A-1",
+  "fields": {
+    "project": { "key": "A" },
+    "issuetype": { "name": "Bug" },
+    "status": { "name": "Done" },
+    "created": "2021-01-01T00:00:00.000+0000",
+    "updated": "2021-01-02T00:00:00.000+0000"
+  }
+}`, nil)
+				}),
+			}
+
+			if _, err := client.GetIssue(context.Background(), "cloud-1", "A-1"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if seenPath != tc.wantPath {
+				t.Fatalf("got path %q, want %q", seenPath, tc.wantPath)
+			}
+		})
+	}
+}
+
+func TestCreateIssueConvertsDescriptionForAPIVersion(t *testing.T) {
+	cases := []struct {
+		name       string
+		apiVersion rest.APIVersion
+		check      func(t *testing.T, description any)
+	}{
+		{
+			name:       "v3 wraps plain text in ADF",
+			apiVersion: rest.APIVersionV3,
+			check: func(t *testing.T, description any) {
+				doc, ok := description.(map[string]any)
+				if !ok {
+					t.Fatalf("expected ADF object, got %T: %v", description, description)
+				}
+				if doc["type"] != "doc" {
+					t.Fatalf("expected ADF doc, got %v", doc)
+				}
+			},
+		},
+		{
+			name:       "v2 keeps plain text as a string",
+			apiVersion: rest.APIVersionV2,
+			check: func(t *testing.T, description any) {
+				if _, ok := description.(string); !ok {
+					t.Fatalf("expected plain string, got %T: %v", description, description)
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sentDescription any
+			client := rest.JiraRESTClient{
+				BaseURL:    "http://example",
+				Auth:       noAuth{},
+				APIVersion: tc.apiVersion,
+				HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+					if req.URL.Path == "/rest/api/"+apiVersionSegment(tc.apiVersion)+"/issue" {
+						var body map[string]any
+						if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+							t.Fatalf("decode request: %v", err)
+						}
+						fields := body["fields"].(map[string]any)
+						sentDescription = fields["description"]
+						return jsonResponse(req, http.StatusCreated, `{"id":"1","key":"A-1"}`, nil)
+					}
+					return jsonResponse(req, http.StatusOK, `{
+  "id": "1",
+  "key": "A-1",
+  "fields": {
+    "project": { "key": "A" },
+    "issuetype": { "name": "Bug" },
+    "status": { "name": "Done" },
+    "created": "2021-01-01T00:00:00.000+0000",
+    "updated": "2021-01-02T00:00:00.000+0000"
+  }
+}`, nil)
+				}),
+			}
+
+			_, err := client.CreateIssue(context.Background(), "cloud-1", rest.CreateIssueInput{
+				ProjectKey:  "A",
+				Summary:     "test",
+				IssueType:   "Bug",
+				Description: "hello world",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tc.check(t, sentDescription)
+		})
+	}
+}
+
+func apiVersionSegment(v rest.APIVersion) string {
+	if v == rest.APIVersionV2 {
+		return "2"
+	}
+	return "3"
+}
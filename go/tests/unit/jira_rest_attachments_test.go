@@ -0,0 +1,157 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"atlassian/atlassian/rest"
+)
+
+func TestListAttachmentsParsesIssueAttachmentField(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Query().Get("fields") != "attachment" {
+				t.Fatalf("expected fields=attachment, got %s", req.URL.RawQuery)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "id": "1",
+  "key": "A-1",
+  "fields": {
+    "project": { "key": "A" },
+    "issuetype": { "name": "Bug" },
+    "status": { "name": "Done" },
+    "created": "2021-01-01T00:00:00.000+0000",
+    "updated": "2021-01-02T00:00:00.000+0000",
+    "attachment": [
+      {
+        "id": "10001",
+        "filename": "log.txt",
+        "mimeType": "text/plain",
+        "size": 42,
+        "content": "http://example/rest/api/3/attachment/content/10001"
+      }
+    ]
+  }
+}`, nil)
+		}),
+	}
+
+	attachments, err := client.ListAttachments(context.Background(), "cloud-1", "A-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(attachments) != 1 {
+		t.Fatalf("expected 1 attachment, got %d", len(attachments))
+	}
+	if attachments[0].ID != "10001" || attachments[0].Filename != "log.txt" {
+		t.Fatalf("unexpected attachment: %+v", attachments[0])
+	}
+	if attachments[0].Size != 42 {
+		t.Fatalf("expected size 42, got %d", attachments[0].Size)
+	}
+}
+
+func TestDownloadAttachmentStreamsContentAndReturnsChecksum(t *testing.T) {
+	const content = "the quick brown fox jumps over the lazy dog"
+	// sha256sum of content, computed once and pinned here so the test doesn't depend on the
+	// stdlib's hash implementation behaving as expected.
+	const wantSHA256 = "05c6e08f1d9fdafa03147fcb8f82f124c76d2f70e3d989dc8aadb5e7d7450bec"
+
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/rest/api/3/attachment/content/10001" {
+				t.Fatalf("unexpected path: %s", req.URL.Path)
+			}
+			if got := req.Header.Get("X-Atlassian-Token"); got != "no-check" {
+				t.Fatalf("expected X-Atlassian-Token: no-check, got %q", got)
+			}
+			return jsonResponse(req, http.StatusOK, content, nil)
+		}),
+	}
+
+	var buf bytes.Buffer
+	n, sum, err := client.DownloadAttachment(context.Background(), "10001", &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != int64(len(content)) {
+		t.Fatalf("expected %d bytes written, got %d", len(content), n)
+	}
+	if buf.String() != content {
+		t.Fatalf("expected streamed content %q, got %q", content, buf.String())
+	}
+	if sum != wantSHA256 {
+		t.Fatalf("expected checksum %s, got %s", wantSHA256, sum)
+	}
+}
+
+func TestDownloadAttachmentRequiresID(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			t.Fatal("expected no request to be made")
+			return nil
+		}),
+	}
+
+	if _, _, err := client.DownloadAttachment(context.Background(), "  ", io.Discard); err == nil {
+		t.Fatal("expected error for blank id")
+	}
+}
+
+func TestUploadAttachmentMapsEntityTooLargeError(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			return jsonResponse(req, http.StatusRequestEntityTooLarge, `{"errorMessages":["The file is too large."]}`, nil)
+		}),
+	}
+
+	_, err := client.UploadAttachment(context.Background(), "cloud-1", "A-1", "big.bin", strings.NewReader("payload"))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	var httpErr *rest.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("expected an *rest.HTTPError, got %T: %v", err, err)
+	}
+	if httpErr.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, httpErr.StatusCode)
+	}
+}
+
+func TestUploadAttachmentMapsUnsupportedMediaTypeError(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if got := req.Header.Get("X-Atlassian-Token"); got != "no-check" {
+				t.Fatalf("expected X-Atlassian-Token: no-check, got %q", got)
+			}
+			return jsonResponse(req, http.StatusUnsupportedMediaType, `{"errorMessages":["Unsupported content type."]}`, nil)
+		}),
+	}
+
+	_, err := client.UploadAttachment(context.Background(), "cloud-1", "A-1", "weird.xyz", strings.NewReader("payload"))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	var httpErr *rest.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("expected an *rest.HTTPError, got %T: %v", err, err)
+	}
+	if httpErr.StatusCode != http.StatusUnsupportedMediaType {
+		t.Fatalf("expected status %d, got %d", http.StatusUnsupportedMediaType, httpErr.StatusCode)
+	}
+}
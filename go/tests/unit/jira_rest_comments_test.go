@@ -0,0 +1,108 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian/rest"
+)
+
+func TestListCommentsParsesPage(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodGet {
+				t.Fatalf("expected GET, got %s", req.Method)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "startAt": 0,
+  "maxResults": 50,
+  "total": 1,
+  "comments": [
+    {
+      "id": "20001",
+      "author": { "accountId": "u-1", "displayName": "Ada" },
+      "created": "2021-01-01T00:00:00.000+0000",
+      "updated": "2021-01-02T00:00:00.000+0000",
+      "body": "release notes"
+    }
+  ]
+}`, nil)
+		}),
+	}
+
+	comments, err := client.ListComments(context.Background(), "cloud-1", "A-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(comments) != 1 {
+		t.Fatalf("expected 1 comment, got %d", len(comments))
+	}
+	if comments[0].ID != "20001" || comments[0].BodyText != "release notes" {
+		t.Fatalf("unexpected comment: %+v", comments[0])
+	}
+	if comments[0].Author == nil || comments[0].Author.DisplayName != "Ada" {
+		t.Fatalf("expected author Ada, got %+v", comments[0].Author)
+	}
+}
+
+func TestAddCommentReturnsCreatedComment(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost {
+				t.Fatalf("expected POST, got %s", req.Method)
+			}
+			return jsonResponse(req, http.StatusCreated, `{
+  "id": "20002",
+  "created": "2021-01-01T00:00:00.000+0000",
+  "body": "shipped v1.2.3"
+}`, nil)
+		}),
+	}
+
+	comment, err := client.AddComment(context.Background(), "cloud-1", "A-1", "shipped v1.2.3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if comment.ID != "20002" || comment.BodyText != "shipped v1.2.3" {
+		t.Fatalf("unexpected comment: %+v", comment)
+	}
+}
+
+func TestUpdateCommentRequiresCommentID(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			t.Fatal("expected no request when commentID is missing")
+			return nil
+		}),
+	}
+
+	if _, err := client.UpdateComment(context.Background(), "A-1", "", "updated body"); err == nil {
+		t.Fatal("expected error for missing commentID")
+	}
+}
+
+func TestDeleteCommentSendsDelete(t *testing.T) {
+	var gotMethod string
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			gotMethod = req.Method
+			return jsonResponse(req, http.StatusNoContent, "", nil)
+		}),
+	}
+
+	if err := client.DeleteComment(context.Background(), "A-1", "20001"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Fatalf("expected DELETE, got %s", gotMethod)
+	}
+}
@@ -0,0 +1,117 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian/rest"
+)
+
+func TestSearchIssuesJQLFollowsNextPageToken(t *testing.T) {
+	calls := 0
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost {
+				t.Fatalf("expected POST, got %s", req.Method)
+			}
+			if req.URL.Path != "/rest/api/3/search/jql" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			calls++
+			switch calls {
+			case 1:
+				return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      }
+    }
+  ],
+  "nextPageToken": "page-2"
+}`, nil)
+			case 2:
+				return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "2",
+      "key": "A-2",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Task" },
+        "status": { "name": "To Do" },
+        "created": "2021-01-03T00:00:00.000+0000",
+        "updated": "2021-01-04T00:00:00.000+0000"
+      }
+    }
+  ]
+}`, nil)
+			default:
+				t.Fatalf("unexpected call %d", calls)
+				return nil
+			}
+		}),
+	}
+
+	var keys []string
+	for issue, err := range client.SearchIssuesJQL(context.Background(), "cloud-1", rest.SearchJQLRequest{JQL: "project = A"}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		keys = append(keys, issue.Key)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+	if len(keys) != 2 || keys[0] != "A-1" || keys[1] != "A-2" {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+}
+
+func TestSearchIssuesJQLStopsEarlyOnBreak(t *testing.T) {
+	calls := 0
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			calls++
+			return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      }
+    }
+  ],
+  "nextPageToken": "page-2"
+}`, nil)
+		}),
+	}
+
+	for issue, err := range client.SearchIssuesJQL(context.Background(), "cloud-1", rest.SearchJQLRequest{JQL: "project = A"}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if issue.Key == "A-1" {
+			break
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected iteration to stop after 1 call, got %d", calls)
+	}
+}
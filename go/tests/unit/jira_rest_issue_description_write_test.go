@@ -0,0 +1,145 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian/rest"
+)
+
+// TestCreateIssueConvertsPlainTextDescriptionToADFOnV3 covers the chunk7-3 ask that a plain-text
+// description is auto-converted to an Atlassian Document Format v1 document when talking to the
+// (default) v3 API, via JiraRESTClient.descriptionForWrite.
+func TestCreateIssueConvertsPlainTextDescriptionToADFOnV3(t *testing.T) {
+	var createdFields map[string]any
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method == http.MethodPost && req.URL.Path == "/rest/api/3/issue" {
+				body := decodeJSONBody(t, req)
+				createdFields = body["fields"].(map[string]any)
+				return jsonResponse(req, http.StatusCreated, `{"id": "1", "key": "A-1"}`, nil)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "id": "1",
+  "key": "A-1",
+  "fields": {
+    "project": { "key": "A" },
+    "issuetype": { "name": "Bug" },
+    "status": { "name": "Done" },
+    "created": "2021-01-01T00:00:00.000+0000",
+    "updated": "2021-01-02T00:00:00.000+0000"
+  }
+}`, nil)
+		}),
+	}
+
+	_, err := client.CreateIssue(context.Background(), "cloud-1", rest.CreateIssueInput{
+		ProjectKey:  "A",
+		Summary:     "test",
+		IssueType:   "Bug",
+		Description: "Plain text description.",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	desc, ok := createdFields["description"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected description to be converted to an ADF document, got %T: %v", createdFields["description"], createdFields["description"])
+	}
+	if desc["type"] != "doc" || desc["version"] != float64(1) {
+		t.Fatalf("unexpected ADF document: %+v", desc)
+	}
+}
+
+// TestCreateIssueConvertsADFDescriptionToPlainTextOnV2 covers the other direction: a raw ADF
+// document passed against a v2 (Server/Data Center) client is flattened to plain text, since v2
+// doesn't understand ADF.
+func TestCreateIssueConvertsADFDescriptionToPlainTextOnV2(t *testing.T) {
+	var createdFields map[string]any
+	client := rest.JiraRESTClient{
+		BaseURL:    "http://example",
+		Auth:       noAuth{},
+		APIVersion: rest.APIVersionV2,
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method == http.MethodPost && req.URL.Path == "/rest/api/2/issue" {
+				body := decodeJSONBody(t, req)
+				createdFields = body["fields"].(map[string]any)
+				return jsonResponse(req, http.StatusCreated, `{"id": "1", "key": "A-1"}`, nil)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "id": "1",
+  "key": "A-1",
+  "fields": {
+    "project": { "key": "A" },
+    "issuetype": { "name": "Bug" },
+    "status": { "name": "Done" },
+    "created": "2021-01-01T00:00:00.000+0000",
+    "updated": "2021-01-02T00:00:00.000+0000"
+  }
+}`, nil)
+		}),
+	}
+
+	_, err := client.CreateIssue(context.Background(), "cloud-1", rest.CreateIssueInput{
+		ProjectKey: "A",
+		Summary:    "test",
+		IssueType:  "Bug",
+		Description: map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{
+				map[string]any{"type": "paragraph", "content": []any{
+					map[string]any{"type": "text", "text": "ADF text."},
+				}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	desc, ok := createdFields["description"].(string)
+	if !ok || desc != "ADF text." {
+		t.Fatalf("expected description flattened to plain text \"ADF text.\", got %T: %v", createdFields["description"], createdFields["description"])
+	}
+}
+
+// TestTransitionIssueLooksUpTransitionIDByStatusName covers driving target_status through
+// GET /issue/{key}/transitions followed by POST /issue/{key}/transitions with the matched ID.
+func TestTransitionIssueLooksUpTransitionIDByStatusName(t *testing.T) {
+	var postedTransition map[string]any
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			switch {
+			case req.Method == http.MethodGet && req.URL.Path == "/rest/api/3/issue/A-1/transitions":
+				return jsonResponse(req, http.StatusOK, `{
+  "transitions": [
+    { "id": "11", "name": "To Do", "to": { "name": "To Do" } },
+    { "id": "21", "name": "Start Progress", "to": { "name": "In Progress" } }
+  ]
+}`, nil)
+			case req.Method == http.MethodPost && req.URL.Path == "/rest/api/3/issue/A-1/transitions":
+				postedTransition = decodeJSONBody(t, req)
+				return jsonResponse(req, http.StatusNoContent, `{}`, nil)
+			default:
+				t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+				return nil
+			}
+		}),
+	}
+
+	if err := client.TransitionIssue(context.Background(), "A-1", "In Progress"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	transition, ok := postedTransition["transition"].(map[string]any)
+	if !ok || transition["id"] != "21" {
+		t.Fatalf("expected transition id 21 for status \"In Progress\", got %+v", postedTransition)
+	}
+}
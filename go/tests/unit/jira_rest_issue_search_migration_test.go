@@ -0,0 +1,144 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+)
+
+// TestSearchIssuesStreamFallsBackOnGone covers the chunk7-5 ask that a 410 (not just 404) from the
+// new token-paginated search endpoint triggers the legacy startAt-paginated fallback, since Jira
+// Cloud returns 410 once an endpoint has been sunset outright.
+func TestSearchIssuesStreamFallsBackOnGone(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			switch {
+			case req.URL.Path == "/rest/api/3/search/jql":
+				return jsonResponse(req, http.StatusGone, `{"errorMessages": ["Gone"]}`, nil)
+			case req.URL.Path == "/rest/api/3/search":
+				return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      }
+    }
+  ],
+  "startAt": 0,
+  "maxResults": 50,
+  "total": 1
+}`, nil)
+			default:
+				t.Fatalf("unexpected path: %s", req.URL.Path)
+				return nil
+			}
+		}),
+	}
+
+	issues, err := client.ListIssuesViaREST(context.Background(), "cloud-1", "project = A", 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "A-1" {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+}
+
+// TestListIssuesViaRESTStreamDeliversIssuesAndCloses covers the new channel-based streaming
+// variant: issues arrive on the issues channel and both channels close cleanly on success.
+func TestListIssuesViaRESTStreamDeliversIssuesAndCloses(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      }
+    }
+  ],
+  "isLast": true
+}`, nil)
+		}),
+	}
+
+	issuesCh, errCh := client.ListIssuesViaRESTStream(context.Background(), "cloud-1", "project = A", 0)
+
+	var got []atlassian.JiraIssue
+	for issue := range issuesCh {
+		got = append(got, issue)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].Key != "A-1" {
+		t.Fatalf("unexpected issues: %+v", got)
+	}
+}
+
+// TestListIssuesViaRESTStreamPropagatesCursorError covers the nextAfterForWorklogs-style cursor
+// error propagation: a page that sets nextPageToken but returns zero issues is a malformed
+// response Jira shouldn't send, and must surface as an error rather than looping forever.
+func TestListIssuesViaRESTStreamPropagatesCursorError(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			return jsonResponse(req, http.StatusOK, `{"issues": [], "nextPageToken": "page-2"}`, nil)
+		}),
+	}
+
+	issuesCh, errCh := client.ListIssuesViaRESTStream(context.Background(), "cloud-1", "project = A", 0)
+
+	for range issuesCh {
+		t.Fatal("expected no issues")
+	}
+	if err := <-errCh; err == nil {
+		t.Fatal("expected a pagination cursor error")
+	}
+}
+
+// TestCountIssuesViaREST covers the new /rest/api/3/search/approximate-count wiring.
+func TestCountIssuesViaREST(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost || req.URL.Path != "/rest/api/3/search/approximate-count" {
+				t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			body := decodeJSONBody(t, req)
+			if body["jql"] != "project = A" {
+				t.Fatalf("unexpected jql: %v", body["jql"])
+			}
+			return jsonResponse(req, http.StatusOK, `{"count": 42}`, nil)
+		}),
+	}
+
+	count, err := client.CountIssuesViaREST(context.Background(), "project = A")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 42 {
+		t.Fatalf("expected count 42, got %d", count)
+	}
+}
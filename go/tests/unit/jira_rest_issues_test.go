@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"atlassian/atlassian/rest"
+	"atlassian/atlassian/rest/mappers"
 )
 
 func TestJiraRESTIssuesPaginationAndMapping(t *testing.T) {
@@ -233,3 +234,220 @@ func TestJiraRESTIssuesEnvFields(t *testing.T) {
 		t.Fatalf("unexpected sprint ids: %+v", issues[0].SprintIDs)
 	}
 }
+
+func TestJiraRESTIssuesADFDescriptionAndComments(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/rest/api/3/search" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			if req.URL.Query().Get("expand") != "renderedFields" {
+				t.Fatalf("expected expand=renderedFields, got %q", req.URL.Query().Get("expand"))
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "startAt": 0,
+  "maxResults": 1,
+  "total": 1,
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000",
+        "description": {
+          "type": "doc",
+          "version": 1,
+          "content": [
+            { "type": "paragraph", "content": [{ "type": "text", "text": "Line one." }] },
+            { "type": "bulletList", "content": [
+              { "type": "listItem", "content": [{ "type": "paragraph", "content": [{ "type": "text", "text": "item a" }] }] },
+              { "type": "listItem", "content": [{ "type": "paragraph", "content": [{ "type": "text", "text": "item b" }] }] }
+            ] }
+          ]
+        },
+        "comment": {
+          "comments": [
+            {
+              "id": "10",
+              "author": { "accountId": "u1", "displayName": "User 1" },
+              "created": "2021-01-03T00:00:00.000+0000",
+              "body": { "type": "doc", "version": 1, "content": [
+                { "type": "paragraph", "content": [{ "type": "text", "text": "A comment." }] }
+              ] }
+            }
+          ]
+        },
+        "renderedFields": { "description": "<p>Line one.</p>" }
+      }
+    }
+  ]
+}`, nil)
+		}),
+	}
+
+	issues, err := client.ListIssuesViaREST(context.Background(), "cloud-123", "project = A", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+	issue := issues[0]
+
+	if issue.DescriptionADF == nil {
+		t.Fatal("expected a parsed ADF description")
+	}
+	wantText := "Line one.\n\n- item a\n- item b"
+	if issue.DescriptionText != wantText {
+		t.Fatalf("DescriptionText = %q, want %q", issue.DescriptionText, wantText)
+	}
+	if issue.DescriptionHTML == nil || *issue.DescriptionHTML != "<p>Line one.</p>" {
+		t.Fatalf("unexpected DescriptionHTML: %+v", issue.DescriptionHTML)
+	}
+	if len(issue.Comments) != 1 {
+		t.Fatalf("expected 1 comment, got %d", len(issue.Comments))
+	}
+	if issue.Comments[0].BodyText != "A comment." {
+		t.Fatalf("unexpected comment body: %q", issue.Comments[0].BodyText)
+	}
+	if issue.Comments[0].Author == nil || issue.Comments[0].Author.DisplayName != "User 1" {
+		t.Fatalf("unexpected comment author: %+v", issue.Comments[0].Author)
+	}
+}
+
+func TestJiraRESTIssuesCustomFieldSpecs(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/rest/api/3/search" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			fields := req.URL.Query().Get("fields")
+			for _, key := range []string{"customfield_10014", "customfield_10021", "customfield_10030", "customfield_10040"} {
+				if !strings.Contains(fields, key) {
+					t.Fatalf("missing %s in fields query: %s", key, fields)
+				}
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "startAt": 0,
+  "maxResults": 50,
+  "total": 1,
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000",
+        "customfield_10014": "EPIC-9",
+        "customfield_10021": { "value": "Platform" },
+        "customfield_10030": { "value": "Region", "child": { "value": "NA" } },
+        "customfield_10040": ["a", "b"]
+      }
+    }
+  ]
+}`, nil)
+		}),
+	}
+
+	issues, err := client.ListIssuesViaRESTWithSpec(
+		context.Background(),
+		"cloud-123",
+		"project = A",
+		50,
+		"",
+		"",
+		[]mappers.CustomFieldSpec{
+			{Key: "customfield_10014", Alias: "epic_link", Kind: mappers.CustomFieldString},
+			{Key: "customfield_10021", Alias: "team", Kind: mappers.CustomFieldOption},
+			{Key: "customfield_10030", Alias: "category", Kind: mappers.CustomFieldCascading},
+			{Key: "customfield_10040", Alias: "tags", Kind: mappers.CustomFieldArray},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+	custom := issues[0].CustomFields
+	if custom["epic_link"] != "EPIC-9" {
+		t.Fatalf("unexpected epic_link: %+v", custom["epic_link"])
+	}
+	if custom["team"] != "Platform" {
+		t.Fatalf("unexpected team: %+v", custom["team"])
+	}
+	category, ok := custom["category"].(mappers.CascadingOption)
+	if !ok || category.Value != "Region" || category.Child == nil || *category.Child != "NA" {
+		t.Fatalf("unexpected category: %+v", custom["category"])
+	}
+	tags, ok := custom["tags"].([]string)
+	if !ok || len(tags) != 2 || tags[0] != "a" || tags[1] != "b" {
+		t.Fatalf("unexpected tags: %+v", custom["tags"])
+	}
+}
+
+func TestJiraRESTIssuesReporterAndPriority(t *testing.T) {
+	var createdFields map[string]any
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method == http.MethodPost && req.URL.Path == "/rest/api/3/issue" {
+				body := decodeJSONBody(t, req)
+				createdFields = body["fields"].(map[string]any)
+				return jsonResponse(req, http.StatusCreated, `{"id": "1", "key": "A-1"}`, nil)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "id": "1",
+  "key": "A-1",
+  "fields": {
+    "project": { "key": "A" },
+    "issuetype": { "name": "Bug" },
+    "status": { "name": "Done" },
+    "created": "2021-01-01T00:00:00.000+0000",
+    "updated": "2021-01-02T00:00:00.000+0000",
+    "reporter": { "accountId": "acc-reporter", "displayName": "Reporter" },
+    "priority": { "name": "High" }
+  }
+}`, nil)
+		}),
+	}
+
+	created, err := client.CreateIssue(context.Background(), "cloud-1", rest.CreateIssueInput{
+		ProjectKey:        "A",
+		Summary:           "test",
+		IssueType:         "Bug",
+		ReporterAccountID: "acc-reporter",
+		Priority:          "High",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reporter, ok := createdFields["reporter"].(map[string]any)
+	if !ok || reporter["accountId"] != "acc-reporter" {
+		t.Fatalf("unexpected reporter field sent: %+v", createdFields["reporter"])
+	}
+	priority, ok := createdFields["priority"].(map[string]any)
+	if !ok || priority["name"] != "High" {
+		t.Fatalf("unexpected priority field sent: %+v", createdFields["priority"])
+	}
+
+	if created.Reporter == nil || created.Reporter.AccountID != "acc-reporter" {
+		t.Fatalf("unexpected mapped reporter: %+v", created.Reporter)
+	}
+	if created.Priority == nil || *created.Priority != "High" {
+		t.Fatalf("unexpected mapped priority: %+v", created.Priority)
+	}
+}
@@ -0,0 +1,150 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+)
+
+func decodeJSONBody(t *testing.T, req *http.Request) map[string]any {
+	t.Helper()
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("read request body: %v", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("decode request body: %v", err)
+	}
+	return out
+}
+
+func TestListIssuesViaRESTV2FollowsIsLast(t *testing.T) {
+	calls := 0
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost {
+				t.Fatalf("expected POST, got %s", req.Method)
+			}
+			if req.URL.Path != "/rest/api/3/search/jql" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			body := decodeJSONBody(t, req)
+			calls++
+			switch calls {
+			case 1:
+				if body["nextPageToken"] != nil {
+					t.Fatalf("expected no nextPageToken on first call, got %v", body["nextPageToken"])
+				}
+				return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      }
+    }
+  ],
+  "nextPageToken": "page-2",
+  "isLast": false
+}`, nil)
+			case 2:
+				if body["nextPageToken"] != "page-2" {
+					t.Fatalf("expected nextPageToken page-2, got %v", body["nextPageToken"])
+				}
+				return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "2",
+      "key": "A-2",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Task" },
+        "status": { "name": "To Do" },
+        "created": "2021-01-03T00:00:00.000+0000",
+        "updated": "2021-01-04T00:00:00.000+0000"
+      }
+    }
+  ],
+  "isLast": true
+}`, nil)
+			default:
+				t.Fatalf("unexpected call %d", calls)
+				return nil
+			}
+		}),
+	}
+
+	issues, err := client.ListIssuesViaRESTV2(context.Background(), "cloud-1", "project = A", 0, rest.JQLSearchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+	if len(issues) != 2 || issues[0].Key != "A-1" || issues[1].Key != "A-2" {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+}
+
+func TestIterateIssuesViaRESTSendsPropertiesAndFieldsByKey(t *testing.T) {
+	var body map[string]any
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			body = decodeJSONBody(t, req)
+			return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      }
+    }
+  ],
+  "isLast": true
+}`, nil)
+		}),
+	}
+
+	var keys []string
+	err := client.IterateIssuesViaREST(context.Background(), "cloud-1", "project = A", 0, rest.JQLSearchOptions{
+		Fields:      []string{"customfield_10099"},
+		Properties:  []string{"my-property"},
+		FieldsByKey: true,
+	}, func(issue atlassian.JiraIssue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != "A-1" {
+		t.Fatalf("unexpected keys: %v", keys)
+	}
+	if body["fieldsByKeys"] != true {
+		t.Fatalf("expected fieldsByKeys=true, got %v", body["fieldsByKeys"])
+	}
+	props, ok := body["properties"].([]any)
+	if !ok || len(props) != 1 || props[0] != "my-property" {
+		t.Fatalf("unexpected properties: %v", body["properties"])
+	}
+}
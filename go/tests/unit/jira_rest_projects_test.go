@@ -0,0 +1,110 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+)
+
+func TestGetProjectMapsResponseFields(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodGet {
+				t.Fatalf("expected GET, got %s", req.Method)
+			}
+			if req.URL.Path != "/rest/api/3/project/PROJ" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "key": "PROJ",
+  "name": "Project",
+  "description": "a project",
+  "projectTypeKey": "software",
+  "assigneeType": "PROJECT_LEAD",
+  "url": "https://example.atlassian.net/projects/PROJ",
+  "lead": { "accountId": "acc-1" },
+  "projectCategory": { "id": "10", "name": "Cat" },
+  "archived": true
+}`, nil)
+		}),
+	}
+
+	p, err := client.GetProject(context.Background(), "cloud-1", "PROJ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Key != "PROJ" || p.Name != "Project" {
+		t.Fatalf("unexpected project: %+v", p)
+	}
+	if p.LeadAccountID == nil || *p.LeadAccountID != "acc-1" {
+		t.Fatalf("unexpected lead account id: %v", p.LeadAccountID)
+	}
+	if p.CategoryID == nil || *p.CategoryID != "10" {
+		t.Fatalf("unexpected category id: %v", p.CategoryID)
+	}
+	if !p.Archived {
+		t.Fatalf("expected project to be archived")
+	}
+}
+
+func TestUpdateProjectSendsOnlyPopulatedFields(t *testing.T) {
+	var body map[string]any
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPut {
+				t.Fatalf("expected PUT, got %s", req.Method)
+			}
+			if req.URL.Path != "/rest/api/3/project/PROJ" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			body = decodeJSONBody(t, req)
+			return jsonResponse(req, http.StatusOK, `{"key": "PROJ", "name": "Updated"}`, nil)
+		}),
+	}
+
+	description := "new description"
+	_, err := client.UpdateProject(context.Background(), "cloud-1", atlassian.JiraProject{
+		Key:         "PROJ",
+		Name:        "Updated",
+		Description: &description,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["description"] != description {
+		t.Fatalf("unexpected description: %v", body["description"])
+	}
+	if _, ok := body["leadAccountId"]; ok {
+		t.Fatalf("expected leadAccountId to be omitted, got %v", body["leadAccountId"])
+	}
+}
+
+func TestArchiveAndRestoreProjectHitExpectedPaths(t *testing.T) {
+	var seenPaths []string
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			seenPaths = append(seenPaths, req.URL.Path)
+			return jsonResponse(req, http.StatusNoContent, "", nil)
+		}),
+	}
+
+	if err := client.ArchiveProject(context.Background(), "PROJ"); err != nil {
+		t.Fatalf("unexpected error archiving: %v", err)
+	}
+	if err := client.RestoreProject(context.Background(), "PROJ"); err != nil {
+		t.Fatalf("unexpected error restoring: %v", err)
+	}
+	want := []string{"/rest/api/3/project/PROJ/archive", "/rest/api/3/project/PROJ/restore"}
+	if len(seenPaths) != 2 || seenPaths[0] != want[0] || seenPaths[1] != want[1] {
+		t.Fatalf("unexpected paths: %v", seenPaths)
+	}
+}
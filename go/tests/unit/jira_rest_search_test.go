@@ -0,0 +1,235 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"atlassian/atlassian/rest"
+)
+
+func TestSearchIssuesUsesTokenPagination(t *testing.T) {
+	calls := 0
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/rest/api/3/search/jql" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			calls++
+			token := req.URL.Query().Get("nextPageToken")
+			switch token {
+			case "":
+				return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      }
+    }
+  ],
+  "nextPageToken": "page-2"
+}`, nil)
+			case "page-2":
+				return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "2",
+      "key": "A-2",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Task" },
+        "status": { "name": "To Do" },
+        "created": "2021-01-03T00:00:00.000+0000",
+        "updated": "2021-01-04T00:00:00.000+0000"
+      }
+    }
+  ]
+}`, nil)
+			default:
+				t.Fatalf("unexpected nextPageToken %q", token)
+				return nil
+			}
+		}),
+	}
+
+	issues, err := client.SearchIssues(context.Background(), "cloud-1", "project = A", rest.SearchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+	if len(issues) != 2 || issues[0].Key != "A-1" || issues[1].Key != "A-2" {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+}
+
+func TestSearchIssuesFallsBackToLegacySearch(t *testing.T) {
+	var seenPaths []string
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			seenPaths = append(seenPaths, req.URL.Path)
+			if req.URL.Path == "/rest/api/3/search/jql" {
+				return jsonResponse(req, http.StatusNotFound, `{"errorMessages":["not found"]}`, nil)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "startAt": 0,
+  "maxResults": 50,
+  "total": 1,
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      }
+    }
+  ]
+}`, nil)
+		}),
+	}
+
+	issues, err := client.SearchIssues(context.Background(), "cloud-1", "project = A", rest.SearchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(seenPaths) != 2 || seenPaths[0] != "/rest/api/3/search/jql" || seenPaths[1] != "/rest/api/3/search" {
+		t.Fatalf("unexpected request paths: %v", seenPaths)
+	}
+	if len(issues) != 1 || issues[0].Key != "A-1" {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+}
+
+func TestSearchIssuesExpandChangelogSplitsHistory(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/rest/api/3/search/jql" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			if req.URL.Query().Get("expand") != "changelog" {
+				t.Fatalf("expected expand=changelog, got %q", req.URL.Query().Get("expand"))
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "issues": [
+    {
+      "id": "1",
+      "key": "A-1",
+      "fields": {
+        "project": { "key": "A" },
+        "issuetype": { "name": "Bug" },
+        "status": { "name": "Done" },
+        "created": "2021-01-01T00:00:00.000+0000",
+        "updated": "2021-01-02T00:00:00.000+0000"
+      },
+      "changelog": {
+        "histories": [
+          {
+            "created": "2021-01-03T00:00:00.000+0000",
+            "items": [
+              { "field": "status", "fromString": "To Do", "toString": "In Progress" },
+              { "field": "assignee", "from": "u1", "to": "u2" },
+              { "field": "Sprint", "fromString": "", "toString": "Sprint 1" }
+            ]
+          },
+          {
+            "created": "2021-01-04T00:00:00.000+0000",
+            "items": [
+              { "field": "Sprint", "fromString": "Sprint 1", "toString": "Sprint 1, Sprint 2" }
+            ]
+          }
+        ]
+      }
+    }
+  ]
+}`, nil)
+		}),
+	}
+
+	issues, err := client.SearchIssues(context.Background(), "cloud-1", "project = A", rest.SearchOptions{
+		Expand:          []string{"changelog"},
+		SprintNameIndex: map[string]string{"Sprint 1": "101", "Sprint 2": "102"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+	issue := issues[0]
+
+	if len(issue.StatusHistory) != 1 || issue.StatusHistory[0].From != "To Do" || issue.StatusHistory[0].To != "In Progress" {
+		t.Fatalf("unexpected status history: %+v", issue.StatusHistory)
+	}
+	if len(issue.AssigneeHistory) != 1 || issue.AssigneeHistory[0].FromAccountID != "u1" || issue.AssigneeHistory[0].ToAccountID != "u2" {
+		t.Fatalf("unexpected assignee history: %+v", issue.AssigneeHistory)
+	}
+	if len(issue.SprintsHistory) != 2 {
+		t.Fatalf("expected 2 sprint changes, got %d: %+v", len(issue.SprintsHistory), issue.SprintsHistory)
+	}
+	if len(issue.SprintsHistory[0].Added) != 1 || issue.SprintsHistory[0].Added[0] != "101" {
+		t.Fatalf("unexpected first sprint change: %+v", issue.SprintsHistory[0])
+	}
+	if len(issue.SprintsHistory[1].Added) != 1 || issue.SprintsHistory[1].Added[0] != "102" {
+		t.Fatalf("unexpected second sprint change: %+v", issue.SprintsHistory[1])
+	}
+}
+
+func TestListIssueChangelogPaginates(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/rest/api/3/issue/A-1/changelog" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			switch req.URL.Query().Get("startAt") {
+			case "0":
+				return jsonResponse(req, http.StatusOK, `{
+  "startAt": 0,
+  "maxResults": 1,
+  "total": 2,
+  "histories": [
+    { "id": "1", "created": "2021-01-01T00:00:00.000+0000", "items": [{ "field": "status", "fromString": "To Do", "toString": "In Progress" }] }
+  ]
+}`, nil)
+			case "1":
+				return jsonResponse(req, http.StatusOK, `{
+  "startAt": 1,
+  "maxResults": 1,
+  "total": 2,
+  "histories": [
+    { "id": "2", "created": "2021-01-02T00:00:00.000+0000", "items": [{ "field": "status", "fromString": "In Progress", "toString": "Done" }] }
+  ]
+}`, nil)
+			default:
+				t.Fatalf("unexpected startAt %q", req.URL.Query().Get("startAt"))
+				return nil
+			}
+		}),
+	}
+
+	histories, err := client.ListIssueChangelog(context.Background(), "cloud-1", "A-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(histories) != 2 {
+		t.Fatalf("expected 2 histories, got %d", len(histories))
+	}
+}
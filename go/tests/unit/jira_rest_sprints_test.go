@@ -163,3 +163,164 @@ func TestListBoardSprintsWithStateFilter(t *testing.T) {
 		t.Fatalf("expected state=active, got %s", sprints[0].State)
 	}
 }
+
+func TestCreateSprintSendsExpectedBody(t *testing.T) {
+	var body map[string]any
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost || req.URL.Path != "/rest/agile/1.0/sprint" {
+				t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			}
+			body = decodeJSONBody(t, req)
+			return jsonResponse(req, http.StatusOK, `{
+  "id": 200,
+  "name": "Sprint 3",
+  "state": "future",
+  "originBoardId": 10,
+  "goal": "Ship it"
+}`, nil)
+		}),
+	}
+
+	sprint, err := client.CreateSprint(context.Background(), rest.CreateSprintInput{
+		Name:          "Sprint 3",
+		OriginBoardID: 10,
+		Goal:          "Ship it",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["name"] != "Sprint 3" || body["goal"] != "Ship it" {
+		t.Fatalf("unexpected request body: %+v", body)
+	}
+	if body["originBoardId"].(float64) != 10 {
+		t.Fatalf("unexpected originBoardId: %+v", body["originBoardId"])
+	}
+	if sprint.ID != "200" || sprint.Goal == nil || *sprint.Goal != "Ship it" {
+		t.Fatalf("unexpected sprint: %+v", sprint)
+	}
+	if sprint.BoardID == nil || *sprint.BoardID != "10" {
+		t.Fatalf("unexpected board ID: %v", sprint.BoardID)
+	}
+}
+
+func TestCreateSprintRequiresNameAndBoardID(t *testing.T) {
+	client := rest.JiraRESTClient{BaseURL: "http://example", Auth: noAuth{}}
+
+	if _, err := client.CreateSprint(context.Background(), rest.CreateSprintInput{OriginBoardID: 10}); err == nil {
+		t.Fatalf("expected error for missing name")
+	}
+	if _, err := client.CreateSprint(context.Background(), rest.CreateSprintInput{Name: "Sprint"}); err == nil {
+		t.Fatalf("expected error for missing originBoardID")
+	}
+}
+
+func TestUpdateSprintSendsOnlyPopulatedFields(t *testing.T) {
+	var body map[string]any
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPut || req.URL.Path != "/rest/agile/1.0/sprint/200" {
+				t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			}
+			body = decodeJSONBody(t, req)
+			return jsonResponse(req, http.StatusOK, `{
+  "id": 200,
+  "name": "Sprint 3",
+  "state": "active"
+}`, nil)
+		}),
+	}
+
+	newState := "active"
+	_, err := client.UpdateSprint(context.Background(), "200", rest.UpdateSprintInput{State: &newState})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(body) != 1 || body["state"] != "active" {
+		t.Fatalf("expected only state in update body, got: %+v", body)
+	}
+}
+
+func TestDeleteSprintHitsExpectedPath(t *testing.T) {
+	called := false
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			called = true
+			if req.Method != http.MethodDelete || req.URL.Path != "/rest/agile/1.0/sprint/200" {
+				t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			}
+			return jsonResponse(req, http.StatusNoContent, ``, nil)
+		}),
+	}
+
+	if err := client.DeleteSprint(context.Background(), "200"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatalf("expected HTTP call")
+	}
+}
+
+func TestMoveIssuesToSprintBatchesInGroupsOfFifty(t *testing.T) {
+	var calls int
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			calls++
+			if req.Method != http.MethodPost || req.URL.Path != "/rest/agile/1.0/sprint/200/issue" {
+				t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			}
+			body := decodeJSONBody(t, req)
+			issues, ok := body["issues"].([]any)
+			if !ok || len(issues) == 0 {
+				t.Fatalf("expected non-empty issues array in request body")
+			}
+			return jsonResponse(req, http.StatusNoContent, ``, nil)
+		}),
+	}
+
+	issueKeys := make([]string, 0, 75)
+	for i := 0; i < 75; i++ {
+		issueKeys = append(issueKeys, strconv.Itoa(i))
+	}
+
+	if err := client.MoveIssuesToSprint(context.Background(), "200", issueKeys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 batched calls, got %d", calls)
+	}
+}
+
+func TestGetSprintViaRESTMapsResponse(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodGet || req.URL.Path != "/rest/agile/1.0/sprint/200" {
+				t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "id": 200,
+  "name": "Sprint 3",
+  "state": "active",
+  "originBoardId": 10
+}`, nil)
+		}),
+	}
+
+	sprint, err := client.GetSprintViaREST(context.Background(), "200")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sprint.ID != "200" || sprint.Name != "Sprint 3" || sprint.State != "active" {
+		t.Fatalf("unexpected sprint: %+v", sprint)
+	}
+}
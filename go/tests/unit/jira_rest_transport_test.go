@@ -0,0 +1,311 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+)
+
+// errRoundTripFunc is like roundTripFunc but can also return a transport error, which
+// roundTripFunc (defined in helpers_test.go) has no way to express.
+type errRoundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f errRoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type recordingObserver struct {
+	retries   int32
+	throttled int32
+	tripped   int32
+	requests  int32
+}
+
+func (o *recordingObserver) OnRetry(host string, attempt int, err error) { atomic.AddInt32(&o.retries, 1) }
+func (o *recordingObserver) OnCircuitOpen(host string)                   { atomic.AddInt32(&o.tripped, 1) }
+func (o *recordingObserver) OnThrottled(host string)                     { atomic.AddInt32(&o.throttled, 1) }
+func (o *recordingObserver) OnRequest(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+	atomic.AddInt32(&o.requests, 1)
+}
+
+func TestTransportRetriesOn503ThenSucceeds(t *testing.T) {
+	var calls int32
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&calls, 1)
+		if n == 1 {
+			return jsonResponse(req, http.StatusServiceUnavailable, `{}`, nil), nil
+		}
+		return jsonResponse(req, http.StatusOK, `{"ok":true}`, nil), nil
+	})
+	observer := &recordingObserver{}
+	transport := rest.NewTransport(rest.ClientOptions{Base: base, Observer: observer, MaxRetries: 3})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected eventual 200, got %d", resp.StatusCode)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 attempts, got %d", calls)
+	}
+	if observer.retries != 1 {
+		t.Fatalf("expected 1 OnRetry call, got %d", observer.retries)
+	}
+}
+
+func TestTransportHonorsRetryAfterSeconds(t *testing.T) {
+	var calls int32
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return jsonResponse(req, http.StatusTooManyRequests, `{}`, http.Header{"Retry-After": []string{"0"}}), nil
+		}
+		return jsonResponse(req, http.StatusOK, `{}`, nil), nil
+	})
+	transport := rest.NewTransport(rest.ClientOptions{Base: base, MaxRetries: 1})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected eventual 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestTransportGivesUpAfterMaxRetries(t *testing.T) {
+	var calls int32
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return jsonResponse(req, http.StatusServiceUnavailable, `{}`, nil), nil
+	})
+	transport := rest.NewTransport(rest.ClientOptions{Base: base, MaxRetries: 2})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 after exhausting retries, got %d", resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 1 initial attempt + 2 retries = 3 calls, got %d", calls)
+	}
+}
+
+func TestTransportDoesNotRetryOn4xxOtherThan429(t *testing.T) {
+	var calls int32
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return jsonResponse(req, http.StatusNotFound, `{}`, nil), nil
+	})
+	transport := rest.NewTransport(rest.ClientOptions{Base: base, MaxRetries: 3})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 passthrough, got %d", resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Fatalf("expected no retries for 404, got %d calls", calls)
+	}
+}
+
+func TestTransportOpensCircuitAfterConsecutiveFailures(t *testing.T) {
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(req, http.StatusServiceUnavailable, `{}`, nil), nil
+	})
+	observer := &recordingObserver{}
+	transport := rest.NewTransport(rest.ClientOptions{
+		Base:             base,
+		Observer:         observer,
+		MaxRetries:       0,
+		CircuitThreshold: 2,
+		CircuitCooldown:  time.Hour,
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	for i := 0; i < 2; i++ {
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatalf("unexpected error on attempt %d: %v", i, err)
+		}
+	}
+
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Fatalf("expected circuit breaker to reject the third request")
+	}
+	if observer.tripped != 1 {
+		t.Fatalf("expected 1 OnCircuitOpen call, got %d", observer.tripped)
+	}
+}
+
+// fakeReauthenticator records how many times Reauth was called and stamps every request with a
+// header so TestTransportReauthenticatesOn401ThenSucceeds can tell a reauthenticated request
+// apart from the original one.
+type fakeReauthenticator struct {
+	calls int32
+}
+
+func (f *fakeReauthenticator) Apply(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer stale")
+	return nil
+}
+
+func (f *fakeReauthenticator) Reauth(req *http.Request) error {
+	atomic.AddInt32(&f.calls, 1)
+	req.Header.Set("Authorization", "Bearer fresh")
+	return nil
+}
+
+func TestTransportReauthenticatesOn401ThenSucceeds(t *testing.T) {
+	var calls int32
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return jsonResponse(req, http.StatusUnauthorized, `{}`, nil), nil
+		}
+		if req.Header.Get("Authorization") != "Bearer fresh" {
+			t.Fatalf("expected retried request to carry the refreshed credential")
+		}
+		return jsonResponse(req, http.StatusOK, `{"ok":true}`, nil), nil
+	})
+	auth := &fakeReauthenticator{}
+	transport := rest.NewTransport(rest.ClientOptions{Base: base, Auth: auth})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	_ = auth.Apply(req)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected eventual 200 after reauth, got %d", resp.StatusCode)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 attempts (original + reauthenticated), got %d", calls)
+	}
+	if auth.calls != 1 {
+		t.Fatalf("expected exactly 1 Reauth call, got %d", auth.calls)
+	}
+}
+
+func TestTransportDoesNotReauthenticateWithoutReauthenticator(t *testing.T) {
+	var calls int32
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return jsonResponse(req, http.StatusUnauthorized, `{}`, nil), nil
+	})
+	transport := rest.NewTransport(rest.ClientOptions{Base: base})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 passthrough, got %d", resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Fatalf("expected no retry without a reauthenticator, got %d calls", calls)
+	}
+}
+
+func TestTransportDoesNotRetryNonIdempotentMethodOn503(t *testing.T) {
+	var calls int32
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return jsonResponse(req, http.StatusServiceUnavailable, `{}`, nil), nil
+	})
+	transport := rest.NewTransport(rest.ClientOptions{Base: base, MaxRetries: 3})
+
+	req, _ := http.NewRequest(http.MethodPost, "http://example/rest/api/3/issue", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 passthrough, got %d", resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Fatalf("expected no retries for a non-idempotent POST on 503, got %d calls", calls)
+	}
+}
+
+func TestTransportCircuitOpenErrorIsAtlassianErrCircuitOpen(t *testing.T) {
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(req, http.StatusServiceUnavailable, `{}`, nil), nil
+	})
+	transport := rest.NewTransport(rest.ClientOptions{
+		Base:             base,
+		MaxRetries:       0,
+		CircuitThreshold: 1,
+		CircuitCooldown:  time.Hour,
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error on first (tripping) attempt: %v", err)
+	}
+
+	_, err := transport.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected the circuit breaker to reject this request")
+	}
+	if !errors.Is(err, atlassian.ErrCircuitOpen) {
+		t.Fatalf("expected errors.Is(err, atlassian.ErrCircuitOpen), got %v", err)
+	}
+}
+
+func TestTransportCallsOnRequestOnceForFinalOutcome(t *testing.T) {
+	var calls int32
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&calls, 1)
+		if n == 1 {
+			return jsonResponse(req, http.StatusServiceUnavailable, `{}`, nil), nil
+		}
+		return jsonResponse(req, http.StatusOK, `{}`, nil), nil
+	})
+	observer := &recordingObserver{}
+	transport := rest.NewTransport(rest.ClientOptions{Base: base, Observer: observer, MaxRetries: 3})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if observer.requests != 1 {
+		t.Fatalf("expected exactly 1 OnRequest call despite 2 attempts, got %d", observer.requests)
+	}
+}
+
+func TestTransportThrottlesOverQPSCeiling(t *testing.T) {
+	base := errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(req, http.StatusOK, `{}`, nil), nil
+	})
+	observer := &recordingObserver{}
+	transport := rest.NewTransport(rest.ClientOptions{Base: base, Observer: observer, MaxQPS: 1000, Burst: 1})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://example/rest/api/3/search", nil)
+	req = req.WithContext(context.Background())
+
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if observer.throttled == 0 {
+		t.Fatalf("expected the burst-exceeding request to be throttled")
+	}
+}
@@ -0,0 +1,68 @@
+package unit
+
+import (
+	"testing"
+
+	"atlassian/atlassian/rest/gen"
+	"atlassian/atlassian/rest/mappers"
+)
+
+func TestMapRESTVersionCarriesFullLifecycleFields(t *testing.T) {
+	v := gen.Version{
+		ID:              strPtr("10000"),
+		ProjectID:       intPtr(20000),
+		Name:            strPtr("v1.0"),
+		Description:     strPtr("First release"),
+		Released:        boolPtr(true),
+		Archived:        boolPtr(true),
+		Overdue:         boolPtr(true),
+		ReleaseDate:     strPtr("2026-01-01"),
+		StartDate:       strPtr("2025-12-01"),
+		UserStartDate:   strPtr("Dec 2025"),
+		UserReleaseDate: strPtr("Jan 2026"),
+		Self:            strPtr("https://example.atlassian.net/rest/api/3/version/10000"),
+	}
+
+	out := mappers.MapRESTVersion("PROJ", v)
+
+	if out.ID != "10000" {
+		t.Fatalf("expected ID 10000, got %s", out.ID)
+	}
+	if out.ProjectKey != "PROJ" {
+		t.Fatalf("expected ProjectKey PROJ, got %s", out.ProjectKey)
+	}
+	if out.ProjectID == nil || *out.ProjectID != 20000 {
+		t.Fatalf("unexpected ProjectID: %v", out.ProjectID)
+	}
+	if !out.Released || !out.Archived || !out.Overdue {
+		t.Fatalf("expected Released, Archived, and Overdue all true, got %+v", out)
+	}
+	if out.StartDate == nil || *out.StartDate != "2025-12-01" {
+		t.Fatalf("unexpected StartDate: %v", out.StartDate)
+	}
+	if out.UserStartDate == nil || *out.UserStartDate != "Dec 2025" {
+		t.Fatalf("unexpected UserStartDate: %v", out.UserStartDate)
+	}
+	if out.UserReleaseDate == nil || *out.UserReleaseDate != "Jan 2026" {
+		t.Fatalf("unexpected UserReleaseDate: %v", out.UserReleaseDate)
+	}
+	if out.Self == nil || *out.Self != "https://example.atlassian.net/rest/api/3/version/10000" {
+		t.Fatalf("unexpected Self: %v", out.Self)
+	}
+}
+
+func TestMapRESTVersionDefaultsOverdueWhenAbsent(t *testing.T) {
+	v := gen.Version{
+		ID:   strPtr("10001"),
+		Name: strPtr("v1.1"),
+	}
+
+	out := mappers.MapRESTVersion("PROJ", v)
+
+	if out.Overdue {
+		t.Fatalf("expected Overdue to default to false")
+	}
+	if out.ProjectID != nil {
+		t.Fatalf("expected nil ProjectID when absent, got %v", out.ProjectID)
+	}
+}
@@ -0,0 +1,85 @@
+package unit
+
+import (
+	"testing"
+
+	"atlassian/atlassian/rest/mappers"
+)
+
+func TestJiraWorklogMapperHappyPath(t *testing.T) {
+	obj := map[string]any{
+		"id":      "10001",
+		"started": "2021-01-01T09:00:00.000+0000",
+		"created": "2021-01-01T09:00:00.000+0000",
+		"updated": "2021-01-01T09:05:00.000+0000",
+		"author": map[string]any{
+			"accountId":   "acc-1",
+			"displayName": "Ada Lovelace",
+		},
+		"timeSpentSeconds": float64(3600),
+		"comment":          "Investigated the outage",
+	}
+
+	out, err := mappers.JiraWorklogFromREST("PROJ-1", obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.IssueKey != "PROJ-1" {
+		t.Fatalf("expected IssueKey PROJ-1, got %s", out.IssueKey)
+	}
+	if out.WorklogID != "10001" {
+		t.Fatalf("expected WorklogID 10001, got %s", out.WorklogID)
+	}
+	if out.TimeSpentSeconds != 3600 {
+		t.Fatalf("expected TimeSpentSeconds 3600, got %d", out.TimeSpentSeconds)
+	}
+	if out.Author == nil || out.Author.AccountID != "acc-1" {
+		t.Fatalf("unexpected Author: %+v", out.Author)
+	}
+	if out.Comment == nil || *out.Comment != "Investigated the outage" {
+		t.Fatalf("unexpected Comment: %v", out.Comment)
+	}
+}
+
+func TestJiraWorklogMapperRequiresTimeSpentSeconds(t *testing.T) {
+	obj := map[string]any{
+		"id":      "10001",
+		"started": "2021-01-01T09:00:00.000+0000",
+		"created": "2021-01-01T09:00:00.000+0000",
+		"updated": "2021-01-01T09:05:00.000+0000",
+	}
+
+	_, err := mappers.JiraWorklogFromREST("PROJ-1", obj)
+	if err == nil {
+		t.Fatal("expected error for missing timeSpentSeconds")
+	}
+}
+
+func TestJiraWorklogMapperRejectsNegativeTimeSpentSeconds(t *testing.T) {
+	obj := map[string]any{
+		"id":               "10001",
+		"started":          "2021-01-01T09:00:00.000+0000",
+		"created":          "2021-01-01T09:00:00.000+0000",
+		"updated":          "2021-01-01T09:05:00.000+0000",
+		"timeSpentSeconds": float64(-1),
+	}
+
+	_, err := mappers.JiraWorklogFromREST("PROJ-1", obj)
+	if err == nil {
+		t.Fatal("expected error for negative timeSpentSeconds")
+	}
+}
+
+func TestJiraWorklogMapperRequiresID(t *testing.T) {
+	obj := map[string]any{
+		"started":          "2021-01-01T09:00:00.000+0000",
+		"created":          "2021-01-01T09:00:00.000+0000",
+		"updated":          "2021-01-01T09:05:00.000+0000",
+		"timeSpentSeconds": float64(60),
+	}
+
+	_, err := mappers.JiraWorklogFromREST("PROJ-1", obj)
+	if err == nil {
+		t.Fatal("expected error for missing id")
+	}
+}
@@ -0,0 +1,125 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+)
+
+func TestJQLBuilderRendersEscapedClausesAndOrderBy(t *testing.T) {
+	where := atlassian.And(
+		atlassian.Project("A"),
+		atlassian.Status().In("Done", "In Progress"),
+		atlassian.Assignee().Is(atlassian.Func("currentUser")),
+		atlassian.Labels().Contains("back end", `quote"and\slash`),
+		atlassian.CustomField("customfield_10016").GreaterThan(5),
+	)
+	got := rest.NewJQLBuilder(where).OrderBy("created", true).OrderBy("key", false).String()
+
+	want := `project = "A" AND status in ("Done", "In Progress") AND assignee = currentUser() AND ` +
+		`labels in ("back end", "quote\"and\\slash") AND customfield_10016 > 5 ORDER BY created DESC, key ASC`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestJQLBuilderGroupsMixedBooleans(t *testing.T) {
+	where := atlassian.And(
+		atlassian.Or(atlassian.Status().Is("Done"), atlassian.Status().Is("Closed")),
+		atlassian.Not(atlassian.Assignee().Is("bob")),
+	)
+	got := rest.NewJQLBuilder(where).String()
+	want := `(status = "Done" OR status = "Closed") AND NOT assignee = "bob"`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+// TestJQLBuilderDoesNotSniffStringValuesForFunctionCalls is a regression test for jqlLiteral
+// previously recognizing any "ident(...)"-shaped string value as a bare function call and
+// emitting it unescaped: a caller-supplied string like "x() OR priority = Highest OR y()" must
+// still be quoted as an ordinary string literal, not injected verbatim into the rendered JQL.
+func TestJQLBuilderDoesNotSniffStringValuesForFunctionCalls(t *testing.T) {
+	got := rest.NewJQLBuilder(atlassian.Assignee().Is("x() OR priority = Highest OR y()")).String()
+	want := `assignee = "x() OR priority = Highest OR y()"`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+// TestJQLFuncRendersQuotedArgs covers Func's escaping of its own string arguments, the
+// replacement for the old content-sniffing path.
+func TestJQLFuncRendersQuotedArgs(t *testing.T) {
+	got := rest.NewJQLBuilder(atlassian.Assignee().In(atlassian.Func("membersOf", "jira-developers"))).String()
+	want := `assignee in (membersOf("jira-developers"))`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestJQLBuilderRendersDateLiteral(t *testing.T) {
+	created := time.Date(2026, 1, 2, 15, 4, 0, 0, time.UTC)
+	got := rest.NewJQLBuilder(atlassian.Created().After(created)).String()
+	want := `created > "2026/01/02 15:04"`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+// TestJQLBuilderNormalizesNonUTCDateLiteralToUTC is a regression test for jqlLiteral silently
+// dropping a non-UTC offset: JQL date literals without an explicit zone are interpreted in the
+// Jira instance's configured timezone, so a time.Time with a +09:00 offset must be normalized to
+// UTC before rendering rather than having its as-is wall-clock fields printed.
+func TestJQLBuilderNormalizesNonUTCDateLiteralToUTC(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	created := time.Date(2026, 1, 3, 0, 4, 0, 0, jst)
+	got := rest.NewJQLBuilder(atlassian.Created().After(created)).String()
+	want := `created > "2026/01/02 15:04"`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidateJQLSurfacesParseErrors(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost || req.URL.Path != "/rest/api/3/jql/parse" {
+				t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			return jsonResponse(req, http.StatusOK, `{
+  "queries": [
+    { "query": "project = ", "errors": ["A value is required for the field 'project'."] }
+  ]
+}`, nil)
+		}),
+	}
+
+	err := client.ValidateJQL(context.Background(), "project = ")
+	if err == nil {
+		t.Fatal("expected an error for invalid JQL")
+	}
+}
+
+func TestValidateJQLAcceptsValidQuery(t *testing.T) {
+	client := rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			return jsonResponse(req, http.StatusOK, `{
+  "queries": [
+    { "query": "project = A", "errors": [] }
+  ]
+}`, nil)
+		}),
+	}
+
+	if err := client.ValidateJQL(context.Background(), "project = A"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
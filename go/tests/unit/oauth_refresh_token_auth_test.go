@@ -0,0 +1,56 @@
+package unit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"atlassian/atlassian"
+)
+
+// TestOAuthRefreshTokenAuthInvokesOnRefresh is a regression test for OnRefresh never firing: the
+// whole point of the callback is letting a caller persist a refreshed token, so Apply triggering
+// a refresh must call it with the new access token, refresh token, and expiry.
+func TestOAuthRefreshTokenAuthInvokesOnRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"new-access","refresh_token":"new-refresh","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	var gotAccessToken, gotRefreshToken string
+	var gotExpiresAt time.Time
+	auth := &atlassian.OAuthRefreshTokenAuth{
+		ClientID:     "client-1",
+		ClientSecret: "secret-1",
+		RefreshToken: "old-refresh",
+		TokenURL:     server.URL,
+		OnRefresh: func(accessToken, refreshToken string, expiresAt time.Time) {
+			gotAccessToken = accessToken
+			gotRefreshToken = refreshToken
+			gotExpiresAt = expiresAt
+		},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "http://example/", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := auth.Apply(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotAccessToken != "new-access" {
+		t.Fatalf("expected OnRefresh to receive the new access token, got %q", gotAccessToken)
+	}
+	if gotRefreshToken != "new-refresh" {
+		t.Fatalf("expected OnRefresh to receive the rotated refresh token, got %q", gotRefreshToken)
+	}
+	if gotExpiresAt.Before(time.Now()) {
+		t.Fatalf("expected OnRefresh's expiresAt to be in the future, got %v", gotExpiresAt)
+	}
+	if req.Header.Get("Authorization") != "Bearer new-access" {
+		t.Fatalf("expected Authorization header to use the new access token, got %q", req.Header.Get("Authorization"))
+	}
+}
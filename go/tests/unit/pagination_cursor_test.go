@@ -0,0 +1,193 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"atlassian/atlassian/pagination"
+)
+
+func TestCursorIteratorWalksPagesUntilExhausted(t *testing.T) {
+	calls := 0
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]int, bool, *string, error) {
+		calls++
+		if after == nil {
+			return []int{1, 2}, true, strPtr("cursor-2"), nil
+		}
+		if *after == "cursor-2" {
+			return []int{3}, false, nil, nil
+		}
+		t.Fatalf("unexpected cursor %q", *after)
+		return nil, false, nil, nil
+	}, pagination.CursorIteratorOptions{})
+
+	var got []int
+	for {
+		item, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item == nil {
+			break
+		}
+		got = append(got, *item)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 page fetches, got %d", calls)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestCursorIteratorMaxPagesStopsEarly(t *testing.T) {
+	calls := 0
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]int, bool, *string, error) {
+		calls++
+		return []int{calls}, true, strPtr("next"), nil
+	}, pagination.CursorIteratorOptions{MaxPages: 2})
+
+	var got []int
+	for {
+		item, err := it.Next(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item == nil {
+			break
+		}
+		got = append(got, *item)
+	}
+	if calls != 2 {
+		t.Fatalf("expected fetch to stop after MaxPages=2, got %d calls", calls)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 items, got %v", got)
+	}
+}
+
+func TestCursorIteratorRetriesOnceThenSucceeds(t *testing.T) {
+	calls := 0
+	sentinel := errors.New("transient")
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]int, bool, *string, error) {
+		calls++
+		if calls == 1 {
+			return nil, false, nil, sentinel
+		}
+		return []int{1}, false, nil, nil
+	}, pagination.CursorIteratorOptions{ErrorPolicy: pagination.CursorErrorRetry})
+
+	item, err := it.Next(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item == nil || *item != 1 {
+		t.Fatalf("expected item 1, got %v", item)
+	}
+	if calls != 2 {
+		t.Fatalf("expected exactly one retry (2 calls), got %d", calls)
+	}
+}
+
+func TestCursorIteratorStopPolicySurfacesError(t *testing.T) {
+	sentinel := errors.New("boom")
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]int, bool, *string, error) {
+		return nil, false, nil, sentinel
+	}, pagination.CursorIteratorOptions{})
+
+	_, err := it.Next(context.Background())
+	if !errors.Is(err, sentinel) {
+		t.Fatalf("expected sentinel error, got %v", err)
+	}
+	if !errors.Is(it.Err(), sentinel) {
+		t.Fatalf("expected Err() to return sentinel, got %v", it.Err())
+	}
+
+	// Once halted, further Next calls keep returning the same error rather than re-fetching.
+	_, err = it.Next(context.Background())
+	if !errors.Is(err, sentinel) {
+		t.Fatalf("expected sticky error, got %v", err)
+	}
+}
+
+func TestCursorIteratorClose(t *testing.T) {
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]int, bool, *string, error) {
+		t.Fatalf("fetch should not be called after Close")
+		return nil, false, nil, nil
+	}, pagination.CursorIteratorOptions{})
+
+	if err := it.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	item, err := it.Next(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item != nil {
+		t.Fatalf("expected nil item after Close, got %v", item)
+	}
+}
+
+func TestCursorIteratorStartAfterResumesFromCheckpoint(t *testing.T) {
+	var seenAfter []string
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]int, bool, *string, error) {
+		if after == nil {
+			seenAfter = append(seenAfter, "<nil>")
+		} else {
+			seenAfter = append(seenAfter, *after)
+		}
+		if after != nil && *after == "cursor-1" {
+			return []int{2}, false, nil, nil
+		}
+		t.Fatalf("unexpected cursor %v", after)
+		return nil, false, nil, nil
+	}, pagination.CursorIteratorOptions{StartAfter: strPtr("cursor-1")})
+
+	item, err := it.Next(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item == nil || *item != 2 {
+		t.Fatalf("expected item 2, got %v", item)
+	}
+	if len(seenAfter) != 1 || seenAfter[0] != "cursor-1" {
+		t.Fatalf("expected the first fetch to resume from cursor-1, got %v", seenAfter)
+	}
+}
+
+func TestCursorIteratorCursorReflectsCurrentPosition(t *testing.T) {
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]int, bool, *string, error) {
+		if after == nil {
+			return []int{1}, true, strPtr("cursor-1"), nil
+		}
+		return []int{2}, false, nil, nil
+	}, pagination.CursorIteratorOptions{})
+
+	if it.Cursor() != nil {
+		t.Fatalf("expected nil cursor before any fetch, got %v", it.Cursor())
+	}
+	if _, err := it.Next(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if it.Cursor() == nil || *it.Cursor() != "cursor-1" {
+		t.Fatalf("expected cursor to be cursor-1 after first page, got %v", it.Cursor())
+	}
+}
+
+func TestCursorIteratorChannelStreamsAllItems(t *testing.T) {
+	it := pagination.NewCursorIterator(func(ctx context.Context, after *string) ([]int, bool, *string, error) {
+		if after == nil {
+			return []int{1, 2}, true, strPtr("c"), nil
+		}
+		return []int{3}, false, nil, nil
+	}, pagination.CursorIteratorOptions{})
+
+	var got []int
+	for item := range it.Channel(context.Background()) {
+		got = append(got, item)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
@@ -0,0 +1,100 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"atlassian/atlassian/pagination"
+)
+
+func boolPtr(b bool) *bool    { return &b }
+func intPtr(i int) *int       { return &i }
+func floatPtr(f float64) *float64 { return &f }
+
+func TestPaginatorAllStopsOnIsLast(t *testing.T) {
+	calls := 0
+	p := pagination.New(func(ctx context.Context, startAt int) ([]int, *bool, *int, error) {
+		calls++
+		if startAt == 0 {
+			return []int{1, 2}, boolPtr(false), nil, nil
+		}
+		return []int{3}, boolPtr(true), nil, nil
+	}, 2)
+
+	items, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 fetches, got %d", calls)
+	}
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+}
+
+func TestPaginatorAllStopsOnTotal(t *testing.T) {
+	p := pagination.New(func(ctx context.Context, startAt int) ([]int, *bool, *int, error) {
+		if startAt == 0 {
+			return []int{1, 2}, nil, intPtr(3), nil
+		}
+		return []int{3}, nil, intPtr(3), nil
+	}, 2)
+
+	items, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+}
+
+func TestPaginatorRejectsEmptyPageWithIsLastFalse(t *testing.T) {
+	p := pagination.New(func(ctx context.Context, startAt int) ([]int, *bool, *int, error) {
+		return nil, boolPtr(false), nil, nil
+	}, 50)
+
+	_, err := p.All(context.Background())
+	if err == nil {
+		t.Fatalf("expected error for empty page with isLast=false")
+	}
+}
+
+func TestPaginatorIteratePropagatesYieldError(t *testing.T) {
+	sentinel := errors.New("stop")
+	p := pagination.New(func(ctx context.Context, startAt int) ([]int, *bool, *int, error) {
+		return []int{1, 2, 3}, boolPtr(true), nil, nil
+	}, 50)
+
+	seen := 0
+	err := p.Iterate(context.Background(), func(item int) error {
+		seen++
+		if item == 2 {
+			return sentinel
+		}
+		return nil
+	})
+	if !errors.Is(err, sentinel) {
+		t.Fatalf("expected sentinel error, got %v", err)
+	}
+	if seen != 2 {
+		t.Fatalf("expected iteration to stop after 2 items, got %d", seen)
+	}
+}
+
+func TestPaginatorRespectsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := pagination.New(func(ctx context.Context, startAt int) ([]int, *bool, *int, error) {
+		t.Fatalf("fetch should not be called on an already-cancelled context")
+		return nil, nil, nil, nil
+	}, 50)
+
+	_, err := p.All(ctx)
+	if err == nil {
+		t.Fatalf("expected context cancellation error")
+	}
+}
@@ -0,0 +1,112 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"atlassian/atlassian/rest"
+	"atlassian/atlassian/vcs"
+)
+
+func TestExtractIssueKeysDedupesPreservingOrder(t *testing.T) {
+	text := "fix(auth): resolve token refresh in PROJ-12\n\nAlso touches proj-13 and PROJ-12 again, and AB-4."
+	got := vcs.ExtractIssueKeys(text)
+	want := []string{"PROJ-12", "AB-4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestExtractIssueKeysNoMatches(t *testing.T) {
+	if got := vcs.ExtractIssueKeys("chore: tidy up imports"); got != nil {
+		t.Fatalf("expected no matches, got %v", got)
+	}
+}
+
+func TestParseConventionalCommitType(t *testing.T) {
+	cases := []struct {
+		subject  string
+		wantType string
+		wantOK   bool
+	}{
+		{"feat(auth): add OAuth support", "feat", true},
+		{"fix: handle empty input", "fix", true},
+		{"feat!: breaking change", "feat", true},
+		{"Merge branch 'main' into feature", "", false},
+		{"PROJ-12 quick hotfix", "", false},
+	}
+	for _, c := range cases {
+		gotType, gotOK := vcs.ParseConventionalCommitType(c.subject)
+		if gotType != c.wantType || gotOK != c.wantOK {
+			t.Fatalf("ParseConventionalCommitType(%q) = (%q, %v), want (%q, %v)", c.subject, gotType, gotOK, c.wantType, c.wantOK)
+		}
+	}
+}
+
+func TestProcessCommitRequiresClient(t *testing.T) {
+	linker := vcs.NewLinker(nil, "cloud-1")
+	results, err := linker.ProcessCommit(context.Background(), vcs.Commit{Subject: "PROJ-1: fix bug"})
+	if err == nil {
+		t.Fatalf("expected error because Client is nil, got results %v", results)
+	}
+}
+
+func TestProcessCommitNoIssueKeysReturnsNilWithoutCallingClient(t *testing.T) {
+	client := &rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			t.Fatalf("unexpected API call to %s", req.URL.Path)
+			return nil
+		}),
+	}
+	linker := vcs.NewLinker(client, "cloud-1")
+	results, err := linker.ProcessCommit(context.Background(), vcs.Commit{Subject: "chore: tidy up imports"})
+	if err != nil || results != nil {
+		t.Fatalf("got results=%v err=%v, want nil, nil", results, err)
+	}
+}
+
+func TestProcessCommitTransitionsAndComments(t *testing.T) {
+	var seenPaths []string
+	client := &rest.JiraRESTClient{
+		BaseURL: "http://example",
+		Auth:    noAuth{},
+		HTTPClient: newHTTPClient(func(req *http.Request) *http.Response {
+			seenPaths = append(seenPaths, req.URL.Path)
+			switch {
+			case req.URL.Path == "/rest/api/3/issue/PROJ-12/comment":
+				return jsonResponse(req, http.StatusCreated, `{}`, nil)
+			case req.URL.Path == "/rest/api/3/issue/PROJ-12/transitions" && req.Method == http.MethodGet:
+				return jsonResponse(req, http.StatusOK, `{"transitions":[{"id":"31","to":{"name":"Done"}}]}`, nil)
+			case req.URL.Path == "/rest/api/3/issue/PROJ-12/transitions" && req.Method == http.MethodPost:
+				return jsonResponse(req, http.StatusNoContent, ``, nil)
+			default:
+				t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+				return nil
+			}
+		}),
+	}
+	linker := vcs.NewLinker(client, "cloud-1")
+	linker.Transitions = map[string]string{"fix": "Done"}
+
+	results, err := linker.ProcessCommit(context.Background(), vcs.Commit{
+		SHA:     "abcdef0123456789",
+		Subject: "fix(auth): resolve token refresh in PROJ-12",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	got := results[0]
+	if got.Err != nil || !got.Commented || !got.Transitioned || got.TransitionedTo != "Done" {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+	if len(seenPaths) != 3 {
+		t.Fatalf("unexpected requests: %v", seenPaths)
+	}
+}
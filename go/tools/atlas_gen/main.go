@@ -0,0 +1,103 @@
+// Command atlas-gen renders a single atlassian/graph/gen file from an OperationManifest spec
+// (see atlassian/graph/codegen.OperationManifest), instead of every new lookup/connection getting
+// its own go/tools/generate_*_models main.go. Adding a new Jira or Confluence query that fits the
+// lookup/connection shapes codegen already understands is a manifest.json edit plus one atlas-gen
+// invocation; only queries needing bespoke rendering (like the Jira Sprint/Worklog generators)
+// still need a dedicated generator.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+
+	"atlassian/atlassian/graph/codegen"
+)
+
+func main() {
+	manifestPath := flag.String("manifest", "", "path to a JSON-encoded codegen.OperationManifest")
+	outPath := flag.String("out", "", "path to write the generated Go file")
+	forceRefresh := flag.Bool("force-refresh", false, "bypass the schema cache and always re-fetch the introspection result")
+	noMocks := flag.Bool("no-mocks", false, "skip generating the gomock/fake-transport doubles under -mock-dir")
+	mockDir := flag.String("mock-dir", "", "directory mocks are written to (default: \"mocks\" next to -out)")
+	flag.Parse()
+
+	if *manifestPath == "" || *outPath == "" {
+		fmt.Fprintln(os.Stderr, "usage: atlas-gen -manifest <manifest.json> -out <output.go>")
+		os.Exit(2)
+	}
+
+	raw, err := os.ReadFile(*manifestPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	manifest, err := codegen.LoadManifest(raw)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	repoRoot, err := codegen.FindRepoRoot()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	schema, err := codegen.Bootstrap(context.Background(), codegen.BootstrapOptions{RepoRoot: repoRoot, ForceRefresh: *forceRefresh})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	resolved, err := codegen.ResolveOperations(schema, manifest, codegen.DefaultTypeMapper())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	source, err := codegen.Render(resolved)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "format generated code:", err)
+		fmt.Fprintln(os.Stderr, source)
+		os.Exit(2)
+	}
+	if err := os.WriteFile(*outPath, formatted, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	fmt.Println("Wrote", *outPath)
+
+	dir := *mockDir
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(*outPath), "mocks")
+	}
+	if err := codegen.WriteMockFiles(dir, *noMocks, writeFormattedFile); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
+
+// writeFormattedFile gofmt's source and writes it to path, creating any missing parent
+// directories first. It's passed to codegen.WriteMockFiles so mock output goes through the same
+// format-then-write step as the main generated file above.
+func writeFormattedFile(path string, source string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		return fmt.Errorf("format %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		return err
+	}
+	fmt.Println("Wrote", path)
+	return nil
+}
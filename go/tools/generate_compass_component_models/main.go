@@ -1,15 +1,20 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"go/format"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,12 +22,20 @@ import (
 	"atlassian/atlassian/graph"
 )
 
-type config struct {
+// schemaIR is the intermediate representation produced by buildSchemaIR from the GraphQL
+// introspection result: everything every emitter (models, client, JSON codecs, handlers,
+// parameters, the pagination iterator) needs to know about the single discovered operation, so
+// adding a new emitter never means re-walking the schema.
+type schemaIR struct {
 	CloudIDType string
 	QueryType   string
 
 	ConnectionTypeName string
-	ErrorTypeName      string
+
+	// ErrorTypes lists every typed error variant searchComponents' result union carries (e.g.
+	// QueryError, ValidationError, PermissionError, RateLimitedError), letting UnmarshalJSON
+	// dispatch to a distinct typed Go error per variant instead of a single optional Error field.
+	ErrorTypes []searchErrorVariantIR
 
 	PageInfoHasEndCursor      bool
 	PageInfoEndCursorNullable bool
@@ -38,14 +51,159 @@ type config struct {
 	OwnerTeamIDNullable     bool
 	OwnerTeamNameNullable   bool
 
-	ErrorMessageNullable              bool
-	ErrorHasExtensions                bool
-	ErrorExtensionsNullable           bool
-	ErrorExtensionsHasStatusCode      bool
-	ErrorExtensionsStatusCodeNullable bool
+	// DedupEnabled controls whether renderModels emits a Bloom-filter-backed CompassComponentDeduper
+	// for skipping components already seen across searchComponents pages. Set from env vars, not
+	// discovered from the schema.
+	DedupEnabled           bool
+	DedupExpectedItems     uint
+	DedupFalsePositiveRate float64
+
+	// EmitMocks controls whether renderMocks writes a gomock double for the generated Client
+	// alongside the typed surface. Set from --emit-mocks or ATLASSIAN_GEN_EMIT_MOCKS, not
+	// discovered from the schema.
+	EmitMocks bool
+
+	// MutationsEnabled and Mutations are discovered from compass.createComponent/updateComponent/
+	// deleteComponent, mirroring the same union-result/typed-error pattern searchComponents already
+	// uses. Entirely optional: a schema with no Mutation type, or none of the three fields, simply
+	// yields MutationsEnabled=false rather than failing codegen for schemas that predate these APIs.
+	MutationsEnabled bool
+	Mutations        []mutationIR
+
+	// MutationErrorTypes holds one resolved shape per distinct error __typename referenced across
+	// Mutations, deduplicated by GraphQL type name so two mutations sharing an error type (e.g. both
+	// returning a validation error) don't generate the same struct twice.
+	MutationErrorTypes []mutationErrorIR
+}
+
+// mutationErrorIR is the resolved shape of one mutation error __typename: a message field plus an
+// optional extensions.statusCode, the same two fields searchErrorVariantIR resolves for
+// searchComponents' own error variants.
+type mutationErrorIR struct {
+	TypeName string
+
+	MessageNullable              bool
+	HasExtensions                bool
+	ExtensionsNullable           bool
+	ExtensionsHasStatusCode      bool
+	ExtensionsStatusCodeNullable bool
+}
+
+// mutationIR is the intermediate representation for one Compass mutation field
+// (createComponent/updateComponent/deleteComponent), resolved by buildMutationIR the same way
+// buildSchemaIR resolves searchComponents: everything renderMutations needs to emit a typed input
+// struct, a discriminated CompassXxxResult, and a DecodeCompassXxx helper.
+type mutationIR struct {
+	FieldName     string
+	GoName        string // e.g. "CreateComponent"
+	InputTypeName string
+	InputFields   []scalarFieldIR
+
+	SuccessTypeName     string
+	HasComponent        bool
+	ComponentNullable   bool
+	HasSuccessFlag      bool
+	SuccessFlagNullable bool
+
+	// ErrorTypeNames lists every typed error variant the result union carries - like
+	// searchErrorVariantIR, a mutation can fan out into several distinct error __typenames (e.g.
+	// validation vs. not-found), each rendered as its own named Go error type.
+	ErrorTypeNames []string
+}
+
+// scalarFieldIR describes one named scalar struct field, shared by mutation input objects
+// (mutationIR.InputFields) and error-variant extra fields (searchErrorVariantIR.ExtraFields) since
+// both are just "a GraphQL scalar field with a Go name and type". A mutation input field is
+// required, so a non-scalar one is an error; an error variant's extra fields are opportunistic
+// (message/extensions are all this generator strictly needs), so a non-scalar one is just skipped.
+type scalarFieldIR struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Nullable bool
+}
+
+// searchErrorVariantIR is the resolved shape of one searchComponents error __typename: its message
+// field, any extra scalar fields beyond message/extensions (e.g. RateLimitedError's
+// retryAfterSeconds), and the usual optional extensions.statusCode.
+type searchErrorVariantIR struct {
+	TypeName        string
+	MessageNullable bool
+	ExtraFields     []scalarFieldIR
+
+	HasExtensions                bool
+	ExtensionsNullable           bool
+	ExtensionsHasStatusCode      bool
+	ExtensionsStatusCodeNullable bool
+}
+
+// UnionVariant describes one __typename case a generated union/interface struct dispatches on:
+// the raw GraphQL type name, the Go struct field that holds the decoded value for that case, and
+// the Go type of that field. GoFieldName and GoTypeName differ when the union names its field
+// after the role a type plays rather than the type itself (e.g. CompassSearchComponentsResult's
+// Connection field holds a *CompassSearchComponentConnection); for the error variants sharing a
+// union with it, both names are just the error type's own name.
+type UnionVariant struct {
+	Typename    string
+	GoFieldName string
+	GoTypeName  string
+}
+
+// UnknownUnionTypeError is returned by every EmitUnion-generated UnmarshalJSON when a payload's
+// __typename doesn't match any declared variant, carrying the raw payload so a forward-compatible
+// caller can still inspect it instead of losing the response outright. Centralizing this in
+// EmitUnion means every union rendered through it gets the same forward-compat behavior for free,
+// rather than each union emitter hand-rolling (and risking drifting) its own unknown-type error.
+type UnknownUnionTypeError struct {
+	Union      string
+	Typename   string
+	RawPayload []byte
+}
+
+func (e *UnknownUnionTypeError) Error() string {
+	return fmt.Sprintf("unsupported %s type: %s", e.Union, e.Typename)
+}
+
+// EmitUnion writes a discriminated union/interface struct named name to w: a Typename field plus
+// one *GoTypeName pointer field per variant (tagged json:"-", since decoding happens by hand in
+// UnmarshalJSON rather than through struct tags), and an UnmarshalJSON that reads __typename,
+// unmarshals into whichever variant's field matches, and returns an *UnknownUnionTypeError for
+// anything else. This is the one place every Compass union/interface (CompassSearchComponentsResult
+// today; CompassNode, CompassComponentOwnerDetails, CompassEventSource, ... as the schema walk
+// grows) renders its __typename-dispatch boilerplate through, instead of each emitter hand-rolling
+// its own copy of the same switch/case. The mutation result unions renderOneMutation emits do not
+// go through it: they keep their own switch and return a plain error for an unknown __typename.
+func EmitUnion(name string, variants []UnionVariant, w io.Writer) error {
+	fmt.Fprintf(w, "type %s struct {\n", name)
+	fmt.Fprintf(w, "\tTypename string `json:\"__typename\"`\n")
+	for _, v := range variants {
+		fmt.Fprintf(w, "\t%s *%s `json:\"-\"`\n", v.GoFieldName, v.GoTypeName)
+	}
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "func (v *%s) UnmarshalJSON(data []byte) error {\n", name)
+	fmt.Fprintf(w, "\tvar base struct {\n\t\tTypename string `json:\"__typename\"`\n\t}\n")
+	fmt.Fprintf(w, "\tif err := json.Unmarshal(data, &base); err != nil {\n\t\treturn err\n\t}\n")
+	fmt.Fprintf(w, "\tif base.Typename == \"\" {\n\t\treturn errors.New(%q)\n\t}\n", "missing __typename for "+name)
+	fmt.Fprintf(w, "\tv.Typename = base.Typename\n")
+	fmt.Fprintf(w, "\tswitch base.Typename {\n")
+	for _, v := range variants {
+		fmt.Fprintf(w, "\tcase %q:\n", v.Typename)
+		fmt.Fprintf(w, "\t\tvar val %s\n", v.GoTypeName)
+		fmt.Fprintf(w, "\t\tif err := json.Unmarshal(data, &val); err != nil {\n\t\t\treturn err\n\t\t}\n")
+		fmt.Fprintf(w, "\t\tv.%s = &val\n", v.GoFieldName)
+	}
+	fmt.Fprintf(w, "\tdefault:\n")
+	fmt.Fprintf(w, "\t\treturn &UnknownUnionTypeError{Union: %q, Typename: base.Typename, RawPayload: append([]byte(nil), data...)}\n", name)
+	fmt.Fprintf(w, "\t}\n\treturn nil\n}\n\n")
+	return nil
 }
 
 func main() {
+	forceRefresh := flag.Bool("force-refresh", false, "bypass the schema cache and always re-fetch the introspection result")
+	emitMocks := flag.Bool("emit-mocks", false, "also write a gomock double for the generated Client under mocks/")
+	flag.Parse()
+
 	repoRoot, err := findRepoRoot()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -58,11 +216,14 @@ func main() {
 	loadEnvFile(tokenFile)
 
 	schemaPath := filepath.Join(repoRoot, "graphql", "schema.introspection.json")
-	if _, err := os.Stat(schemaPath); err != nil {
-		if !errors.Is(err, os.ErrNotExist) {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(2)
-		}
+	experimentalAPIs := parseExperimentalAPIs()
+	maxAge := graph.ParseSchemaMaxAge(os.Getenv("ATLASSIAN_GQL_SCHEMA_MAX_AGE"))
+	refetch, err := graph.ShouldRefetchSchema(schemaPath, experimentalAPIs, maxAge, *forceRefresh)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if refetch {
 		baseURL := strings.TrimSpace(os.Getenv("ATLASSIAN_GQL_BASE_URL"))
 		if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN")) != "" {
 			baseURL = "https://api.atlassian.com"
@@ -74,7 +235,7 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Missing %s and ATLASSIAN_GQL_BASE_URL not set\n", schemaPath)
 			os.Exit(2)
 		}
-		auth := buildAuthFromEnv()
+		auth := buildAuthFromEnv(tokenFile)
 		if auth == nil {
 			fmt.Fprintln(os.Stderr, "No credentials available in env vars to fetch schema")
 			os.Exit(2)
@@ -82,14 +243,19 @@ func main() {
 
 		opts := graph.SchemaFetchOptions{
 			OutputDir:        filepath.Dir(schemaPath),
-			ExperimentalAPIs: parseExperimentalAPIs(),
+			ExperimentalAPIs: experimentalAPIs,
 			Timeout:          30 * time.Second,
 			HTTPClient:       &http.Client{Timeout: 30 * time.Second},
 		}
+		fetchedAt := time.Now()
 		if _, err := graph.FetchSchemaIntrospection(context.Background(), baseURL, auth, opts); err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			os.Exit(2)
 		}
+		if err := graph.RecordSchemaFetch(schemaPath, experimentalAPIs, fetchedAt); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
 	}
 
 	schema, err := loadSchema(schemaPath)
@@ -97,33 +263,81 @@ func main() {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
-	cfg, err := discoverConfig(schema)
+	cfg, err := buildSchemaIR(schema)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
+	cfg.DedupEnabled = parseBoolEnv("ATLASSIAN_COMPASS_DEDUP_ENABLED", false)
+	cfg.DedupExpectedItems = parseUintEnv("ATLASSIAN_COMPASS_DEDUP_EXPECTED_ITEMS", 10_000)
+	cfg.DedupFalsePositiveRate = parseFloatEnv("ATLASSIAN_COMPASS_DEDUP_FALSE_POSITIVE_RATE", 0.01)
+	cfg.EmitMocks = *emitMocks || parseBoolEnv("ATLASSIAN_GEN_EMIT_MOCKS", false)
 
-	outPath := filepath.Join(repoRoot, "go", "atlassian", "graph", "gen", "compass_components_api.go")
-	if err := os.MkdirAll(filepath.Dir(outPath), 0o755); err != nil {
+	outDir := filepath.Join(repoRoot, "go", "atlassian", "graph", "gen")
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
-	source, err := renderGo(cfg)
+
+	// Every emitter below renders against the same schemaIR; each owns one file of the ogen-style
+	// layered surface (typed models, client, reflection-free JSON codecs, server handlers, request
+	// parameter decoding) instead of one emitter trying to own the whole surface.
+	emitters := []struct {
+		dir      string
+		filename string
+		render   func(*schemaIR) (string, error)
+	}{
+		{outDir, "compass_components_api.go", renderModels},
+		{outDir, "oas_client_gen.go", renderClient},
+		{outDir, "oas_json_gen.go", renderJSON},
+		{outDir, "oas_handlers_gen.go", renderHandlers},
+		{outDir, "oas_parameters_gen.go", renderParameters},
+		{outDir, "compass_components_iterator.go", renderIteratorFile},
+		{outDir, "compass_runtime_client.go", renderRuntimeClient},
+	}
+	if cfg.MutationsEnabled {
+		emitters = append(emitters, struct {
+			dir      string
+			filename string
+			render   func(*schemaIR) (string, error)
+		}{outDir, "compass_mutations_gen.go", renderMutations})
+	}
+	if cfg.EmitMocks {
+		emitters = append(emitters, struct {
+			dir      string
+			filename string
+			render   func(*schemaIR) (string, error)
+		}{filepath.Join(outDir, "mocks"), "compass_components_api_mock.go", renderMocks})
+	}
+	for _, e := range emitters {
+		if err := os.MkdirAll(e.dir, 0o755); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if err := writeGenFile(e.dir, e.filename, cfg, e.render); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+}
+
+// writeGenFile renders source for filename via render, gofmt's it, and writes it into dir.
+func writeGenFile(dir string, filename string, cfg *schemaIR, render func(*schemaIR) (string, error)) error {
+	outPath := filepath.Join(dir, filename)
+	source, err := render(cfg)
 	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(2)
+		return fmt.Errorf("render %s: %w", filename, err)
 	}
 	formatted, err := format.Source([]byte(source))
 	if err != nil {
-		fmt.Fprintln(os.Stderr, "format generated code:", err)
 		fmt.Fprintln(os.Stderr, source)
-		os.Exit(2)
+		return fmt.Errorf("format %s: %w", filename, err)
 	}
 	if err := os.WriteFile(outPath, formatted, 0o644); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(2)
+		return err
 	}
 	fmt.Println("Wrote", outPath)
+	return nil
 }
 
 func findRepoRoot() (string, error) {
@@ -182,6 +396,128 @@ func loadEnvFile(path string) {
 	}
 }
 
+// saveEnvFile rewrites path, setting each key in updates to its new value while preserving every
+// other line verbatim (comments, blank lines, "export " prefixes, and quoting style). A key
+// already present is updated in place; a key with no existing line is appended, quoted, with an
+// "export " prefix. The file is written atomically via a temp file + rename so a crash mid-write
+// never leaves path truncated or half-written.
+func saveEnvFile(path string, updates map[string]string) error {
+	remaining := make(map[string]string, len(updates))
+	for k, v := range updates {
+		remaining[k] = v
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	var lines []string
+	if err == nil {
+		lines = strings.Split(string(data), "\n")
+		if len(lines) > 0 && lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+	}
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		body := trimmed
+		prefix := ""
+		if strings.HasPrefix(body, "export ") {
+			prefix = "export "
+			body = strings.TrimSpace(strings.TrimPrefix(body, "export "))
+		}
+		eq := strings.Index(body, "=")
+		if eq <= 0 {
+			continue
+		}
+		key := strings.TrimSpace(body[:eq])
+		newValue, ok := remaining[key]
+		if !ok {
+			continue
+		}
+		quote := ""
+		existingValue := strings.TrimSpace(body[eq+1:])
+		if len(existingValue) >= 2 && (existingValue[0] == '"' || existingValue[0] == '\'') {
+			quote = string(existingValue[0])
+		}
+		lines[i] = prefix + key + "=" + quote + newValue + quote
+		delete(remaining, key)
+	}
+
+	for _, key := range sortedKeys(remaining) {
+		lines = append(lines, fmt.Sprintf("export %s=%q", key, remaining[key]))
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	_, writeErr := tmp.WriteString(strings.Join(lines, "\n") + "\n")
+	closeErr := tmp.Close()
+	if writeErr != nil {
+		os.Remove(tmpPath)
+		return writeErr
+	}
+	if closeErr != nil {
+		os.Remove(tmpPath)
+		return closeErr
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// sortedKeys returns m's keys in ascending order, so appended lines have a deterministic order
+// across runs.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func parseBoolEnv(key string, fallback bool) bool {
+	clean := strings.TrimSpace(os.Getenv(key))
+	if clean == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseBool(clean)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+func parseUintEnv(key string, fallback uint) uint {
+	clean := strings.TrimSpace(os.Getenv(key))
+	if clean == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseUint(clean, 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return uint(parsed)
+}
+
+func parseFloatEnv(key string, fallback float64) float64 {
+	clean := strings.TrimSpace(os.Getenv(key))
+	if clean == "" {
+		return fallback
+	}
+	parsed, err := strconv.ParseFloat(clean, 64)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
 func stripQuotes(raw string) string {
 	if len(raw) >= 2 {
 		first := raw[0]
@@ -193,7 +529,11 @@ func stripQuotes(raw string) string {
 	return raw
 }
 
-func buildAuthFromEnv() atlassian.AuthProvider {
+// buildAuthFromEnv builds an AuthProvider from env vars. When the result refreshes an OAuth
+// access token, the new access token, refresh token, and expiry are flushed back to tokenFile so
+// the next invocation (e.g. the next CI job reusing the same token file) doesn't have to
+// re-authenticate from scratch.
+func buildAuthFromEnv(tokenFile string) atlassian.AuthProvider {
 	token := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN"))
 	refreshToken := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN"))
 	clientID := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_ID"))
@@ -208,6 +548,15 @@ func buildAuthFromEnv() atlassian.AuthProvider {
 			ClientSecret: clientSecret,
 			RefreshToken: refreshToken,
 			Timeout:      30 * time.Second,
+			OnRefresh: func(accessToken string, newRefreshToken string, expiry time.Time) {
+				if err := saveEnvFile(tokenFile, map[string]string{
+					"ATLASSIAN_OAUTH_ACCESS_TOKEN":  accessToken,
+					"ATLASSIAN_OAUTH_REFRESH_TOKEN": newRefreshToken,
+					"ATLASSIAN_OAUTH_TOKEN_EXPIRY":  expiry.Format(time.RFC3339),
+				}); err != nil {
+					fmt.Fprintln(os.Stderr, "warning: failed to persist refreshed OAuth tokens:", err)
+				}
+			},
 		}
 	}
 	if token != "" {
@@ -356,6 +705,48 @@ func isNullable(typeRef any) bool {
 	return true
 }
 
+func fieldGoName(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// scalarGoType maps a GraphQL scalar name to the Go type a mutation input field resolves to.
+// Mutation input objects in this schema only ever carry these five scalars; a field typed as
+// anything else (another input object, an enum, a custom scalar) is out of scope for
+// buildMutationIR and surfaces as an explicit error instead of guessing a representation.
+func scalarGoType(name string) (string, bool) {
+	switch name {
+	case "ID", "String":
+		return "string", true
+	case "Int":
+		return "int", true
+	case "Float":
+		return "float64", true
+	case "Boolean":
+		return "bool", true
+	default:
+		return "", false
+	}
+}
+
+// findRetryableErrorType locates the single searchComponents error variant carrying an extra
+// "retryAfterSeconds" field - the rate-limited variant's conventional shape. RuntimeClient only
+// retries a decoded (non-transport) error when this variant is present; every other typed error
+// (validation, permission, ...) fails the same way again on retry, so renderRuntimeClient returns
+// those immediately instead of guessing at which ones are safe to retry.
+func findRetryableErrorType(cfg *schemaIR) (typeName string, field scalarFieldIR, ok bool) {
+	for _, e := range cfg.ErrorTypes {
+		for _, f := range e.ExtraFields {
+			if f.Name == "retryAfterSeconds" {
+				return e.TypeName, f, true
+			}
+		}
+	}
+	return "", scalarFieldIR{}, false
+}
+
 func unwrapListElemTypeName(typeRef any) (string, error) {
 	cur, ok := typeRef.(map[string]any)
 	if !ok {
@@ -380,7 +771,7 @@ func unwrapListElemTypeName(typeRef any) (string, error) {
 	return "", errors.New("list type depth exceeded")
 }
 
-func discoverConfig(schema map[string]any) (*config, error) {
+func buildSchemaIR(schema map[string]any) (*schemaIR, error) {
 	types, err := typesMap(schema)
 	if err != nil {
 		return nil, err
@@ -431,7 +822,7 @@ func discoverConfig(schema map[string]any) (*config, error) {
 	}
 
 	connectionTypeName := ""
-	errorTypeName := ""
+	var errorTypeNames []string
 
 	if returnKind == "UNION" {
 		unionDef := types[returnTypeName]
@@ -463,10 +854,7 @@ func discoverConfig(schema map[string]any) (*config, error) {
 				continue
 			}
 			if getField(def, "message") != nil {
-				if errorTypeName != "" {
-					return nil, errors.New("multiple error-like types in searchComponents union")
-				}
-				errorTypeName = name
+				errorTypeNames = append(errorTypeNames, name)
 			}
 		}
 		if connectionTypeName == "" {
@@ -561,44 +949,30 @@ func discoverConfig(schema map[string]any) (*config, error) {
 		return nil, fmt.Errorf("owner team type %s missing id/displayName", ownerTeamTypeName)
 	}
 
-	var errorMessageNullable bool
-	var errorHasExtensions bool
-	var errorExtensionsNullable bool
-	var errorExtensionsHasStatusCode bool
-	var errorExtensionsStatusCodeNullable bool
-	if errorTypeName != "" {
-		errorDef := types[errorTypeName]
-		if errorDef == nil {
-			return nil, fmt.Errorf("missing error type definition: %s", errorTypeName)
-		}
-		errorMessageField := getField(errorDef, "message")
-		if errorMessageField == nil {
-			return nil, fmt.Errorf("error type %s missing message field", errorTypeName)
-		}
-		errorMessageNullable = isNullable(errorMessageField["type"])
-		errorExtensionsField := getField(errorDef, "extensions")
-		if errorExtensionsField != nil {
-			errorHasExtensions = true
-			errorExtensionsNullable = isNullable(errorExtensionsField["type"])
-			extTypeName, _ := unwrapNamedType(errorExtensionsField["type"])
-			extDef := types[extTypeName]
-			if extDef == nil {
-				return nil, fmt.Errorf("missing error extensions type definition: %s", extTypeName)
-			}
-			statusField := getField(extDef, "statusCode")
-			if statusField != nil {
-				errorExtensionsHasStatusCode = true
-				errorExtensionsStatusCodeNullable = isNullable(statusField["type"])
-			}
+	errorTypes := make([]searchErrorVariantIR, 0, len(errorTypeNames))
+	for _, name := range errorTypeNames {
+		variant, err := resolveSearchErrorVariant(types, name)
+		if err != nil {
+			return nil, err
 		}
+		errorTypes = append(errorTypes, variant)
+	}
+
+	mutationsEnabled, mutations, mutationErrorTypes, err := buildMutationIR(schema, types)
+	if err != nil {
+		return nil, err
 	}
 
-	return &config{
+	return &schemaIR{
 		CloudIDType: cloudIDType,
 		QueryType:   queryArgType,
 
+		MutationsEnabled:   mutationsEnabled,
+		Mutations:          mutations,
+		MutationErrorTypes: mutationErrorTypes,
+
 		ConnectionTypeName: connectionTypeName,
-		ErrorTypeName:      errorTypeName,
+		ErrorTypes:         errorTypes,
 
 		PageInfoHasEndCursor:      pageInfoHasEndCursor,
 		PageInfoEndCursorNullable: pageInfoEndCursorNullable,
@@ -613,15 +987,277 @@ func discoverConfig(schema map[string]any) (*config, error) {
 		ComponentOwnerNullable:  ownerTeamNullable,
 		OwnerTeamIDNullable:     isNullable(ownerTeamIDField["type"]),
 		OwnerTeamNameNullable:   isNullable(ownerTeamNameField["type"]),
-
-		ErrorMessageNullable:              errorMessageNullable,
-		ErrorHasExtensions:                errorHasExtensions,
-		ErrorExtensionsNullable:           errorExtensionsNullable,
-		ErrorExtensionsHasStatusCode:      errorExtensionsHasStatusCode,
-		ErrorExtensionsStatusCodeNullable: errorExtensionsStatusCodeNullable,
 	}, nil
 }
 
+// resolveSearchErrorVariant resolves one searchComponents error __typename's message field, any
+// extra scalar fields beyond message/extensions (e.g. RateLimitedError's retryAfterSeconds), and
+// the usual optional extensions.statusCode.
+func resolveSearchErrorVariant(types map[string]map[string]any, name string) (searchErrorVariantIR, error) {
+	def := types[name]
+	if def == nil {
+		return searchErrorVariantIR{}, fmt.Errorf("missing error type definition: %s", name)
+	}
+	messageField := getField(def, "message")
+	if messageField == nil {
+		return searchErrorVariantIR{}, fmt.Errorf("error type %s missing message field", name)
+	}
+	variant := searchErrorVariantIR{
+		TypeName:        name,
+		MessageNullable: isNullable(messageField["type"]),
+	}
+
+	rawFields, _ := def["fields"].([]any)
+	for _, raw := range rawFields {
+		fd, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		fieldName, _ := fd["name"].(string)
+		if fieldName == "" || fieldName == "message" || fieldName == "extensions" {
+			continue
+		}
+		typeName, _ := unwrapNamedType(fd["type"])
+		goT, ok := scalarGoType(typeName)
+		if !ok {
+			continue
+		}
+		variant.ExtraFields = append(variant.ExtraFields, scalarFieldIR{
+			Name:     fieldName,
+			GoName:   fieldGoName(fieldName),
+			GoType:   goT,
+			Nullable: isNullable(fd["type"]),
+		})
+	}
+
+	extensionsField := getField(def, "extensions")
+	if extensionsField == nil {
+		return variant, nil
+	}
+	variant.HasExtensions = true
+	variant.ExtensionsNullable = isNullable(extensionsField["type"])
+	extTypeName, _ := unwrapNamedType(extensionsField["type"])
+	extDef := types[extTypeName]
+	if extDef == nil {
+		return searchErrorVariantIR{}, fmt.Errorf("missing error extensions type definition: %s", extTypeName)
+	}
+	statusField := getField(extDef, "statusCode")
+	if statusField == nil {
+		return variant, nil
+	}
+	variant.ExtensionsHasStatusCode = true
+	variant.ExtensionsStatusCodeNullable = isNullable(statusField["type"])
+	return variant, nil
+}
+
+// buildMutationIR discovers the create/update/delete Compass component mutations from the schema,
+// mirroring buildSchemaIR's strictness once a mutation field is actually present but treating the
+// feature as a whole as optional: a schema with no Mutation type, or no compass.createComponent et
+// al., simply yields enabled=false rather than failing generation for schemas that predate them.
+func buildMutationIR(schema map[string]any, types map[string]map[string]any) (bool, []mutationIR, []mutationErrorIR, error) {
+	mutationType, ok := schema["mutationType"].(map[string]any)
+	if !ok {
+		return false, nil, nil, nil
+	}
+	mutationTypeName, _ := mutationType["name"].(string)
+	if mutationTypeName == "" {
+		return false, nil, nil, nil
+	}
+	mutationDef := types[mutationTypeName]
+	if mutationDef == nil {
+		return false, nil, nil, nil
+	}
+	compassField := getField(mutationDef, "compass")
+	if compassField == nil {
+		return false, nil, nil, nil
+	}
+	compassTypeName, _ := unwrapNamedType(compassField["type"])
+	compassDef := types[compassTypeName]
+	if compassTypeName == "" || compassDef == nil {
+		return false, nil, nil, fmt.Errorf("failed to resolve type for field %s.compass", mutationTypeName)
+	}
+
+	specs := []struct {
+		fieldName string
+		goName    string
+	}{
+		{"createComponent", "CreateComponent"},
+		{"updateComponent", "UpdateComponent"},
+		{"deleteComponent", "DeleteComponent"},
+	}
+
+	var mutations []mutationIR
+	errorTypeNames := map[string]bool{}
+	for _, spec := range specs {
+		field := getField(compassDef, spec.fieldName)
+		if field == nil {
+			continue
+		}
+		m, err := resolveMutationField(types, spec.fieldName, spec.goName, field)
+		if err != nil {
+			return false, nil, nil, fmt.Errorf("%s.%s: %w", compassTypeName, spec.fieldName, err)
+		}
+		mutations = append(mutations, m)
+		for _, name := range m.ErrorTypeNames {
+			errorTypeNames[name] = true
+		}
+	}
+	if len(mutations) == 0 {
+		return false, nil, nil, nil
+	}
+
+	sortedErrorNames := make([]string, 0, len(errorTypeNames))
+	for name := range errorTypeNames {
+		sortedErrorNames = append(sortedErrorNames, name)
+	}
+	sort.Strings(sortedErrorNames)
+
+	errorTypes := make([]mutationErrorIR, 0, len(sortedErrorNames))
+	for _, name := range sortedErrorNames {
+		shape, err := resolveMutationErrorShape(types, name)
+		if err != nil {
+			return false, nil, nil, err
+		}
+		errorTypes = append(errorTypes, shape)
+	}
+
+	return true, mutations, errorTypes, nil
+}
+
+// resolveMutationErrorShape resolves one mutation error __typename's message/extensions fields,
+// the same two-field shape buildSchemaIR already resolves for searchComponents' own error type.
+func resolveMutationErrorShape(types map[string]map[string]any, name string) (mutationErrorIR, error) {
+	def := types[name]
+	if def == nil {
+		return mutationErrorIR{}, fmt.Errorf("missing error type definition: %s", name)
+	}
+	messageField := getField(def, "message")
+	if messageField == nil {
+		return mutationErrorIR{}, fmt.Errorf("error type %s missing message field", name)
+	}
+	shape := mutationErrorIR{
+		TypeName:        name,
+		MessageNullable: isNullable(messageField["type"]),
+	}
+	extensionsField := getField(def, "extensions")
+	if extensionsField == nil {
+		return shape, nil
+	}
+	shape.HasExtensions = true
+	shape.ExtensionsNullable = isNullable(extensionsField["type"])
+	extTypeName, _ := unwrapNamedType(extensionsField["type"])
+	extDef := types[extTypeName]
+	if extDef == nil {
+		return mutationErrorIR{}, fmt.Errorf("missing error extensions type definition: %s", extTypeName)
+	}
+	statusField := getField(extDef, "statusCode")
+	if statusField == nil {
+		return shape, nil
+	}
+	shape.ExtensionsHasStatusCode = true
+	shape.ExtensionsStatusCodeNullable = isNullable(statusField["type"])
+	return shape, nil
+}
+
+// resolveMutationField resolves one compass.createComponent/updateComponent/deleteComponent field
+// into a mutationIR: its input object's scalar fields, and its result union's success type (the
+// one member without a "message" field, reusing CompassComponent when it exposes a "component"
+// field) plus every typed error variant (every member with a "message" field).
+func resolveMutationField(types map[string]map[string]any, fieldName, goName string, field map[string]any) (mutationIR, error) {
+	inputArg := getArg(field, "input")
+	if inputArg == nil {
+		return mutationIR{}, errors.New("missing input arg")
+	}
+	inputTypeName, inputKind := unwrapNamedType(inputArg["type"])
+	if inputTypeName == "" || inputKind != "INPUT_OBJECT" {
+		return mutationIR{}, errors.New("input arg is not an input object")
+	}
+	inputDef := types[inputTypeName]
+	if inputDef == nil {
+		return mutationIR{}, fmt.Errorf("missing input type definition: %s", inputTypeName)
+	}
+	rawInputFields, _ := inputDef["inputFields"].([]any)
+	var inputFields []scalarFieldIR
+	for _, raw := range rawInputFields {
+		fd, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := fd["name"].(string)
+		if name == "" {
+			continue
+		}
+		typeName, _ := unwrapNamedType(fd["type"])
+		goT, ok := scalarGoType(typeName)
+		if !ok {
+			return mutationIR{}, fmt.Errorf("input field %s.%s has unsupported non-scalar type %s", inputTypeName, name, typeName)
+		}
+		inputFields = append(inputFields, scalarFieldIR{
+			Name:     name,
+			GoName:   fieldGoName(name),
+			GoType:   goT,
+			Nullable: isNullable(fd["type"]),
+		})
+	}
+
+	resultTypeName, resultKind := unwrapNamedType(field["type"])
+	if resultTypeName == "" {
+		return mutationIR{}, errors.New("unable to resolve result type")
+	}
+	var memberNames []string
+	if resultKind == "UNION" {
+		unionDef := types[resultTypeName]
+		if unionDef == nil {
+			return mutationIR{}, fmt.Errorf("missing union definition: %s", resultTypeName)
+		}
+		possibleTypes, _ := unionDef["possibleTypes"].([]any)
+		for _, raw := range possibleTypes {
+			pt, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if n, _ := pt["name"].(string); n != "" {
+				memberNames = append(memberNames, n)
+			}
+		}
+	} else {
+		memberNames = []string{resultTypeName}
+	}
+	if len(memberNames) == 0 {
+		return mutationIR{}, fmt.Errorf("result type %s has no members", resultTypeName)
+	}
+
+	m := mutationIR{FieldName: fieldName, GoName: goName, InputTypeName: inputTypeName, InputFields: inputFields}
+	successFound := false
+	for _, name := range memberNames {
+		def := types[name]
+		if def == nil {
+			return mutationIR{}, fmt.Errorf("missing possible type definition: %s", name)
+		}
+		if getField(def, "message") != nil {
+			m.ErrorTypeNames = append(m.ErrorTypeNames, name)
+			continue
+		}
+		if successFound {
+			return mutationIR{}, fmt.Errorf("multiple non-error result members for %s", fieldName)
+		}
+		successFound = true
+		m.SuccessTypeName = name
+		if componentField := getField(def, "component"); componentField != nil {
+			m.HasComponent = true
+			m.ComponentNullable = isNullable(componentField["type"])
+		}
+		if successField := getField(def, "success"); successField != nil {
+			m.HasSuccessFlag = true
+			m.SuccessFlagNullable = isNullable(successField["type"])
+		}
+	}
+	if !successFound {
+		return mutationIR{}, fmt.Errorf("result type %s has no non-error member", resultTypeName)
+	}
+	return m, nil
+}
+
 func goType(base string, nullable bool) string {
 	if !nullable {
 		return base
@@ -639,7 +1275,7 @@ func jsonTag(name string, nullable bool) string {
 	return fmt.Sprintf("`json:\"%s\"`", name)
 }
 
-func renderGo(cfg *config) (string, error) {
+func renderModels(cfg *schemaIR) (string, error) {
 	pageInfoSelect := "pageInfo { hasNextPage"
 	if cfg.PageInfoHasEndCursor {
 		pageInfoSelect += " endCursor"
@@ -654,20 +1290,25 @@ func renderGo(cfg *config) (string, error) {
 	}
 
 	errorFragment := ""
-	if cfg.ErrorTypeName != "" {
+	for _, e := range cfg.ErrorTypes {
 		errorFields := "message"
-		if cfg.ErrorHasExtensions && cfg.ErrorExtensionsHasStatusCode {
+		for _, f := range e.ExtraFields {
+			errorFields += " " + f.Name
+		}
+		if e.HasExtensions && e.ExtensionsHasStatusCode {
 			errorFields += " extensions { statusCode }"
 		}
-		errorFragment = fmt.Sprintf("\n      ... on %s { %s }", cfg.ErrorTypeName, errorFields)
+		errorFragment += fmt.Sprintf("\n      ... on %s { %s }", e.TypeName, errorFields)
 	}
 
 	query := fmt.Sprintf(`query CompassSearchComponents(
   $cloudId: %s,
-  $query: %s
+  $query: %s,
+  $after: String,
+  $first: Int
 ) {
   compass {
-    searchComponents(cloudId: $cloudId, query: $query) {
+    searchComponents(cloudId: $cloudId, query: $query, after: $after, first: $first) {
       __typename
       ... on %s {
         nodes { %s }
@@ -699,23 +1340,39 @@ func renderGo(cfg *config) (string, error) {
 	componentNodeType := goType("CompassComponent", cfg.NodeComponentNullable)
 	edgeNodeType := goType("CompassComponentNode", cfg.EdgeNodeNullable)
 
-	lines := []string{
-		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
-		"package gen",
-		"",
+	importLines := []string{
 		"import (",
+		"\t\"context\"",
 		"\t\"encoding/json\"",
 		"\t\"errors\"",
 		"\t\"fmt\"",
-		")",
+		"\t\"sync\"",
+		"\t\"time\"",
+	}
+	if cfg.DedupEnabled {
+		importLines = append(importLines,
+			"",
+			"\tbloom \"github.com/bits-and-blooms/bloom/v3\"",
+			"",
+			"\t\"atlassian/atlassian/pagination\"",
+		)
+	}
+	importLines = append(importLines, ")", "")
+
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"package gen",
 		"",
+	}
+	lines = append(lines, importLines...)
+	lines = append(lines,
 		"const (",
 		fmt.Sprintf("\tCompassSearchComponentsPageInfoHasEndCursor = %t", cfg.PageInfoHasEndCursor),
 		fmt.Sprintf("\tCompassSearchComponentsEdgeHasCursor = %t", cfg.EdgeHasCursor),
 		fmt.Sprintf("\tCompassSearchComponentsConnectionTypename = %q", cfg.ConnectionTypeName),
-	}
-	if cfg.ErrorTypeName != "" {
-		lines = append(lines, fmt.Sprintf("\tCompassSearchComponentsErrorTypename = %q", cfg.ErrorTypeName))
+	)
+	for _, e := range cfg.ErrorTypes {
+		lines = append(lines, fmt.Sprintf("\tCompassSearchComponents%sTypename = %q", e.TypeName, e.TypeName))
 	}
 	lines = append(lines,
 		")",
@@ -758,78 +1415,80 @@ func renderGo(cfg *config) (string, error) {
 		"\tNodes []CompassComponentNode "+jsonTag("nodes", false),
 		"}",
 		"",
+		"// Page returns the connection's PageInfo, so callers driving a",
+		"// CompassSearchComponentIterator by hand don't need to reach into the connection struct.",
+		"func (v *CompassSearchComponentConnection) Page() PageInfo {",
+		"\treturn v.PageInfo",
+		"}",
+		"",
 	)
 
-	if cfg.ErrorTypeName != "" {
-		errorMessageType := goType("string", cfg.ErrorMessageNullable)
-		errorExtensionsType := "CompassSearchComponentsErrorExtensions"
-		errorExtensionsFieldType := goType(errorExtensionsType, cfg.ErrorExtensionsNullable)
-		lines = append(lines,
-			"type CompassSearchComponentsErrorExtensions struct {",
+	for _, e := range cfg.ErrorTypes {
+		messageType := goType("string", e.MessageNullable)
+		lines = append(lines, "type "+e.TypeName+" struct {",
+			"\tMessage "+messageType+" "+jsonTag("message", e.MessageNullable),
 		)
-		if cfg.ErrorExtensionsHasStatusCode {
-			statusType := goType("int", cfg.ErrorExtensionsStatusCodeNullable)
-			lines = append(lines, "\tStatusCode "+statusType+" "+jsonTag("statusCode", cfg.ErrorExtensionsStatusCodeNullable))
+		for _, f := range e.ExtraFields {
+			lines = append(lines, "\t"+f.GoName+" "+goType(f.GoType, f.Nullable)+" "+jsonTag(f.Name, f.Nullable))
 		}
-		lines = append(lines,
-			"}",
-			"",
-			"type CompassSearchComponentsError struct {",
-			"\tMessage "+errorMessageType+" "+jsonTag("message", cfg.ErrorMessageNullable),
-		)
-		if cfg.ErrorHasExtensions {
-			lines = append(lines, "\tExtensions "+errorExtensionsFieldType+" "+jsonTag("extensions", cfg.ErrorExtensionsNullable))
+		if e.HasExtensions {
+			extType := goType(e.TypeName+"Extensions", e.ExtensionsNullable)
+			lines = append(lines, "\tExtensions "+extType+" "+jsonTag("extensions", e.ExtensionsNullable))
 		}
-		lines = append(lines,
+		lines = append(lines, "}", "",
+			"func (e *"+e.TypeName+") Error() string {",
+			"\treturn e.Message",
 			"}",
 			"",
 		)
+		if e.HasExtensions {
+			lines = append(lines, "type "+e.TypeName+"Extensions struct {")
+			if e.ExtensionsHasStatusCode {
+				statusType := goType("int", e.ExtensionsStatusCodeNullable)
+				lines = append(lines, "\tStatusCode "+statusType+" "+jsonTag("statusCode", e.ExtensionsStatusCodeNullable))
+			}
+			lines = append(lines, "}", "")
+		}
 	}
 
-	lines = append(lines,
-		"type CompassSearchComponentsResult struct {",
-		"\tTypename string `json:\"__typename\"`",
-		"\tConnection *CompassSearchComponentConnection `json:\"-\"`",
-	)
-	if cfg.ErrorTypeName != "" {
-		lines = append(lines, "\tError *CompassSearchComponentsError `json:\"-\"`")
+	searchResultVariants := []UnionVariant{
+		{Typename: cfg.ConnectionTypeName, GoFieldName: "Connection", GoTypeName: "CompassSearchComponentConnection"},
+	}
+	for _, e := range cfg.ErrorTypes {
+		searchResultVariants = append(searchResultVariants, UnionVariant{Typename: e.TypeName, GoFieldName: e.TypeName, GoTypeName: e.TypeName})
+	}
+	var resultBuf bytes.Buffer
+	if err := EmitUnion("CompassSearchComponentsResult", searchResultVariants, &resultBuf); err != nil {
+		return "", err
 	}
 	lines = append(lines,
+		strings.TrimRight(resultBuf.String(), "\n"),
+		"",
+		"// UnknownUnionTypeError is returned by an EmitUnion-generated UnmarshalJSON when the",
+		"// payload's __typename matches none of the union's variants; RawPayload holds the raw object.",
+		"type UnknownUnionTypeError struct {",
+		"\tUnion      string",
+		"\tTypename   string",
+		"\tRawPayload []byte",
 		"}",
 		"",
-		"func (r *CompassSearchComponentsResult) UnmarshalJSON(data []byte) error {",
-		"\tvar base struct {",
-		"\t\tTypename string `json:\"__typename\"`",
-		"\t}",
-		"\tif err := json.Unmarshal(data, &base); err != nil {",
-		"\t\treturn err",
-		"\t}",
-		"\tif base.Typename == \"\" {",
-		"\t\treturn errors.New(\"missing __typename for searchComponents\")",
-		"\t}",
-		"\tr.Typename = base.Typename",
-		"\tswitch base.Typename {",
-		"\tcase CompassSearchComponentsConnectionTypename:",
-		"\t\tvar conn CompassSearchComponentConnection",
-		"\t\tif err := json.Unmarshal(data, &conn); err != nil {",
-		"\t\t\treturn err",
-		"\t\t}",
-		"\t\tr.Connection = &conn",
+		"func (e *UnknownUnionTypeError) Error() string {",
+		"\treturn fmt.Sprintf(\"unsupported %s type: %s\", e.Union, e.Typename)",
+		"}",
+		"",
+		"// Err returns the first non-nil typed error variant this result carries, or nil if the",
+		"// result is a successful Connection. Every variant is a typed Go error, so callers can use",
+		"// errors.As to recover the concrete variant (e.g. a RateLimitedError's RetryAfterSeconds).",
+		"func (r *CompassSearchComponentsResult) Err() error {",
 	)
-	if cfg.ErrorTypeName != "" {
+	for _, e := range cfg.ErrorTypes {
 		lines = append(lines,
-			"\tcase CompassSearchComponentsErrorTypename:",
-			"\t\tvar errResp CompassSearchComponentsError",
-			"\t\tif err := json.Unmarshal(data, &errResp); err != nil {",
-			"\t\t\treturn err",
-			"\t\t}",
-			"\t\tr.Error = &errResp",
+			"\tif r."+e.TypeName+" != nil {",
+			"\t\treturn r."+e.TypeName,
+			"\t}",
 		)
 	}
 	lines = append(lines,
-		"\tdefault:",
-		"\t\treturn fmt.Errorf(\"unsupported searchComponents type: %s\", base.Typename)",
-		"\t}",
 		"\treturn nil",
 		"}",
 		"",
@@ -852,5 +1511,1165 @@ func renderGo(cfg *config) (string, error) {
 		"}",
 	)
 
+	lines = append(lines, deadlineClientLines()...)
+
+	if cfg.DedupEnabled {
+		lines = append(lines, dedupLines(cfg)...)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderClient renders oas_client_gen.go: an ogen-style typed Client with one method per
+// discovered Compass field (today, just SearchComponents), sitting on top of the models
+// renderModels already produced.
+func renderClient(cfg *schemaIR) (string, error) {
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"package gen",
+		"",
+		"import \"context\"",
+		"",
+		"// ClientInvoker executes a single GraphQL operation and returns its raw decoded JSON",
+		"// payload. atlassian/atlassian/graph's query executor already returns this shape, so a",
+		"// thin adapter is all callers need to satisfy this interface.",
+		"type ClientInvoker interface {",
+		"\tInvoke(ctx context.Context, query string, variables map[string]any) (map[string]any, error)",
+		"}",
+		"",
+		"// Client is the ogen-style typed surface over the Compass GraphQL-backed REST bridge: one",
+		"// method per discovered field, today just SearchComponents.",
+		"type Client struct {",
+		"\tinvoker ClientInvoker",
+		"}",
+		"",
+		"// NewClient builds a Client backed by the given invoker.",
+		"func NewClient(invoker ClientInvoker) *Client {",
+		"\treturn &Client{invoker: invoker}",
+		"}",
+		"",
+		"// SearchComponentsClient is the method set callers depend on, so a test double (see",
+		"// mocks.MockCompassSearchComponentsClient) can stand in for *Client.",
+		"type SearchComponentsClient interface {",
+		"\tSearchComponents(ctx context.Context, params SearchComponentsParams) (SearchComponentsRes, error)",
+		"}",
+		"",
+		"var _ SearchComponentsClient = (*Client)(nil)",
+		"",
+		"// SearchComponentsParams holds the variables for the SearchComponents operation. Both",
+		fmt.Sprintf("// GraphQL scalars (%s, %s) serialize as Go strings. After and First page through", cfg.CloudIDType, cfg.QueryType),
+		"// the connection; a CompassSearchComponentIterator sets them from the previous page's",
+		"// PageInfo instead of requiring callers to track cursors by hand.",
+		"type SearchComponentsParams struct {",
+		"\tCloudID string",
+		"\tQuery   string",
+		"\tAfter   *string",
+		"\tFirst   int",
+		"}",
+		"",
+		"// SearchComponentsRes is the decoded result of a SearchComponents call.",
+		"type SearchComponentsRes struct {",
+		"\tConnection *CompassSearchComponentConnection",
+	}
+	for _, e := range cfg.ErrorTypes {
+		lines = append(lines, "\t"+e.TypeName+" *"+e.TypeName)
+	}
+	lines = append(lines,
+		"}",
+		"",
+		"// SearchComponents executes the CompassSearchComponentsQuery operation and decodes its",
+		"// result into SearchComponentsRes.",
+		"func (c *Client) SearchComponents(ctx context.Context, params SearchComponentsParams) (SearchComponentsRes, error) {",
+		"\tvar first any",
+		"\tif params.First > 0 {",
+		"\t\tfirst = params.First",
+		"\t}",
+		"\tpayload, err := c.invoker.Invoke(ctx, CompassSearchComponentsQuery, map[string]any{",
+		"\t\t\"cloudId\": params.CloudID,",
+		"\t\t\"query\":   params.Query,",
+		"\t\t\"after\":   params.After,",
+		"\t\t\"first\":   first,",
+		"\t})",
+		"\tif err != nil {",
+		"\t\treturn SearchComponentsRes{}, err",
+		"\t}",
+		"\tdata, err := DecodeCompassSearchComponents(payload)",
+		"\tif err != nil {",
+		"\t\treturn SearchComponentsRes{}, err",
+		"\t}",
+		"\tres := SearchComponentsRes{Connection: data.Compass.SearchComponents.Connection}",
+	)
+	for _, e := range cfg.ErrorTypes {
+		lines = append(lines, "\tres."+e.TypeName+" = data.Compass.SearchComponents."+e.TypeName)
+	}
+	lines = append(lines,
+		"\treturn res, nil",
+		"}",
+	)
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderMutations renders compass_mutations_gen.go: one input struct, success payload struct,
+// discriminated Compass<Name>Result, DecodeCompass<Name> helper, and Client method per discovered
+// compass.createComponent/updateComponent/deleteComponent field, following the same
+// union-result/UnmarshalJSON pattern renderModels renders via EmitUnion for searchComponents, but
+// with its own switch, so an unknown __typename is a plain error rather than an
+// *UnknownUnionTypeError. Only
+// called when cfg.MutationsEnabled; the caller never registers this emitter otherwise.
+func renderMutations(cfg *schemaIR) (string, error) {
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"package gen",
+		"",
+		"import (",
+		"\t\"context\"",
+		"\t\"encoding/json\"",
+		"\t\"errors\"",
+		"\t\"fmt\"",
+		")",
+		"",
+	}
+
+	errorShapes := make(map[string]mutationErrorIR, len(cfg.MutationErrorTypes))
+	for _, e := range cfg.MutationErrorTypes {
+		errorShapes[e.TypeName] = e
+
+		messageType := goType("string", e.MessageNullable)
+		lines = append(lines, "type "+e.TypeName+" struct {")
+		if e.HasExtensions {
+			extType := goType(e.TypeName+"Extensions", e.ExtensionsNullable)
+			lines = append(lines,
+				"\tMessage "+messageType+" "+jsonTag("message", e.MessageNullable),
+				"\tExtensions "+extType+" "+jsonTag("extensions", e.ExtensionsNullable),
+				"}", "",
+			)
+			lines = append(lines, "type "+e.TypeName+"Extensions struct {")
+			if e.ExtensionsHasStatusCode {
+				statusType := goType("int", e.ExtensionsStatusCodeNullable)
+				lines = append(lines, "\tStatusCode "+statusType+" "+jsonTag("statusCode", e.ExtensionsStatusCodeNullable))
+			}
+			lines = append(lines, "}", "")
+		} else {
+			lines = append(lines,
+				"\tMessage "+messageType+" "+jsonTag("message", e.MessageNullable),
+				"}", "",
+			)
+		}
+	}
+
+	for _, m := range cfg.Mutations {
+		lines = append(lines, renderOneMutation(m, errorShapes)...)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderOneMutation renders the full surface for a single create/update/delete mutation: its
+// input struct, success payload struct, discriminated Compass<GoName>Result with UnmarshalJSON,
+// the DecodeCompass<GoName> helper, and the Client method that executes it.
+func renderOneMutation(m mutationIR, errorShapes map[string]mutationErrorIR) []string {
+	var lines []string
+
+	lines = append(lines, "type "+m.InputTypeName+" struct {")
+	for _, f := range m.InputFields {
+		lines = append(lines, "\t"+f.GoName+" "+goType(f.GoType, f.Nullable)+" "+jsonTag(f.Name, f.Nullable))
+	}
+	lines = append(lines, "}", "")
+
+	successSelect := "__typename"
+	lines = append(lines, "type "+m.SuccessTypeName+" struct {")
+	if m.HasComponent {
+		compType := goType("CompassComponent", m.ComponentNullable)
+		lines = append(lines, "\tComponent "+compType+" "+jsonTag("component", m.ComponentNullable))
+		successSelect += " component { id name typeId description ownerTeam { id displayName } }"
+	}
+	if m.HasSuccessFlag {
+		lines = append(lines, "\tSuccess bool "+jsonTag("success", m.SuccessFlagNullable))
+		successSelect += " success"
+	}
+	lines = append(lines, "}", "")
+
+	resultType := "Compass" + m.GoName + "Result"
+	successConst := "Compass" + m.GoName + "SuccessTypename"
+	lines = append(lines, "const (",
+		"\t"+successConst+" = "+strconv.Quote(m.SuccessTypeName),
+	)
+	for _, errName := range m.ErrorTypeNames {
+		lines = append(lines, "\t"+"Compass"+m.GoName+errName+"Typename = "+strconv.Quote(errName))
+	}
+	lines = append(lines, ")", "")
+
+	lines = append(lines,
+		"type "+resultType+" struct {",
+		"\tTypename string `json:\"__typename\"`",
+		"\t"+m.SuccessTypeName+" *"+m.SuccessTypeName+" `json:\"-\"`",
+	)
+	for _, errName := range m.ErrorTypeNames {
+		lines = append(lines, "\t"+errName+" *"+errName+" `json:\"-\"`")
+	}
+	lines = append(lines, "}", "")
+
+	lines = append(lines,
+		"func (r *"+resultType+") UnmarshalJSON(data []byte) error {",
+		"\tvar base struct {",
+		"\t\tTypename string `json:\"__typename\"`",
+		"\t}",
+		"\tif err := json.Unmarshal(data, &base); err != nil {",
+		"\t\treturn err",
+		"\t}",
+		"\tif base.Typename == \"\" {",
+		fmt.Sprintf("\t\treturn errors.New(\"missing __typename for %s\")", m.FieldName),
+		"\t}",
+		"\tr.Typename = base.Typename",
+		"\tswitch base.Typename {",
+		"\tcase "+successConst+":",
+		"\t\tvar val "+m.SuccessTypeName,
+		"\t\tif err := json.Unmarshal(data, &val); err != nil {",
+		"\t\t\treturn err",
+		"\t\t}",
+		"\t\tr."+m.SuccessTypeName+" = &val",
+	)
+	for _, errName := range m.ErrorTypeNames {
+		lines = append(lines,
+			"\tcase Compass"+m.GoName+errName+"Typename:",
+			"\t\tvar val "+errName,
+			"\t\tif err := json.Unmarshal(data, &val); err != nil {",
+			"\t\t\treturn err",
+			"\t\t}",
+			"\t\tr."+errName+" = &val",
+		)
+	}
+	lines = append(lines,
+		"\tdefault:",
+		fmt.Sprintf("\t\treturn fmt.Errorf(\"unsupported %s type: %%s\", base.Typename)", m.FieldName),
+		"\t}",
+		"\treturn nil",
+		"}",
+		"",
+	)
+
+	dataType := "Compass" + m.GoName + "Data"
+	lines = append(lines,
+		"type "+dataType+" struct {",
+		"\tCompass struct {",
+		"\t\t"+m.GoName+" "+resultType+" "+jsonTag(m.FieldName, false),
+		"\t} `json:\"compass\"`",
+		"}",
+		"",
+		"func Decode"+"Compass"+m.GoName+"(data map[string]any) (*"+dataType+", error) {",
+		"\tb, err := json.Marshal(data)",
+		"\tif err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		"\tvar out "+dataType,
+		"\tif err := json.Unmarshal(b, &out); err != nil {",
+		"\t\treturn nil, err",
+		"\t}",
+		"\treturn &out, nil",
+		"}",
+		"",
+	)
+
+	errorFragments := ""
+	for _, errName := range m.ErrorTypeNames {
+		shape := errorShapes[errName]
+		errorFields := "message"
+		if shape.HasExtensions && shape.ExtensionsHasStatusCode {
+			errorFields += " extensions { statusCode }"
+		}
+		errorFragments += fmt.Sprintf("\n      ... on %s { %s }", errName, errorFields)
+	}
+	query := fmt.Sprintf(`mutation Compass%s($input: %s!) {
+  compass {
+    %s(input: $input) {
+      %s%s
+    }
+  }
+}
+`, m.GoName, m.InputTypeName, m.FieldName, successSelect, errorFragments)
+
+	paramsType := m.GoName + "Params"
+	resType := m.GoName + "Res"
+	lines = append(lines,
+		fmt.Sprintf("const Compass%sMutation = %q", m.GoName, query),
+		"",
+		"type "+paramsType+" struct {",
+		"\tInput "+m.InputTypeName,
+		"}",
+		"",
+		"type "+resType+" struct {",
+		"\t"+m.SuccessTypeName+" *"+m.SuccessTypeName,
+	)
+	for _, errName := range m.ErrorTypeNames {
+		lines = append(lines, "\t"+errName+" *"+errName)
+	}
+	lines = append(lines,
+		"}",
+		"",
+		fmt.Sprintf("// %s executes the Compass%sMutation operation and decodes its result into %s.", m.GoName, m.GoName, resType),
+		"func (c *Client) "+m.GoName+"(ctx context.Context, params "+paramsType+") ("+resType+", error) {",
+		"\tpayload, err := c.invoker.Invoke(ctx, Compass"+m.GoName+"Mutation, map[string]any{",
+		"\t\t\"input\": params.Input,",
+		"\t})",
+		"\tif err != nil {",
+		"\t\treturn "+resType+"{}, err",
+		"\t}",
+		"\tdata, err := Decode"+"Compass"+m.GoName+"(payload)",
+		"\tif err != nil {",
+		"\t\treturn "+resType+"{}, err",
+		"\t}",
+		"\tres := "+resType+"{"+m.SuccessTypeName+": data.Compass."+m.GoName+"."+m.SuccessTypeName+"}",
+	)
+	for _, errName := range m.ErrorTypeNames {
+		lines = append(lines, "\tres."+errName+" = data.Compass."+m.GoName+"."+errName)
+	}
+	lines = append(lines,
+		"\treturn res, nil",
+		"}",
+		"",
+	)
+
+	return lines
+}
+
+// renderJSON renders oas_json_gen.go: hand-rolled Encode/Decode methods on the connection-shaped
+// models, writing directly with jx token streams instead of reflecting over struct tags the way
+// encoding/json does.
+func renderJSON(cfg *schemaIR) (string, error) {
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"package gen",
+		"",
+		"import \"github.com/ogen-go/ogen/jx\"",
+		"",
+		"// Encode writes v as a JSON object directly onto e, without reflecting over struct tags.",
+		"func (v CompassComponentOwnerTeam) Encode(e *jx.Encoder) {",
+		"\te.ObjStart()",
+	}
+	lines = append(lines, encodeStringFieldLines("ID", "id", cfg.OwnerTeamIDNullable, "\t")...)
+	lines = append(lines, encodeStringFieldLines("DisplayName", "displayName", cfg.OwnerTeamNameNullable, "\t")...)
+	lines = append(lines,
+		"\te.ObjEnd()",
+		"}",
+		"",
+		"// Decode reads a CompassComponentOwnerTeam from d's current JSON object.",
+		"func (v *CompassComponentOwnerTeam) Decode(d *jx.Decoder) error {",
+		"\treturn d.Obj(func(d *jx.Decoder, key string) error {",
+		"\t\tswitch key {",
+		"\t\tcase \"id\":",
+	)
+	lines = append(lines, decodeStringFieldLines("ID", cfg.OwnerTeamIDNullable, "\t\t\t")...)
+	lines = append(lines, "\t\tcase \"displayName\":")
+	lines = append(lines, decodeStringFieldLines("DisplayName", cfg.OwnerTeamNameNullable, "\t\t\t")...)
+	lines = append(lines,
+		"\t\tdefault:",
+		"\t\t\treturn d.Skip()",
+		"\t\t}",
+		"\t})",
+		"}",
+		"",
+		"// Encode writes v as a JSON object directly onto e, without reflecting over struct tags.",
+		"func (v CompassComponent) Encode(e *jx.Encoder) {",
+		"\te.ObjStart()",
+	)
+	lines = append(lines, encodeStringFieldLines("ID", "id", cfg.ComponentIDNullable, "\t")...)
+	lines = append(lines, encodeStringFieldLines("Name", "name", cfg.ComponentNameNullable, "\t")...)
+	lines = append(lines, encodeStringFieldLines("TypeID", "typeId", cfg.ComponentTypeIDNullable, "\t")...)
+	lines = append(lines, encodeStringFieldLines("Description", "description", cfg.ComponentDescNullable, "\t")...)
+	if cfg.ComponentOwnerNullable {
+		lines = append(lines,
+			"\tif v.OwnerTeam != nil {",
+			"\t\te.FieldStart(\"ownerTeam\")",
+			"\t\tv.OwnerTeam.Encode(e)",
+			"\t}",
+		)
+	} else {
+		lines = append(lines,
+			"\te.FieldStart(\"ownerTeam\")",
+			"\tv.OwnerTeam.Encode(e)",
+		)
+	}
+	lines = append(lines,
+		"\te.ObjEnd()",
+		"}",
+		"",
+		"// Decode reads a CompassComponent from d's current JSON object.",
+		"func (v *CompassComponent) Decode(d *jx.Decoder) error {",
+		"\treturn d.Obj(func(d *jx.Decoder, key string) error {",
+		"\t\tswitch key {",
+		"\t\tcase \"id\":",
+	)
+	lines = append(lines, decodeStringFieldLines("ID", cfg.ComponentIDNullable, "\t\t\t")...)
+	lines = append(lines, "\t\tcase \"name\":")
+	lines = append(lines, decodeStringFieldLines("Name", cfg.ComponentNameNullable, "\t\t\t")...)
+	lines = append(lines, "\t\tcase \"typeId\":")
+	lines = append(lines, decodeStringFieldLines("TypeID", cfg.ComponentTypeIDNullable, "\t\t\t")...)
+	lines = append(lines, "\t\tcase \"description\":")
+	lines = append(lines, decodeStringFieldLines("Description", cfg.ComponentDescNullable, "\t\t\t")...)
+	ownerTeamAssign := "\t\t\tv.OwnerTeam = team"
+	if cfg.ComponentOwnerNullable {
+		ownerTeamAssign = "\t\t\tv.OwnerTeam = &team"
+	}
+	lines = append(lines,
+		"\t\tcase \"ownerTeam\":",
+		"\t\t\tvar team CompassComponentOwnerTeam",
+		"\t\t\tif err := team.Decode(d); err != nil {",
+		"\t\t\t\treturn err",
+		"\t\t\t}",
+		ownerTeamAssign,
+		"\t\t\treturn nil",
+		"\t\tdefault:",
+		"\t\t\treturn d.Skip()",
+		"\t\t}",
+		"\t})",
+		"}",
+		"",
+		"// Encode writes v as a JSON object directly onto e, without reflecting over struct tags.",
+		"func (v PageInfo) Encode(e *jx.Encoder) {",
+		"\te.ObjStart()",
+		"\te.FieldStart(\"hasNextPage\")",
+		"\te.Bool(v.HasNextPage)",
+	)
+	if cfg.PageInfoHasEndCursor {
+		lines = append(lines, encodeStringFieldLines("EndCursor", "endCursor", cfg.PageInfoEndCursorNullable, "\t")...)
+	}
+	lines = append(lines,
+		"\te.ObjEnd()",
+		"}",
+		"",
+		"// Decode reads a PageInfo from d's current JSON object.",
+		"func (v *PageInfo) Decode(d *jx.Decoder) error {",
+		"\treturn d.Obj(func(d *jx.Decoder, key string) error {",
+		"\t\tswitch key {",
+		"\t\tcase \"hasNextPage\":",
+		"\t\t\tb, err := d.Bool()",
+		"\t\t\tif err != nil {",
+		"\t\t\t\treturn err",
+		"\t\t\t}",
+		"\t\t\tv.HasNextPage = b",
+		"\t\t\treturn nil",
+	)
+	if cfg.PageInfoHasEndCursor {
+		lines = append(lines, "\t\tcase \"endCursor\":")
+		lines = append(lines, decodeStringFieldLines("EndCursor", cfg.PageInfoEndCursorNullable, "\t\t\t")...)
+	}
+	lines = append(lines,
+		"\t\tdefault:",
+		"\t\t\treturn d.Skip()",
+		"\t\t}",
+		"\t})",
+		"}",
+	)
+	return strings.Join(lines, "\n"), nil
+}
+
+// encodeStringFieldLines renders the jx.Encoder statements writing a single string field,
+// dereferencing it first and skipping a nil write when nullable is true (matching the *string
+// field renderModels emits for that case via goType).
+func encodeStringFieldLines(goField string, jsonName string, nullable bool, indent string) []string {
+	if nullable {
+		return []string{
+			indent + "if v." + goField + " != nil {",
+			indent + "\te.FieldStart(\"" + jsonName + "\")",
+			indent + "\te.Str(*v." + goField + ")",
+			indent + "}",
+		}
+	}
+	return []string{
+		indent + "e.FieldStart(\"" + jsonName + "\")",
+		indent + "e.Str(v." + goField + ")",
+	}
+}
+
+// decodeStringFieldLines renders the jx.Decoder statements reading a single string field back,
+// taking its address when nullable is true to match the *string field renderModels emits.
+func decodeStringFieldLines(goField string, nullable bool, indent string) []string {
+	assign := indent + "v." + goField + " = s"
+	if nullable {
+		assign = indent + "v." + goField + " = &s"
+	}
+	return []string{
+		indent + "s, err := d.Str()",
+		indent + "if err != nil {",
+		indent + "\treturn err",
+		indent + "}",
+		assign,
+		indent + "return nil",
+	}
+}
+
+// renderHandlers renders oas_handlers_gen.go: a server-side http.Handler adapter dispatching
+// decoded requests to a Handler interface implementation, mirroring ogen's generated server glue.
+func renderHandlers(cfg *schemaIR) (string, error) {
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"package gen",
+		"",
+		"import (",
+		"\t\"context\"",
+		"\t\"encoding/json\"",
+		"\t\"net/http\"",
+		")",
+		"",
+		"// Handler is implemented by a server backing the generated Compass operations.",
+		"type Handler interface {",
+		"\tSearchComponents(ctx context.Context, params SearchComponentsParams) (SearchComponentsRes, error)",
+		"}",
+		"",
+		"// NewServer builds an http.Handler dispatching requests to handler.",
+		"func NewServer(handler Handler) http.Handler {",
+		"\treturn &server{handler: handler}",
+		"}",
+		"",
+		"type server struct {",
+		"\thandler Handler",
+		"}",
+		"",
+		"func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {",
+		"\tswitch r.URL.Path {",
+		"\tcase \"/searchComponents\":",
+		"\t\ts.handleSearchComponents(w, r)",
+		"\tdefault:",
+		"\t\thttp.NotFound(w, r)",
+		"\t}",
+		"}",
+		"",
+		"func (s *server) handleSearchComponents(w http.ResponseWriter, r *http.Request) {",
+		"\tparams, err := decodeSearchComponentsParams(r)",
+		"\tif err != nil {",
+		"\t\thttp.Error(w, err.Error(), http.StatusBadRequest)",
+		"\t\treturn",
+		"\t}",
+		"\tres, err := s.handler.SearchComponents(r.Context(), params)",
+		"\tif err != nil {",
+		"\t\thttp.Error(w, err.Error(), http.StatusInternalServerError)",
+		"\t\treturn",
+		"\t}",
+		"\tw.Header().Set(\"Content-Type\", \"application/json\")",
+		"\t_ = json.NewEncoder(w).Encode(res)",
+		"}",
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderParameters renders oas_parameters_gen.go: decoding SearchComponentsParams from an
+// inbound *http.Request's query string (cloudId, query, and the optional after/first pagination
+// params), the parameter-decoding counterpart to renderHandlers's dispatch and renderClient's
+// typed call.
+func renderParameters(cfg *schemaIR) (string, error) {
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"package gen",
+		"",
+		"import (",
+		"\t\"errors\"",
+		"\t\"fmt\"",
+		"\t\"net/http\"",
+		"\t\"strconv\"",
+		")",
+		"",
+		"// decodeSearchComponentsParams reads SearchComponentsParams off r's query string.",
+		"func decodeSearchComponentsParams(r *http.Request) (SearchComponentsParams, error) {",
+		"\tquery := r.URL.Query()",
+		"\tcloudID := query.Get(\"cloudId\")",
+		"\tif cloudID == \"\" {",
+		"\t\treturn SearchComponentsParams{}, errors.New(\"missing required query parameter: cloudId\")",
+		"\t}",
+		"\tsearchQuery := query.Get(\"query\")",
+		"\tif searchQuery == \"\" {",
+		"\t\treturn SearchComponentsParams{}, errors.New(\"missing required query parameter: query\")",
+		"\t}",
+		"\tparams := SearchComponentsParams{CloudID: cloudID, Query: searchQuery}",
+		"\tif after := query.Get(\"after\"); after != \"\" {",
+		"\t\tparams.After = &after",
+		"\t}",
+		"\tif first := query.Get(\"first\"); first != \"\" {",
+		"\t\tn, err := strconv.Atoi(first)",
+		"\t\tif err != nil {",
+		"\t\t\treturn SearchComponentsParams{}, fmt.Errorf(\"invalid first query parameter: %w\", err)",
+		"\t\t}",
+		"\t\tparams.First = n",
+		"\t}",
+		"\treturn params, nil",
+		"}",
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderMocks renders mocks/compass_components_api_mock.go: a gomock double for
+// gen.SearchComponentsClient, in the same structural style mockgen itself produces (a mock plus a
+// *MockRecorder pair, wired through gomock.Controller.Call/RecordCallWithMethodType) so it compiles
+// against github.com/golang/mock/gomock without needing the mockgen binary on PATH. Only written
+// when cfg.EmitMocks is set.
+func renderMocks(cfg *schemaIR) (string, error) {
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"// Source: atlassian/atlassian/graph/gen (interfaces: SearchComponentsClient)",
+		"package mocks",
+		"",
+		"import (",
+		"\tcontext \"context\"",
+		"\treflect \"reflect\"",
+		"",
+		"\tgomock \"github.com/golang/mock/gomock\"",
+		"",
+		"\tgen \"atlassian/atlassian/graph/gen\"",
+		")",
+		"",
+		"// MockCompassSearchComponentsClient is a mock of the gen.SearchComponentsClient interface.",
+		"type MockCompassSearchComponentsClient struct {",
+		"\tctrl     *gomock.Controller",
+		"\trecorder *MockCompassSearchComponentsClientMockRecorder",
+		"}",
+		"",
+		"// MockCompassSearchComponentsClientMockRecorder is the mock recorder for MockCompassSearchComponentsClient.",
+		"type MockCompassSearchComponentsClientMockRecorder struct {",
+		"\tmock *MockCompassSearchComponentsClient",
+		"}",
+		"",
+		"// NewMockCompassSearchComponentsClient creates a new mock instance.",
+		"func NewMockCompassSearchComponentsClient(ctrl *gomock.Controller) *MockCompassSearchComponentsClient {",
+		"\tmock := &MockCompassSearchComponentsClient{ctrl: ctrl}",
+		"\tmock.recorder = &MockCompassSearchComponentsClientMockRecorder{mock}",
+		"\treturn mock",
+		"}",
+		"",
+		"// EXPECT returns an object that allows the caller to indicate expected use.",
+		"func (m *MockCompassSearchComponentsClient) EXPECT() *MockCompassSearchComponentsClientMockRecorder {",
+		"\treturn m.recorder",
+		"}",
+		"",
+		"// SearchComponents mocks gen.SearchComponentsClient's SearchComponents method.",
+		"func (m *MockCompassSearchComponentsClient) SearchComponents(ctx context.Context, params gen.SearchComponentsParams) (gen.SearchComponentsRes, error) {",
+		"\tm.ctrl.T.Helper()",
+		"\tret := m.ctrl.Call(m, \"SearchComponents\", ctx, params)",
+		"\tret0, _ := ret[0].(gen.SearchComponentsRes)",
+		"\tret1, _ := ret[1].(error)",
+		"\treturn ret0, ret1",
+		"}",
+		"",
+		"// SearchComponents indicates an expected call of SearchComponents.",
+		"func (mr *MockCompassSearchComponentsClientMockRecorder) SearchComponents(ctx, params interface{}) *gomock.Call {",
+		"\tmr.mock.ctrl.T.Helper()",
+		"\treturn mr.mock.ctrl.RecordCallWithMethodType(mr.mock, \"SearchComponents\", reflect.TypeOf((*MockCompassSearchComponentsClient)(nil).SearchComponents), ctx, params)",
+		"}",
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// deadlineClientLines renders CompassSearchComponentsClient, which lets a long-running reconciler
+// bound total pagination time and per-request time independent of the ambient context, following
+// the netstack deadlineTimer pattern: a cancel channel is closed when the timer fires, and that
+// channel is merged into a derived context so an in-flight cursor loop or round trip unblocks
+// without discarding whatever page results it already has.
+func deadlineClientLines() []string {
+	return []string{
+		"",
+		"// CompassSearchComponentsClient bounds how long a caller is willing to wait while walking",
+		"// a searchComponents cursor, independent of whatever context the caller passes in. Two",
+		"// deadlines are tracked separately: SetPaginationDeadline bounds the whole cursor walk,",
+		"// SetPerRequestDeadline bounds any single underlying round trip.",
+		"type CompassSearchComponentsClient struct {",
+		"\tmu sync.Mutex",
+		"",
+		"\tpaginationTimer *time.Timer",
+		"\tpaginationDone  chan struct{}",
+		"",
+		"\tperRequestTimer *time.Timer",
+		"\tperRequestDone  chan struct{}",
+		"}",
+		"",
+		"// SetPaginationDeadline bounds the total time PaginationContext's derived context stays",
+		"// live. Calling it again stops the previous timer first (mirroring time.Timer.Stop()) so",
+		"// only the latest deadline is ever armed.",
+		"func (c *CompassSearchComponentsClient) SetPaginationDeadline(t time.Time) {",
+		"\tc.mu.Lock()",
+		"\tdefer c.mu.Unlock()",
+		"\tc.paginationDone = armDeadlineTimer(&c.paginationTimer, t)",
+		"}",
+		"",
+		"// SetPerRequestDeadline bounds the total time PerRequestContext's derived context stays",
+		"// live for a single request. Calling it again stops the previous timer first (mirroring",
+		"// time.Timer.Stop()) so only the latest deadline is ever armed.",
+		"func (c *CompassSearchComponentsClient) SetPerRequestDeadline(t time.Time) {",
+		"\tc.mu.Lock()",
+		"\tdefer c.mu.Unlock()",
+		"\tc.perRequestDone = armDeadlineTimer(&c.perRequestTimer, t)",
+		"}",
+		"",
+		"// armDeadlineTimer stops and replaces timer, mirroring time.Timer.Stop() semantics: the",
+		"// previous timer is always stopped, even if it already fired, before a fresh one is armed,",
+		"// so only one deadline's done channel is ever live at a time.",
+		"func armDeadlineTimer(timer **time.Timer, t time.Time) chan struct{} {",
+		"\tif *timer != nil {",
+		"\t\t(*timer).Stop()",
+		"\t}",
+		"\tdone := make(chan struct{})",
+		"\t*timer = time.AfterFunc(time.Until(t), func() { close(done) })",
+		"\treturn done",
+		"}",
+		"",
+		"// PaginationContext derives a context from parent that's additionally canceled once the",
+		"// pagination deadline set by SetPaginationDeadline fires. The cursor loop should check this",
+		"// context between pages (not mid-request) so it can still return whatever page results it",
+		"// already has before the deadline's cancellation error surfaces.",
+		"func (c *CompassSearchComponentsClient) PaginationContext(parent context.Context) context.Context {",
+		"\tc.mu.Lock()",
+		"\tdone := c.paginationDone",
+		"\tc.mu.Unlock()",
+		"\treturn deadlineContext(parent, done)",
+		"}",
+		"",
+		"// PerRequestContext derives a context from parent that's additionally canceled once the",
+		"// per-request deadline set by SetPerRequestDeadline fires, bounding a single underlying",
+		"// HTTP round trip independent of the ambient context.",
+		"func (c *CompassSearchComponentsClient) PerRequestContext(parent context.Context) context.Context {",
+		"\tc.mu.Lock()",
+		"\tdone := c.perRequestDone",
+		"\tc.mu.Unlock()",
+		"\treturn deadlineContext(parent, done)",
+		"}",
+		"",
+		"// deadlineContext merges done into parent: the returned context is canceled when either",
+		"// parent is done or done is closed, whichever happens first. A nil done (no deadline set)",
+		"// returns parent unchanged.",
+		"func deadlineContext(parent context.Context, done <-chan struct{}) context.Context {",
+		"\tif done == nil {",
+		"\t\treturn parent",
+		"\t}",
+		"\tctx, cancel := context.WithCancel(parent)",
+		"\tgo func() {",
+		"\t\tselect {",
+		"\t\tcase <-done:",
+		"\t\t\tcancel()",
+		"\t\tcase <-ctx.Done():",
+		"\t\t}",
+		"\t}()",
+		"\treturn ctx",
+		"}",
+	}
+}
+
+// renderIteratorFile renders compass_components_iterator.go for the emitters table; renderIterator
+// does the actual work and returns its lines directly so dedupLines-style helpers could reuse them
+// if a future emitter needs to.
+func renderIteratorFile(cfg *schemaIR) (string, error) {
+	return strings.Join(renderIterator(cfg), "\n"), nil
+}
+
+// renderIterator renders compass_components_iterator.go: CompassSearchComponentIterator, which
+// walks a SearchComponents connection page by page over an injected ClientInvoker, so callers
+// don't re-implement cursor-walking for every query the way CompassSearchComponentConnection's
+// raw Nodes/Edges would otherwise require.
+func renderIterator(cfg *schemaIR) []string {
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"package gen",
+		"",
+		"import \"context\"",
+		"",
+		"// CompassSearchComponentIteratorOptions configures a CompassSearchComponentIterator.",
+		"type CompassSearchComponentIteratorOptions struct {",
+		"\t// PageSize is the `first` argument sent with each page request; defaults to 50.",
+		"\tPageSize int",
+		"\t// MaxResults bounds how many CompassComponentNode values Next/All return in total;",
+		"\t// zero means unlimited.",
+		"\tMaxResults int",
+		"}",
+		"",
+		"// CompassSearchComponentIterator walks a SearchComponents connection page by page over an",
+		"// injected ClientInvoker, transparently issuing follow-up queries using the previous page's",
+		"// endCursor while hasNextPage is true.",
+		"type CompassSearchComponentIterator struct {",
+		"\tinvoker  ClientInvoker",
+		"\tcloudID  string",
+		"\tquery    string",
+		"\topts     CompassSearchComponentIteratorOptions",
+		"\tbuf      []CompassComponentNode",
+		"\tafter    *string",
+		"\tdone     bool",
+		"\treturned int",
+		"\terr      error",
+		"}",
+		"",
+		"// NewCompassSearchComponentIterator builds a CompassSearchComponentIterator that searches",
+		"// cloudID for query, executing each page request through invoker.",
+		"func NewCompassSearchComponentIterator(invoker ClientInvoker, cloudID, query string, opts CompassSearchComponentIteratorOptions) *CompassSearchComponentIterator {",
+		"\treturn &CompassSearchComponentIterator{invoker: invoker, cloudID: cloudID, query: query, opts: opts}",
+		"}",
+		"",
+		"func (it *CompassSearchComponentIterator) pageSize() int {",
+		"\tif it.opts.PageSize > 0 {",
+		"\t\treturn it.opts.PageSize",
+		"\t}",
+		"\treturn 50",
+		"}",
+		"",
+	}
+
+	pageAdvanceLines := []string{
+		"\t// This schema's PageInfo has no endCursor: without a cursor there's no way to request a",
+		"\t// genuinely new page, so the connection is treated as exhausted after one page.",
+		"\tit.done = true",
+	}
+	if cfg.PageInfoHasEndCursor {
+		if cfg.PageInfoEndCursorNullable {
+			pageAdvanceLines = []string{
+				"\tpage := res.Connection.Page()",
+				"\tit.after = page.EndCursor",
+				"\tit.done = !page.HasNextPage",
+			}
+		} else {
+			pageAdvanceLines = []string{
+				"\tpage := res.Connection.Page()",
+				"\tit.after = &page.EndCursor",
+				"\tit.done = !page.HasNextPage",
+			}
+		}
+	}
+
+	lines = append(lines,
+		"func (it *CompassSearchComponentIterator) fetchNextPage(ctx context.Context) error {",
+		"\tclient := NewClient(it.invoker)",
+		"\tres, err := client.SearchComponents(ctx, SearchComponentsParams{",
+		"\t\tCloudID: it.cloudID,",
+		"\t\tQuery:   it.query,",
+		"\t\tAfter:   it.after,",
+		"\t\tFirst:   it.pageSize(),",
+		"\t})",
+		"\tif err != nil {",
+		"\t\treturn err",
+		"\t}",
+		"\tif res.Connection == nil {",
+		"\t\tit.done = true",
+		"\t\treturn nil",
+		"\t}",
+		"\tit.buf = append(it.buf, res.Connection.Nodes...)",
+	)
+	lines = append(lines, pageAdvanceLines...)
+	lines = append(lines, "\treturn nil", "}", "")
+
+	lines = append(lines,
+		"// Next returns the next CompassComponentNode, or (nil, nil) once the connection is",
+		"// exhausted or MaxResults has been reached.",
+		"func (it *CompassSearchComponentIterator) Next(ctx context.Context) (*CompassComponentNode, error) {",
+		"\tif it.err != nil {",
+		"\t\treturn nil, it.err",
+		"\t}",
+		"\tif it.opts.MaxResults > 0 && it.returned >= it.opts.MaxResults {",
+		"\t\treturn nil, nil",
+		"\t}",
+		"\tfor len(it.buf) == 0 {",
+		"\t\tif it.done {",
+		"\t\t\treturn nil, nil",
+		"\t\t}",
+		"\t\tif err := it.fetchNextPage(ctx); err != nil {",
+		"\t\t\tit.err = err",
+		"\t\t\treturn nil, err",
+		"\t\t}",
+		"\t}",
+		"\tnode := it.buf[0]",
+		"\tit.buf = it.buf[1:]",
+		"\tit.returned++",
+		"\treturn &node, nil",
+		"}",
+		"",
+	)
+
+	lines = append(lines,
+		"// All drains the iterator, returning every remaining CompassComponentNode up to",
+		"// MaxResults.",
+		"func (it *CompassSearchComponentIterator) All(ctx context.Context) ([]*CompassComponentNode, error) {",
+		"\tvar out []*CompassComponentNode",
+		"\tfor {",
+		"\t\tnode, err := it.Next(ctx)",
+		"\t\tif err != nil {",
+		"\t\t\treturn out, err",
+		"\t\t}",
+		"\t\tif node == nil {",
+		"\t\t\treturn out, nil",
+		"\t\t}",
+		"\t\tout = append(out, node)",
+		"\t}",
+		"}",
+	)
+
+	return lines
+}
+
+// renderRuntimeClient renders compass_runtime_client.go: RuntimeClient, a transport-aware wrapper
+// around the generated Client, following the same "wrap the invoker, don't touch the decoders"
+// shape CompassSearchComponentsClient already uses for pagination deadlines. RuntimeClient adds
+// per-call deadlines, a pluggable middleware chain (auth, logging, OTel tracing, ...) ahead of the
+// invoker, and bounded exponential-backoff retries for SearchComponents - honoring a rate-limited
+// error variant's retryAfterSeconds when the schema defines one. Callers never touch raw
+// map[string]any; every method here returns the same typed Res the generated Client already does.
+func renderRuntimeClient(cfg *schemaIR) (string, error) {
+	lines := []string{
+		"// Code generated by go/tools/generate_compass_component_models/main.go. DO NOT EDIT.",
+		"package gen",
+		"",
+		"import (",
+		"\t\"context\"",
+		"\t\"time\"",
+		")",
+		"",
+		"// RuntimeMiddleware wraps a ClientInvoker with cross-cutting behavior (auth, logging, OTel",
+		"// tracing, ...) without the wrapped invoker needing to know it's been wrapped.",
+		"type RuntimeMiddleware func(ClientInvoker) ClientInvoker",
+		"",
+		"// ChainMiddleware applies mw in order around invoker: the first middleware is outermost, so",
+		"// it sees a call first and the response last.",
+		"func ChainMiddleware(invoker ClientInvoker, mw ...RuntimeMiddleware) ClientInvoker {",
+		"\tfor i := len(mw) - 1; i >= 0; i-- {",
+		"\t\tinvoker = mw[i](invoker)",
+		"\t}",
+		"\treturn invoker",
+		"}",
+		"",
+		"// RuntimeClientOptions configures RuntimeClient's deadline and retry behavior. The zero",
+		"// value disables both: no per-call timeout is applied, and no retries are attempted.",
+		"type RuntimeClientOptions struct {",
+		"\t// PerCallTimeout bounds a single underlying Invoke call, independent of ctx's own",
+		"\t// deadline, the same way CompassSearchComponentsClient.SetPerRequestDeadline bounds a",
+		"\t// single round trip during pagination. Zero means no additional bound is applied.",
+		"\tPerCallTimeout time.Duration",
+		"\t// MaxRetries bounds how many additional attempts a retryable failure gets. Zero disables",
+		"\t// retries entirely.",
+		"\tMaxRetries int",
+		"\t// RetryBaseDelay is the first retry's backoff; each subsequent retry doubles it.",
+		"\tRetryBaseDelay time.Duration",
+		"\t// RetryMaxDelay caps the backoff delay after doubling. Zero means uncapped.",
+		"\tRetryMaxDelay time.Duration",
+		"}",
+		"",
+		"// RuntimeClient wraps a Client with per-call deadlines, a middleware chain ahead of the",
+		"// invoker, and bounded exponential-backoff retries, so callers get the generated operations",
+		"// without re-implementing transport concerns themselves.",
+		"type RuntimeClient struct {",
+		"\tclient *Client",
+		"\topts   RuntimeClientOptions",
+		"",
+		"\tstreamReadDeadline time.Duration",
+		"}",
+		"",
+		"// NewRuntimeClient builds a RuntimeClient backed by invoker, wrapped with mw (outermost",
+		"// first) before any call reaches it.",
+		"func NewRuntimeClient(invoker ClientInvoker, opts RuntimeClientOptions, mw ...RuntimeMiddleware) *RuntimeClient {",
+		"\treturn &RuntimeClient{client: NewClient(ChainMiddleware(invoker, mw...)), opts: opts}",
+		"}",
+		"",
+		"// SetStreamReadDeadline bounds how long a persistent connection backing a future streaming",
+		"// or subscription operation may sit idle without a message, mirroring net.Conn's",
+		"// SetReadDeadline. No generated operation streams today, so this only records d on the",
+		"// client; no method below reads it, and PerCallTimeout is left unchanged.",
+		"func (c *RuntimeClient) SetStreamReadDeadline(d time.Duration) {",
+		"\tc.streamReadDeadline = d",
+		"}",
+		"",
+		"func (c *RuntimeClient) callContext(ctx context.Context) (context.Context, context.CancelFunc) {",
+		"\tif c.opts.PerCallTimeout <= 0 {",
+		"\t\treturn ctx, func() {}",
+		"\t}",
+		"\treturn context.WithTimeout(ctx, c.opts.PerCallTimeout)",
+		"}",
+		"",
+		"// sleepOrDone waits for d, returning ctx.Err() early if ctx is canceled first.",
+		"func (c *RuntimeClient) sleepOrDone(ctx context.Context, d time.Duration) error {",
+		"\ttimer := time.NewTimer(d)",
+		"\tdefer timer.Stop()",
+		"\tselect {",
+		"\tcase <-timer.C:",
+		"\t\treturn nil",
+		"\tcase <-ctx.Done():",
+		"\t\treturn ctx.Err()",
+		"\t}",
+		"}",
+		"",
+	}
+
+	retryTypeName, retryField, hasRetryable := findRetryableErrorType(cfg)
+
+	lines = append(lines,
+		"// SearchComponents calls Client.SearchComponents under opts.PerCallTimeout, retrying up to",
+	)
+	if hasRetryable {
+		lines = append(lines,
+			fmt.Sprintf("// opts.MaxRetries times on a transport error or a decoded %s, waiting", retryTypeName),
+			"// RetryAfterSeconds between attempts when the server provided one and the exponential",
+			"// backoff otherwise. Every other typed error is returned immediately: retrying a",
+			"// validation or permission failure can't change the outcome.",
+		)
+	} else {
+		lines = append(lines,
+			"// opts.MaxRetries times on a transport error with exponential backoff. This schema has no",
+			"// rate-limited error variant carrying retryAfterSeconds, so a decoded typed error is always",
+			"// returned immediately instead of guessing at which ones are safe to retry.",
+		)
+	}
+	lines = append(lines,
+		"func (c *RuntimeClient) SearchComponents(ctx context.Context, params SearchComponentsParams) (SearchComponentsRes, error) {",
+		"\tdelay := c.opts.RetryBaseDelay",
+		"\tvar res SearchComponentsRes",
+		"\tvar err error",
+		"\tfor attempt := 0; ; attempt++ {",
+		"\t\tcallCtx, cancel := c.callContext(ctx)",
+		"\t\tres, err = c.client.SearchComponents(callCtx, params)",
+		"\t\tcancel()",
+		"",
+		"\t\twait, retryable := c.searchComponentsRetryWait(res, err, delay)",
+		"\t\tif !retryable || attempt >= c.opts.MaxRetries {",
+		"\t\t\treturn res, err",
+		"\t\t}",
+		"\t\tif waitErr := c.sleepOrDone(ctx, wait); waitErr != nil {",
+		"\t\t\treturn res, waitErr",
+		"\t\t}",
+		"\t\tdelay *= 2",
+		"\t\tif c.opts.RetryMaxDelay > 0 && delay > c.opts.RetryMaxDelay {",
+		"\t\t\tdelay = c.opts.RetryMaxDelay",
+		"\t\t}",
+		"\t}",
+		"}",
+		"",
+		"// searchComponentsRetryWait reports whether a SearchComponents attempt should be retried",
+		"// and, if so, how long to wait first: a transport error always retries, and (if this schema",
+		"// defines one) so does a decoded rate-limited error, preferring its RetryAfterSeconds over",
+		"// fallback when present; every other outcome (success or any other typed error) does not",
+		"// retry.",
+		"func (c *RuntimeClient) searchComponentsRetryWait(res SearchComponentsRes, err error, fallback time.Duration) (time.Duration, bool) {",
+		"\tif err != nil {",
+		"\t\treturn fallback, true",
+		"\t}",
+	)
+	if hasRetryable {
+		retryAccessor := "rl." + retryField.GoName
+		if retryField.Nullable {
+			retryAccessor = "*rl." + retryField.GoName
+		}
+		lines = append(lines,
+			"\trl := res."+retryTypeName,
+			"\tif rl == nil {",
+			"\t\treturn 0, false",
+			"\t}",
+		)
+		if retryField.Nullable {
+			lines = append(lines,
+				"\tif rl."+retryField.GoName+" != nil {",
+				"\t\treturn time.Duration("+retryAccessor+") * time.Second, true",
+				"\t}",
+				"\treturn fallback, true",
+			)
+		} else {
+			lines = append(lines,
+				"\treturn time.Duration("+retryAccessor+") * time.Second, true",
+			)
+		}
+	} else {
+		lines = append(lines,
+			"\treturn 0, false",
+		)
+	}
+	lines = append(lines, "}", "")
+
+	if cfg.MutationsEnabled {
+		for _, m := range cfg.Mutations {
+			paramsType := m.GoName + "Params"
+			resType := m.GoName + "Res"
+			lines = append(lines,
+				fmt.Sprintf("// %s calls Client.%s under opts.PerCallTimeout. Create/update/delete mutations", m.GoName, m.GoName),
+				"// aren't safely retried without caller-level idempotency, so this only applies the",
+				"// deadline and middleware chain, not the retry loop SearchComponents gets.",
+				"func (c *RuntimeClient) "+m.GoName+"(ctx context.Context, params "+paramsType+") ("+resType+", error) {",
+				"\tcallCtx, cancel := c.callContext(ctx)",
+				"\tdefer cancel()",
+				"\treturn c.client."+m.GoName+"(callCtx, params)",
+				"}",
+				"",
+			)
+		}
+	}
+
 	return strings.Join(lines, "\n"), nil
 }
+
+// dedupLines renders CompassComponentDeduper, a Bloom-filter-backed helper that skips components
+// already seen across searchComponents pages (keyed on CompassComponent.ID), plus WrapFetch to
+// plug it into a pagination.CursorIterator[CompassComponentNode] without callers holding every ID
+// seen so far in memory themselves.
+func dedupLines(cfg *schemaIR) []string {
+	idLines := []string{"\t\tid := n.Component.ID"}
+	if cfg.NodeComponentNullable {
+		idLines = append([]string{
+			"\t\tif n.Component == nil {",
+			"\t\t\tout = append(out, n)",
+			"\t\t\tcontinue",
+			"\t\t}",
+		}, idLines...)
+	}
+	if cfg.ComponentIDNullable {
+		idLines = append(idLines,
+			"\t\tif id == nil {",
+			"\t\t\tout = append(out, n)",
+			"\t\t\tcontinue",
+			"\t\t}",
+			"\t\tif d.SeenOrAdd(*id) {",
+			"\t\t\tcontinue",
+			"\t\t}",
+		)
+	} else {
+		idLines = append(idLines,
+			"\t\tif d.SeenOrAdd(id) {",
+			"\t\t\tcontinue",
+			"\t\t}",
+		)
+	}
+
+	lines := []string{
+		"",
+		"// CompassComponentDeduper skips components already seen across searchComponents pages,",
+		"// keyed on CompassComponent.ID, so callers driving very large cursors don't hold every ID",
+		"// seen so far in memory themselves.",
+		"type CompassComponentDeduper struct {",
+		"\tfilter *bloom.BloomFilter",
+		"}",
+		"",
+		"// NewCompassComponentDeduper builds a CompassComponentDeduper sized for expectedItems",
+		"// components at the given falsePositiveRate, mirroring bloom.NewWithEstimates.",
+		"func NewCompassComponentDeduper(expectedItems uint, falsePositiveRate float64) *CompassComponentDeduper {",
+		"\treturn &CompassComponentDeduper{filter: bloom.NewWithEstimates(expectedItems, falsePositiveRate)}",
+		"}",
+		"",
+		"// SeenOrAdd reports whether id has already been returned by a previous call, adding it to",
+		"// the filter if not. False positives are possible (tunable via falsePositiveRate), meaning a",
+		"// small fraction of genuinely-new components may be skipped as already seen.",
+		"func (d *CompassComponentDeduper) SeenOrAdd(id string) bool {",
+		"\tb := []byte(id)",
+		"\tif d.filter.Test(b) {",
+		"\t\treturn true",
+		"\t}",
+		"\td.filter.Add(b)",
+		"\treturn false",
+		"}",
+		"",
+		"// WrapFetch adapts a pagination.CursorFetchFunc over CompassComponentNode to drop nodes",
+		"// whose component ID has already been seen, so a pagination.CursorIterator built from the",
+		"// wrapped fetch func yields each component at most once across the whole cursor.",
+		"func (d *CompassComponentDeduper) WrapFetch(fetch pagination.CursorFetchFunc[CompassComponentNode]) pagination.CursorFetchFunc[CompassComponentNode] {",
+		"\treturn func(ctx context.Context, after *string) ([]CompassComponentNode, bool, *string, error) {",
+		"\t\tnodes, hasNextPage, endCursor, err := fetch(ctx, after)",
+		"\t\tif err != nil {",
+		"\t\t\treturn nil, false, nil, err",
+		"\t\t}",
+		"\t\tout := make([]CompassComponentNode, 0, len(nodes))",
+		"\t\tfor _, n := range nodes {",
+	}
+	lines = append(lines, idLines...)
+	lines = append(lines,
+		"\t\t\tout = append(out, n)",
+		"\t\t}",
+		"\t\treturn out, hasNextPage, endCursor, nil",
+		"\t}",
+		"}",
+	)
+	return lines
+}
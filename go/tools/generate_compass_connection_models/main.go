@@ -0,0 +1,410 @@
+// Command generate_compass_connection_models renders one atlassian/graph/gen/*_api.go file per
+// Compass connection field reached through compass.component(id: ...) - e.g. scorecards,
+// relationships - via the shared codegen.ConnectionFieldManifest pipeline, instead of every such
+// field getting its own hand-rolled introspection walk and template the way the scorecards-only
+// generator this replaced did. Adding a new compass.component connection field is a
+// ConnectionFieldManifest literal added to connectionTargets below, not a new go/tools directory.
+//
+// compass.searchComponents isn't a target here: it's a root-level connection with no parent
+// entity lookup, a shape the more elaborate generate_compass_component_models generator already
+// covers.
+//
+// Each target's {Entity}Result is a discriminated union over more than just "connection or error":
+// codegen.RenderConnectionField builds it from a []codegen.UnionMember list (currently Connection
+// plus, if the field's error variant resolved, Error), which emits an N-way __typename switch, an
+// As{Name}() accessor per member, and an IsError() helper - so adding a third member (a
+// ValidationError, a permission-denied variant) is a UnionMember entry, not a rewrite of the
+// switch. A __typename matching none of them is kept as raw JSON on Result.Unknown rather than
+// failing to decode, so a server-side union addition doesn't break callers running old generated
+// code.
+//
+// -fastjson swaps the generated Node/Edge/Connection/ref types' JSON codec from encoding/json
+// reflection to hand-written MarshalJSON/UnmarshalJSON, which matters since these types sit on the
+// hot pagination path. It only covers the scalar shapes the current connectionTargets use; a
+// target selecting a field type codegen.RenderConnectionField doesn't have a fastjson writer for
+// fails the generate step rather than silently falling back to reflection.
+//
+// Each target also gets a gomock double of its {Entity}Client interface under -mock-dir (package
+// mock_gen), so callers can fetch a page or the whole connection in their own tests without hitting
+// live Atlassian. Pass -no-mocks to skip them.
+//
+// Every target also gets an {Entity}Iterator wrapping a caller-supplied page-fetch callback, so
+// paging node by node across a whole connection doesn't require hand-rolling the
+// "loop until !pageInfo.hasNextPage" logic against {Entity}Client directly. It honors ctx.Done()
+// between pages, supports a per-page SetPageDeadline, and exposes ResumeToken so a caller can
+// checkpoint and resume iteration across process restarts.
+//
+// A target can additionally set EmitSeqIterator for an Iterate{Entity} range-over-func iterator
+// (iter.Seq2[*{Entity}Node, error]) plus an Iterate{Entity}Chan channel-based equivalent for
+// pre-1.23 Go - both of which, unlike {Entity}Iterator, stop on the first typed {Entity}Error
+// surfaced by the query's discriminated result, not just a transport-level Go error.
+//
+// -check runs the same resolve/render pipeline but, instead of writing the gen/mock_gen files,
+// diffs the formatted output against what's on disk and exits non-zero (printing a unified diff)
+// on any mismatch. If ATLASSIAN_* credentials are available in the environment, it also re-fetches
+// the live Compass schema into a tempfile and reports any field reachable from the Query root whose
+// type, nullability, or argument types have drifted from the cached schema.introspection.json -
+// catching a schema change before it breaks runtime unmarshalling, instead of after.
+//
+// mutationTargets renders one atlassian/graph/gen/*_api.go per compass.{field} mutation via the
+// same shared codegen pipeline's mutation side (codegen.MutationManifest/ResolveMutation/
+// RenderMutation), each getting its own {Entity}Client interface and, unless -no-mocks, a mock_gen
+// double - the same -check/-fastjson(n/a for mutations)/-no-mocks/-mock-dir handling as
+// connectionTargets. Compass scorecard updates happen synchronously; this generator doesn't yet
+// cover the long-running-operation-behind-a-poll-or-webhook shape some other Compass mutations use,
+// since no such field is reachable from the cached schema today - RunXxx either returns the final
+// result or an error, not an in-progress handle.
+//
+// Every target's Fields/Refs/ResultFields still name which scalars to pull off the schema and
+// (via FieldManifest.ForceOptional) can pin a field's Go type regardless of what the schema says -
+// but the schema itself no longer has to come from a live introspection query. codegen.ParseSDL
+// reads the same shape out of a hand-authored .graphql SDL file, so a target's manifest is the
+// only thing that needs maintaining by hand; this binary still loads schema.introspection.json via
+// codegen.Bootstrap since that's the cached schema checked into this repo, but either source feeds
+// every ResolveXxx function identically.
+//
+// Each connectionTargets entry's gen file also gets a Fetch{Entity}(ctx, vars, opts...) function
+// next to Decode{Entity}, so calling the query is a gen-package function call rather than every
+// caller hand-assembling vars and invoking Client.Execute directly the way atlassian/graph/compass.go
+// still does for the pre-codegen connection fields. Fetch{Entity} shares one CallOpt-based
+// WithMaxRetries/WithTimeout/WithDeadline runtime (codegen.RenderConnectionFieldCommon emits it once
+// per package, alongside PageInfo) across every target, and retries specifically a
+// *graphql.RateLimitError the same way graphql/subscribe.go's polling loop already does - a 200
+// response whose GraphQL errors[] carries a rate limit that the transport layer's own HTTP-level
+// retry can't see. It does not retry on other errors: HTTP-level 429/5xx already gets retried inside
+// Client.Execute's transport, same as it does for the REST backend.
+//
+// A target can additionally set EmitHelpers for Clone/Equal/IsZero methods on every struct it
+// generates (its refs, {Entity}Node, {Entity}Edge, {Entity}Connection, {Entity}Result), for a
+// caller that caches a decoded page or diffs it across polling intervals and would otherwise need
+// reflection to copy or compare pointer-heavy structs like a nullable-score, nullable-evaluatedAt
+// scorecard node safely - two nil pointers compare equal, a nil-vs-set pair doesn't, and time.Time
+// fields compare via time.Time.Equal rather than ==. Only scorecards sets it today, since it's the
+// one target actually cached across poll intervals; PageInfo's own Clone/Equal, shared by every
+// {Entity}Connection regardless of which target asked for the rest, is rendered once in
+// RenderConnectionFieldCommon whenever any target sets EmitHelpers.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go/format"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"atlassian/atlassian/graph"
+	"atlassian/atlassian/graph/codegen"
+)
+
+const generatorPath = "go/tools/generate_compass_connection_models/main.go"
+
+// connectionTargets lists every Compass connection field this generator renders a client for.
+var connectionTargets = []*codegen.ConnectionFieldManifest{
+	{
+		Package:             "gen",
+		GeneratorPath:       generatorPath,
+		EntityName:          "CompassComponentScorecards",
+		Namespace:           "compass",
+		ParentField:         "component",
+		ParentIDArgName:     "id",
+		ConnectionFieldName: "scorecards",
+		Fields: []codegen.FieldManifest{
+			{Name: "score"},
+			{Name: "maxScore"},
+			{Name: "evaluatedAt"},
+		},
+		Refs: []codegen.NestedRefManifest{
+			{Name: "scorecard", Fields: []codegen.FieldManifest{{Name: "id"}, {Name: "name"}}},
+		},
+		// Scorecards is the one target callers actually page through end to end today, so it's the
+		// one that gets the heavier Iterate{Entity}/Iterate{Entity}Chan surface on top of the plain
+		// {Entity}Iterator every target gets.
+		EmitSeqIterator: true,
+		// Scorecard pages get cached and diffed across polling intervals, so they're the one
+		// target worth the Clone/Equal/IsZero surface on top of everything else.
+		EmitHelpers: true,
+	},
+	{
+		Package:             "gen",
+		GeneratorPath:       generatorPath,
+		EntityName:          "CompassComponentRelationships",
+		Namespace:           "compass",
+		ParentField:         "component",
+		ParentIDArgName:     "id",
+		ConnectionFieldName: "relationships",
+		Fields: []codegen.FieldManifest{
+			{Name: "type"},
+		},
+		Refs: []codegen.NestedRefManifest{
+			{Name: "startNode", Fields: []codegen.FieldManifest{{Name: "id"}, {Name: "name"}}},
+			{Name: "endNode", Fields: []codegen.FieldManifest{{Name: "id"}, {Name: "name"}}},
+		},
+	},
+}
+
+// mutationTargets lists every compass.* mutation field this generator renders a client for.
+var mutationTargets = []*codegen.MutationManifest{
+	{
+		Package:       "gen",
+		GeneratorPath: generatorPath,
+		EntityName:    "CompassCreateScorecard",
+		Namespace:     "compass",
+		FieldName:     "createScorecard",
+		InputArgName:  "input",
+		ResultFields: []codegen.FieldManifest{
+			{Name: "id"},
+			{Name: "name"},
+		},
+	},
+	{
+		Package:       "gen",
+		GeneratorPath: generatorPath,
+		EntityName:    "CompassUpdateScorecardScore",
+		Namespace:     "compass",
+		FieldName:     "updateScorecardScore",
+		InputArgName:  "input",
+		ResultFields: []codegen.FieldManifest{
+			{Name: "id"},
+			{Name: "score"},
+		},
+	},
+}
+
+func main() {
+	forceRefresh := flag.Bool("force-refresh", false, "bypass the schema cache and always re-fetch the introspection result")
+	fastJSON := flag.Bool("fastjson", false, "emit hand-written MarshalJSON/UnmarshalJSON methods for Node/Edge/Connection/ref types instead of relying on encoding/json reflection")
+	noMocks := flag.Bool("no-mocks", false, "skip generating the gomock {Entity}Client doubles under -mock-dir")
+	mockDir := flag.String("mock-dir", "", "directory the {Entity}Client mocks are written to (default: \"mock_gen\" next to the gen output dir)")
+	checkMode := flag.Bool("check", false, "don't write generated files; diff the rendered output against what's on disk (and, with credentials, the live schema against the cache) and exit non-zero if either has drifted")
+	flag.Parse()
+
+	repoRoot, err := codegen.FindRepoRoot()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	schema, err := codegen.Bootstrap(context.Background(), codegen.BootstrapOptions{RepoRoot: repoRoot, ForceRefresh: *forceRefresh})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	genDir := filepath.Join(repoRoot, "go", "atlassian", "graph", "gen")
+	if !*checkMode {
+		if err := os.MkdirAll(genDir, 0o755); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+
+	// Rendered once and shared by every target below, rather than each target's file declaring
+	// its own PageInfo struct and colliding in package gen.
+	pageInfo, err := codegen.ResolvePageInfoType(schema)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	emitHelpers := false
+	for _, t := range connectionTargets {
+		if t.EmitHelpers {
+			emitHelpers = true
+			break
+		}
+	}
+	commonSource := codegen.RenderConnectionFieldCommon(pageInfo, generatorPath, "gen", *fastJSON, emitHelpers)
+	commonPath := filepath.Join(genDir, "compass_connection_common.go")
+
+	dir := *mockDir
+	if dir == "" {
+		dir = filepath.Join(genDir, "mock_gen")
+	}
+	if !*noMocks && !*checkMode {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+
+	drifted := false
+	if d, err := outputGoFile(commonPath, commonSource, *checkMode); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	} else {
+		drifted = drifted || d
+	}
+
+	tm := codegen.DefaultTypeMapper()
+	for _, target := range connectionTargets {
+		resolved, err := codegen.ResolveConnectionField(schema, target, tm)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", target.ConnectionFieldName, err)
+			os.Exit(2)
+		}
+		source, err := codegen.RenderConnectionField(resolved, *fastJSON)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", target.ConnectionFieldName, err)
+			os.Exit(2)
+		}
+		outPath := filepath.Join(genDir, fmt.Sprintf("compass_%s_api.go", target.ConnectionFieldName))
+		if d, err := outputGoFile(outPath, source, *checkMode); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		} else {
+			drifted = drifted || d
+		}
+
+		if *noMocks {
+			continue
+		}
+		mockSource := codegen.RenderConnectionFieldMock(resolved)
+		mockPath := filepath.Join(dir, fmt.Sprintf("compass_%s_mock.go", target.ConnectionFieldName))
+		if d, err := outputGoFile(mockPath, mockSource, *checkMode); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		} else {
+			drifted = drifted || d
+		}
+	}
+
+	for _, target := range mutationTargets {
+		resolved, err := codegen.ResolveMutation(schema, target, tm)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", target.FieldName, err)
+			os.Exit(2)
+		}
+		source, err := codegen.RenderMutation(resolved)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", target.FieldName, err)
+			os.Exit(2)
+		}
+		outPath := filepath.Join(genDir, fmt.Sprintf("compass_%s_api.go", target.FieldName))
+		if d, err := outputGoFile(outPath, source, *checkMode); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		} else {
+			drifted = drifted || d
+		}
+
+		if *noMocks {
+			continue
+		}
+		mockSource := codegen.RenderMutationMock(resolved)
+		mockPath := filepath.Join(dir, fmt.Sprintf("compass_%s_mock.go", target.FieldName))
+		if d, err := outputGoFile(mockPath, mockSource, *checkMode); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		} else {
+			drifted = drifted || d
+		}
+	}
+
+	if !*checkMode {
+		return
+	}
+	schemaDrifted, err := checkSchemaDrift(repoRoot, schema)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "schema drift check:", err)
+		os.Exit(2)
+	}
+	if drifted || schemaDrifted {
+		os.Exit(1)
+	}
+}
+
+// outputGoFile gofmt's source and either writes it to path (check == false, the normal generate
+// behavior) or diffs it against what's already on disk and reports whether it differs (check ==
+// true), printing a unified diff when the file differs and a one-line notice when it is missing.
+func outputGoFile(path string, source string, check bool) (bool, error) {
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, source)
+		return false, fmt.Errorf("format %s: %w", path, err)
+	}
+	if !check {
+		if err := os.WriteFile(path, formatted, 0o644); err != nil {
+			return false, err
+		}
+		fmt.Println("Wrote", path)
+		return false, nil
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			fmt.Printf("%s: missing (would be generated)\n", path)
+			return true, nil
+		}
+		return false, err
+	}
+	if string(existing) == string(formatted) {
+		return false, nil
+	}
+	fmt.Print(codegen.UnifiedDiff(path, path, string(existing), string(formatted)))
+	return true, nil
+}
+
+// checkSchemaDrift re-fetches the live Compass schema (if ATLASSIAN_* credentials are available in
+// the environment) into a tempfile and diffs it, from the schema's own Query root, against the
+// cached schema.introspection.json Bootstrap already loaded - catching a live schema change before
+// the next run's Bootstrap silently regenerates against it.
+func checkSchemaDrift(repoRoot string, cachedSchema map[string]any) (bool, error) {
+	auth := codegen.BuildAuthFromEnv()
+	if auth == nil {
+		fmt.Println("schema drift check: no credentials in env, skipping live schema comparison")
+		return false, nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "atlassian-schema-check")
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	baseURL := os.Getenv("ATLASSIAN_GQL_BASE_URL")
+	if baseURL == "" {
+		baseURL = "https://api.atlassian.com"
+	}
+	opts := graph.SchemaFetchOptions{
+		OutputDir:        tmpDir,
+		ExperimentalAPIs: codegen.ExperimentalAPIsFromEnv(),
+		Timeout:          30 * time.Second,
+		HTTPClient:       &http.Client{Timeout: 30 * time.Second},
+	}
+	if _, err := graph.FetchSchemaIntrospection(context.Background(), baseURL, auth, opts); err != nil {
+		return false, fmt.Errorf("fetch live schema: %w", err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(tmpDir, "schema.introspection.json"))
+	if err != nil {
+		return false, err
+	}
+	liveSchema, err := codegen.LoadSchema(raw)
+	if err != nil {
+		return false, err
+	}
+
+	queryTypeName := "Query"
+	if qt, ok := cachedSchema["queryType"].(map[string]any); ok {
+		if name, _ := qt["name"].(string); name != "" {
+			queryTypeName = name
+		}
+	}
+
+	diffs, err := codegen.DiffSchemaIntrospection(cachedSchema, liveSchema, []string{queryTypeName})
+	if err != nil {
+		return false, err
+	}
+	if len(diffs) == 0 {
+		fmt.Println("schema drift check: live schema matches schema.introspection.json")
+		return false, nil
+	}
+	for _, d := range diffs {
+		for _, line := range d.Lines() {
+			fmt.Println("schema drift:", line)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,232 @@
+// Command generate_compass_schema_models walks the full Compass GraphQL schema introspection
+// result and emits Go types for every Object, Interface, Union, Enum and Input Object it declares,
+// plus a DecodeXxx helper per root Query/Mutation field - unlike
+// go/tools/generate_compass_component_models, which hand-rolls just the searchComponents shapes
+// from a small config struct. Run via `go generate ./...` (see the //go:generate directive in
+// go/atlassian/graph/gen/compass/doc.go) whenever the Compass schema changes.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/graph"
+	"atlassian/atlassian/graph/codegen"
+)
+
+func main() {
+	forceRefresh := flag.Bool("force-refresh", false, "bypass the schema cache and always re-fetch the introspection result")
+	flag.Parse()
+
+	repoRoot, err := findRepoRoot()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	tokenFile := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_TOKEN_FILE"))
+	if tokenFile == "" {
+		tokenFile = filepath.Join(repoRoot, "oauth_tokens.txt")
+	}
+	loadEnvFile(tokenFile)
+
+	schemaPath := filepath.Join(repoRoot, "graphql", "schema.introspection.json")
+	experimentalAPIs := parseExperimentalAPIs()
+	maxAge := graph.ParseSchemaMaxAge(os.Getenv("ATLASSIAN_GQL_SCHEMA_MAX_AGE"))
+	refetch, err := graph.ShouldRefetchSchema(schemaPath, experimentalAPIs, maxAge, *forceRefresh)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if refetch {
+		baseURL := strings.TrimSpace(os.Getenv("ATLASSIAN_GQL_BASE_URL"))
+		if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN")) != "" {
+			baseURL = "https://api.atlassian.com"
+		}
+		if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN")) != "" {
+			baseURL = "https://api.atlassian.com"
+		}
+		if baseURL == "" {
+			fmt.Fprintf(os.Stderr, "Missing %s and ATLASSIAN_GQL_BASE_URL not set\n", schemaPath)
+			os.Exit(2)
+		}
+		auth := buildAuthFromEnv()
+		if auth == nil {
+			fmt.Fprintln(os.Stderr, "No credentials available in env vars to fetch schema")
+			os.Exit(2)
+		}
+
+		opts := graph.SchemaFetchOptions{
+			OutputDir:        filepath.Dir(schemaPath),
+			ExperimentalAPIs: experimentalAPIs,
+			Timeout:          30 * time.Second,
+			HTTPClient:       &http.Client{Timeout: 30 * time.Second},
+		}
+		fetchedAt := time.Now()
+		if _, err := graph.FetchSchemaIntrospection(context.Background(), baseURL, auth, opts); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if err := graph.RecordSchemaFetch(schemaPath, experimentalAPIs, fetchedAt); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+
+	rawSchema, err := os.ReadFile(schemaPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	schema, err := codegen.LoadSchema(rawSchema)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	model, err := codegen.WalkSchema(schema, codegen.DefaultTypeMapper())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	outPath := filepath.Join(repoRoot, "go", "atlassian", "graph", "gen", "compass", "compass_schema_api.go")
+	if err := os.MkdirAll(filepath.Dir(outPath), 0o755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	source, err := codegen.RenderSchemaModel(model, "compass", "go/tools/generate_compass_schema_models/main.go")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "format generated code:", err)
+		fmt.Fprintln(os.Stderr, source)
+		os.Exit(2)
+	}
+	if err := os.WriteFile(outPath, formatted, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	fmt.Println("Wrote", outPath)
+}
+
+func findRepoRoot() (string, error) {
+	_, thisFile, _, ok := runtime.Caller(0)
+	if !ok {
+		return "", errors.New("unable to locate generator path")
+	}
+	dir := filepath.Dir(thisFile)
+	root := filepath.Clean(filepath.Join(dir, "..", "..", ".."))
+	return root, nil
+}
+
+func parseExperimentalAPIs() []string {
+	raw := os.Getenv("ATLASSIAN_GQL_EXPERIMENTAL_APIS")
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ",")
+	var out []string
+	for _, p := range parts {
+		if s := strings.TrimSpace(p); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func loadEnvFile(path string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	lines := strings.Split(string(data), "\n")
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "export ") {
+			trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "export "))
+		}
+		eq := strings.Index(trimmed, "=")
+		if eq <= 0 {
+			continue
+		}
+		key := strings.TrimSpace(trimmed[:eq])
+		val := strings.TrimSpace(trimmed[eq+1:])
+		if key == "" {
+			continue
+		}
+		if _, ok := os.LookupEnv(key); ok {
+			continue
+		}
+		val = stripQuotes(val)
+		_ = os.Setenv(key, val)
+	}
+}
+
+func stripQuotes(raw string) string {
+	if len(raw) >= 2 {
+		first := raw[0]
+		last := raw[len(raw)-1]
+		if (first == '"' && last == '"') || (first == '\'' && last == '\'') {
+			return raw[1 : len(raw)-1]
+		}
+	}
+	return raw
+}
+
+func buildAuthFromEnv() atlassian.AuthProvider {
+	token := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN"))
+	refreshToken := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN"))
+	clientID := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_ID"))
+	clientSecret := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_SECRET"))
+	email := strings.TrimSpace(os.Getenv("ATLASSIAN_EMAIL"))
+	apiToken := strings.TrimSpace(os.Getenv("ATLASSIAN_API_TOKEN"))
+	cookiesJSON := strings.TrimSpace(os.Getenv("ATLASSIAN_COOKIES_JSON"))
+
+	if refreshToken != "" && clientID != "" && clientSecret != "" {
+		return &atlassian.OAuthRefreshTokenAuth{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RefreshToken: refreshToken,
+			Timeout:      30 * time.Second,
+		}
+	}
+	if token != "" {
+		if clientSecret != "" && token == clientSecret {
+			fmt.Fprintln(os.Stderr, "ATLASSIAN_OAUTH_ACCESS_TOKEN appears to be set to ATLASSIAN_CLIENT_SECRET; set an OAuth access token (not the client secret).")
+			return nil
+		}
+		return atlassian.BearerAuth{
+			TokenGetter: func() (string, error) { return token, nil },
+		}
+	}
+	if email != "" && apiToken != "" {
+		return atlassian.BasicAPITokenAuth{Email: email, Token: apiToken}
+	}
+	if cookiesJSON != "" {
+		var cookies map[string]string
+		if err := json.Unmarshal([]byte(cookiesJSON), &cookies); err == nil && len(cookies) > 0 {
+			var httpCookies []*http.Cookie
+			for k, v := range cookies {
+				httpCookies = append(httpCookies, &http.Cookie{Name: k, Value: v})
+			}
+			return atlassian.CookieAuth{Cookies: httpCookies}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Command generate_graph_mappers renders go/atlassian/graph/mappers/*_gen.go from mappers.json.
+// Unlike go/tools/generate_team_models, it never talks to the network: the manifest fully
+// describes each gen type's shape, so there's nothing to introspect.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"atlassian/atlassian/graph/mappergen"
+)
+
+func main() {
+	thisDir, err := toolDir()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	manifestPath := filepath.Join(thisDir, "mappers.json")
+	raw, err := os.ReadFile(manifestPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	manifest, err := mappergen.LoadManifest(raw)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	mappersDir := filepath.Join(thisDir, "..", "..", "atlassian", "graph", "mappers")
+	customTypes, err := mappergen.FindCustomTypes(mappersDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	rendered, err := mappergen.Render(manifest, customTypes)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	files := make([]string, 0, len(rendered))
+	for file := range rendered {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		formatted, err := format.Source([]byte(rendered[file]))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "format generated code:", err)
+			fmt.Fprintln(os.Stderr, rendered[file])
+			os.Exit(2)
+		}
+		outPath := filepath.Join(mappersDir, file+"_gen.go")
+		if err := os.WriteFile(outPath, formatted, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		fmt.Println("Wrote", outPath)
+	}
+}
+
+func toolDir() (string, error) {
+	_, thisFile, _, ok := runtime.Caller(0)
+	if !ok {
+		return "", errors.New("unable to locate generator path")
+	}
+	return filepath.Dir(thisFile), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"go/format"
 	"net/http"
@@ -18,6 +19,9 @@ import (
 )
 
 func main() {
+	forceRefresh := flag.Bool("force-refresh", false, "bypass the schema cache and always re-fetch the introspection result")
+	flag.Parse()
+
 	repoRoot, err := findRepoRoot()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -30,11 +34,14 @@ func main() {
 	loadEnvFile(tokenFile)
 
 	schemaPath := filepath.Join(repoRoot, "graphql", "schema.introspection.json")
-	if _, err := os.Stat(schemaPath); err != nil {
-		if !errors.Is(err, os.ErrNotExist) {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(2)
-		}
+	experimentalAPIs := parseExperimentalAPIs()
+	maxAge := graph.ParseSchemaMaxAge(os.Getenv("ATLASSIAN_GQL_SCHEMA_MAX_AGE"))
+	refetch, err := graph.ShouldRefetchSchema(schemaPath, experimentalAPIs, maxAge, *forceRefresh)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if refetch {
 		baseURL := strings.TrimSpace(os.Getenv("ATLASSIAN_GQL_BASE_URL"))
 		if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN")) != "" {
 			baseURL = "https://api.atlassian.com"
@@ -54,14 +61,19 @@ func main() {
 
 		opts := graph.SchemaFetchOptions{
 			OutputDir:        filepath.Dir(schemaPath),
-			ExperimentalAPIs: parseExperimentalAPIs(),
+			ExperimentalAPIs: experimentalAPIs,
 			Timeout:          30 * time.Second,
 			HTTPClient:       &http.Client{Timeout: 30 * time.Second},
 		}
+		fetchedAt := time.Now()
 		if _, err := graph.FetchSchemaIntrospection(context.Background(), baseURL, auth, opts); err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			os.Exit(2)
 		}
+		if err := graph.RecordSchemaFetch(schemaPath, experimentalAPIs, fetchedAt); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
 	}
 
 	schema, err := loadSchema(schemaPath)
@@ -69,7 +81,13 @@ func main() {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
-	if err := validateSchema(schema); err != nil {
+	caps, err := validateSchema(schema)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	mutCaps, err := validateMutations(schema)
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
@@ -79,7 +97,7 @@ func main() {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
-	source := renderGo()
+	source := renderGo(caps, mutCaps, typesMap(schema))
 	formatted, err := format.Source([]byte(source))
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "format generated code:", err)
@@ -168,6 +186,9 @@ func buildAuthFromEnv() atlassian.AuthProvider {
 	email := strings.TrimSpace(os.Getenv("ATLASSIAN_EMAIL"))
 	apiToken := strings.TrimSpace(os.Getenv("ATLASSIAN_API_TOKEN"))
 	cookiesJSON := strings.TrimSpace(os.Getenv("ATLASSIAN_COOKIES_JSON"))
+	oauth1ConsumerKey := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH1_CONSUMER_KEY"))
+	oauth1PrivateKeyFile := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH1_PRIVATE_KEY_FILE"))
+	oauth1AccessToken := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH1_ACCESS_TOKEN"))
 
 	if refreshToken != "" && clientID != "" && clientSecret != "" {
 		return &atlassian.OAuthRefreshTokenAuth{
@@ -177,6 +198,18 @@ func buildAuthFromEnv() atlassian.AuthProvider {
 			Timeout:      30 * time.Second,
 		}
 	}
+	if oauth1ConsumerKey != "" && oauth1PrivateKeyFile != "" && oauth1AccessToken != "" {
+		privateKeyPEM, err := os.ReadFile(oauth1PrivateKeyFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "reading ATLASSIAN_OAUTH1_PRIVATE_KEY_FILE: %s\n", err)
+			return nil
+		}
+		return &atlassian.OAuth1Auth{
+			ConsumerKey:   oauth1ConsumerKey,
+			PrivateKeyPEM: string(privateKeyPEM),
+			AccessToken:   oauth1AccessToken,
+		}
+	}
 	if token != "" {
 		if clientSecret != "" && token == clientSecret {
 			fmt.Fprintln(os.Stderr, "ATLASSIAN_OAUTH_ACCESS_TOKEN appears to be set to ATLASSIAN_CLIENT_SECRET; set an OAuth access token (not the client secret).")
@@ -221,86 +254,421 @@ func loadSchema(path string) (map[string]any, error) {
 	return schema, nil
 }
 
-func validateSchema(schema map[string]any) error {
+// issueSchemaCapabilities records which optional sections of the Jira issue GraphQL type this
+// schema snapshot exposes. Missing sections are logged as warnings by validateSchema rather than
+// failing the run, so a stripped-down schema still produces a working (smaller) generated file.
+type issueSchemaCapabilities struct {
+	Comments        bool
+	Attachments     bool
+	Worklogs        bool
+	Changelog       bool
+	IssueLinks      bool
+	Components      bool
+	FixVersions     bool
+	AffectsVersions bool
+	Watches         bool
+	Priority        bool
+	Labels          bool
+	Environment     bool
+	Summary         bool
+
+	// IssueSearch and issueSearchField cover the JQL search entry point on the query type
+	// (commonly issueSearchStable or issues), resolved by locateIssueSearchField.
+	IssueSearch      bool
+	issueSearchField string
+}
+
+func validateSchema(schema map[string]any) (*issueSchemaCapabilities, error) {
 	types := typesMap(schema)
 	queryName, err := queryTypeName(schema)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	queryDef := types[queryName]
 	if queryDef == nil {
-		return fmt.Errorf("missing query type %s", queryName)
+		return nil, fmt.Errorf("missing query type %s", queryName)
 	}
 	issueByKey := field(queryDef, "issueByKey")
 	if issueByKey == nil {
-		return fmt.Errorf("missing field %s.issueByKey", queryName)
+		return nil, fmt.Errorf("missing field %s.issueByKey", queryName)
 	}
 	if arg(issueByKey, "key") == nil || arg(issueByKey, "cloudId") == nil {
-		return errors.New("issueByKey missing key/cloudId args")
+		return nil, errors.New("issueByKey missing key/cloudId args")
 	}
 
 	issueTypeName := unwrapNamedType(issueByKey["type"])
 	if issueTypeName == "" {
-		return errors.New("unable to resolve issueByKey return type")
+		return nil, errors.New("unable to resolve issueByKey return type")
 	}
 	issueDef := types[issueTypeName]
 	if issueDef == nil {
-		return fmt.Errorf("missing type %s", issueTypeName)
+		return nil, fmt.Errorf("missing type %s", issueTypeName)
 	}
 	if field(issueDef, "issueType") == nil || field(issueDef, "status") == nil || field(issueDef, "projectField") == nil {
-		return errors.New("issueByKey missing required issue fields")
+		return nil, errors.New("issueByKey missing required issue fields")
 	}
 	if field(issueDef, "createdField") == nil || field(issueDef, "updatedField") == nil || field(issueDef, "resolutionDateField") == nil {
-		return errors.New("issueByKey missing required date fields")
+		return nil, errors.New("issueByKey missing required date fields")
 	}
 	if field(issueDef, "assigneeField") == nil || field(issueDef, "reporter") == nil {
-		return errors.New("issueByKey missing assignee/reporter fields")
+		return nil, errors.New("issueByKey missing assignee/reporter fields")
 	}
 
 	projectField := field(issueDef, "projectField")
 	projectFieldType := types[unwrapNamedType(projectField["type"])]
 	if projectFieldType == nil || field(projectFieldType, "project") == nil {
-		return errors.New("projectField.project missing")
+		return nil, errors.New("projectField.project missing")
 	}
 	projectType := types[unwrapNamedType(field(projectFieldType, "project")["type"])]
 	if projectType == nil || field(projectType, "key") == nil || field(projectType, "cloudId") == nil {
-		return errors.New("project missing key/cloudId")
+		return nil, errors.New("project missing key/cloudId")
 	}
 
 	issueTypeField := field(issueDef, "issueType")
 	issueType := types[unwrapNamedType(issueTypeField["type"])]
 	if issueType == nil || field(issueType, "name") == nil {
-		return errors.New("issueType.name missing")
+		return nil, errors.New("issueType.name missing")
 	}
 
 	statusField := field(issueDef, "status")
 	statusType := types[unwrapNamedType(statusField["type"])]
 	if statusType == nil || field(statusType, "name") == nil {
-		return errors.New("status.name missing")
+		return nil, errors.New("status.name missing")
 	}
 
 	for _, name := range []string{"createdField", "updatedField", "resolutionDateField"} {
 		dtField := field(issueDef, name)
 		dtType := types[unwrapNamedType(dtField["type"])]
 		if dtType == nil || field(dtType, "dateTime") == nil {
-			return fmt.Errorf("%s.dateTime missing", name)
+			return nil, fmt.Errorf("%s.dateTime missing", name)
 		}
 	}
 
 	assigneeField := field(issueDef, "assigneeField")
 	assigneeType := types[unwrapNamedType(assigneeField["type"])]
 	if assigneeType == nil || field(assigneeType, "user") == nil {
-		return errors.New("assigneeField.user missing")
+		return nil, errors.New("assigneeField.user missing")
 	}
 
 	userType := types["User"]
 	if userType == nil || field(userType, "accountId") == nil || field(userType, "name") == nil {
-		return errors.New("User.accountId or User.name missing")
+		return nil, errors.New("User.accountId or User.name missing")
+	}
+
+	caps := &issueSchemaCapabilities{
+		Comments: checkOptionalSection("comments", func() error {
+			return checkConnection(types, issueDef, "comments", "author", "body", "created", "updated")
+		}),
+		Attachments: checkOptionalSection("attachments", func() error {
+			return checkConnection(types, issueDef, "attachments", "filename", "mimeType", "size", "content")
+		}),
+		Worklogs: checkOptionalSection("worklogs", func() error {
+			return checkConnection(types, issueDef, "worklogs", "author", "timeSpentSeconds", "started", "comment")
+		}),
+		Changelog: checkOptionalSection("changelog", func() error {
+			if err := checkConnection(types, issueDef, "changelog", "author", "created", "items"); err != nil {
+				return err
+			}
+			changelogField := field(issueDef, "changelog")
+			entryType := types[unwrapNamedType(field(types[unwrapNamedType(changelogField["type"])], "nodes")["type"])]
+			itemsField := field(entryType, "items")
+			itemType := types[unwrapNamedType(itemsField["type"])]
+			return requireFields(itemType, "field", "fromString", "toString")
+		}),
+		IssueLinks: checkOptionalSection("issueLinks", func() error {
+			if err := checkConnection(types, issueDef, "issueLinks", "type", "inwardIssue", "outwardIssue"); err != nil {
+				return err
+			}
+			linksField := field(issueDef, "issueLinks")
+			linkType := types[unwrapNamedType(field(types[unwrapNamedType(linksField["type"])], "nodes")["type"])]
+			linkTypeType := types[unwrapNamedType(field(linkType, "type")["type"])]
+			if err := requireFields(linkTypeType, "name", "inward", "outward"); err != nil {
+				return err
+			}
+			inwardType := types[unwrapNamedType(field(linkType, "inwardIssue")["type"])]
+			return requireFields(inwardType, "key")
+		}),
+		Components: checkOptionalSection("components", func() error {
+			return checkConnection(types, issueDef, "components", "name")
+		}),
+		FixVersions: checkOptionalSection("fixVersions", func() error {
+			return checkConnection(types, issueDef, "fixVersions", "name", "released")
+		}),
+		AffectsVersions: checkOptionalSection("affectsVersions", func() error {
+			return checkConnection(types, issueDef, "affectsVersions", "name", "released")
+		}),
+		Watches: checkOptionalSection("watches", func() error {
+			watchesField := field(issueDef, "watches")
+			if watchesField == nil {
+				return errors.New("issueByKey.watches missing")
+			}
+			return requireFields(types[unwrapNamedType(watchesField["type"])], "watchCount", "isWatching")
+		}),
+		Priority: checkOptionalSection("priority", func() error {
+			priorityField := field(issueDef, "priority")
+			if priorityField == nil {
+				return errors.New("issueByKey.priority missing")
+			}
+			return requireFields(types[unwrapNamedType(priorityField["type"])], "name")
+		}),
+		Labels: checkOptionalSection("labels", func() error {
+			if field(issueDef, "labels") == nil {
+				return errors.New("issueByKey.labels missing")
+			}
+			return nil
+		}),
+		Environment: checkOptionalSection("environment", func() error {
+			environmentField := field(issueDef, "environmentField")
+			if environmentField == nil {
+				return errors.New("issueByKey.environmentField missing")
+			}
+			return requireFields(types[unwrapNamedType(environmentField["type"])], "text")
+		}),
+		Summary: checkOptionalSection("summary", func() error {
+			if field(issueDef, "summary") == nil {
+				return errors.New("issueByKey.summary missing")
+			}
+			return nil
+		}),
+	}
+
+	issueSearchField, err := locateIssueSearchField(types, queryDef, issueTypeName)
+	caps.IssueSearch = checkOptionalSection("issueSearch", func() error { return err })
+	if caps.IssueSearch {
+		caps.issueSearchField = issueSearchField
+	}
+
+	return caps, nil
+}
+
+// locateIssueSearchField finds the JQL search entry point on the query type: a field taking a
+// "jql" argument and returning a Relay-style connection (edges/node/pageInfo) whose node type
+// matches issueTypeName, so the same JiraIssueNode decodes both issueByKey and the search. Real
+// schemas commonly name this field issueSearchStable or issues; this walks every query field
+// rather than hardcoding either name.
+func locateIssueSearchField(types map[string]map[string]any, queryDef map[string]any, issueTypeName string) (string, error) {
+	raw, ok := queryDef["fields"].([]any)
+	if !ok {
+		return "", errors.New("query type has no fields")
+	}
+	for _, item := range raw {
+		fieldDef, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := fieldDef["name"].(string)
+		if name == "" || arg(fieldDef, "jql") == nil {
+			continue
+		}
+		connType := types[unwrapNamedType(fieldDef["type"])]
+		if err := requireFields(connType, "edges", "pageInfo"); err != nil {
+			continue
+		}
+		edgeType := types[unwrapNamedType(field(connType, "edges")["type"])]
+		nodeField := field(edgeType, "node")
+		if nodeField == nil || unwrapNamedType(nodeField["type"]) != issueTypeName {
+			continue
+		}
+		return name, nil
+	}
+	return "", fmt.Errorf("no query field with a jql argument returning a %s connection found", issueTypeName)
+}
+
+// requireFields reports an error naming the first field in names that is missing from typeDef.
+func requireFields(typeDef map[string]any, names ...string) error {
+	if typeDef == nil {
+		return errors.New("type is missing")
+	}
+	for _, name := range names {
+		if field(typeDef, name) == nil {
+			return fmt.Errorf("missing field %s", name)
+		}
+	}
+	return nil
+}
+
+// requireInputFields reports an error naming the first input field in names that is missing from
+// typeDef. Input object types carry their fields under "inputFields" rather than "fields", so this
+// mirrors requireFields/field using inputField below instead.
+func requireInputFields(typeDef map[string]any, names ...string) error {
+	if typeDef == nil {
+		return errors.New("type is missing")
+	}
+	for _, name := range names {
+		if inputField(typeDef, name) == nil {
+			return fmt.Errorf("missing input field %s", name)
+		}
+	}
+	return nil
+}
+
+// inputField looks up name among typeDef's inputFields (the introspection shape for INPUT_OBJECT
+// types), mirroring field's lookup over a type's regular fields.
+func inputField(typeDef map[string]any, name string) map[string]any {
+	raw, ok := typeDef["inputFields"].([]any)
+	if !ok {
+		return nil
+	}
+	for _, item := range raw {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if obj["name"] == name {
+			return obj
+		}
 	}
+	return nil
+}
 
+// checkConnection validates that issueDef exposes a Relay-style connection field named
+// fieldName with nodes/pageInfo, and that each node carries nodeFields.
+func checkConnection(types map[string]map[string]any, issueDef map[string]any, fieldName string, nodeFields ...string) error {
+	connField := field(issueDef, fieldName)
+	if connField == nil {
+		return fmt.Errorf("issueByKey.%s missing", fieldName)
+	}
+	connType := types[unwrapNamedType(connField["type"])]
+	if err := requireFields(connType, "nodes", "pageInfo"); err != nil {
+		return fmt.Errorf("%s connection %w", fieldName, err)
+	}
+	nodeType := types[unwrapNamedType(field(connType, "nodes")["type"])]
+	if err := requireFields(nodeType, nodeFields...); err != nil {
+		return fmt.Errorf("%s node %w", fieldName, err)
+	}
 	return nil
 }
 
+// checkOptionalSection runs check and, if it fails, warns on stderr and reports the section as
+// unsupported rather than failing the whole generator run.
+func checkOptionalSection(label string, check func() error) bool {
+	if err := check(); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: skipping %s section: %s\n", label, err)
+		return false
+	}
+	return true
+}
+
+// jiraMutationSpec describes one of the Jira issue mutations the generator knows how to produce
+// Encode/Decode helpers for, and what its input object must carry for the helpers to be safe to
+// generate.
+type jiraMutationSpec struct {
+	fieldName            string // mutation field name on the Mutation type
+	opName               string // Go identifier prefix for the generated types/functions
+	requiredInputScalars []string
+}
+
+var jiraMutationSpecs = []jiraMutationSpec{
+	{fieldName: "createJiraIssue", opName: "CreateJiraIssue", requiredInputScalars: []string{"cloudId"}},
+	{fieldName: "updateJiraIssue", opName: "UpdateJiraIssue", requiredInputScalars: []string{"cloudId", "issueId"}},
+	{fieldName: "transitionJiraIssue", opName: "TransitionJiraIssue", requiredInputScalars: []string{"cloudId", "issueId"}},
+	{fieldName: "addJiraComment", opName: "AddJiraComment", requiredInputScalars: []string{"cloudId", "issueId"}},
+	{fieldName: "addJiraWorklog", opName: "AddJiraWorklog", requiredInputScalars: []string{"cloudId", "issueId"}},
+}
+
+// jiraMutationInfo is what validateMutations discovers about one supported mutation: its input
+// object type, whether that input carries a nested "fields" input object (the classic Jira
+// custom-fields bag), and its payload (return) type.
+type jiraMutationInfo struct {
+	inputType       string
+	hasCustomFields bool
+	payloadType     string
+}
+
+// jiraMutationCapabilities records which of jiraMutationSpecs this schema snapshot exposes.
+// Missing mutations are logged as warnings by validateMutations rather than failing the run, same
+// as issueSchemaCapabilities does for the optional issue sections.
+type jiraMutationCapabilities struct {
+	CreateIssue     bool
+	UpdateIssue     bool
+	TransitionIssue bool
+	AddComment      bool
+	AddWorklog      bool
+
+	info map[string]jiraMutationInfo // keyed by fieldName, populated for enabled mutations
+}
+
+func validateMutations(schema map[string]any) (*jiraMutationCapabilities, error) {
+	types := typesMap(schema)
+	caps := &jiraMutationCapabilities{info: map[string]jiraMutationInfo{}}
+
+	mutationName, ok := mutationTypeName(schema)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "warning: skipping mutations: schema has no mutationType")
+		return caps, nil
+	}
+	mutationDef := types[mutationName]
+	if mutationDef == nil {
+		fmt.Fprintf(os.Stderr, "warning: skipping mutations: missing mutation type %s\n", mutationName)
+		return caps, nil
+	}
+
+	enabledFor := map[string]*bool{
+		"createJiraIssue":     &caps.CreateIssue,
+		"updateJiraIssue":     &caps.UpdateIssue,
+		"transitionJiraIssue": &caps.TransitionIssue,
+		"addJiraComment":      &caps.AddComment,
+		"addJiraWorklog":      &caps.AddWorklog,
+	}
+	for _, spec := range jiraMutationSpecs {
+		spec := spec
+		*enabledFor[spec.fieldName] = checkOptionalSection(spec.fieldName, func() error {
+			info, err := resolveMutationInfo(types, mutationDef, spec)
+			if err != nil {
+				return err
+			}
+			caps.info[spec.fieldName] = *info
+			return nil
+		})
+	}
+
+	return caps, nil
+}
+
+func mutationTypeName(schema map[string]any) (string, bool) {
+	raw, ok := schema["mutationType"].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	name, ok := raw["name"].(string)
+	if !ok || name == "" {
+		return "", false
+	}
+	return name, true
+}
+
+// resolveMutationInfo locates spec.fieldName on mutationDef, resolves its "input" argument's
+// input object type, and validates that the input object declares every scalar in
+// spec.requiredInputScalars -- failing the mutation (the caller warns and skips it) rather than
+// generating helpers that could never populate a required field at runtime.
+func resolveMutationInfo(types map[string]map[string]any, mutationDef map[string]any, spec jiraMutationSpec) (*jiraMutationInfo, error) {
+	fieldDef := field(mutationDef, spec.fieldName)
+	if fieldDef == nil {
+		return nil, fmt.Errorf("missing mutation %s", spec.fieldName)
+	}
+	inputArg := arg(fieldDef, "input")
+	if inputArg == nil {
+		return nil, fmt.Errorf("%s missing input argument", spec.fieldName)
+	}
+	inputTypeName := unwrapNamedType(inputArg["type"])
+	if inputTypeName == "" {
+		return nil, fmt.Errorf("%s unable to resolve input type", spec.fieldName)
+	}
+	inputDef := types[inputTypeName]
+	if err := requireInputFields(inputDef, spec.requiredInputScalars...); err != nil {
+		return nil, fmt.Errorf("%s input %s: %w", spec.fieldName, inputTypeName, err)
+	}
+	payloadTypeName := unwrapNamedType(fieldDef["type"])
+	if payloadTypeName == "" {
+		return nil, fmt.Errorf("%s unable to resolve payload type", spec.fieldName)
+	}
+	return &jiraMutationInfo{
+		inputType:       inputTypeName,
+		hasCustomFields: inputField(inputDef, "fields") != nil,
+		payloadType:     payloadTypeName,
+	}, nil
+}
+
 func typesMap(schema map[string]any) map[string]map[string]any {
 	out := map[string]map[string]any{}
 	raw, ok := schema["types"].([]any)
@@ -383,90 +751,707 @@ func unwrapNamedType(ref any) string {
 	return ""
 }
 
-func renderGo() string {
-	return `// Code generated by go/tools/generate_jira_issue_models/main.go. DO NOT EDIT.
-package gen
-
-import "encoding/json"
-
-const JiraIssueByKeyQuery = ` + "`" + `query JiraIssueByKey(
-  $cloudId: ID!,
-  $key: String!
-) {
-  issueByKey(key: $key, cloudId: $cloudId) {
-    key
-    issueType { name }
-    status { name }
-    projectField {
-      project { key cloudId }
-    }
-    createdField { dateTime }
-    updatedField { dateTime }
-    resolutionDateField { dateTime }
-    assigneeField {
-      user { accountId name }
-    }
-    reporter { accountId name }
-  }
-}
-` + "`" + `
-
-type JiraUser struct {
-	AccountID string ` + "`json:\"accountId\"`" + `
-	Name      string ` + "`json:\"name\"`" + `
-}
-
-type JiraIssueType struct {
-	Name string ` + "`json:\"name\"`" + `
+// jiraIssueSection describes one optional connection/field on the Jira issue GraphQL type that
+// the generator may or may not emit, depending on issueSchemaCapabilities.
+type jiraIssueSection struct {
+	enabled    bool
+	optionName string // Include* field name on JiraIssueByKeyOptions
+	queryField string // GraphQL field name under issueByKey
+	queryBody  string // GraphQL selection set, may reference %d for the connection's page size
+	paginated  bool
+	types      string // extra Go type declarations emitted once
+	nodeField  string // struct field line appended to JiraIssueNode
+	decodeHelp string // extra DecodeNextPage-style helper emitted once
 }
 
-type JiraStatus struct {
-	Name string ` + "`json:\"name\"`" + `
+// graphqlTypeFields returns typeDef's field definitions under key ("fields" for OBJECT types,
+// "inputFields" for INPUT_OBJECT types).
+func graphqlTypeFields(typeDef map[string]any, key string) []map[string]any {
+	raw, _ := typeDef[key].([]any)
+	var out []map[string]any
+	for _, item := range raw {
+		if f, ok := item.(map[string]any); ok {
+			out = append(out, f)
+		}
+	}
+	return out
 }
 
-type JiraProject struct {
-	Key     string ` + "`json:\"key\"`" + `
-	CloudID string ` + "`json:\"cloudId\"`" + `
+// scalarGoType maps a GraphQL scalar (or enum, which decodes the same as its underlying string)
+// name to the Go type used for generated mutation input/payload struct fields.
+func scalarGoType(name string) string {
+	switch name {
+	case "Int":
+		return "int"
+	case "Float":
+		return "float64"
+	case "Boolean":
+		return "bool"
+	default: // String, ID, and any unrecognized scalar or enum
+		return "string"
+	}
 }
 
-type JiraProjectField struct {
-	Project JiraProject ` + "`json:\"project\"`" + `
+// exportName capitalizes the first letter of a GraphQL field name to produce the corresponding Go
+// struct field name.
+func exportName(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
 }
 
-type JiraDateTimePickerField struct {
-	DateTime *string ` + "`json:\"dateTime\"`" + `
+// resolveFieldGoType resolves fieldType (an introspection type reference) to a Go type for a
+// generated struct field, recursively emitting any nested INPUT_OBJECT/OBJECT struct definitions
+// it references into extra via emitStructForType.
+func resolveFieldGoType(types map[string]map[string]any, fieldType map[string]any, nonNull bool, fieldsKey, jsonTagSuffix string, extra *strings.Builder, emitted map[string]bool) string {
+	kind, _ := fieldType["kind"].(string)
+	switch kind {
+	case "NON_NULL":
+		of, _ := fieldType["ofType"].(map[string]any)
+		return resolveFieldGoType(types, of, true, fieldsKey, jsonTagSuffix, extra, emitted)
+	case "LIST":
+		of, _ := fieldType["ofType"].(map[string]any)
+		elem := resolveFieldGoType(types, of, false, fieldsKey, jsonTagSuffix, extra, emitted)
+		return "[]" + elem
+	case "INPUT_OBJECT", "OBJECT":
+		name, _ := fieldType["name"].(string)
+		emitStructForType(types, name, fieldsKey, jsonTagSuffix, extra, emitted)
+		if nonNull {
+			return name
+		}
+		return "*" + name
+	case "SCALAR", "ENUM":
+		name, _ := fieldType["name"].(string)
+		goType := scalarGoType(name)
+		if nonNull {
+			return goType
+		}
+		return "*" + goType
+	default:
+		return "any"
+	}
 }
 
-type JiraSingleSelectUserPickerField struct {
-	User *JiraUser ` + "`json:\"user\"`" + `
+// structFieldsBody renders the field lines (without the surrounding "type X struct {}") for
+// typeDef's fields/inputFields, recursing into nested input/output object types as it goes.
+func structFieldsBody(types map[string]map[string]any, typeDef map[string]any, fieldsKey, jsonTagSuffix string, extra *strings.Builder, emitted map[string]bool) string {
+	var body strings.Builder
+	for _, f := range graphqlTypeFields(typeDef, fieldsKey) {
+		name, _ := f["name"].(string)
+		if name == "" {
+			continue
+		}
+		ftype, _ := f["type"].(map[string]any)
+		goType := resolveFieldGoType(types, ftype, false, fieldsKey, jsonTagSuffix, extra, emitted)
+		fmt.Fprintf(&body, "\t%s %s `json:\"%s%s\"`\n", exportName(name), goType, name, jsonTagSuffix)
+	}
+	return body.String()
 }
 
-type JiraIssueNode struct {
-	Key                 string                     ` + "`json:\"key\"`" + `
-	IssueType           JiraIssueType              ` + "`json:\"issueType\"`" + `
-	Status              JiraStatus                 ` + "`json:\"status\"`" + `
-	ProjectField        JiraProjectField           ` + "`json:\"projectField\"`" + `
-	CreatedField        JiraDateTimePickerField    ` + "`json:\"createdField\"`" + `
-	UpdatedField        JiraDateTimePickerField    ` + "`json:\"updatedField\"`" + `
-	ResolutionDateField *JiraDateTimePickerField   ` + "`json:\"resolutionDateField\"`" + `
-	AssigneeField       *JiraSingleSelectUserPickerField ` + "`json:\"assigneeField\"`" + `
-	Reporter            *JiraUser                  ` + "`json:\"reporter\"`" + `
+// emitStructForType writes a Go struct definition for typeName into extra, skipping types already
+// present in emitted so a type referenced from multiple places is only emitted once.
+func emitStructForType(types map[string]map[string]any, typeName, fieldsKey, jsonTagSuffix string, extra *strings.Builder, emitted map[string]bool) {
+	if typeName == "" || emitted[typeName] {
+		return
+	}
+	emitted[typeName] = true
+	typeDef := types[typeName]
+	if typeDef == nil {
+		return
+	}
+	body := structFieldsBody(types, typeDef, fieldsKey, jsonTagSuffix, extra, emitted)
+	fmt.Fprintf(extra, "type %s struct {\n%s}\n\n", typeName, body)
 }
 
-type JiraIssueByKeyData struct {
-	IssueByKey *JiraIssueNode ` + "`json:\"issueByKey\"`" + `
+func renderGo(caps *issueSchemaCapabilities, mutCaps *jiraMutationCapabilities, types map[string]map[string]any) string {
+	sections := []jiraIssueSection{
+		{
+			enabled: caps.Comments, optionName: "IncludeComments", queryField: "comments",
+			paginated: true,
+			queryBody: `comments(first: %d) {
+      nodes { id author { accountId name } body created updated visibility { type value } }
+      pageInfo { hasNextPage endCursor }
+    }`,
+			types: `
+type JiraCommentVisibility struct {
+	Type  *string ` + "`json:\"type\"`" + `
+	Value *string ` + "`json:\"value\"`" + `
 }
 
-func DecodeJiraIssueByKey(data map[string]any) (*JiraIssueByKeyData, error) {
+type JiraComment struct {
+	ID         string                  ` + "`json:\"id\"`" + `
+	Author     *JiraUser               ` + "`json:\"author\"`" + `
+	Body       *string                 ` + "`json:\"body\"`" + `
+	Created    *string                 ` + "`json:\"created\"`" + `
+	Updated    *string                 ` + "`json:\"updated\"`" + `
+	Visibility *JiraCommentVisibility  ` + "`json:\"visibility\"`" + `
+}
+
+type JiraCommentConnection struct {
+	Nodes    []JiraComment ` + "`json:\"nodes\"`" + `
+	PageInfo PageInfo      ` + "`json:\"pageInfo\"`" + `
+}
+`,
+			nodeField: `	Comments *JiraCommentConnection ` + "`json:\"comments\"`" + `
+`,
+			decodeHelp: `
+// DecodeCommentsNextPage decodes a comments connection page fetched with an after cursor.
+func DecodeCommentsNextPage(data map[string]any) (*JiraCommentConnection, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out JiraCommentConnection
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+`,
+		},
+		{
+			enabled: caps.Attachments, optionName: "IncludeAttachments", queryField: "attachments",
+			paginated: true,
+			queryBody: `attachments(first: %d) {
+      nodes { id filename mimeType size content }
+      pageInfo { hasNextPage endCursor }
+    }`,
+			types: `
+type JiraAttachment struct {
+	ID       string  ` + "`json:\"id\"`" + `
+	Filename string  ` + "`json:\"filename\"`" + `
+	MimeType *string ` + "`json:\"mimeType\"`" + `
+	Size     *int    ` + "`json:\"size\"`" + `
+	Content  *string ` + "`json:\"content\"`" + `
+}
+
+type JiraAttachmentConnection struct {
+	Nodes    []JiraAttachment ` + "`json:\"nodes\"`" + `
+	PageInfo PageInfo         ` + "`json:\"pageInfo\"`" + `
+}
+`,
+			nodeField: `	Attachments *JiraAttachmentConnection ` + "`json:\"attachments\"`" + `
+`,
+			decodeHelp: `
+// DecodeAttachmentsNextPage decodes an attachments connection page fetched with an after cursor.
+func DecodeAttachmentsNextPage(data map[string]any) (*JiraAttachmentConnection, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out JiraAttachmentConnection
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+`,
+		},
+		{
+			enabled: caps.Worklogs, optionName: "IncludeWorklogs", queryField: "worklogs",
+			paginated: true,
+			queryBody: `worklogs(first: %d) {
+      nodes { id author { accountId name } timeSpentSeconds started comment }
+      pageInfo { hasNextPage endCursor }
+    }`,
+			types: `
+type JiraWorklog struct {
+	ID               string    ` + "`json:\"id\"`" + `
+	Author           *JiraUser ` + "`json:\"author\"`" + `
+	TimeSpentSeconds *int      ` + "`json:\"timeSpentSeconds\"`" + `
+	Started          *string   ` + "`json:\"started\"`" + `
+	Comment          *string   ` + "`json:\"comment\"`" + `
+}
+
+type JiraWorklogConnection struct {
+	Nodes    []JiraWorklog ` + "`json:\"nodes\"`" + `
+	PageInfo PageInfo      ` + "`json:\"pageInfo\"`" + `
+}
+`,
+			nodeField: `	Worklogs *JiraWorklogConnection ` + "`json:\"worklogs\"`" + `
+`,
+			decodeHelp: `
+// DecodeWorklogsNextPage decodes a worklogs connection page fetched with an after cursor.
+func DecodeWorklogsNextPage(data map[string]any) (*JiraWorklogConnection, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out JiraWorklogConnection
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+`,
+		},
+		{
+			enabled: caps.Changelog, optionName: "IncludeChangelog", queryField: "changelog",
+			paginated: true,
+			queryBody: `changelog(first: %d) {
+      nodes {
+        id
+        author { accountId name }
+        created
+        items { field fromString toString }
+      }
+      pageInfo { hasNextPage endCursor }
+    }`,
+			types: `
+type JiraChangelogItem struct {
+	Field      string  ` + "`json:\"field\"`" + `
+	FromString *string ` + "`json:\"fromString\"`" + `
+	ToString   *string ` + "`json:\"toString\"`" + `
+}
+
+type JiraChangelogEntry struct {
+	ID      string               ` + "`json:\"id\"`" + `
+	Author  *JiraUser            ` + "`json:\"author\"`" + `
+	Created string               ` + "`json:\"created\"`" + `
+	Items   []JiraChangelogItem  ` + "`json:\"items\"`" + `
+}
+
+type JiraChangelogConnection struct {
+	Nodes    []JiraChangelogEntry ` + "`json:\"nodes\"`" + `
+	PageInfo PageInfo             ` + "`json:\"pageInfo\"`" + `
+}
+`,
+			nodeField: `	Changelog *JiraChangelogConnection ` + "`json:\"changelog\"`" + `
+`,
+			decodeHelp: `
+// DecodeChangelogNextPage decodes a changelog connection page fetched with an after cursor.
+func DecodeChangelogNextPage(data map[string]any) (*JiraChangelogConnection, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out JiraChangelogConnection
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+`,
+		},
+		{
+			enabled: caps.IssueLinks, optionName: "IncludeIssueLinks", queryField: "issueLinks",
+			paginated: true,
+			queryBody: `issueLinks(first: %d) {
+      nodes {
+        id
+        type { name inward outward }
+        inwardIssue { key }
+        outwardIssue { key }
+      }
+      pageInfo { hasNextPage endCursor }
+    }`,
+			types: `
+type JiraIssueLinkType struct {
+	Name    string ` + "`json:\"name\"`" + `
+	Inward  string ` + "`json:\"inward\"`" + `
+	Outward string ` + "`json:\"outward\"`" + `
+}
+
+type JiraLinkedIssue struct {
+	Key string ` + "`json:\"key\"`" + `
+}
+
+type JiraIssueLink struct {
+	ID           string             ` + "`json:\"id\"`" + `
+	Type         JiraIssueLinkType  ` + "`json:\"type\"`" + `
+	InwardIssue  *JiraLinkedIssue   ` + "`json:\"inwardIssue\"`" + `
+	OutwardIssue *JiraLinkedIssue   ` + "`json:\"outwardIssue\"`" + `
+}
+
+type JiraIssueLinkConnection struct {
+	Nodes    []JiraIssueLink ` + "`json:\"nodes\"`" + `
+	PageInfo PageInfo        ` + "`json:\"pageInfo\"`" + `
+}
+`,
+			nodeField: `	IssueLinks *JiraIssueLinkConnection ` + "`json:\"issueLinks\"`" + `
+`,
+			decodeHelp: `
+// DecodeIssueLinksNextPage decodes an issueLinks connection page fetched with an after cursor.
+func DecodeIssueLinksNextPage(data map[string]any) (*JiraIssueLinkConnection, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out JiraIssueLinkConnection
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+`,
+		},
+		{
+			enabled: caps.Components, optionName: "IncludeComponents", queryField: "components",
+			paginated: true,
+			queryBody: `components(first: %d) {
+      nodes { id name }
+      pageInfo { hasNextPage endCursor }
+    }`,
+			types: `
+type JiraComponent struct {
+	ID   string ` + "`json:\"id\"`" + `
+	Name string ` + "`json:\"name\"`" + `
+}
+
+type JiraComponentConnection struct {
+	Nodes    []JiraComponent ` + "`json:\"nodes\"`" + `
+	PageInfo PageInfo        ` + "`json:\"pageInfo\"`" + `
+}
+`,
+			nodeField: `	Components *JiraComponentConnection ` + "`json:\"components\"`" + `
+`,
+			decodeHelp: `
+// DecodeComponentsNextPage decodes a components connection page fetched with an after cursor.
+func DecodeComponentsNextPage(data map[string]any) (*JiraComponentConnection, error) {
 	b, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	var out JiraIssueByKeyData
+	var out JiraComponentConnection
 	if err := json.Unmarshal(b, &out); err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
-`
+`,
+		},
+		{
+			enabled: caps.FixVersions, optionName: "IncludeFixVersions", queryField: "fixVersions",
+			paginated: true,
+			queryBody: `fixVersions(first: %d) {
+      nodes { id name released }
+      pageInfo { hasNextPage endCursor }
+    }`,
+			types: `
+type JiraVersionRef struct {
+	ID       string ` + "`json:\"id\"`" + `
+	Name     string ` + "`json:\"name\"`" + `
+	Released *bool  ` + "`json:\"released\"`" + `
+}
+
+type JiraFixVersionConnection struct {
+	Nodes    []JiraVersionRef ` + "`json:\"nodes\"`" + `
+	PageInfo PageInfo         ` + "`json:\"pageInfo\"`" + `
+}
+`,
+			nodeField: `	FixVersions *JiraFixVersionConnection ` + "`json:\"fixVersions\"`" + `
+`,
+			decodeHelp: `
+// DecodeFixVersionsNextPage decodes a fixVersions connection page fetched with an after cursor.
+func DecodeFixVersionsNextPage(data map[string]any) (*JiraFixVersionConnection, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out JiraFixVersionConnection
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+`,
+		},
+		{
+			enabled: caps.AffectsVersions, optionName: "IncludeAffectsVersions", queryField: "affectsVersions",
+			paginated: true,
+			queryBody: `affectsVersions(first: %d) {
+      nodes { id name released }
+      pageInfo { hasNextPage endCursor }
+    }`,
+			types: `
+type JiraAffectsVersionConnection struct {
+	Nodes    []JiraVersionRef ` + "`json:\"nodes\"`" + `
+	PageInfo PageInfo         ` + "`json:\"pageInfo\"`" + `
+}
+`,
+			nodeField: `	AffectsVersions *JiraAffectsVersionConnection ` + "`json:\"affectsVersions\"`" + `
+`,
+			decodeHelp: `
+// DecodeAffectsVersionsNextPage decodes an affectsVersions connection page fetched with an after cursor.
+func DecodeAffectsVersionsNextPage(data map[string]any) (*JiraAffectsVersionConnection, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var out JiraAffectsVersionConnection
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+`,
+		},
+		{
+			enabled: caps.Watches, optionName: "IncludeWatches", queryField: "watches",
+			queryBody: `watches { watchCount isWatching }`,
+			types: `
+type JiraWatches struct {
+	WatchCount int  ` + "`json:\"watchCount\"`" + `
+	IsWatching bool ` + "`json:\"isWatching\"`" + `
+}
+`,
+			nodeField: `	Watches *JiraWatches ` + "`json:\"watches\"`" + `
+`,
+		},
+		{
+			enabled: caps.Priority, optionName: "IncludePriority", queryField: "priority",
+			queryBody: `priority { name }`,
+			types: `
+type JiraPriority struct {
+	Name string ` + "`json:\"name\"`" + `
+}
+`,
+			nodeField: `	Priority *JiraPriority ` + "`json:\"priority\"`" + `
+`,
+		},
+		{
+			enabled: caps.Labels, optionName: "IncludeLabels", queryField: "labels",
+			queryBody: `labels`,
+			nodeField: `	Labels []string ` + "`json:\"labels\"`" + `
+`,
+		},
+		{
+			enabled: caps.Environment, optionName: "IncludeEnvironment", queryField: "environmentField",
+			queryBody: `environmentField { text }`,
+			types: `
+type JiraRichTextField struct {
+	Text *string ` + "`json:\"text\"`" + `
+}
+`,
+			nodeField: `	EnvironmentField *JiraRichTextField ` + "`json:\"environmentField\"`" + `
+`,
+		},
+		{
+			enabled: caps.Summary, optionName: "IncludeSummary", queryField: "summary",
+			queryBody: `summary`,
+			nodeField: `	Summary *string ` + "`json:\"summary\"`" + `
+`,
+		},
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by go/tools/generate_jira_issue_models/main.go. DO NOT EDIT.\n")
+	b.WriteString("package gen\n\n")
+	if caps.IssueSearch {
+		b.WriteString("import (\n\t\"context\"\n\t\"encoding/json\"\n\t\"fmt\"\n\t\"strings\"\n)\n\n")
+	} else {
+		b.WriteString("import (\n\t\"encoding/json\"\n\t\"fmt\"\n\t\"strings\"\n)\n\n")
+	}
+
+	b.WriteString("// PageInfo is the Relay-style pagination cursor shared by every connection field on the\n")
+	b.WriteString("// Jira issue type.\n")
+	b.WriteString("type PageInfo struct {\n")
+	b.WriteString("\tHasNextPage bool    `json:\"hasNextPage\"`\n")
+	b.WriteString("\tEndCursor   *string `json:\"endCursor\"`\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("type JiraUser struct {\n")
+	b.WriteString("\tAccountID string `json:\"accountId\"`\n")
+	b.WriteString("\tName      string `json:\"name\"`\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("type JiraIssueType struct {\n\tName string `json:\"name\"`\n}\n\n")
+	b.WriteString("type JiraStatus struct {\n\tName string `json:\"name\"`\n}\n\n")
+
+	b.WriteString("type JiraProject struct {\n")
+	b.WriteString("\tKey     string `json:\"key\"`\n")
+	b.WriteString("\tCloudID string `json:\"cloudId\"`\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("type JiraProjectField struct {\n\tProject JiraProject `json:\"project\"`\n}\n\n")
+	b.WriteString("type JiraDateTimePickerField struct {\n\tDateTime *string `json:\"dateTime\"`\n}\n\n")
+	b.WriteString("type JiraSingleSelectUserPickerField struct {\n\tUser *JiraUser `json:\"user\"`\n}\n\n")
+
+	for _, s := range sections {
+		if s.enabled && s.types != "" {
+			b.WriteString(s.types)
+		}
+	}
+
+	b.WriteString("type JiraIssueNode struct {\n")
+	b.WriteString("\tKey                 string                            `json:\"key\"`\n")
+	b.WriteString("\tIssueType           JiraIssueType                     `json:\"issueType\"`\n")
+	b.WriteString("\tStatus              JiraStatus                        `json:\"status\"`\n")
+	b.WriteString("\tProjectField        JiraProjectField                  `json:\"projectField\"`\n")
+	b.WriteString("\tCreatedField        JiraDateTimePickerField           `json:\"createdField\"`\n")
+	b.WriteString("\tUpdatedField        JiraDateTimePickerField           `json:\"updatedField\"`\n")
+	b.WriteString("\tResolutionDateField *JiraDateTimePickerField          `json:\"resolutionDateField\"`\n")
+	b.WriteString("\tAssigneeField       *JiraSingleSelectUserPickerField  `json:\"assigneeField\"`\n")
+	b.WriteString("\tReporter            *JiraUser                         `json:\"reporter\"`\n")
+	for _, s := range sections {
+		if s.enabled {
+			b.WriteString(s.nodeField)
+		}
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("type JiraIssueByKeyData struct {\n\tIssueByKey *JiraIssueNode `json:\"issueByKey\"`\n}\n\n")
+
+	b.WriteString("func DecodeJiraIssueByKey(data map[string]any) (*JiraIssueByKeyData, error) {\n")
+	b.WriteString("\tb, err := json.Marshal(data)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	b.WriteString("\tvar out JiraIssueByKeyData\n\tif err := json.Unmarshal(b, &out); err != nil {\n\t\treturn nil, err\n\t}\n")
+	b.WriteString("\treturn &out, nil\n}\n\n")
+
+	for _, s := range sections {
+		if s.enabled && s.decodeHelp != "" {
+			b.WriteString(s.decodeHelp)
+		}
+	}
+
+	b.WriteString("// JiraIssueByKeyOptions selects which optional sections of the issue to request and, for\n")
+	b.WriteString("// connection fields, how many items per page to fetch. Only sections supported by the\n")
+	b.WriteString("// schema snapshot this file was generated from have an Include field here.\n")
+	b.WriteString("type JiraIssueByKeyOptions struct {\n")
+	for _, s := range sections {
+		if s.enabled {
+			fmt.Fprintf(&b, "\t%s bool\n", s.optionName)
+		}
+	}
+	b.WriteString("\n\t// PageSize is the first value used for every connection field; defaults to 50 when unset.\n")
+	b.WriteString("\tPageSize int\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("// BuildJiraIssueByKeyQuery renders the JiraIssueByKey query, including only the optional\n")
+	b.WriteString("// sections requested in opts.\n")
+	b.WriteString("func BuildJiraIssueByKeyQuery(opts JiraIssueByKeyOptions) string {\n")
+	b.WriteString("\tpageSize := opts.PageSize\n\tif pageSize <= 0 {\n\t\tpageSize = 50\n\t}\n\n")
+	b.WriteString("\tvar q strings.Builder\n")
+	b.WriteString("\tq.WriteString(\"query JiraIssueByKey(\\n  $cloudId: ID!,\\n  $key: String!\\n) {\\n  issueByKey(key: $key, cloudId: $cloudId) {\\n\")\n")
+	b.WriteString("\tq.WriteString(\"    key\\n    issueType { name }\\n    status { name }\\n\")\n")
+	b.WriteString("\tq.WriteString(\"    projectField {\\n      project { key cloudId }\\n    }\\n\")\n")
+	b.WriteString("\tq.WriteString(\"    createdField { dateTime }\\n    updatedField { dateTime }\\n    resolutionDateField { dateTime }\\n\")\n")
+	b.WriteString("\tq.WriteString(\"    assigneeField {\\n      user { accountId name }\\n    }\\n\")\n")
+	b.WriteString("\tq.WriteString(\"    reporter { accountId name }\\n\")\n\n")
+	for _, s := range sections {
+		if !s.enabled {
+			continue
+		}
+		fmt.Fprintf(&b, "\tif opts.%s {\n", s.optionName)
+		if s.paginated {
+			fmt.Fprintf(&b, "\t\tfmt.Fprintf(&q, \"    %s\\n\", pageSize)\n", strings.ReplaceAll(strings.ReplaceAll(s.queryBody, `"`, `\"`), "\n", `\n`))
+		} else {
+			fmt.Fprintf(&b, "\t\tq.WriteString(\"    %s\\n\")\n", s.queryBody)
+		}
+		b.WriteString("\t}\n")
+	}
+	b.WriteString("\n\tq.WriteString(\"  }\\n}\\n\")\n")
+	b.WriteString("\treturn q.String()\n")
+	b.WriteString("}\n")
+
+	if caps.IssueSearch {
+		b.WriteString("\n")
+		b.WriteString("// JiraIssueSearchVariables are the GraphQL variables for JiraIssueSearchQuery.\n")
+		b.WriteString("type JiraIssueSearchVariables struct {\n")
+		b.WriteString("\tCloudID string\n")
+		b.WriteString("\tJQL     string\n")
+		b.WriteString("\tFirst   int\n")
+		b.WriteString("\tAfter   *string\n")
+		b.WriteString("}\n\n")
+
+		b.WriteString("type JiraIssueSearchEdge struct {\n")
+		b.WriteString("\tCursor string        `json:\"cursor\"`\n")
+		b.WriteString("\tNode   JiraIssueNode `json:\"node\"`\n")
+		b.WriteString("}\n\n")
+
+		b.WriteString("type JiraIssueSearchConnection struct {\n")
+		b.WriteString("\tEdges    []JiraIssueSearchEdge `json:\"edges\"`\n")
+		b.WriteString("\tPageInfo PageInfo              `json:\"pageInfo\"`\n")
+		b.WriteString("}\n\n")
+
+		b.WriteString("// JiraIssueSearchData wraps the search connection under a fixed \"issueSearch\" alias, so\n")
+		b.WriteString("// decoding doesn't depend on what the underlying schema calls the field (issueSearchStable,\n")
+		b.WriteString("// issues, ...).\n")
+		b.WriteString("type JiraIssueSearchData struct {\n")
+		b.WriteString("\tIssueSearch *JiraIssueSearchConnection `json:\"issueSearch\"`\n")
+		b.WriteString("}\n\n")
+
+		fmt.Fprintf(&b, "const JiraIssueSearchQuery = `query JiraIssueSearch($cloudId: ID!, $jql: String!, $first: Int!, $after: String) {\n  issueSearch: %s(cloudId: $cloudId, jql: $jql, first: $first, after: $after) {\n    edges {\n      cursor\n      node {\n        key\n        issueType { name }\n        status { name }\n        projectField { project { key cloudId } }\n        createdField { dateTime }\n        updatedField { dateTime }\n        resolutionDateField { dateTime }\n        assigneeField { user { accountId name } }\n        reporter { accountId name }\n      }\n    }\n    pageInfo { hasNextPage endCursor }\n  }\n}\n`\n\n", caps.issueSearchField)
+
+		b.WriteString("func DecodeJiraIssueSearch(data map[string]any) (*JiraIssueSearchData, error) {\n")
+		b.WriteString("\tb, err := json.Marshal(data)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+		b.WriteString("\tvar out JiraIssueSearchData\n\tif err := json.Unmarshal(b, &out); err != nil {\n\t\treturn nil, err\n\t}\n")
+		b.WriteString("\treturn &out, nil\n}\n\n")
+
+		b.WriteString("// JiraIssueSearchExecutor runs a single JiraIssueSearch request and returns the raw \"data\"\n")
+		b.WriteString("// envelope, matching the shape of graph.Client.Execute's data return value.\n")
+		b.WriteString("type JiraIssueSearchExecutor func(ctx context.Context, query string, variables map[string]any) (map[string]any, error)\n\n")
+
+		b.WriteString("// IterateJiraIssueSearch drives JiraIssueSearchQuery page by page using pageInfo's cursor,\n")
+		b.WriteString("// calling yield with each matching issue as soon as its page arrives, until the connection is\n")
+		b.WriteString("// exhausted or yield returns an error. Mirrors how JQL clients like go-jira's\n")
+		b.WriteString("// IssueService.Search expose result streaming.\n")
+		b.WriteString("func IterateJiraIssueSearch(ctx context.Context, exec JiraIssueSearchExecutor, vars JiraIssueSearchVariables, yield func(*JiraIssueNode) error) error {\n")
+		b.WriteString("\tfirst := vars.First\n\tif first <= 0 {\n\t\tfirst = 50\n\t}\n")
+		b.WriteString("\tafter := vars.After\n")
+		b.WriteString("\tfor {\n")
+		b.WriteString("\t\tvariables := map[string]any{\n\t\t\t\"cloudId\": vars.CloudID,\n\t\t\t\"jql\":     vars.JQL,\n\t\t\t\"first\":   first,\n\t\t\t\"after\":   after,\n\t\t}\n")
+		b.WriteString("\t\tdata, err := exec(ctx, JiraIssueSearchQuery, variables)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n")
+		b.WriteString("\t\tdecoded, err := DecodeJiraIssueSearch(data)\n\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n")
+		b.WriteString("\t\tif decoded.IssueSearch == nil {\n\t\t\treturn nil\n\t\t}\n")
+		b.WriteString("\t\tfor _, edge := range decoded.IssueSearch.Edges {\n\t\t\tnode := edge.Node\n\t\t\tif err := yield(&node); err != nil {\n\t\t\t\treturn err\n\t\t\t}\n\t\t}\n")
+		b.WriteString("\t\tif !decoded.IssueSearch.PageInfo.HasNextPage || decoded.IssueSearch.PageInfo.EndCursor == nil {\n\t\t\treturn nil\n\t\t}\n")
+		b.WriteString("\t\tafter = decoded.IssueSearch.PageInfo.EndCursor\n")
+		b.WriteString("\t}\n")
+		b.WriteString("}\n")
+	}
+
+	if mutCaps != nil && len(mutCaps.info) > 0 {
+		b.WriteString("\n// --- mutations ---\n\n")
+		emitted := map[string]bool{}
+		for _, spec := range jiraMutationSpecs {
+			info, ok := mutCaps.info[spec.fieldName]
+			if !ok {
+				continue
+			}
+
+			var extra strings.Builder
+			emitted[info.inputType] = true
+			inputDef := types[info.inputType]
+			inputBody := structFieldsBody(types, inputDef, "inputFields", ",omitempty", &extra, emitted)
+			if info.hasCustomFields {
+				inputBody += "\n\t// CustomFields carries free-form Jira fields (customfield_XXXXX, or any field not\n" +
+					"\t// modeled above) merged into this input's fields object when encoded, mirroring how\n" +
+					"\t// go-jira's tcontainer.MarshalMap lets callers pass arbitrary field values without\n" +
+					"\t// regenerating.\n" +
+					"\tCustomFields map[string]any `json:\"-\"`\n"
+			}
+			fmt.Fprintf(&extra, "type %s struct {\n%s}\n\n", info.inputType, inputBody)
+			emitStructForType(types, info.payloadType, "fields", "", &extra, emitted)
+			b.WriteString(extra.String())
+
+			fmt.Fprintf(&b, "// Encode%sVariables marshals input into the GraphQL variables for %s", spec.opName, spec.fieldName)
+			if info.hasCustomFields {
+				b.WriteString(", merging input.CustomFields into the fields object")
+			}
+			b.WriteString(".\n")
+			fmt.Fprintf(&b, "func Encode%sVariables(input %s) (map[string]any, error) {\n", spec.opName, info.inputType)
+			b.WriteString("\tb, err := json.Marshal(input)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+			b.WriteString("\tvar raw map[string]any\n\tif err := json.Unmarshal(b, &raw); err != nil {\n\t\treturn nil, err\n\t}\n")
+			if info.hasCustomFields {
+				b.WriteString("\tif len(input.CustomFields) > 0 {\n")
+				b.WriteString("\t\tfieldsRaw, _ := raw[\"fields\"].(map[string]any)\n")
+				b.WriteString("\t\tif fieldsRaw == nil {\n\t\t\tfieldsRaw = map[string]any{}\n\t\t}\n")
+				b.WriteString("\t\tfor k, v := range input.CustomFields {\n\t\t\tfieldsRaw[k] = v\n\t\t}\n")
+				b.WriteString("\t\traw[\"fields\"] = fieldsRaw\n")
+				b.WriteString("\t}\n")
+			}
+			b.WriteString("\treturn map[string]any{\"input\": raw}, nil\n}\n\n")
+
+			fmt.Fprintf(&b, "type %sData struct {\n\t%s *%s `json:\"%s\"`\n}\n\n", spec.opName, spec.opName, info.payloadType, spec.fieldName)
+
+			fmt.Fprintf(&b, "// Decode%sResult decodes the %s mutation's response data.\n", spec.opName, spec.fieldName)
+			fmt.Fprintf(&b, "func Decode%sResult(data map[string]any) (*%sData, error) {\n", spec.opName, spec.opName)
+			b.WriteString("\tb, err := json.Marshal(data)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+			fmt.Fprintf(&b, "\tvar out %sData\n\tif err := json.Unmarshal(b, &out); err != nil {\n\t\treturn nil, err\n\t}\n", spec.opName)
+			b.WriteString("\treturn &out, nil\n}\n\n")
+		}
+	}
+
+	return b.String()
 }
@@ -2,84 +2,45 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
+	"flag"
 	"fmt"
 	"go/format"
-	"net/http"
 	"os"
 	"path/filepath"
-	"runtime"
-	"strings"
-	"time"
 
-	"atlassian/atlassian"
-	"atlassian/atlassian/graph"
+	"atlassian/atlassian/graph/codegen"
 )
 
 func main() {
-	repoRoot, err := findRepoRoot()
+	forceRefresh := flag.Bool("force-refresh", false, "bypass the schema cache and always re-fetch the introspection result")
+	noMocks := flag.Bool("no-mocks", false, "skip generating the gomock/fake-transport doubles under -mock-dir")
+	mockDir := flag.String("mock-dir", "", "directory mocks are written to (default: \"mocks\" next to the gen output dir)")
+	flag.Parse()
+
+	repoRoot, err := codegen.FindRepoRoot()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
-	tokenFile := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_TOKEN_FILE"))
-	if tokenFile == "" {
-		tokenFile = filepath.Join(repoRoot, "oauth_tokens.txt")
-	}
-	loadEnvFile(tokenFile)
-
-	schemaPath := filepath.Join(repoRoot, "graphql", "schema.introspection.json")
-	if _, err := os.Stat(schemaPath); err != nil {
-		if !errors.Is(err, os.ErrNotExist) {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(2)
-		}
-		baseURL := strings.TrimSpace(os.Getenv("ATLASSIAN_GQL_BASE_URL"))
-		if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN")) != "" {
-			baseURL = "https://api.atlassian.com"
-		}
-		if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN")) != "" {
-			baseURL = "https://api.atlassian.com"
-		}
-		if baseURL == "" {
-			fmt.Fprintf(os.Stderr, "Missing %s and ATLASSIAN_GQL_BASE_URL not set\n", schemaPath)
-			os.Exit(2)
-		}
-		auth := buildAuthFromEnv()
-		if auth == nil {
-			fmt.Fprintln(os.Stderr, "No credentials available in env vars to fetch schema")
-			os.Exit(2)
-		}
-
-		opts := graph.SchemaFetchOptions{
-			OutputDir:        filepath.Dir(schemaPath),
-			ExperimentalAPIs: parseExperimentalAPIs(),
-			Timeout:          30 * time.Second,
-			HTTPClient:       &http.Client{Timeout: 30 * time.Second},
-		}
-		if _, err := graph.FetchSchemaIntrospection(context.Background(), baseURL, auth, opts); err != nil {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(2)
-		}
-	}
 
-	schema, err := loadSchema(schemaPath)
+	schema, err := codegen.Bootstrap(context.Background(), codegen.BootstrapOptions{RepoRoot: repoRoot, ForceRefresh: *forceRefresh})
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
-	if err := validateSchema(schema); err != nil {
+	backend, err := detectBackend(schema)
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
+	fmt.Println("JiraSprintBackend:", backend)
 
 	outPath := filepath.Join(repoRoot, "go", "atlassian", "graph", "gen", "jira_sprints_api.go")
 	if err := os.MkdirAll(filepath.Dir(outPath), 0o755); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
-	source := renderGo()
+	source := renderGo(backend)
 	formatted, err := format.Source([]byte(source))
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "format generated code:", err)
@@ -91,256 +52,91 @@ func main() {
 		os.Exit(2)
 	}
 	fmt.Println("Wrote", outPath)
-}
-
-func findRepoRoot() (string, error) {
-	_, thisFile, _, ok := runtime.Caller(0)
-	if !ok {
-		return "", errors.New("unable to locate generator path")
-	}
-	dir := filepath.Dir(thisFile)
-	root := filepath.Clean(filepath.Join(dir, "..", "..", ".."))
-	return root, nil
-}
-
-func parseExperimentalAPIs() []string {
-	raw := os.Getenv("ATLASSIAN_GQL_EXPERIMENTAL_APIS")
-	if strings.TrimSpace(raw) == "" {
-		return nil
-	}
-	parts := strings.Split(raw, ",")
-	var out []string
-	for _, p := range parts {
-		if s := strings.TrimSpace(p); s != "" {
-			out = append(out, s)
-		}
-	}
-	return out
-}
 
-func loadEnvFile(path string) {
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return
-	}
-	lines := strings.Split(string(data), "\n")
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
-			continue
-		}
-		if strings.HasPrefix(trimmed, "export ") {
-			trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "export "))
-		}
-		eq := strings.Index(trimmed, "=")
-		if eq <= 0 {
-			continue
-		}
-		key := strings.TrimSpace(trimmed[:eq])
-		val := strings.TrimSpace(trimmed[eq+1:])
-		if key == "" {
-			continue
-		}
-		if _, ok := os.LookupEnv(key); ok {
-			continue
-		}
-		val = stripQuotes(val)
-		_ = os.Setenv(key, val)
+	dir := *mockDir
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(outPath), "mocks")
 	}
-}
-
-func stripQuotes(raw string) string {
-	if len(raw) >= 2 {
-		first := raw[0]
-		last := raw[len(raw)-1]
-		if (first == '"' && last == '"') || (first == '\'' && last == '\'') {
-			return raw[1 : len(raw)-1]
-		}
+	if err := codegen.WriteMockFiles(dir, *noMocks, writeFormattedFile); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
-	return raw
 }
 
-func buildAuthFromEnv() atlassian.AuthProvider {
-	token := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN"))
-	refreshToken := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN"))
-	clientID := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_ID"))
-	clientSecret := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_SECRET"))
-	email := strings.TrimSpace(os.Getenv("ATLASSIAN_EMAIL"))
-	apiToken := strings.TrimSpace(os.Getenv("ATLASSIAN_API_TOKEN"))
-	cookiesJSON := strings.TrimSpace(os.Getenv("ATLASSIAN_COOKIES_JSON"))
-
-	if refreshToken != "" && clientID != "" && clientSecret != "" {
-		return &atlassian.OAuthRefreshTokenAuth{
-			ClientID:     clientID,
-			ClientSecret: clientSecret,
-			RefreshToken: refreshToken,
-			Timeout:      30 * time.Second,
-		}
+// writeFormattedFile gofmt's source and writes it to path, creating any missing parent
+// directories first. It's passed to codegen.WriteMockFiles so mock output goes through the same
+// format-then-write step as the main generated file above.
+func writeFormattedFile(path string, source string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
 	}
-	if token != "" {
-		if clientSecret != "" && token == clientSecret {
-			fmt.Fprintln(os.Stderr, "ATLASSIAN_OAUTH_ACCESS_TOKEN appears to be set to ATLASSIAN_CLIENT_SECRET; set an OAuth access token (not the client secret).")
-			return nil
-		}
-		return atlassian.BearerAuth{
-			TokenGetter: func() (string, error) { return token, nil },
-		}
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		return fmt.Errorf("format %s: %w", path, err)
 	}
-	if email != "" && apiToken != "" {
-		return atlassian.BasicAPITokenAuth{Email: email, Token: apiToken}
-	}
-	if cookiesJSON != "" {
-		var cookies map[string]string
-		if err := json.Unmarshal([]byte(cookiesJSON), &cookies); err == nil && len(cookies) > 0 {
-			var httpCookies []*http.Cookie
-			for k, v := range cookies {
-				httpCookies = append(httpCookies, &http.Cookie{Name: k, Value: v})
-			}
-			return atlassian.CookieAuth{Cookies: httpCookies}
-		}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		return err
 	}
+	fmt.Println("Wrote", path)
 	return nil
 }
 
-func loadSchema(path string) (map[string]any, error) {
-	rawBytes, err := os.ReadFile(path)
+// detectBackend reports which API graph.Client.GetSprintByID should prefer at runtime:
+// "graphql" when Query.sprintById and its return type's scalar fields are present in the
+// introspection result, "rest" when the schema otherwise parses but lacks them (e.g. the tenant
+// hasn't rolled out unified GraphQL, or ATLASSIAN_GQL_EXPERIMENTAL_APIS omits the Sprint API).
+// Either way renderGo emits both the GraphQL and REST v3 code paths, so a regeneration that
+// changes the detected backend never breaks callers built against the previous one; only a schema
+// missing the Query type entirely is still a fatal error, since that indicates a malformed or
+// unfetched introspection result rather than one individual field being absent.
+func detectBackend(schema map[string]any) (string, error) {
+	types, err := codegen.TypesMap(schema)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	var envelope map[string]any
-	if err := json.Unmarshal(rawBytes, &envelope); err != nil {
-		return nil, err
-	}
-	if data, ok := envelope["data"].(map[string]any); ok {
-		envelope = data
-	}
-	schema, ok := envelope["__schema"].(map[string]any)
-	if !ok {
-		return nil, errors.New("introspection missing __schema")
-	}
-	return schema, nil
-}
-
-func validateSchema(schema map[string]any) error {
-	types := typesMap(schema)
-	queryName, err := queryTypeName(schema)
+	queryDef, err := codegen.QueryTypeDef(schema, types)
 	if err != nil {
-		return err
-	}
-	queryDef := types[queryName]
-	if queryDef == nil {
-		return fmt.Errorf("missing query type %s", queryName)
+		return "", err
 	}
-	sprintByID := field(queryDef, "sprintById")
-	if sprintByID == nil {
-		return fmt.Errorf("missing field %s.sprintById", queryName)
-	}
-	if arg(sprintByID, "id") == nil {
-		return errors.New("sprintById missing id arg")
-	}
-	sprintTypeName := unwrapNamedType(sprintByID["type"])
-	if sprintTypeName == "" {
-		return errors.New("unable to resolve sprintById return type")
+	sprintByID := codegen.GetField(queryDef, "sprintById")
+	if sprintByID == nil || codegen.GetArg(sprintByID, "id") == nil {
+		return "rest", nil
 	}
+	sprintTypeName, _ := codegen.UnwrapNamedType(sprintByID["type"])
 	sprintDef := types[sprintTypeName]
-	if sprintDef == nil {
-		return fmt.Errorf("missing type %s", sprintTypeName)
+	if sprintTypeName == "" || sprintDef == nil {
+		return "rest", nil
 	}
 	for _, name := range []string{"sprintId", "name", "state", "startDate", "endDate", "completionDate"} {
-		if field(sprintDef, name) == nil {
-			return fmt.Errorf("sprintById missing %s field", name)
-		}
-	}
-	return nil
-}
-
-func typesMap(schema map[string]any) map[string]map[string]any {
-	out := map[string]map[string]any{}
-	raw, ok := schema["types"].([]any)
-	if !ok {
-		return out
-	}
-	for _, item := range raw {
-		obj, ok := item.(map[string]any)
-		if !ok {
-			continue
-		}
-		if name, ok := obj["name"].(string); ok && name != "" {
-			out[name] = obj
+		if codegen.GetField(sprintDef, name) == nil {
+			return "rest", nil
 		}
 	}
-	return out
+	return "graphql", nil
 }
 
-func queryTypeName(schema map[string]any) (string, error) {
-	raw, ok := schema["queryType"].(map[string]any)
-	if !ok {
-		return "", errors.New("missing queryType")
-	}
-	name, ok := raw["name"].(string)
-	if !ok || name == "" {
-		return "", errors.New("missing queryType.name")
-	}
-	return name, nil
-}
-
-func field(typeDef map[string]any, name string) map[string]any {
-	raw, ok := typeDef["fields"].([]any)
-	if !ok {
-		return nil
-	}
-	for _, item := range raw {
-		obj, ok := item.(map[string]any)
-		if !ok {
-			continue
-		}
-		if obj["name"] == name {
-			return obj
-		}
-	}
-	return nil
-}
-
-func arg(fieldDef map[string]any, name string) map[string]any {
-	raw, ok := fieldDef["args"].([]any)
-	if !ok {
-		return nil
-	}
-	for _, item := range raw {
-		obj, ok := item.(map[string]any)
-		if !ok {
-			continue
-		}
-		if obj["name"] == name {
-			return obj
-		}
-	}
-	return nil
-}
-
-func unwrapNamedType(ref any) string {
-	cur, ok := ref.(map[string]any)
-	if !ok {
-		return ""
-	}
-	for i := 0; i < 16; i++ {
-		if name, ok := cur["name"].(string); ok && name != "" {
-			return name
-		}
-		next, ok := cur["ofType"].(map[string]any)
-		if !ok {
-			return ""
-		}
-		cur = next
-	}
-	return ""
-}
-
-func renderGo() string {
+func renderGo(backend string) string {
 	return `// Code generated by go/tools/generate_jira_sprint_models/main.go. DO NOT EDIT.
 package gen
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"atlassian/atlassian"
+)
+
+// JiraSprintBackend records which API this introspection supported at generation time: "graphql"
+// when Query.sprintById was present, "rest" otherwise. graph.Client.GetSprintByID checks this to
+// decide whether to issue JiraSprintByIdQuery or call FetchJiraSprintByIdREST; both code paths
+// below are always generated so switching backends across a regeneration never breaks callers.
+const JiraSprintBackend = "` + backend + `"
 
 const JiraSprintByIdQuery = ` + "`" + `query JiraSprintById(
   $id: ID!
@@ -369,6 +165,33 @@ type JiraSprintByIdData struct {
 	SprintById *JiraSprintNode ` + "`json:\"sprintById\"`" + `
 }
 
+// JiraSprintByIdOptions configures per-call timeout/deadline semantics for GetSprintByID.
+// Deadline, if non-zero, bounds the call as a whole; Timeout, if non-zero, is measured from the
+// moment ResolveJiraSprintByIdDeadline runs. When both are set the tighter of the two wins.
+// RetryBudget is reserved for a future retry policy and is currently unused by graph.Client.
+type JiraSprintByIdOptions struct {
+	Timeout     time.Duration
+	Deadline    time.Time
+	RetryBudget int
+}
+
+// ResolveJiraSprintByIdDeadline derives a child context from ctx honoring whichever of
+// opts.Deadline/opts.Timeout is tighter, returning ctx unchanged (with a no-op cancel) when
+// neither is set.
+func ResolveJiraSprintByIdDeadline(ctx context.Context, opts JiraSprintByIdOptions) (context.Context, context.CancelFunc) {
+	deadline := opts.Deadline
+	if opts.Timeout > 0 {
+		byTimeout := time.Now().Add(opts.Timeout)
+		if deadline.IsZero() || byTimeout.Before(deadline) {
+			deadline = byTimeout
+		}
+	}
+	if deadline.IsZero() {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(ctx, deadline)
+}
+
 func DecodeJiraSprintById(data map[string]any) (*JiraSprintByIdData, error) {
 	b, err := json.Marshal(data)
 	if err != nil {
@@ -380,5 +203,69 @@ func DecodeJiraSprintById(data map[string]any) (*JiraSprintByIdData, error) {
 	}
 	return &out, nil
 }
+
+// FetchJiraSprintByIdREST fetches a sprint via the Jira REST v3 API, for tenants whose
+// introspection doesn't expose Query.sprintById (unified GraphQL not rolled out, or
+// ATLASSIAN_GQL_EXPERIMENTAL_APIS omits the Sprint API), normalising the response into the same
+// JiraSprintNode the GraphQL backend decodes.
+// Ref: GET /rest/api/3/sprint/{id}
+func FetchJiraSprintByIdREST(ctx context.Context, httpClient *http.Client, baseURL string, auth atlassian.AuthProvider, id string) (*JiraSprintNode, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return nil, errors.New("id is required")
+	}
+	url := strings.TrimRight(baseURL, "/") + "/rest/api/3/sprint/" + id
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		if err := auth.Apply(req); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("GET %s: unexpected status %d: %s", url, resp.StatusCode, string(body))
+	}
+
+	var raw struct {
+		ID           json.Number ` + "`json:\"id\"`" + `
+		Name         string      ` + "`json:\"name\"`" + `
+		State        string      ` + "`json:\"state\"`" + `
+		StartDate    string      ` + "`json:\"startDate\"`" + `
+		EndDate      string      ` + "`json:\"endDate\"`" + `
+		CompleteDate string      ` + "`json:\"completeDate\"`" + `
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("decode sprint REST response: %w", err)
+	}
+
+	node := &JiraSprintNode{SprintID: raw.ID.String()}
+	if raw.Name != "" {
+		node.Name = &raw.Name
+	}
+	if raw.State != "" {
+		node.State = &raw.State
+	}
+	if raw.StartDate != "" {
+		node.StartDate = &raw.StartDate
+	}
+	if raw.EndDate != "" {
+		node.EndDate = &raw.EndDate
+	}
+	if raw.CompleteDate != "" {
+		node.CompletionDate = &raw.CompleteDate
+	}
+	return node, nil
+}
 `
 }
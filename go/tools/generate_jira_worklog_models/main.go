@@ -2,74 +2,39 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
+	"flag"
 	"fmt"
 	"go/format"
-	"net/http"
 	"os"
 	"path/filepath"
-	"runtime"
-	"strings"
-	"time"
 
-	"atlassian/atlassian"
-	"atlassian/atlassian/graph"
+	"atlassian/atlassian/graph/codegen"
 )
 
 type config struct {
+	// Backend records which API graph.Client should prefer at runtime: "graphql" when the
+	// issue.worklogs Relay connection shape discoverConfig expects is present in the
+	// introspection, "rest" otherwise. renderGo always emits both code paths regardless of
+	// Backend, so a regeneration that changes it never breaks callers built against the
+	// previous one.
+	Backend              string
 	PageInfoHasEndCursor bool
 	EdgeHasCursor        bool
 }
 
 func main() {
-	repoRoot, err := findRepoRoot()
+	forceRefresh := flag.Bool("force-refresh", false, "bypass the schema cache and always re-fetch the introspection result")
+	noMocks := flag.Bool("no-mocks", false, "skip generating the gomock/fake-transport doubles under -mock-dir")
+	mockDir := flag.String("mock-dir", "", "directory mocks are written to (default: \"mocks\" next to the gen output dir)")
+	flag.Parse()
+
+	repoRoot, err := codegen.FindRepoRoot()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
-	tokenFile := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_TOKEN_FILE"))
-	if tokenFile == "" {
-		tokenFile = filepath.Join(repoRoot, "oauth_tokens.txt")
-	}
-	loadEnvFile(tokenFile)
-
-	schemaPath := filepath.Join(repoRoot, "graphql", "schema.introspection.json")
-	if _, err := os.Stat(schemaPath); err != nil {
-		if !errors.Is(err, os.ErrNotExist) {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(2)
-		}
-		baseURL := strings.TrimSpace(os.Getenv("ATLASSIAN_GQL_BASE_URL"))
-		if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN")) != "" {
-			baseURL = "https://api.atlassian.com"
-		}
-		if baseURL == "" && strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN")) != "" {
-			baseURL = "https://api.atlassian.com"
-		}
-		if baseURL == "" {
-			fmt.Fprintf(os.Stderr, "Missing %s and ATLASSIAN_GQL_BASE_URL not set\n", schemaPath)
-			os.Exit(2)
-		}
-		auth := buildAuthFromEnv()
-		if auth == nil {
-			fmt.Fprintln(os.Stderr, "No credentials available in env vars to fetch schema")
-			os.Exit(2)
-		}
-
-		opts := graph.SchemaFetchOptions{
-			OutputDir:        filepath.Dir(schemaPath),
-			ExperimentalAPIs: parseExperimentalAPIs(),
-			Timeout:          30 * time.Second,
-			HTTPClient:       &http.Client{Timeout: 30 * time.Second},
-		}
-		if _, err := graph.FetchSchemaIntrospection(context.Background(), baseURL, auth, opts); err != nil {
-			fmt.Fprintln(os.Stderr, err)
-			os.Exit(2)
-		}
-	}
 
-	schema, err := loadSchema(schemaPath)
+	schema, err := codegen.Bootstrap(context.Background(), codegen.BootstrapOptions{RepoRoot: repoRoot, ForceRefresh: *forceRefresh})
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
@@ -79,6 +44,7 @@ func main() {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
+	fmt.Println("WorklogsBackend:", cfg.Backend)
 
 	outPath := filepath.Join(repoRoot, "go", "atlassian", "graph", "gen", "jira_worklogs_api.go")
 	if err := os.MkdirAll(filepath.Dir(outPath), 0o755); err != nil {
@@ -97,301 +63,153 @@ func main() {
 		os.Exit(2)
 	}
 	fmt.Println("Wrote", outPath)
-}
-
-func findRepoRoot() (string, error) {
-	_, thisFile, _, ok := runtime.Caller(0)
-	if !ok {
-		return "", errors.New("unable to locate generator path")
-	}
-	dir := filepath.Dir(thisFile)
-	root := filepath.Clean(filepath.Join(dir, "..", "..", ".."))
-	return root, nil
-}
-
-func parseExperimentalAPIs() []string {
-	raw := os.Getenv("ATLASSIAN_GQL_EXPERIMENTAL_APIS")
-	if strings.TrimSpace(raw) == "" {
-		return nil
-	}
-	parts := strings.Split(raw, ",")
-	var out []string
-	for _, p := range parts {
-		if s := strings.TrimSpace(p); s != "" {
-			out = append(out, s)
-		}
-	}
-	return out
-}
 
-func loadEnvFile(path string) {
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return
+	dir := *mockDir
+	if dir == "" {
+		dir = filepath.Join(filepath.Dir(outPath), "mocks")
 	}
-	lines := strings.Split(string(data), "\n")
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
-			continue
-		}
-		if strings.HasPrefix(trimmed, "export ") {
-			trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "export "))
-		}
-		eq := strings.Index(trimmed, "=")
-		if eq <= 0 {
-			continue
-		}
-		key := strings.TrimSpace(trimmed[:eq])
-		val := strings.TrimSpace(trimmed[eq+1:])
-		if key == "" {
-			continue
-		}
-		if _, ok := os.LookupEnv(key); ok {
-			continue
-		}
-		val = stripQuotes(val)
-		_ = os.Setenv(key, val)
-	}
-}
-
-func stripQuotes(raw string) string {
-	if len(raw) >= 2 {
-		first := raw[0]
-		last := raw[len(raw)-1]
-		if (first == '"' && last == '"') || (first == '\'' && last == '\'') {
-			return raw[1 : len(raw)-1]
-		}
+	if err := codegen.WriteMockFiles(dir, *noMocks, writeFormattedFile); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
-	return raw
 }
 
-func buildAuthFromEnv() atlassian.AuthProvider {
-	token := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN"))
-	refreshToken := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_REFRESH_TOKEN"))
-	clientID := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_ID"))
-	clientSecret := strings.TrimSpace(os.Getenv("ATLASSIAN_CLIENT_SECRET"))
-	email := strings.TrimSpace(os.Getenv("ATLASSIAN_EMAIL"))
-	apiToken := strings.TrimSpace(os.Getenv("ATLASSIAN_API_TOKEN"))
-	cookiesJSON := strings.TrimSpace(os.Getenv("ATLASSIAN_COOKIES_JSON"))
-
-	if refreshToken != "" && clientID != "" && clientSecret != "" {
-		return &atlassian.OAuthRefreshTokenAuth{
-			ClientID:     clientID,
-			ClientSecret: clientSecret,
-			RefreshToken: refreshToken,
-			Timeout:      30 * time.Second,
-		}
+// writeFormattedFile gofmt's source and writes it to path, creating any missing parent
+// directories first. It's passed to codegen.WriteMockFiles so mock output goes through the same
+// format-then-write step as the main generated file above.
+func writeFormattedFile(path string, source string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
 	}
-	if token != "" {
-		if clientSecret != "" && token == clientSecret {
-			fmt.Fprintln(os.Stderr, "ATLASSIAN_OAUTH_ACCESS_TOKEN appears to be set to ATLASSIAN_CLIENT_SECRET; set an OAuth access token (not the client secret).")
-			return nil
-		}
-		return atlassian.BearerAuth{
-			TokenGetter: func() (string, error) { return token, nil },
-		}
-	}
-	if email != "" && apiToken != "" {
-		return atlassian.BasicAPITokenAuth{Email: email, Token: apiToken}
-	}
-	if cookiesJSON != "" {
-		var cookies map[string]string
-		if err := json.Unmarshal([]byte(cookiesJSON), &cookies); err == nil && len(cookies) > 0 {
-			var httpCookies []*http.Cookie
-			for k, v := range cookies {
-				httpCookies = append(httpCookies, &http.Cookie{Name: k, Value: v})
-			}
-			return atlassian.CookieAuth{Cookies: httpCookies}
-		}
-	}
-	return nil
-}
-
-func loadSchema(path string) (map[string]any, error) {
-	rawBytes, err := os.ReadFile(path)
+	formatted, err := format.Source([]byte(source))
 	if err != nil {
-		return nil, err
-	}
-	var envelope map[string]any
-	if err := json.Unmarshal(rawBytes, &envelope); err != nil {
-		return nil, err
+		return fmt.Errorf("format %s: %w", path, err)
 	}
-	if data, ok := envelope["data"].(map[string]any); ok {
-		envelope = data
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		return err
 	}
-	schema, ok := envelope["__schema"].(map[string]any)
-	if !ok {
-		return nil, errors.New("introspection missing __schema")
-	}
-	return schema, nil
+	fmt.Println("Wrote", path)
+	return nil
 }
 
+// restFallbackConfig is the config discoverConfig returns when the introspection doesn't expose
+// the issue.worklogs Relay connection shape renderGo's GraphQL path assumes: the REST v3 fallback
+// synthesizes its own cursor from startAt/maxResults/total, so both flags are simply true.
+var restFallbackConfig = config{Backend: "rest", PageInfoHasEndCursor: true, EdgeHasCursor: true}
+
+// discoverConfig walks Query.issueByKey.worklogs to see whether the connection shape renderGo's
+// GraphQL path assumes (a pageInfo/edges Relay connection nesting JiraWorklogNode) is present in
+// the introspection, recording whether pageInfo.endCursor and edges[].cursor are present so
+// renderGo can emit them as the WorklogsPageInfoHasEndCursor/WorklogsEdgeHasCursor constants. A schema that otherwise parses but lacks this shape
+// (e.g. the tenant hasn't rolled out unified GraphQL, or ATLASSIAN_GQL_EXPERIMENTAL_APIS omits
+// the Worklogs API) isn't a fatal error: discoverConfig falls back to restFallbackConfig instead,
+// since renderGo always emits the REST v3 path too. Only a schema missing the Query type entirely
+// still errors, since that indicates a malformed or unfetched introspection result.
 func discoverConfig(schema map[string]any) (config, error) {
-	types := typesMap(schema)
-	queryName, err := queryTypeName(schema)
+	types, err := codegen.TypesMap(schema)
 	if err != nil {
 		return config{}, err
 	}
-	queryDef := types[queryName]
-	if queryDef == nil {
-		return config{}, fmt.Errorf("missing query type %s", queryName)
+	queryDef, err := codegen.QueryTypeDef(schema, types)
+	if err != nil {
+		return config{}, err
 	}
-	issueByKey := field(queryDef, "issueByKey")
+	issueByKey := codegen.GetField(queryDef, "issueByKey")
 	if issueByKey == nil {
-		return config{}, fmt.Errorf("missing field %s.issueByKey", queryName)
+		return restFallbackConfig, nil
 	}
-	if arg(issueByKey, "key") == nil || arg(issueByKey, "cloudId") == nil {
-		return config{}, errors.New("issueByKey missing key/cloudId args")
+	if codegen.GetArg(issueByKey, "key") == nil || codegen.GetArg(issueByKey, "cloudId") == nil {
+		return restFallbackConfig, nil
 	}
 
-	issueType := types[unwrapNamedType(issueByKey["type"])]
+	issueTypeName, _ := codegen.UnwrapNamedType(issueByKey["type"])
+	issueType := types[issueTypeName]
 	if issueType == nil {
-		return config{}, errors.New("missing issueByKey return type")
+		return restFallbackConfig, nil
 	}
-	worklogsField := field(issueType, "worklogs")
+	worklogsField := codegen.GetField(issueType, "worklogs")
 	if worklogsField == nil {
-		return config{}, errors.New("issue.worklogs missing")
+		return restFallbackConfig, nil
 	}
-	if arg(worklogsField, "first") == nil || arg(worklogsField, "after") == nil {
-		return config{}, errors.New("issue.worklogs missing first/after args")
+	if codegen.GetArg(worklogsField, "first") == nil || codegen.GetArg(worklogsField, "after") == nil {
+		return restFallbackConfig, nil
 	}
 
-	connType := types[unwrapNamedType(worklogsField["type"])]
+	connTypeName, _ := codegen.UnwrapNamedType(worklogsField["type"])
+	connType := types[connTypeName]
 	if connType == nil {
-		return config{}, errors.New("worklogs connection type missing")
+		return restFallbackConfig, nil
 	}
-	pageInfoField := field(connType, "pageInfo")
-	edgesField := field(connType, "edges")
+	pageInfoField := codegen.GetField(connType, "pageInfo")
+	edgesField := codegen.GetField(connType, "edges")
 	if pageInfoField == nil || edgesField == nil {
-		return config{}, errors.New("worklogs connection missing pageInfo/edges")
+		return restFallbackConfig, nil
 	}
-	pageInfoType := types[unwrapNamedType(pageInfoField["type"])]
-	if pageInfoType == nil || field(pageInfoType, "hasNextPage") == nil {
-		return config{}, errors.New("pageInfo.hasNextPage missing")
+	pageInfoTypeName, _ := codegen.UnwrapNamedType(pageInfoField["type"])
+	pageInfoType := types[pageInfoTypeName]
+	if pageInfoType == nil || codegen.GetField(pageInfoType, "hasNextPage") == nil {
+		return restFallbackConfig, nil
 	}
-	pageInfoHasEndCursor := field(pageInfoType, "endCursor") != nil
+	pageInfoHasEndCursor := codegen.GetField(pageInfoType, "endCursor") != nil
 
-	edgeType := types[unwrapNamedType(edgesField["type"])]
-	if edgeType == nil || field(edgeType, "node") == nil {
-		return config{}, errors.New("worklogs edge missing node")
+	edgeTypeName, _ := codegen.UnwrapNamedType(edgesField["type"])
+	edgeType := types[edgeTypeName]
+	if edgeType == nil || codegen.GetField(edgeType, "node") == nil {
+		return restFallbackConfig, nil
 	}
-	edgeHasCursor := field(edgeType, "cursor") != nil
-	worklogType := types[unwrapNamedType(field(edgeType, "node")["type"])]
+	edgeHasCursor := codegen.GetField(edgeType, "cursor") != nil
+	worklogTypeName, _ := codegen.UnwrapNamedType(codegen.GetField(edgeType, "node")["type"])
+	worklogType := types[worklogTypeName]
 	if worklogType == nil {
-		return config{}, errors.New("worklog node type missing")
+		return restFallbackConfig, nil
 	}
 	for _, name := range []string{"worklogId", "author", "timeSpent", "created", "updated", "startDate"} {
-		if field(worklogType, name) == nil {
-			return config{}, fmt.Errorf("worklog missing %s field", name)
+		if codegen.GetField(worklogType, name) == nil {
+			return restFallbackConfig, nil
 		}
 	}
-	estimateType := types[unwrapNamedType(field(worklogType, "timeSpent")["type"])]
-	if estimateType == nil || field(estimateType, "timeInSeconds") == nil {
-		return config{}, errors.New("worklog.timeSpent.timeInSeconds missing")
+	estimateTypeName, _ := codegen.UnwrapNamedType(codegen.GetField(worklogType, "timeSpent")["type"])
+	estimateType := types[estimateTypeName]
+	if estimateType == nil || codegen.GetField(estimateType, "timeInSeconds") == nil {
+		return restFallbackConfig, nil
 	}
 	userType := types["User"]
-	if userType == nil || field(userType, "accountId") == nil || field(userType, "name") == nil {
-		return config{}, errors.New("User.accountId or User.name missing")
+	if userType == nil || codegen.GetField(userType, "accountId") == nil || codegen.GetField(userType, "name") == nil {
+		return restFallbackConfig, nil
 	}
 
 	return config{
+		Backend:              "graphql",
 		PageInfoHasEndCursor: pageInfoHasEndCursor,
 		EdgeHasCursor:        edgeHasCursor,
 	}, nil
 }
 
-func typesMap(schema map[string]any) map[string]map[string]any {
-	out := map[string]map[string]any{}
-	raw, ok := schema["types"].([]any)
-	if !ok {
-		return out
-	}
-	for _, item := range raw {
-		obj, ok := item.(map[string]any)
-		if !ok {
-			continue
-		}
-		if name, ok := obj["name"].(string); ok && name != "" {
-			out[name] = obj
-		}
-	}
-	return out
-}
-
-func queryTypeName(schema map[string]any) (string, error) {
-	raw, ok := schema["queryType"].(map[string]any)
-	if !ok {
-		return "", errors.New("missing queryType")
-	}
-	name, ok := raw["name"].(string)
-	if !ok || name == "" {
-		return "", errors.New("missing queryType.name")
-	}
-	return name, nil
-}
-
-func field(typeDef map[string]any, name string) map[string]any {
-	raw, ok := typeDef["fields"].([]any)
-	if !ok {
-		return nil
-	}
-	for _, item := range raw {
-		obj, ok := item.(map[string]any)
-		if !ok {
-			continue
-		}
-		if obj["name"] == name {
-			return obj
-		}
-	}
-	return nil
-}
-
-func arg(fieldDef map[string]any, name string) map[string]any {
-	raw, ok := fieldDef["args"].([]any)
-	if !ok {
-		return nil
-	}
-	for _, item := range raw {
-		obj, ok := item.(map[string]any)
-		if !ok {
-			continue
-		}
-		if obj["name"] == name {
-			return obj
-		}
-	}
-	return nil
-}
-
-func unwrapNamedType(ref any) string {
-	cur, ok := ref.(map[string]any)
-	if !ok {
-		return ""
-	}
-	for i := 0; i < 16; i++ {
-		if name, ok := cur["name"].(string); ok && name != "" {
-			return name
-		}
-		next, ok := cur["ofType"].(map[string]any)
-		if !ok {
-			return ""
-		}
-		cur = next
-	}
-	return ""
-}
-
 func renderGo(cfg config) string {
 	return fmt.Sprintf(`// Code generated by go/tools/generate_jira_worklog_models/main.go. DO NOT EDIT.
 package gen
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"atlassian/atlassian"
 )
 
+// WorklogsBackend records which API this introspection supported at generation time: "graphql"
+// when issue.worklogs was a Relay connection shaped as discoverConfig expects, "rest" otherwise.
+// graph.Client checks this to decide whether to issue JiraIssueWorklogsPageQuery or call
+// FetchIssueWorklogsPageREST page by page; both code paths below are always generated so
+// switching backends across a regeneration never breaks callers.
+const WorklogsBackend = %q
+
 const (
 	WorklogsPageInfoHasEndCursor = %t
 	WorklogsEdgeHasCursor        = %t
@@ -461,6 +279,35 @@ type JiraIssueWorklogsPageData struct {
 	} `+"`json:\"issue\"`"+`
 }
 
+// JiraWorklogsPageOptions configures per-call timeout/deadline semantics around an issue's
+// worklogs connection. Deadline, if non-zero, bounds the whole paginated walk (every page an
+// auto-paginator fetches); Timeout, if non-zero, is re-applied to each individual page fetch, so a
+// slow page can't eat into the next page's budget. RetryBudget is reserved for a future retry
+// policy and is currently unused by graph.Client.
+type JiraWorklogsPageOptions struct {
+	Timeout     time.Duration
+	Deadline    time.Time
+	RetryBudget int
+}
+
+// ResolveJiraWorklogsPageDeadline derives a child context from ctx honoring whichever of
+// opts.Deadline/opts.Timeout is tighter, returning ctx unchanged (with a no-op cancel) when
+// neither is set. Callers walking a paginated connection call this once per page with the page's
+// own ctx, so the per-page Timeout resets on every cursor fetch instead of accumulating.
+func ResolveJiraWorklogsPageDeadline(ctx context.Context, opts JiraWorklogsPageOptions) (context.Context, context.CancelFunc) {
+	deadline := opts.Deadline
+	if opts.Timeout > 0 {
+		byTimeout := time.Now().Add(opts.Timeout)
+		if deadline.IsZero() || byTimeout.Before(deadline) {
+			deadline = byTimeout
+		}
+	}
+	if deadline.IsZero() {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(ctx, deadline)
+}
+
 func DecodeIssueWorklogsPage(data map[string]any) (*JiraWorklogConnection, error) {
 	b, err := json.Marshal(data)
 	if err != nil {
@@ -476,5 +323,107 @@ func DecodeIssueWorklogsPage(data map[string]any) (*JiraWorklogConnection, error
 	conn := out.Issue.Worklogs
 	return &conn, nil
 }
-`, cfg.PageInfoHasEndCursor, cfg.EdgeHasCursor)
+
+// FetchIssueWorklogsPageREST fetches one page of an issue's worklogs via the Jira REST v3 API,
+// for tenants whose introspection doesn't expose issue.worklogs as a Relay connection (unified
+// GraphQL not rolled out, or ATLASSIAN_GQL_EXPERIMENTAL_APIS omits the Worklogs API). after
+// encodes the REST startAt offset to resume from; the returned connection synthesises
+// pageInfo.hasNextPage/endCursor and each edge's cursor from startAt/maxResults/total so callers
+// built against the GraphQL shape don't need to special-case the REST backend.
+// Ref: GET /rest/api/3/issue/{key}/worklog?startAt=&maxResults=
+func FetchIssueWorklogsPageREST(ctx context.Context, httpClient *http.Client, baseURL string, auth atlassian.AuthProvider, issueKey string, first int, after *string) (*JiraWorklogConnection, error) {
+	issueKey = strings.TrimSpace(issueKey)
+	if issueKey == "" {
+		return nil, errors.New("issueKey is required")
+	}
+	if first <= 0 {
+		first = 50
+	}
+	startAt := 0
+	if after != nil {
+		v, err := strconv.Atoi(strings.TrimSpace(*after))
+		if err != nil {
+			return nil, fmt.Errorf("invalid after cursor %q: %w", *after, err)
+		}
+		startAt = v
+	}
+
+	url := fmt.Sprintf("%s/rest/api/3/issue/%s/worklog?startAt=%d&maxResults=%d", strings.TrimRight(baseURL, "/"), issueKey, startAt, first)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if auth != nil {
+		if err := auth.Apply(req); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("GET %s: unexpected status %d: %s", url, resp.StatusCode, string(body))
+	}
+
+	var raw struct {
+		StartAt  int `+"`json:\"startAt\"`"+`
+		Total    int `+"`json:\"total\"`"+`
+		Worklogs []struct {
+			ID     string `+"`json:\"id\"`"+`
+			Author *struct {
+				AccountID   string `+"`json:\"accountId\"`"+`
+				DisplayName string `+"`json:\"displayName\"`"+`
+			} `+"`json:\"author\"`"+`
+			TimeSpentSeconds *int   `+"`json:\"timeSpentSeconds\"`"+`
+			Created          string `+"`json:\"created\"`"+`
+			Updated          string `+"`json:\"updated\"`"+`
+			Started          string `+"`json:\"started\"`"+`
+		} `+"`json:\"worklogs\"`"+`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("decode worklog REST response: %w", err)
+	}
+
+	edges := make([]JiraWorklogEdge, len(raw.Worklogs))
+	for i, w := range raw.Worklogs {
+		node := JiraWorklogNode{
+			WorklogID: w.ID,
+			TimeSpent: WorklogEstimate{TimeInSeconds: w.TimeSpentSeconds},
+			Created:   w.Created,
+		}
+		if w.Updated != "" {
+			updated := w.Updated
+			node.Updated = &updated
+		}
+		if w.Started != "" {
+			started := w.Started
+			node.StartDate = &started
+		}
+		if w.Author != nil {
+			node.Author = &WorklogUser{AccountID: w.Author.AccountID, Name: w.Author.DisplayName}
+		}
+		cursor := strconv.Itoa(raw.StartAt + i + 1)
+		edges[i] = JiraWorklogEdge{Cursor: &cursor, Node: node}
+	}
+
+	nextStart := raw.StartAt + len(raw.Worklogs)
+	hasNext := nextStart < raw.Total
+	var endCursor *string
+	if hasNext {
+		cursor := strconv.Itoa(nextStart)
+		endCursor = &cursor
+	}
+
+	return &JiraWorklogConnection{
+		PageInfo: WorklogPageInfo{HasNextPage: hasNext, EndCursor: endCursor},
+		Edges:    edges,
+	}, nil
+}
+`, cfg.Backend, cfg.PageInfoHasEndCursor, cfg.EdgeHasCursor)
 }
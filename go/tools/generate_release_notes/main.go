@@ -0,0 +1,478 @@
+// Command generate_release_notes walks a git revision range, extracts the Jira issue keys
+// referenced by its commits, resolves each one through the Jira GraphQL API exactly once, and
+// renders grouped Markdown release notes.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/graph"
+	"atlassian/atlassian/graph/gen"
+)
+
+const commitSeparator = "\x1e"
+const fieldSeparator = "\x1f"
+
+// defaultKeyPattern mirrors git-chglog's header.pattern_maps convention: a named JiraIssueId
+// capture group lets callers swap in their own pattern (e.g. a project-specific prefix) without
+// losing the ability to pull just the key out of a larger match.
+const defaultKeyPattern = `(?P<JiraIssueId>[A-Z][A-Z0-9]+-\d+)`
+
+var defaultTypeMap = map[string]string{
+	"Story": "Features",
+	"Bug":   "Fixes",
+	"Task":  "Chores",
+}
+
+func main() {
+	revRange := flag.String("range", "", "git revision range to scan, e.g. 'v1.2.0..v1.3.0' (required)")
+	repoDir := flag.String("repo", ".", "path to the git repository")
+	keyPattern := flag.String("key-pattern", defaultKeyPattern, "regexp used to find Jira issue keys in commit subjects/bodies/branch names")
+	typeMapPath := flag.String("type-map", "", "path to a 'GraphQLType: Section' mapping file; unmapped types fall under Other")
+	cachePath := flag.String("cache", ".release-notes-cache.json", "path to the resolved-issue cache file")
+	outPath := flag.String("out", "", "output Markdown path (default: stdout)")
+	failOnUnresolved := flag.Bool("fail-on-unresolved", false, "exit non-zero if a referenced issue key can't be fetched")
+	flag.Parse()
+
+	if strings.TrimSpace(*revRange) == "" {
+		fmt.Fprintln(os.Stderr, "missing required -range flag")
+		os.Exit(2)
+	}
+
+	pattern, err := regexp.Compile(*keyPattern)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid -key-pattern: %s\n", err)
+		os.Exit(2)
+	}
+
+	typeMap := defaultTypeMap
+	if strings.TrimSpace(*typeMapPath) != "" {
+		typeMap, err = loadTypeMap(*typeMapPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	}
+
+	cloudID := strings.TrimSpace(os.Getenv("ATLASSIAN_CLOUD_ID"))
+	if cloudID == "" {
+		cloudID = strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_CLOUD_ID"))
+	}
+	baseURL := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_BASE_URL"))
+	browseURLTemplate := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_BROWSE_URL_TEMPLATE"))
+	if browseURLTemplate == "" && baseURL != "" {
+		browseURLTemplate = strings.TrimRight(baseURL, "/") + "/browse/{key}"
+	}
+
+	commits, err := readCommits(*repoDir, *revRange)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	refs := collectIssueRefs(pattern, commits, headRef(*revRange))
+	if len(refs) == 0 {
+		fmt.Fprintln(os.Stderr, "no Jira issue keys found in range", *revRange)
+		return
+	}
+
+	if cloudID == "" || baseURL == "" {
+		fmt.Fprintln(os.Stderr, "ATLASSIAN_CLOUD_ID (or ATLASSIAN_JIRA_CLOUD_ID) and ATLASSIAN_JIRA_BASE_URL must be set")
+		os.Exit(2)
+	}
+	auth := buildAuthFromEnv()
+	if auth == nil {
+		fmt.Fprintln(os.Stderr, "no credentials available in env vars")
+		os.Exit(2)
+	}
+	client := &graph.Client{
+		BaseURL:    baseURL,
+		Auth:       auth,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+
+	store := loadCache(*cachePath)
+
+	var resolved []resolvedIssue
+	var unresolved []string
+	for _, ref := range refs {
+		issue, err := resolveIssue(context.Background(), client, &store, cloudID, ref)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: unresolved issue %s: %s\n", ref.Key, err)
+			unresolved = append(unresolved, ref.Key)
+			continue
+		}
+		resolved = append(resolved, *issue)
+	}
+
+	if err := saveCache(*cachePath, store); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: saving cache: %s\n", err)
+	}
+
+	markdown := renderMarkdown(resolved, typeMap, browseURLTemplate)
+	if strings.TrimSpace(*outPath) == "" {
+		fmt.Print(markdown)
+	} else if err := os.WriteFile(*outPath, []byte(markdown), 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if *failOnUnresolved && len(unresolved) > 0 {
+		fmt.Fprintf(os.Stderr, "failing: %d unresolved issue(s): %s\n", len(unresolved), strings.Join(unresolved, ", "))
+		os.Exit(1)
+	}
+}
+
+// commitRef is the minimal information readCommits needs per commit: its SHA and full message,
+// subject and body (which, for a typical commit, already includes any trailer lines).
+type commitRef struct {
+	SHA     string
+	Subject string
+	Body    string
+}
+
+// readCommits runs `git log` over revRange and returns one commitRef per commit, oldest first, so
+// issueRef.Commit ends up attributing each issue to the first commit that referenced it.
+func readCommits(repoDir string, revRange string) ([]commitRef, error) {
+	format := strings.Join([]string{"%H", "%s", "%b"}, fieldSeparator) + commitSeparator
+	cmd := exec.Command("git", "log", "--reverse", "--format="+format, revRange)
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log %s: %w", revRange, err)
+	}
+
+	var commits []commitRef
+	for _, raw := range strings.Split(string(out), commitSeparator) {
+		raw = strings.Trim(raw, "\n")
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		fields := strings.SplitN(raw, fieldSeparator, 3)
+		if len(fields) < 2 {
+			continue
+		}
+		commit := commitRef{SHA: fields[0], Subject: fields[1]}
+		if len(fields) == 3 {
+			commit.Body = strings.TrimSpace(fields[2])
+		}
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
+// headRef returns the ref on the right-hand side of a "from..to" revision range (or revRange
+// itself if it isn't a range), so its name can be scanned for issue keys the same as a branch
+// name, e.g. "release/PROJ-123-checkout".
+func headRef(revRange string) string {
+	if idx := strings.LastIndex(revRange, ".."); idx >= 0 {
+		return revRange[idx+2:]
+	}
+	return revRange
+}
+
+// issueRef is one Jira issue key discovered in the scanned range, together with the first commit
+// that referenced it.
+type issueRef struct {
+	Key    string
+	Commit commitRef
+}
+
+// collectIssueRefs scans every commit's subject, body, and trailers (already folded into Body by
+// readCommits), plus rangeHead (the range's branch-like upper bound), for issue keys matching
+// pattern. Keys are returned in order of first appearance, each attributed to the first commit
+// that mentioned it.
+func collectIssueRefs(pattern *regexp.Regexp, commits []commitRef, rangeHead string) []issueRef {
+	seen := map[string]bool{}
+	var refs []issueRef
+	for _, commit := range commits {
+		for _, key := range extractKeys(pattern, commit.Subject+"\n"+commit.Body) {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			refs = append(refs, issueRef{Key: key, Commit: commit})
+		}
+	}
+	for _, key := range extractKeys(pattern, rangeHead) {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		refs = append(refs, issueRef{Key: key})
+	}
+	return refs
+}
+
+// extractKeys returns every issue key text matches against pattern, in order and with repeats
+// kept (collectIssueRefs drops the duplicates), preferring the
+// "JiraIssueId" named capture group when pattern defines one (so callers can supply a pattern
+// with surrounding context) and falling back to the whole match otherwise.
+func extractKeys(pattern *regexp.Regexp, text string) []string {
+	groupIndex := -1
+	for i, name := range pattern.SubexpNames() {
+		if name == "JiraIssueId" {
+			groupIndex = i
+			break
+		}
+	}
+
+	var keys []string
+	for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+		if groupIndex >= 0 && groupIndex < len(match) && match[groupIndex] != "" {
+			keys = append(keys, match[groupIndex])
+		} else if len(match) > 0 {
+			keys = append(keys, match[0])
+		}
+	}
+	return keys
+}
+
+// resolvedIssue is one issue ready to render: the fields pulled from Jira plus the commit it was
+// first referenced by.
+type resolvedIssue struct {
+	Key       string
+	IssueType string
+	Status    string
+	Summary   string
+	Assignee  string
+	CommitSHA string
+}
+
+// cacheEntry is the resolved data for one issue as of UpdatedAt (the issue's updatedField.
+// dateTime at fetch time), so a stale entry left behind by a past release can be told apart from
+// a current one if the cache file is ever inspected by hand.
+type cacheEntry struct {
+	CloudID   string `json:"cloudId"`
+	Key       string `json:"key"`
+	UpdatedAt string `json:"updatedAt"`
+	IssueType string `json:"issueType"`
+	Status    string `json:"status"`
+	Summary   string `json:"summary"`
+	Assignee  string `json:"assignee"`
+}
+
+// cacheFile is the on-disk shape of the release-notes cache: a flat map from cacheKey(cloudId,
+// key) to the issue's last-fetched data. Re-running over the same range is then a pure cache
+// read with no Jira calls at all.
+type cacheFile struct {
+	Entries map[string]cacheEntry `json:"entries"`
+}
+
+func cacheKey(cloudID, key string) string {
+	return cloudID + "/" + key
+}
+
+func loadCache(path string) cacheFile {
+	store := cacheFile{Entries: map[string]cacheEntry{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return store
+	}
+	if err := json.Unmarshal(data, &store); err != nil || store.Entries == nil {
+		return cacheFile{Entries: map[string]cacheEntry{}}
+	}
+	return store
+}
+
+func saveCache(path string, store cacheFile) error {
+	data, err := json.MarshalIndent(store, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// resolveIssue returns ref's issue data from store's cache if present, otherwise fetches it via
+// client.GetIssueByKeyWithOptions (requesting the summary section, which atlassian.JiraIssue
+// doesn't carry) and records the result in store for next time.
+func resolveIssue(ctx context.Context, client *graph.Client, store *cacheFile, cloudID string, ref issueRef) (*resolvedIssue, error) {
+	key := cacheKey(cloudID, ref.Key)
+	if cached, ok := store.Entries[key]; ok {
+		return &resolvedIssue{
+			Key:       ref.Key,
+			IssueType: cached.IssueType,
+			Status:    cached.Status,
+			Summary:   cached.Summary,
+			Assignee:  cached.Assignee,
+			CommitSHA: ref.Commit.SHA,
+		}, nil
+	}
+
+	node, err := client.GetIssueByKeyWithOptions(ctx, cloudID, ref.Key, gen.JiraIssueByKeyOptions{IncludeSummary: true})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := cacheEntry{
+		CloudID:   cloudID,
+		Key:       ref.Key,
+		IssueType: node.IssueType.Name,
+		Status:    node.Status.Name,
+	}
+	if node.UpdatedField.DateTime != nil {
+		entry.UpdatedAt = *node.UpdatedField.DateTime
+	}
+	if node.Summary != nil {
+		entry.Summary = *node.Summary
+	}
+	if node.AssigneeField != nil && node.AssigneeField.User != nil {
+		entry.Assignee = node.AssigneeField.User.Name
+	}
+	store.Entries[key] = entry
+
+	return &resolvedIssue{
+		Key:       ref.Key,
+		IssueType: entry.IssueType,
+		Status:    entry.Status,
+		Summary:   entry.Summary,
+		Assignee:  entry.Assignee,
+		CommitSHA: ref.Commit.SHA,
+	}, nil
+}
+
+// loadTypeMap reads a flat "GraphQLIssueTypeName: Section" mapping, one per line, in the same
+// minimal key:-value style as generate_jira_issue_models's oauth_tokens.txt loader: blank lines
+// and lines starting with # are skipped, and surrounding double quotes around the value are stripped.
+func loadTypeMap(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading type map %s: %w", path, err)
+	}
+	out := map[string]string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		colon := strings.Index(trimmed, ":")
+		if colon <= 0 {
+			continue
+		}
+		k := strings.TrimSpace(trimmed[:colon])
+		v := strings.TrimSpace(trimmed[colon+1:])
+		if len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"' {
+			v = v[1 : len(v)-1]
+		}
+		if k == "" || v == "" {
+			continue
+		}
+		out[k] = v
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("type map %s defines no entries", path)
+	}
+	return out, nil
+}
+
+// renderMarkdown groups issues by their mapped section (falling back to "Other" for an
+// unrecognized IssueType) and, within each section, by Status, rendering a
+// "- [KEY](url) Summary — @assignee (sha)" line per issue.
+func renderMarkdown(issues []resolvedIssue, typeMap map[string]string, browseURLTemplate string) string {
+	type statusGroup struct {
+		status string
+		issues []resolvedIssue
+	}
+	sections := map[string][]resolvedIssue{}
+	for _, issue := range issues {
+		section := typeMap[issue.IssueType]
+		if section == "" {
+			section = "Other"
+		}
+		sections[section] = append(sections[section], issue)
+	}
+
+	sectionNames := make([]string, 0, len(sections))
+	for name := range sections {
+		if name != "Other" {
+			sectionNames = append(sectionNames, name)
+		}
+	}
+	sort.Strings(sectionNames)
+	if _, ok := sections["Other"]; ok {
+		sectionNames = append(sectionNames, "Other")
+	}
+
+	var b strings.Builder
+	for _, section := range sectionNames {
+		fmt.Fprintf(&b, "## %s\n\n", section)
+
+		byStatus := map[string][]resolvedIssue{}
+		for _, issue := range sections[section] {
+			byStatus[issue.Status] = append(byStatus[issue.Status], issue)
+		}
+		statusNames := make([]string, 0, len(byStatus))
+		for name := range byStatus {
+			statusNames = append(statusNames, name)
+		}
+		sort.Strings(statusNames)
+
+		var groups []statusGroup
+		for _, status := range statusNames {
+			group := byStatus[status]
+			sort.Slice(group, func(i, j int) bool { return group[i].Key < group[j].Key })
+			groups = append(groups, statusGroup{status: status, issues: group})
+		}
+
+		for _, group := range groups {
+			fmt.Fprintf(&b, "### %s\n\n", group.status)
+			for _, issue := range group.issues {
+				fmt.Fprintf(&b, "- %s %s — %s", issueLink(issue.Key, browseURLTemplate), issue.Summary, assigneeMention(issue.Assignee))
+				if issue.CommitSHA != "" {
+					fmt.Fprintf(&b, " (%s)", shortSHA(issue.CommitSHA))
+				}
+				b.WriteString("\n")
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func issueLink(key, browseURLTemplate string) string {
+	if browseURLTemplate == "" {
+		return key
+	}
+	return fmt.Sprintf("[%s](%s)", key, strings.ReplaceAll(browseURLTemplate, "{key}", key))
+}
+
+func assigneeMention(assignee string) string {
+	if strings.TrimSpace(assignee) == "" {
+		return "unassigned"
+	}
+	return "@" + assignee
+}
+
+func shortSHA(sha string) string {
+	if len(sha) <= 12 {
+		return sha
+	}
+	return sha[:12]
+}
+
+func buildAuthFromEnv() atlassian.AuthProvider {
+	token := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN"))
+	email := strings.TrimSpace(os.Getenv("ATLASSIAN_EMAIL"))
+	apiToken := strings.TrimSpace(os.Getenv("ATLASSIAN_API_TOKEN"))
+
+	if token != "" {
+		return atlassian.BearerAuth{
+			TokenGetter: func() (string, error) { return token, nil },
+		}
+	}
+	if email != "" && apiToken != "" {
+		return atlassian.BasicAPITokenAuth{Email: email, Token: apiToken}
+	}
+	return nil
+}
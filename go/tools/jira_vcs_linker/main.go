@@ -0,0 +1,172 @@
+// Command jira_vcs_linker scans a git log range for conventional-commit style messages,
+// extracts referenced Jira issue keys, and links each one via atlassian/vcs.Linker: it
+// comments and remote-links the commit, transitions the issue based on its conventional-commit
+// type, and optionally attaches a fix version.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+	"atlassian/atlassian/vcs"
+)
+
+const commitSeparator = "\x1e"
+const fieldSeparator = "\x1f"
+
+func main() {
+	revRange := flag.String("range", "", "git revision range to scan, e.g. 'origin/main..HEAD' (required)")
+	repoDir := flag.String("repo", ".", "path to the git repository")
+	fixVersion := flag.String("fix-version", "", "fix version name to attach to every referenced issue")
+	remoteURLTemplate := flag.String("remote-url-template", "", "template for the remote link URL, with {sha} substituted")
+	dryRun := flag.Bool("dry-run", false, "print what would be done without calling the Jira API")
+	flag.Parse()
+
+	if strings.TrimSpace(*revRange) == "" {
+		fmt.Fprintln(os.Stderr, "missing required -range flag")
+		os.Exit(2)
+	}
+
+	cloudID := strings.TrimSpace(os.Getenv("ATLASSIAN_CLOUD_ID"))
+	if cloudID == "" {
+		cloudID = strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_CLOUD_ID"))
+	}
+	baseURL := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_BASE_URL"))
+	if cloudID == "" || baseURL == "" {
+		fmt.Fprintln(os.Stderr, "ATLASSIAN_JIRA_CLOUD_ID (or ATLASSIAN_CLOUD_ID) and ATLASSIAN_JIRA_BASE_URL must be set")
+		os.Exit(2)
+	}
+
+	commits, err := readCommits(*repoDir, *revRange, *remoteURLTemplate)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	linker := vcs.NewLinker(&rest.JiraRESTClient{
+		BaseURL:    baseURL,
+		Auth:       buildAuthFromEnv(),
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}, cloudID)
+	linker.Transitions = parseTransitionsFromEnv()
+	linker.FixVersion = strings.TrimSpace(*fixVersion)
+	linker.SkipComment = *dryRun
+	linker.SkipRemoteLink = *dryRun
+
+	if linker.Auth == nil && !*dryRun {
+		fmt.Fprintln(os.Stderr, "no credentials available in env vars")
+		os.Exit(2)
+	}
+
+	exitCode := 0
+	for _, commit := range commits {
+		if *dryRun {
+			for _, key := range vcs.ExtractIssueKeys(commit.Subject + "\n" + commit.Body) {
+				fmt.Printf("%s: would link %s (%s)\n", shortSHA(commit.SHA), key, commit.Subject)
+			}
+			continue
+		}
+		results, err := linker.ProcessCommit(context.Background(), commit)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", shortSHA(commit.SHA), err)
+			exitCode = 1
+			continue
+		}
+		for _, result := range results {
+			if result.Err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", shortSHA(commit.SHA), result.Err)
+				exitCode = 1
+				continue
+			}
+			fmt.Printf("%s: linked %s (commented=%v remoteLinked=%v transitionedTo=%q fixVersionSet=%v)\n",
+				shortSHA(commit.SHA), result.IssueKey, result.Commented, result.RemoteLinked, result.TransitionedTo, result.FixVersionSet)
+		}
+	}
+	os.Exit(exitCode)
+}
+
+// readCommits runs `git log` over revRange and returns one vcs.Commit per commit, oldest first.
+func readCommits(repoDir string, revRange string, remoteURLTemplate string) ([]vcs.Commit, error) {
+	format := strings.Join([]string{"%H", "%s", "%b"}, fieldSeparator) + commitSeparator
+	cmd := exec.Command("git", "log", "--reverse", "--format="+format, revRange)
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log %s: %w", revRange, err)
+	}
+
+	var commits []vcs.Commit
+	for _, raw := range strings.Split(string(out), commitSeparator) {
+		raw = strings.Trim(raw, "\n")
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		fields := strings.SplitN(raw, fieldSeparator, 3)
+		if len(fields) < 2 {
+			continue
+		}
+		commit := vcs.Commit{SHA: fields[0], Subject: fields[1]}
+		if len(fields) == 3 {
+			commit.Body = strings.TrimSpace(fields[2])
+		}
+		if remoteURLTemplate != "" {
+			commit.URL = strings.ReplaceAll(remoteURLTemplate, "{sha}", commit.SHA)
+		}
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
+// parseTransitionsFromEnv reads ATLASSIAN_VCS_LINKER_TRANSITIONS, a comma-separated list of
+// type=status pairs (e.g. "feat=In Review,fix=Done"), into a commit-type -> status name map.
+func parseTransitionsFromEnv() map[string]string {
+	raw := strings.TrimSpace(os.Getenv("ATLASSIAN_VCS_LINKER_TRANSITIONS"))
+	if raw == "" {
+		return nil
+	}
+	transitions := map[string]string{}
+	for _, pair := range strings.Split(raw, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(kv[0]))
+		value := strings.TrimSpace(kv[1])
+		if key == "" || value == "" {
+			continue
+		}
+		transitions[key] = value
+	}
+	return transitions
+}
+
+func shortSHA(sha string) string {
+	if len(sha) <= 12 {
+		return sha
+	}
+	return sha[:12]
+}
+
+func buildAuthFromEnv() atlassian.AuthProvider {
+	token := strings.TrimSpace(os.Getenv("ATLASSIAN_OAUTH_ACCESS_TOKEN"))
+	email := strings.TrimSpace(os.Getenv("ATLASSIAN_EMAIL"))
+	apiToken := strings.TrimSpace(os.Getenv("ATLASSIAN_API_TOKEN"))
+
+	if token != "" {
+		return atlassian.BearerAuth{
+			TokenGetter: func() (string, error) { return token, nil },
+		}
+	}
+	if email != "" && apiToken != "" {
+		return atlassian.BasicAPITokenAuth{Email: email, Token: apiToken}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwtest builds real terraform-plugin-framework datasource.ReadRequest/ReadResponse
+// values from a plain tftypes.Value config and invokes a datasource's Read, so a test can assert
+// on its mapped state end-to-end instead of constructing the datasource struct by hand and
+// poking its unexported providerData field, or skipping Read entirely because mocking
+// ReadRequest/ReadResponse looked like it needed a full terraform-plugin-testing runner.
+package fwtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ReadOptions configures ReadDataSource.
+type ReadOptions struct {
+	// ProviderData is passed to ds.Configure before Read runs, exactly as the real provider's
+	// Configure method does once it's parsed its own configuration block. Leave nil to exercise
+	// a datasource that hasn't been configured yet.
+	ProviderData any
+}
+
+// ReadDataSource drives ds's Schema, (optionally) Configure, and Read methods the way Terraform
+// core would for a real `data` block: it builds req.Config from config (a tftypes.Value matching
+// ds.Schema's type), seeds resp.State with a null value of the same type as the real framework
+// server does before calling Read, and returns the resulting state and diagnostics.
+func ReadDataSource(ctx context.Context, t *testing.T, ds datasource.DataSource, config tftypes.Value, opts ReadOptions) (*tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("fwtest: datasource Schema() returned diagnostics: %s", schemaResp.Diagnostics)
+	}
+
+	if opts.ProviderData != nil {
+		configureResp := &datasource.ConfigureResponse{}
+		ds.Configure(ctx, datasource.ConfigureRequest{ProviderData: opts.ProviderData}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("fwtest: datasource Configure() returned diagnostics: %s", configureResp.Diagnostics)
+		}
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    config,
+			Schema: schemaResp.Schema,
+		},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Raw:    tftypes.NewValue(config.Type(), nil),
+			Schema: schemaResp.Schema,
+		},
+	}
+
+	ds.Read(ctx, req, resp)
+
+	return &resp.State, resp.Diagnostics
+}
+
+// AssertNoErrorDiagnostics fails t, printing every error diagnostic's summary and detail, if
+// diags contains one or more errors. It's a no-op for warning-only or empty diags.
+func AssertNoErrorDiagnostics(t *testing.T, diags diag.Diagnostics) {
+	t.Helper()
+	if !diags.HasError() {
+		return
+	}
+	for _, d := range diags.Errors() {
+		t.Errorf("%s: %s", d.Summary(), d.Detail())
+	}
+	t.FailNow()
+}
+
+// MustGetAttr reads attrPath out of state into a new T, failing t via AssertNoErrorDiagnostics on
+// any diagnostic (most commonly a typo'd path or a T that doesn't match the attribute's schema
+// type).
+func MustGetAttr[T any](t *testing.T, state *tfsdk.State, attrPath path.Path) T {
+	t.Helper()
+	var out T
+	diags := state.GetAttribute(context.Background(), attrPath, &out)
+	AssertNoErrorDiagnostics(t, diags)
+	return out
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian/atlassian/rest"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BoardResource{}
+var _ resource.ResourceWithImportState = &BoardResource{}
+
+func NewBoardResource() resource.Resource {
+	return &BoardResource{}
+}
+
+// BoardResource defines the resource implementation.
+type BoardResource struct {
+	providerData *JiraProviderData
+}
+
+// BoardResourceModel describes the resource data model.
+type BoardResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	FilterID types.Int64  `tfsdk:"filter_id"`
+}
+
+func (r *BoardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_board"
+}
+
+func (r *BoardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jira Agile board. The Agile REST API has no board update endpoint, so every " +
+			"attribute requires replacement of the board on change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The board ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The board name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The board type: 'scrum' or 'kanban'.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filter_id": schema.Int64Attribute{
+				Description: "The ID of the saved filter that determines which issues appear on the board.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BoardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *BoardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BoardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.providerData.Client.CreateBoard(ctx, rest.CreateBoardInput{
+		Name:     data.Name.ValueString(),
+		Type:     data.Type.ValueString(),
+		FilterID: int(data.FilterID.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Jira board", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(created.ID)
+	data.Name = types.StringValue(created.Name)
+	data.Type = types.StringValue(created.Type)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BoardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BoardResourceModel
+
+	// Read Terraform current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.providerData.Client.GetBoard(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the board is gone, drop it from state so Terraform recreates it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(current.Name)
+	data.Type = types.StringValue(current.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never expected to run a change against Jira: every attribute is RequiresReplace, so
+// the framework always plans a destroy/create instead. It's implemented to satisfy
+// resource.Resource and simply persists the plan, in case a future Computed-only field changes
+// that.
+func (r *BoardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BoardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BoardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BoardResourceModel
+
+	// Read Terraform current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.providerData.Client.DeleteBoard(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting Jira board", err.Error())
+		return
+	}
+}
+
+func (r *BoardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
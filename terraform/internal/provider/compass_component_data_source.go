@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian/atlassian"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompassComponentDataSource{}
+
+func NewCompassComponentDataSource() datasource.DataSource {
+	return &CompassComponentDataSource{}
+}
+
+// CompassComponentDataSource defines the data source implementation.
+type CompassComponentDataSource struct {
+	providerData *JiraProviderData
+}
+
+// CompassComponentDataSourceModel describes the data source data model. Exactly one of id or
+// name+type must be set to identify the component.
+type CompassComponentDataSourceModel struct {
+	CloudID       types.String `tfsdk:"cloud_id"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Description   types.String `tfsdk:"description"`
+	OwnerTeamID   types.String `tfsdk:"owner_team_id"`
+	OwnerTeamName types.String `tfsdk:"owner_team_name"`
+	Labels        []string     `tfsdk:"labels"`
+}
+
+func (d *CompassComponentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compass_component"
+}
+
+func (d *CompassComponentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Compass component, either by id or by name+type within the cloud. " +
+			"Schema is symmetric with the compass_component resource's attributes.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The component ID. Either this or name+type must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The component name. Required (with type) when id is not set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The component type. Required (with name) when id is not set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The component description, if set.",
+				Computed:    true,
+			},
+			"owner_team_id": schema.StringAttribute{
+				Description: "The ID of the team that owns the component, if set.",
+				Computed:    true,
+			},
+			"owner_team_name": schema.StringAttribute{
+				Description: "The name of the team that owns the component, if set.",
+				Computed:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "Labels attached to the component.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *CompassComponentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *CompassComponentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CompassComponentDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	id := strings.TrimSpace(data.ID.ValueString())
+	name := strings.TrimSpace(data.Name.ValueString())
+	componentType := strings.TrimSpace(data.Type.ValueString())
+
+	if id == "" && (name == "" || componentType == "") {
+		resp.Diagnostics.AddError(
+			"Missing Component Identifier",
+			"Either id, or both name and type, must be set to look up a Compass component.",
+		)
+		return
+	}
+
+	// Compass has no single-component lookup in this client, so every lookup mode lists all
+	// components for the cloud and filters client-side; acceptable given components.list is
+	// already paginated and cached per-Read.
+	components, err := d.providerData.GraphClient.ListComponents(ctx, cloudID, 50)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Compass components",
+			fmt.Sprintf("Unable to list components for cloud %s: %s", cloudID, err),
+		)
+		return
+	}
+
+	var match *atlassian.CompassComponent
+	for i := range components {
+		c := components[i]
+		if id != "" {
+			if c.ID == id {
+				match = &c
+				break
+			}
+			continue
+		}
+		if c.Name == name && c.Type == componentType {
+			match = &c
+			break
+		}
+	}
+
+	if match == nil {
+		if id != "" {
+			resp.Diagnostics.AddError("Compass Component Not Found", fmt.Sprintf("No component with id %q was found.", id))
+		} else {
+			resp.Diagnostics.AddError("Compass Component Not Found", fmt.Sprintf("No component named %q of type %q was found.", name, componentType))
+		}
+		return
+	}
+
+	model := compassComponentToModel(*match)
+	data = CompassComponentDataSourceModel{
+		CloudID:       types.StringValue(cloudID),
+		ID:            model.ID,
+		Name:          model.Name,
+		Type:          model.Type,
+		Description:   model.Description,
+		OwnerTeamID:   model.OwnerTeamID,
+		OwnerTeamName: model.OwnerTeamName,
+		Labels:        model.Labels,
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
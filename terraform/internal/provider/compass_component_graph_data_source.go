@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/compassgraph"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompassComponentGraphDataSource{}
+
+func NewCompassComponentGraphDataSource() datasource.DataSource {
+	return &CompassComponentGraphDataSource{}
+}
+
+// CompassComponentGraphDataSource defines the data source implementation.
+type CompassComponentGraphDataSource struct {
+	providerData *JiraProviderData
+}
+
+// CompassComponentGraphDataSourceModel describes the data source data model.
+type CompassComponentGraphDataSourceModel struct {
+	CloudID         types.String            `tfsdk:"cloud_id"`
+	RootComponentID types.String            `tfsdk:"root_component_id"`
+	MaxDepth        types.Int64             `tfsdk:"max_depth"`
+	OwnerTeamID     types.String            `tfsdk:"owner_team_id"`
+	ComponentType   types.String            `tfsdk:"component_type"`
+	Label           types.String            `tfsdk:"label"`
+	Components      []CompassComponentModel `tfsdk:"components"`
+	HasCycle        types.Bool              `tfsdk:"has_cycle"`
+}
+
+// CompassComponentModel describes a single Compass component in the transitive dependency set.
+type CompassComponentModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Description   types.String `tfsdk:"description"`
+	OwnerTeamID   types.String `tfsdk:"owner_team_id"`
+	OwnerTeamName types.String `tfsdk:"owner_team_name"`
+	Labels        []string     `tfsdk:"labels"`
+}
+
+func (d *CompassComponentGraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compass_component_graph"
+}
+
+func (d *CompassComponentGraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Walks the Compass component dependency graph from a root component and returns the " +
+			"transitive set of components it depends on, optionally filtered by owner team, component type, " +
+			"or label (e.g. to find services owned by a given team that depend on a deprecated component).",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"root_component_id": schema.StringAttribute{
+				Description: "The Compass component ID to start the dependency walk from.",
+				Required:    true,
+			},
+			"max_depth": schema.Int64Attribute{
+				Description: "How many relationship hops to walk outward from root_component_id. Defaults to 5.",
+				Optional:    true,
+			},
+			"owner_team_id": schema.StringAttribute{
+				Description: "If set, only components owned by this team ID are returned.",
+				Optional:    true,
+			},
+			"component_type": schema.StringAttribute{
+				Description: "If set, only components of this type are returned.",
+				Optional:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "If set, only components carrying this label are returned.",
+				Optional:    true,
+			},
+			"has_cycle": schema.BoolAttribute{
+				Description: "Whether a dependency cycle was detected anywhere in the walked graph.",
+				Computed:    true,
+			},
+			"components": schema.ListNestedAttribute{
+				Description: "The transitive set of components root_component_id depends on, after filtering.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The component ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The component name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The component type.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The component description, if set.",
+							Computed:    true,
+						},
+						"owner_team_id": schema.StringAttribute{
+							Description: "The ID of the team that owns the component, if set.",
+							Computed:    true,
+						},
+						"owner_team_name": schema.StringAttribute{
+							Description: "The name of the team that owns the component, if set.",
+							Computed:    true,
+						},
+						"labels": schema.ListAttribute{
+							Description: "Labels attached to the component.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CompassComponentGraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *CompassComponentGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CompassComponentGraphDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	rootComponentID := data.RootComponentID.ValueString()
+	if rootComponentID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Root Component ID",
+			"root_component_id is required and cannot be empty.",
+		)
+		return
+	}
+
+	maxDepth := 5
+	if !data.MaxDepth.IsNull() && !data.MaxDepth.IsUnknown() {
+		maxDepth = int(data.MaxDepth.ValueInt64())
+	}
+
+	loader := compassgraph.NewLoader(d.providerData.GraphClient)
+	g, err := loader.Load(ctx, cloudID, rootComponentID, maxDepth)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error loading Compass component graph",
+			fmt.Sprintf("Unable to load the dependency graph for component %s: %s", rootComponentID, err),
+		)
+		return
+	}
+
+	ownerTeamID := data.OwnerTeamID.ValueString()
+	componentType := data.ComponentType.ValueString()
+	label := data.Label.ValueString()
+
+	pred := func(c atlassian.CompassComponent) bool {
+		if ownerTeamID != "" && (c.OwnerTeamID == nil || *c.OwnerTeamID != ownerTeamID) {
+			return false
+		}
+		if componentType != "" && !strings.EqualFold(c.Type, componentType) {
+			return false
+		}
+		if label != "" {
+			found := false
+			for _, l := range c.Labels {
+				if l == label {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	matches := g.FilteredBFS(rootComponentID, pred)
+
+	components := make([]CompassComponentModel, 0, len(matches))
+	for _, c := range matches {
+		components = append(components, compassComponentToModel(c))
+	}
+
+	hasCycle := false
+	for _, scc := range g.StronglyConnectedComponents() {
+		if len(scc) > 1 {
+			hasCycle = true
+			break
+		}
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.MaxDepth = types.Int64Value(int64(maxDepth))
+	data.Components = components
+	data.HasCycle = types.BoolValue(hasCycle)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// compassComponentToModel maps an atlassian.CompassComponent to its Terraform model.
+func compassComponentToModel(c atlassian.CompassComponent) CompassComponentModel {
+	m := CompassComponentModel{
+		ID:     types.StringValue(c.ID),
+		Name:   types.StringValue(c.Name),
+		Type:   types.StringValue(c.Type),
+		Labels: c.Labels,
+	}
+	if c.Description != nil {
+		m.Description = types.StringValue(*c.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+	if c.OwnerTeamID != nil {
+		m.OwnerTeamID = types.StringValue(*c.OwnerTeamID)
+	} else {
+		m.OwnerTeamID = types.StringNull()
+	}
+	if c.OwnerTeamName != nil {
+		m.OwnerTeamName = types.StringValue(*c.OwnerTeamName)
+	} else {
+		m.OwnerTeamName = types.StringNull()
+	}
+	return m
+}
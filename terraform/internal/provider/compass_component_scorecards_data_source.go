@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompassComponentScorecardsDataSource{}
+
+func NewCompassComponentScorecardsDataSource() datasource.DataSource {
+	return &CompassComponentScorecardsDataSource{}
+}
+
+// CompassComponentScorecardsDataSource defines the data source implementation.
+type CompassComponentScorecardsDataSource struct {
+	providerData *JiraProviderData
+}
+
+// CompassComponentScorecardsDataSourceModel describes the data source data model.
+type CompassComponentScorecardsDataSourceModel struct {
+	CloudID     types.String                 `tfsdk:"cloud_id"`
+	ComponentID types.String                 `tfsdk:"component_id"`
+	Scores      []CompassScorecardScoreModel `tfsdk:"scores"`
+}
+
+// CompassScorecardScoreModel describes a single scorecard score for a component.
+type CompassScorecardScoreModel struct {
+	ScorecardID   types.String                     `tfsdk:"scorecard_id"`
+	ScorecardName types.String                     `tfsdk:"scorecard_name"`
+	Score         types.Float64                    `tfsdk:"score"`
+	MaxScore      types.Float64                    `tfsdk:"max_score"`
+	EvaluatedAt   types.String                     `tfsdk:"evaluated_at"`
+	Criteria      []CompassScorecardCriterionModel `tfsdk:"criteria"`
+}
+
+// CompassScorecardCriterionModel describes a single criterion within a scorecard score.
+type CompassScorecardCriterionModel struct {
+	ID       types.String  `tfsdk:"id"`
+	Name     types.String  `tfsdk:"name"`
+	Weight   types.Float64 `tfsdk:"weight"`
+	Score    types.Float64 `tfsdk:"score"`
+	MaxScore types.Float64 `tfsdk:"max_score"`
+	Status   types.String  `tfsdk:"status"`
+	Message  types.String  `tfsdk:"message"`
+}
+
+func (d *CompassComponentScorecardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compass_component_scorecards"
+}
+
+func (d *CompassComponentScorecardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches every Compass scorecard score evaluated against a component.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"component_id": schema.StringAttribute{
+				Description: "The Compass component ID to fetch scorecard scores for.",
+				Required:    true,
+			},
+			"scores": schema.ListNestedAttribute{
+				Description: "The scorecard scores evaluated against the component.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scorecard_id": schema.StringAttribute{
+							Description: "The scorecard ID.",
+							Computed:    true,
+						},
+						"scorecard_name": schema.StringAttribute{
+							Description: "The scorecard name, if set.",
+							Computed:    true,
+						},
+						"score": schema.Float64Attribute{
+							Description: "The component's current score against the scorecard.",
+							Computed:    true,
+						},
+						"max_score": schema.Float64Attribute{
+							Description: "The maximum possible score for the scorecard, if known.",
+							Computed:    true,
+						},
+						"evaluated_at": schema.StringAttribute{
+							Description: "When the scorecard was last evaluated, if known.",
+							Computed:    true,
+						},
+						"criteria": schema.ListNestedAttribute{
+							Description: "The per-criterion breakdown of the scorecard score.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The criterion ID.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "The criterion name.",
+										Computed:    true,
+									},
+									"weight": schema.Float64Attribute{
+										Description: "The criterion's weight toward the overall score.",
+										Computed:    true,
+									},
+									"score": schema.Float64Attribute{
+										Description: "The criterion's current score.",
+										Computed:    true,
+									},
+									"max_score": schema.Float64Attribute{
+										Description: "The criterion's maximum possible score, if known.",
+										Computed:    true,
+									},
+									"status": schema.StringAttribute{
+										Description: `The criterion's status, e.g. "pass", "fail", or "unknown".`,
+										Computed:    true,
+									},
+									"message": schema.StringAttribute{
+										Description: "A human-readable message explaining the criterion's status, if set.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CompassComponentScorecardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *CompassComponentScorecardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CompassComponentScorecardsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	componentID := strings.TrimSpace(data.ComponentID.ValueString())
+	if componentID == "" {
+		resp.Diagnostics.AddError("Missing Component ID", "component_id is required and cannot be empty.")
+		return
+	}
+
+	results, err := d.providerData.GraphClient.ListScorecardScores(ctx, cloudID, componentID, 50)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Compass scorecard scores",
+			fmt.Sprintf("Unable to list scorecard scores for component %s: %s", componentID, err),
+		)
+		return
+	}
+
+	scores := make([]CompassScorecardScoreModel, 0, len(results))
+	for _, s := range results {
+		m := CompassScorecardScoreModel{
+			ScorecardID: types.StringValue(s.ScorecardID),
+			Score:       types.Float64Value(s.Score),
+		}
+		if s.ScorecardName != nil {
+			m.ScorecardName = types.StringValue(*s.ScorecardName)
+		} else {
+			m.ScorecardName = types.StringNull()
+		}
+		if s.MaxScore != nil {
+			m.MaxScore = types.Float64Value(*s.MaxScore)
+		} else {
+			m.MaxScore = types.Float64Null()
+		}
+		if s.EvaluatedAt != nil {
+			m.EvaluatedAt = types.StringValue(*s.EvaluatedAt)
+		} else {
+			m.EvaluatedAt = types.StringNull()
+		}
+
+		criteria := make([]CompassScorecardCriterionModel, 0, len(s.Criteria))
+		for _, crit := range s.Criteria {
+			cm := CompassScorecardCriterionModel{
+				ID:     types.StringValue(crit.ID),
+				Name:   types.StringValue(crit.Name),
+				Weight: types.Float64Value(crit.Weight),
+				Score:  types.Float64Value(crit.Score),
+				Status: types.StringValue(crit.Status),
+			}
+			if crit.MaxScore != nil {
+				cm.MaxScore = types.Float64Value(*crit.MaxScore)
+			} else {
+				cm.MaxScore = types.Float64Null()
+			}
+			if crit.Message != nil {
+				cm.Message = types.StringValue(*crit.Message)
+			} else {
+				cm.Message = types.StringNull()
+			}
+			criteria = append(criteria, cm)
+		}
+		m.Criteria = criteria
+
+		scores = append(scores, m)
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Scores = scores
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
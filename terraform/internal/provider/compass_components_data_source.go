@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompassComponentsDataSource{}
+
+func NewCompassComponentsDataSource() datasource.DataSource {
+	return &CompassComponentsDataSource{}
+}
+
+// CompassComponentsDataSource defines the data source implementation.
+type CompassComponentsDataSource struct {
+	providerData *JiraProviderData
+}
+
+// CompassComponentsDataSourceModel describes the data source data model.
+type CompassComponentsDataSourceModel struct {
+	CloudID       types.String             `tfsdk:"cloud_id"`
+	OwnerTeamID   types.String             `tfsdk:"owner_team_id"`
+	ComponentType types.String             `tfsdk:"component_type"`
+	Label         types.String             `tfsdk:"label"`
+	Components    []CompassComponentModel  `tfsdk:"components"`
+}
+
+func (d *CompassComponentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compass_components"
+}
+
+func (d *CompassComponentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches Compass components for a cloud, optionally filtered by owner team, component " +
+			"type, or label.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"owner_team_id": schema.StringAttribute{
+				Description: "If set, only components owned by this team ID are returned.",
+				Optional:    true,
+			},
+			"component_type": schema.StringAttribute{
+				Description: "If set, only components of this type are returned.",
+				Optional:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "If set, only components carrying this label are returned.",
+				Optional:    true,
+			},
+			"components": schema.ListNestedAttribute{
+				Description: "The components matching the criteria.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The component ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The component name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The component type.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The component description, if set.",
+							Computed:    true,
+						},
+						"owner_team_id": schema.StringAttribute{
+							Description: "The ID of the team that owns the component, if set.",
+							Computed:    true,
+						},
+						"owner_team_name": schema.StringAttribute{
+							Description: "The name of the team that owns the component, if set.",
+							Computed:    true,
+						},
+						"labels": schema.ListAttribute{
+							Description: "Labels attached to the component.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CompassComponentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *CompassComponentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CompassComponentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	results, err := d.providerData.GraphClient.ListComponents(ctx, cloudID, 50)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Compass components",
+			fmt.Sprintf("Unable to list components for cloud %s: %s", cloudID, err),
+		)
+		return
+	}
+
+	ownerTeamID := strings.TrimSpace(data.OwnerTeamID.ValueString())
+	componentType := strings.TrimSpace(data.ComponentType.ValueString())
+	label := strings.TrimSpace(data.Label.ValueString())
+
+	components := make([]CompassComponentModel, 0, len(results))
+	for _, c := range results {
+		if ownerTeamID != "" && (c.OwnerTeamID == nil || *c.OwnerTeamID != ownerTeamID) {
+			continue
+		}
+		if componentType != "" && c.Type != componentType {
+			continue
+		}
+		if label != "" {
+			found := false
+			for _, l := range c.Labels {
+				if l == label {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+		components = append(components, compassComponentToModel(c))
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Components = components
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
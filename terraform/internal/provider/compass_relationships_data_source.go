@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CompassRelationshipsDataSource{}
+
+func NewCompassRelationshipsDataSource() datasource.DataSource {
+	return &CompassRelationshipsDataSource{}
+}
+
+// CompassRelationshipsDataSource defines the data source implementation.
+type CompassRelationshipsDataSource struct {
+	providerData *JiraProviderData
+}
+
+// CompassRelationshipsDataSourceModel describes the data source data model.
+type CompassRelationshipsDataSourceModel struct {
+	CloudID        types.String                `tfsdk:"cloud_id"`
+	ComponentID    types.String                `tfsdk:"component_id"`
+	Type           types.String                `tfsdk:"type"`
+	EndComponentID types.String                `tfsdk:"end_component_id"`
+	Relationships  []CompassRelationshipModel  `tfsdk:"relationships"`
+}
+
+// CompassRelationshipModel describes a single Compass relationship edge.
+type CompassRelationshipModel struct {
+	ID               types.String `tfsdk:"id"`
+	Type             types.String `tfsdk:"type"`
+	StartComponentID types.String `tfsdk:"start_component_id"`
+	EndComponentID   types.String `tfsdk:"end_component_id"`
+}
+
+func (d *CompassRelationshipsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compass_relationships"
+}
+
+func (d *CompassRelationshipsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a Compass component's relationship edges, optionally filtered by relationship " +
+			"type or the other endpoint's component ID.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"component_id": schema.StringAttribute{
+				Description: "The Compass component ID to fetch relationships for.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "If set, only relationships of this type are returned.",
+				Optional:    true,
+			},
+			"end_component_id": schema.StringAttribute{
+				Description: "If set, only relationships whose start or end component ID matches this value " +
+					"are returned.",
+				Optional: true,
+			},
+			"relationships": schema.ListNestedAttribute{
+				Description: "The relationships matching the criteria.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The relationship ID.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The relationship type.",
+							Computed:    true,
+						},
+						"start_component_id": schema.StringAttribute{
+							Description: "The ID of the component the relationship starts from.",
+							Computed:    true,
+						},
+						"end_component_id": schema.StringAttribute{
+							Description: "The ID of the component the relationship ends at.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CompassRelationshipsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *CompassRelationshipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CompassRelationshipsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	componentID := strings.TrimSpace(data.ComponentID.ValueString())
+	if componentID == "" {
+		resp.Diagnostics.AddError("Missing Component ID", "component_id is required and cannot be empty.")
+		return
+	}
+
+	results, err := d.providerData.GraphClient.ListRelationships(ctx, cloudID, componentID, 50)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Compass relationships",
+			fmt.Sprintf("Unable to list relationships for component %s: %s", componentID, err),
+		)
+		return
+	}
+
+	relationshipType := strings.TrimSpace(data.Type.ValueString())
+	endComponentID := strings.TrimSpace(data.EndComponentID.ValueString())
+
+	relationships := make([]CompassRelationshipModel, 0, len(results))
+	for _, r := range results {
+		if relationshipType != "" && r.Type != relationshipType {
+			continue
+		}
+		if endComponentID != "" && r.StartComponentID != endComponentID && r.EndComponentID != endComponentID {
+			continue
+		}
+		relationships = append(relationships, CompassRelationshipModel{
+			ID:               types.StringValue(r.ID),
+			Type:             types.StringValue(r.Type),
+			StartComponentID: types.StringValue(r.StartComponentID),
+			EndComponentID:   types.StringValue(r.EndComponentID),
+		})
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Relationships = relationships
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zalando/go-keyring"
+)
+
+// Credentials is the set of auth material a CredentialProvider can resolve. Any field may be left
+// empty, e.g. a provider that only manages an API token leaves Email for another source (or the
+// email attribute/ATLASSIAN_EMAIL env var) to fill in.
+type Credentials struct {
+	BaseURL      string
+	CloudID      string
+	Email        string
+	APIToken     string
+	AccessToken  string
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	TokenURL     string
+}
+
+// merge overlays other's fields onto a copy of c wherever c's own field is empty, so chaining
+// credentials_source blocks in declaration order means earlier blocks win and later ones only
+// fill in the gaps.
+func (c Credentials) merge(other Credentials) Credentials {
+	if c.BaseURL == "" {
+		c.BaseURL = other.BaseURL
+	}
+	if c.CloudID == "" {
+		c.CloudID = other.CloudID
+	}
+	if c.Email == "" {
+		c.Email = other.Email
+	}
+	if c.APIToken == "" {
+		c.APIToken = other.APIToken
+	}
+	if c.AccessToken == "" {
+		c.AccessToken = other.AccessToken
+	}
+	if c.ClientID == "" {
+		c.ClientID = other.ClientID
+	}
+	if c.ClientSecret == "" {
+		c.ClientSecret = other.ClientSecret
+	}
+	if c.RefreshToken == "" {
+		c.RefreshToken = other.RefreshToken
+	}
+	if c.TokenURL == "" {
+		c.TokenURL = other.TokenURL
+	}
+	return c
+}
+
+// CredentialProvider resolves Credentials from an external secret store, so long-lived Jira API
+// tokens don't need to live in tfvars or shell env. Implementations: fileCredentialProvider,
+// keyringCredentialProvider, vaultCredentialProvider, execCredentialProvider.
+type CredentialProvider interface {
+	Resolve(ctx context.Context) (Credentials, error)
+}
+
+// credentialsDocument is the JSON shape every CredentialProvider in this file decodes its secret
+// payload into, whether that payload comes from a file on disk, an OS keyring entry, a Vault KV v2
+// secret, or an exec helper's stdout.
+type credentialsDocument struct {
+	BaseURL      string `json:"base_url"`
+	CloudID      string `json:"cloud_id"`
+	Email        string `json:"email"`
+	APIToken     string `json:"api_token"`
+	AccessToken  string `json:"access_token"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token"`
+	TokenURL     string `json:"token_url"`
+}
+
+func decodeCredentialsJSON(data []byte) (Credentials, error) {
+	var doc credentialsDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return Credentials{}, fmt.Errorf("decode credentials JSON: %w", err)
+	}
+	return Credentials{
+		BaseURL:      doc.BaseURL,
+		CloudID:      doc.CloudID,
+		Email:        doc.Email,
+		APIToken:     doc.APIToken,
+		AccessToken:  doc.AccessToken,
+		ClientID:     doc.ClientID,
+		ClientSecret: doc.ClientSecret,
+		RefreshToken: doc.RefreshToken,
+		TokenURL:     doc.TokenURL,
+	}, nil
+}
+
+// fileCredentialProvider reads a JSON document (see credentialsDocument) from Path. YAML isn't
+// supported: this repo has no YAML dependency today (see go/atlassian/graph/codegen/loader.go),
+// so only JSON is wired up here too.
+type fileCredentialProvider struct {
+	Path string
+}
+
+func (f fileCredentialProvider) Resolve(ctx context.Context) (Credentials, error) {
+	if strings.TrimSpace(f.Path) == "" {
+		return Credentials{}, errors.New("credentials_source type \"file\" requires path")
+	}
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("read credentials file %q: %w", f.Path, err)
+	}
+	return decodeCredentialsJSON(data)
+}
+
+// keyringCredentialProvider reads a credentialsDocument JSON payload from the OS keychain via
+// go-keyring, under the given service/key (keyring calls it "user", but here it identifies which
+// secret within the service to read, e.g. "default" or a profile name).
+type keyringCredentialProvider struct {
+	Service string
+	Key     string
+}
+
+func (k keyringCredentialProvider) Resolve(ctx context.Context) (Credentials, error) {
+	service := k.Service
+	if service == "" {
+		service = "jira-terraform"
+	}
+	key := k.Key
+	if key == "" {
+		key = "default"
+	}
+	secret, err := keyring.Get(service, key)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("read keyring secret %s/%s: %w", service, key, err)
+	}
+	return decodeCredentialsJSON([]byte(secret))
+}
+
+// vaultCredentialProvider reads a KV v2 secret from HashiCorp Vault at Mount/Path, authenticating
+// with VAULT_TOKEN against VAULT_ADDR. It hand-rolls the HTTP call rather than pulling in the
+// Vault API client, matching how JiraRESTClient hand-rolls its own requests instead of depending
+// on a generated SDK.
+type vaultCredentialProvider struct {
+	Mount string
+	Path  string
+}
+
+func (v vaultCredentialProvider) Resolve(ctx context.Context) (Credentials, error) {
+	addr := strings.TrimRight(strings.TrimSpace(os.Getenv("VAULT_ADDR")), "/")
+	token := strings.TrimSpace(os.Getenv("VAULT_TOKEN"))
+	if addr == "" || token == "" {
+		return Credentials{}, errors.New("credentials_source type \"vault\" requires VAULT_ADDR and VAULT_TOKEN to be set")
+	}
+	mount := v.Mount
+	if mount == "" {
+		mount = "secret"
+	}
+	if strings.TrimSpace(v.Path) == "" {
+		return Credentials{}, errors.New("credentials_source type \"vault\" requires vault_path")
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/data/%s", addr, mount, strings.TrimLeft(v.Path, "/"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Credentials{}, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+
+	httpResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("vault KV v2 request to %s: %w", url, err)
+	}
+	defer httpResp.Body.Close()
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("read vault response body: %w", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return Credentials{}, fmt.Errorf("vault KV v2 request to %s failed with status %d: %s", url, httpResp.StatusCode, string(body))
+	}
+
+	var parsed struct {
+		Data struct {
+			Data credentialsDocument `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return Credentials{}, fmt.Errorf("decode vault KV v2 response: %w", err)
+	}
+	doc := parsed.Data.Data
+	return Credentials{
+		BaseURL:      doc.BaseURL,
+		CloudID:      doc.CloudID,
+		Email:        doc.Email,
+		APIToken:     doc.APIToken,
+		AccessToken:  doc.AccessToken,
+		ClientID:     doc.ClientID,
+		ClientSecret: doc.ClientSecret,
+		RefreshToken: doc.RefreshToken,
+		TokenURL:     doc.TokenURL,
+	}, nil
+}
+
+// execCredentialProvider runs an external helper and parses its stdout as a credentialsDocument,
+// mirroring the credential-process pattern used by the AWS and gcloud CLI config formats.
+type execCredentialProvider struct {
+	Command string
+	Args    []string
+}
+
+func (e execCredentialProvider) Resolve(ctx context.Context) (Credentials, error) {
+	if strings.TrimSpace(e.Command) == "" {
+		return Credentials{}, errors.New("credentials_source type \"exec\" requires command")
+	}
+	cmd := exec.CommandContext(ctx, e.Command, e.Args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return Credentials{}, fmt.Errorf("run credentials helper %q: %w", e.Command, err)
+	}
+	return decodeCredentialsJSON(out)
+}
+
+// resolveCredentialChain runs providers in declaration order, merging each result onto the
+// accumulated Credentials so a field left empty by an earlier source can still be filled in by a
+// later one. A provider that errors (missing file, keyring entry, Vault unreachable, ...) is
+// logged via tflog and skipped rather than aborting the whole chain, since a source later in the
+// list is often meant as a fallback for when an earlier one isn't available in a given
+// environment.
+func resolveCredentialChain(ctx context.Context, providers []CredentialProvider) Credentials {
+	var out Credentials
+	for _, p := range providers {
+		creds, err := p.Resolve(ctx)
+		if err != nil {
+			tflog.Warn(ctx, "credentials_source provider failed, continuing chain", map[string]any{
+				"error": err.Error(),
+			})
+			continue
+		}
+		out = out.merge(creds)
+	}
+	return out
+}
+
+// firstNonEmpty returns the first non-empty string in values, or "" if all are empty. Used to let
+// explicit config/env values take priority over credentials_source, which is consulted last.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// FieldKind describes the Terraform attr.Type a FieldMapper's Map method produces. The issues
+// data source uses it to validate a custom FieldMapper before registering it, without having to
+// inspect the attr.Value it eventually returns.
+type FieldKind int
+
+const (
+	FieldKindString FieldKind = iota
+	FieldKindNumber
+	FieldKindBool
+	FieldKindStringList
+	FieldKindObject
+)
+
+// FieldMapper converts one raw Jira issue field value (already JSON-decoded from issue.fields)
+// into a Terraform attr.Value. Key names the issue.fields entry this mapper handles (e.g.
+// "priority", "customfield_10014").
+type FieldMapper interface {
+	Key() string
+	Kind() FieldKind
+	Map(raw any) (attr.Value, diag.Diagnostics)
+}
+
+// Registry holds the FieldMappers consulted when expanding an issue's dynamic field_mappings
+// map. NewBuiltinFieldMapperRegistry seeds one with the provider's built-in mappers; callers
+// add their own with Register so custom fields become first-class attributes without the
+// provider needing to know about them ahead of time.
+type Registry struct {
+	mappers map[string]FieldMapper
+	order   []string
+}
+
+// NewRegistry builds an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{mappers: make(map[string]FieldMapper)}
+}
+
+// Register adds m to the registry, replacing any existing mapper registered for the same Key.
+func (r *Registry) Register(m FieldMapper) {
+	if _, exists := r.mappers[m.Key()]; !exists {
+		r.order = append(r.order, m.Key())
+	}
+	r.mappers[m.Key()] = m
+}
+
+// Keys returns the registered field keys in registration order.
+func (r *Registry) Keys() []string {
+	return r.order
+}
+
+// MapAll runs every registered mapper whose Key is present and non-nil in fields, returning the
+// resulting attr.Values keyed by Key. A mapper whose Key is absent from fields is skipped
+// entirely rather than mapped to a null value, since most Jira instances only return the
+// subset of fields they actually use.
+func (r *Registry) MapAll(fields map[string]any) (map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := make(map[string]attr.Value, len(r.order))
+	for _, key := range r.order {
+		raw, ok := fields[key]
+		if !ok || raw == nil {
+			continue
+		}
+		value, d := r.mappers[key].Map(raw)
+		diags.Append(d...)
+		if d.HasError() {
+			continue
+		}
+		out[key] = value
+	}
+	return out, diags
+}
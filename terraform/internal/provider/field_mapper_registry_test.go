@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRegistryMapAllSkipsAbsentFields(t *testing.T) {
+	reg := NewBuiltinFieldMapperRegistry("customfield_10016", "customfield_10020", "customfield_10014")
+
+	fields := map[string]any{
+		"customfield_10016": 5.0,
+		"priority":          map[string]any{"name": "High"},
+	}
+
+	values, diags := reg.MapAll(fields)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if _, ok := values["customfield_10020"]; ok {
+		t.Fatalf("expected sprints field to be skipped when absent from fields")
+	}
+	if got, ok := values["priority"]; !ok || got.(types.String).ValueString() != "High" {
+		t.Fatalf("expected priority mapped to High, got %v", values["priority"])
+	}
+	if got, ok := values["customfield_10016"]; !ok {
+		t.Fatalf("expected story points to be mapped")
+	} else if n := got.(types.Number); n.IsNull() {
+		t.Fatalf("expected story points to be non-null")
+	}
+}
+
+func TestRegistryMapAllOmitsMappersWithoutConfiguredKey(t *testing.T) {
+	reg := NewBuiltinFieldMapperRegistry("", "", "")
+
+	if len(reg.Keys()) != 5 {
+		t.Fatalf("expected only the 5 fixed-key built-in mappers when no custom field keys are configured, got %d: %v", len(reg.Keys()), reg.Keys())
+	}
+}
+
+func TestNameListFieldMapperMapsFixVersions(t *testing.T) {
+	m := nameListFieldMapper{key: "fixVersions"}
+	raw := []any{
+		map[string]any{"name": "1.0"},
+		map[string]any{"name": "2.0"},
+	}
+
+	value, diags := m.Map(raw)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	list := value.(types.List)
+	var names []string
+	if diags := list.ElementsAs(context.Background(), &names, false); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if len(names) != 2 || names[0] != "1.0" || names[1] != "2.0" {
+		t.Fatalf("unexpected names: %v", names)
+	}
+}
+
+func TestTimeTrackingFieldMapperMapsEstimates(t *testing.T) {
+	m := timeTrackingFieldMapper{}
+	raw := map[string]any{
+		"originalEstimateSeconds": 3600.0,
+		"timeSpentSeconds":        1800.0,
+	}
+
+	value, diags := m.Map(raw)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	obj := value.(types.Object)
+	attrs := obj.Attributes()
+	if attrs["remaining_estimate_seconds"].(types.Number).IsNull() != true {
+		t.Fatalf("expected remaining_estimate_seconds to be null when absent")
+	}
+	if attrs["original_estimate_seconds"].(types.Number).IsNull() {
+		t.Fatalf("expected original_estimate_seconds to be set")
+	}
+}
+
+func TestParentFieldMapperRequiresKey(t *testing.T) {
+	m := parentFieldMapper{}
+	if _, diags := m.Map(map[string]any{"fields": map[string]any{}}); !diags.HasError() {
+		t.Fatalf("expected an error when parent.key is missing")
+	}
+}
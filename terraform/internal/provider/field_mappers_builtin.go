@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"atlassian/atlassian/rest/mappers"
+)
+
+// NewBuiltinFieldMapperRegistry seeds a Registry with mappers for the standard Jira fields most
+// consumers want surfaced alongside the issues data source's hardcoded attributes: story points
+// and sprints (both behind custom field IDs that vary per Jira instance, hence the key
+// parameters), epic link, parent, priority, resolution, fix versions, affects versions, and
+// time tracking. Callers append their own mappers via Register for anything instance-specific
+// this doesn't cover.
+func NewBuiltinFieldMapperRegistry(storyPointsField string, sprintsField string, epicLinkField string) *Registry {
+	reg := NewRegistry()
+	if storyPointsField != "" {
+		reg.Register(storyPointsFieldMapper{key: storyPointsField})
+	}
+	if sprintsField != "" {
+		reg.Register(sprintsFieldMapper{key: sprintsField})
+	}
+	if epicLinkField != "" {
+		reg.Register(epicLinkFieldMapper{key: epicLinkField})
+	}
+	reg.Register(parentFieldMapper{})
+	reg.Register(nameObjectFieldMapper{key: "priority"})
+	reg.Register(nameObjectFieldMapper{key: "resolution"})
+	reg.Register(nameListFieldMapper{key: "fixVersions"})
+	reg.Register(nameListFieldMapper{key: "versions"})
+	reg.Register(timeTrackingFieldMapper{})
+	return reg
+}
+
+type storyPointsFieldMapper struct{ key string }
+
+func (m storyPointsFieldMapper) Key() string      { return m.key }
+func (m storyPointsFieldMapper) Kind() FieldKind  { return FieldKindNumber }
+func (m storyPointsFieldMapper) Map(raw any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	points, err := mappers.ParseStoryPointsValue(raw)
+	if err != nil {
+		diags.AddError("Error mapping story points field", err.Error())
+		return types.NumberNull(), diags
+	}
+	if points == nil {
+		return types.NumberNull(), diags
+	}
+	return types.NumberValue(bigFloatFromFloat64(*points)), diags
+}
+
+type sprintsFieldMapper struct{ key string }
+
+func (m sprintsFieldMapper) Key() string     { return m.key }
+func (m sprintsFieldMapper) Kind() FieldKind { return FieldKindStringList }
+func (m sprintsFieldMapper) Map(raw any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ids, err := mappers.ParseSprintIDsValue(raw)
+	if err != nil {
+		diags.AddError("Error mapping sprints field", err.Error())
+		return types.ListNull(types.StringType), diags
+	}
+	return stringListValue(ids, &diags)
+}
+
+// epicLinkFieldMapper maps the classic Jira Software "Epic Link" custom field, which holds the
+// parent epic's issue key as a plain string.
+type epicLinkFieldMapper struct{ key string }
+
+func (m epicLinkFieldMapper) Key() string     { return m.key }
+func (m epicLinkFieldMapper) Kind() FieldKind { return FieldKindString }
+func (m epicLinkFieldMapper) Map(raw any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	s, ok := raw.(string)
+	if !ok {
+		diags.AddError("Error mapping epic link field", fmt.Sprintf("expected a string, got %T", raw))
+		return types.StringNull(), diags
+	}
+	return types.StringValue(s), diags
+}
+
+type parentFieldMapper struct{}
+
+func (m parentFieldMapper) Key() string     { return "parent" }
+func (m parentFieldMapper) Kind() FieldKind { return FieldKindString }
+func (m parentFieldMapper) Map(raw any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	obj, ok := raw.(map[string]any)
+	if !ok {
+		diags.AddError("Error mapping parent field", fmt.Sprintf("expected an object, got %T", raw))
+		return types.StringNull(), diags
+	}
+	key, ok := obj["key"].(string)
+	if !ok {
+		diags.AddError("Error mapping parent field", "expected parent.key to be a string")
+		return types.StringNull(), diags
+	}
+	return types.StringValue(key), diags
+}
+
+// nameObjectFieldMapper maps a Jira field whose value is an object with a "name" property
+// (priority, resolution, issue type, status, ...) to that name.
+type nameObjectFieldMapper struct{ key string }
+
+func (m nameObjectFieldMapper) Key() string     { return m.key }
+func (m nameObjectFieldMapper) Kind() FieldKind { return FieldKindString }
+func (m nameObjectFieldMapper) Map(raw any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	obj, ok := raw.(map[string]any)
+	if !ok {
+		diags.AddError(fmt.Sprintf("Error mapping %s field", m.key), fmt.Sprintf("expected an object, got %T", raw))
+		return types.StringNull(), diags
+	}
+	name, ok := obj["name"].(string)
+	if !ok {
+		diags.AddError(fmt.Sprintf("Error mapping %s field", m.key), fmt.Sprintf("expected %s.name to be a string", m.key))
+		return types.StringNull(), diags
+	}
+	return types.StringValue(name), diags
+}
+
+// nameListFieldMapper maps a Jira field whose value is a list of objects with a "name" property
+// (fixVersions, versions/affects versions, components, ...) to their names.
+type nameListFieldMapper struct{ key string }
+
+func (m nameListFieldMapper) Key() string     { return m.key }
+func (m nameListFieldMapper) Kind() FieldKind { return FieldKindStringList }
+func (m nameListFieldMapper) Map(raw any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	arr, ok := raw.([]any)
+	if !ok {
+		diags.AddError(fmt.Sprintf("Error mapping %s field", m.key), fmt.Sprintf("expected a list, got %T", raw))
+		return types.ListNull(types.StringType), diags
+	}
+	names := make([]string, 0, len(arr))
+	for idx, item := range arr {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			diags.AddError(fmt.Sprintf("Error mapping %s field", m.key), fmt.Sprintf("%s[%d] must be an object", m.key, idx))
+			return types.ListNull(types.StringType), diags
+		}
+		name, ok := obj["name"].(string)
+		if !ok {
+			diags.AddError(fmt.Sprintf("Error mapping %s field", m.key), fmt.Sprintf("%s[%d].name must be a string", m.key, idx))
+			return types.ListNull(types.StringType), diags
+		}
+		names = append(names, name)
+	}
+	return stringListValue(names, &diags)
+}
+
+// timeTrackingFieldMapper maps Jira's "timetracking" field to its three estimate figures.
+type timeTrackingFieldMapper struct{}
+
+var timeTrackingAttrTypes = map[string]attr.Type{
+	"original_estimate_seconds":  types.NumberType,
+	"time_spent_seconds":         types.NumberType,
+	"remaining_estimate_seconds": types.NumberType,
+}
+
+func (m timeTrackingFieldMapper) Key() string     { return "timetracking" }
+func (m timeTrackingFieldMapper) Kind() FieldKind { return FieldKindObject }
+func (m timeTrackingFieldMapper) Map(raw any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	obj, ok := raw.(map[string]any)
+	if !ok {
+		diags.AddError("Error mapping timetracking field", fmt.Sprintf("expected an object, got %T", raw))
+		return types.ObjectNull(timeTrackingAttrTypes), diags
+	}
+
+	attrs := map[string]attr.Value{
+		"original_estimate_seconds":  timeTrackingSeconds(obj, "originalEstimateSeconds"),
+		"time_spent_seconds":         timeTrackingSeconds(obj, "timeSpentSeconds"),
+		"remaining_estimate_seconds": timeTrackingSeconds(obj, "remainingEstimateSeconds"),
+	}
+	value, d := types.ObjectValue(timeTrackingAttrTypes, attrs)
+	diags.Append(d...)
+	return value, diags
+}
+
+func timeTrackingSeconds(obj map[string]any, key string) types.Number {
+	raw, ok := obj[key]
+	if !ok || raw == nil {
+		return types.NumberNull()
+	}
+	seconds, ok := raw.(float64)
+	if !ok {
+		return types.NumberNull()
+	}
+	return types.NumberValue(bigFloatFromFloat64(seconds))
+}
+
+func stringListValue(values []string, diags *diag.Diagnostics) (types.List, diag.Diagnostics) {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	value, d := types.ListValue(types.StringType, elements)
+	diags.Append(d...)
+	return value, *diags
+}
@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IssueAttachmentResource{}
+var _ resource.ResourceWithModifyPlan = &IssueAttachmentResource{}
+
+func NewIssueAttachmentResource() resource.Resource {
+	return &IssueAttachmentResource{}
+}
+
+// IssueAttachmentResource defines the resource implementation. Attachments are immutable in
+// Jira: there's no update endpoint, so every configurable attribute forces replacement.
+type IssueAttachmentResource struct {
+	providerData *JiraProviderData
+}
+
+// IssueAttachmentResourceModel describes the resource data model. Exactly one of SourcePath or
+// ContentBase64 must be set to provide the attachment's content.
+type IssueAttachmentResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	CloudID       types.String `tfsdk:"cloud_id"`
+	IssueKey      types.String `tfsdk:"issue_key"`
+	SourcePath    types.String `tfsdk:"source_path"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Filename      types.String `tfsdk:"filename"`
+	MimeType      types.String `tfsdk:"mime_type"`
+	Size          types.Int64  `tfsdk:"size"`
+	ContentURL    types.String `tfsdk:"content_url"`
+	SHA256        types.String `tfsdk:"sha256"`
+}
+
+func (r *IssueAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_attachment"
+}
+
+func (r *IssueAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a file as an attachment on a Jira issue. Attachments are immutable in Jira: any configuration change replaces the attachment rather than updating it in place. Exactly one of source_path or content_base64 must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the attachment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The key of the issue to attach the file to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				Description: "Local path of the file to upload. Exactly one of source_path or content_base64 must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Description: "Base64-encoded content to upload as the attachment, for content that isn't already a local file (e.g. generated in-line in configuration). Exactly one of source_path or content_base64 must be set. filename is required when using content_base64, since there's no source path to derive a default from.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Description: "The filename to upload the attachment as. Defaults to the base name of source_path; required when using content_base64.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mime_type": schema.StringAttribute{
+				Description: "The MIME type Jira detected for the attachment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the attachment in bytes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_url": schema.StringAttribute{
+				Description: "The URL Jira serves the attachment's binary content from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha256": schema.StringAttribute{
+				Description: "The hex-encoded SHA-256 checksum of the attachment's content as of the last apply or refresh. Tracked so that ModifyPlan can detect source_path's file changing in place and Read can detect the attachment's server-side content being replaced out of band, even though source_path/content_base64 didn't change.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan forces replacement when source_path's content has changed since the last apply,
+// even though source_path itself is unchanged. RequiresReplace on source_path alone only catches
+// the file being pointed at a different path; it misses the file at the same path being
+// overwritten in place, which this resource must also treat as a new attachment.
+func (r *IssueAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		// Create or destroy; nothing to compare against.
+		return
+	}
+
+	var state, plan IssueAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourcePath.ValueString() == "" {
+		// Content comes from content_base64, which is itself plan-diffed and forces replacement
+		// via its own RequiresReplace plan modifier; there's no local file to re-check here.
+		return
+	}
+
+	if plan.SourcePath.ValueString() != state.SourcePath.ValueString() {
+		// stringplanmodifier.RequiresReplace on source_path already handles this case.
+		return
+	}
+
+	sum, err := sha256File(plan.SourcePath.ValueString())
+	if err != nil {
+		// Let Create/Update surface the error when they try to open the file themselves.
+		return
+	}
+	if sum != state.SHA256.ValueString() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_path"))
+	}
+}
+
+// sha256File returns the hex-encoded SHA-256 checksum of filePath's content.
+func sha256File(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (r *IssueAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *IssueAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IssueAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := r.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	sourcePath := data.SourcePath.ValueString()
+	contentBase64 := data.ContentBase64.ValueString()
+	if (sourcePath == "") == (contentBase64 == "") {
+		resp.Diagnostics.AddError(
+			"Invalid Attachment Content",
+			"Exactly one of source_path or content_base64 must be set.",
+		)
+		return
+	}
+
+	var content io.Reader
+	if sourcePath != "" {
+		f, err := os.Open(sourcePath)
+		if err != nil {
+			resp.Diagnostics.AddError("Error opening attachment source file", err.Error())
+			return
+		}
+		defer f.Close()
+		content = f
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(contentBase64)
+		if err != nil {
+			resp.Diagnostics.AddError("Error decoding content_base64", err.Error())
+			return
+		}
+		content = bytes.NewReader(decoded)
+	}
+
+	filename := data.Filename.ValueString()
+	if filename == "" {
+		if sourcePath == "" {
+			resp.Diagnostics.AddError(
+				"Missing Attachment Filename",
+				"filename is required when using content_base64, since there's no source path to derive a default from.",
+			)
+			return
+		}
+		filename = filepath.Base(sourcePath)
+	}
+
+	hasher := sha256.New()
+	uploaded, err := r.providerData.Client.UploadAttachment(ctx, cloudID, data.IssueKey.ValueString(), filename, io.TeeReader(content, hasher))
+	if err != nil {
+		resp.Diagnostics.AddError("Error uploading Jira attachment", err.Error())
+		return
+	}
+	if len(uploaded) == 0 {
+		resp.Diagnostics.AddError("Error uploading Jira attachment", "Jira returned no attachment in the upload response")
+		return
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Filename = types.StringValue(filename)
+	data.SHA256 = types.StringValue(hex.EncodeToString(hasher.Sum(nil)))
+	attachmentDomainToModel(uploaded[0], &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IssueAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachments, err := r.providerData.Client.ListAttachments(ctx, data.CloudID.ValueString(), data.IssueKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Jira attachments", err.Error())
+		return
+	}
+
+	for _, a := range attachments {
+		if a.ID == data.ID.ValueString() {
+			attachmentDomainToModel(a, &data)
+
+			// Re-hash the attachment's actual server-side content, rather than trusting that it
+			// still matches what was last uploaded, so an attachment replaced out of band (e.g.
+			// by another tool hitting the Jira API directly) is detected as drift the same way a
+			// locally edited source_path file is.
+			_, sum, err := r.providerData.Client.DownloadAttachment(ctx, a.ID, io.Discard)
+			if err != nil {
+				resp.Diagnostics.AddError("Error downloading Jira attachment content", err.Error())
+				return
+			}
+			data.SHA256 = types.StringValue(sum)
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// The attachment is gone from the issue; drop it from state so Terraform recreates it.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IssueAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute forces replacement, so Update is never called in practice;
+	// it only exists to satisfy resource.Resource.
+	resp.Diagnostics.AddError(
+		"Jira attachments cannot be updated in place",
+		"Changing any attribute of a jira_issue_attachment resource requires replacing it.",
+	)
+}
+
+func (r *IssueAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IssueAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.providerData.Client.DeleteAttachment(ctx, data.ID.ValueString()); err != nil {
+		if !rest.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error deleting Jira attachment", err.Error())
+		}
+		return
+	}
+}
+
+// attachmentDomainToModel copies a JiraAttachment returned by the API back onto the Terraform
+// model.
+func attachmentDomainToModel(a atlassian.JiraAttachment, data *IssueAttachmentResourceModel) {
+	data.ID = types.StringValue(a.ID)
+	data.Filename = types.StringValue(a.Filename)
+	data.Size = types.Int64Value(a.Size)
+	if a.MimeType != nil {
+		data.MimeType = types.StringValue(*a.MimeType)
+	} else {
+		data.MimeType = types.StringValue("")
+	}
+	if a.ContentURL != nil {
+		data.ContentURL = types.StringValue(*a.ContentURL)
+	} else {
+		data.ContentURL = types.StringValue("")
+	}
+}
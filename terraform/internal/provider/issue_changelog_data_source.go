@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian/atlassian/rest/mappers"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IssueChangelogDataSource{}
+
+func NewIssueChangelogDataSource() datasource.DataSource {
+	return &IssueChangelogDataSource{}
+}
+
+// IssueChangelogDataSource defines the data source implementation.
+type IssueChangelogDataSource struct {
+	providerData *JiraProviderData
+}
+
+// IssueChangelogDataSourceModel describes the data source data model.
+type IssueChangelogDataSourceModel struct {
+	CloudID         types.String           `tfsdk:"cloud_id"`
+	IssueKey        types.String           `tfsdk:"issue_key"`
+	IncludeRendered types.Bool             `tfsdk:"include_rendered"`
+	Histories       []IssueHistoryModel    `tfsdk:"histories"`
+	Transitions     []IssueTransitionModel `tfsdk:"transitions"`
+}
+
+// IssueHistoryModel describes a single changelog history entry.
+type IssueHistoryModel struct {
+	AuthorAccountID types.String            `tfsdk:"author_account_id"`
+	AuthorName      types.String            `tfsdk:"author_name"`
+	CreatedAt       types.String            `tfsdk:"created_at"`
+	Items           []IssueHistoryItemModel `tfsdk:"items"`
+}
+
+// IssueHistoryItemModel describes a single field change within a changelog history entry.
+type IssueHistoryItemModel struct {
+	Field      types.String `tfsdk:"field"`
+	FromString types.String `tfsdk:"from_string"`
+	ToString   types.String `tfsdk:"to_string"`
+}
+
+// IssueTransitionModel describes a single available workflow transition.
+type IssueTransitionModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	ToStatusName types.String `tfsdk:"to_status_name"`
+}
+
+func (d *IssueChangelogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_changelog"
+}
+
+func (d *IssueChangelogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a Jira issue's changelog histories and available workflow transitions, optionally including Jira's HTML-rendered fields.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The Jira issue key (e.g., 'PROJ-123') to fetch the changelog and transitions for.",
+				Required:    true,
+			},
+			"include_rendered": schema.BoolAttribute{
+				Description: "Whether to request Jira's HTML-rendered fields (expand=renderedFields) alongside the changelog.",
+				Optional:    true,
+			},
+			"histories": schema.ListNestedAttribute{
+				Description: "The issue's changelog histories, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"author_account_id": schema.StringAttribute{
+							Description: "The Atlassian account ID of the user who made the change, if known.",
+							Computed:    true,
+						},
+						"author_name": schema.StringAttribute{
+							Description: "The display name of the user who made the change, if known.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the change was made (RFC3339 format).",
+							Computed:    true,
+						},
+						"items": schema.ListNestedAttribute{
+							Description: "The field changes recorded in this history entry.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field": schema.StringAttribute{
+										Description: "The name of the field that changed.",
+										Computed:    true,
+									},
+									"from_string": schema.StringAttribute{
+										Description: "The field's previous value, as a display string. Empty if the field had no prior value.",
+										Computed:    true,
+									},
+									"to_string": schema.StringAttribute{
+										Description: "The field's new value, as a display string. Empty if the field was cleared.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"transitions": schema.ListNestedAttribute{
+				Description: "The workflow transitions currently available for the issue.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The transition ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The transition's own name (e.g. 'Start Progress').",
+							Computed:    true,
+						},
+						"to_status_name": schema.StringAttribute{
+							Description: "The name of the workflow status this transition leads to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IssueChangelogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *IssueChangelogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IssueChangelogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	issueKey := strings.TrimSpace(data.IssueKey.ValueString())
+	if issueKey == "" {
+		resp.Diagnostics.AddError("Missing Issue Key", "issue_key is required and cannot be empty.")
+		return
+	}
+
+	expansions := []string{"changelog"}
+	if data.IncludeRendered.ValueBool() {
+		expansions = append(expansions, "renderedFields")
+	}
+
+	issue, err := d.providerData.Client.GetIssueWithExpansionsViaREST(ctx, issueKey, expansions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching Jira issue changelog",
+			fmt.Sprintf("Unable to fetch changelog for issue %s: %s", issueKey, err),
+		)
+		return
+	}
+
+	histories := mappers.IssueHistoriesFromGen(issue.Changelog)
+	historyModels := make([]IssueHistoryModel, 0, len(histories))
+	for _, h := range histories {
+		hm := IssueHistoryModel{
+			CreatedAt: types.StringValue(h.CreatedAt),
+			Items:     make([]IssueHistoryItemModel, 0, len(h.Items)),
+		}
+		if h.Author != nil {
+			hm.AuthorAccountID = types.StringValue(h.Author.AccountID)
+			hm.AuthorName = types.StringValue(h.Author.DisplayName)
+		} else {
+			hm.AuthorAccountID = types.StringNull()
+			hm.AuthorName = types.StringNull()
+		}
+		for _, item := range h.Items {
+			hm.Items = append(hm.Items, IssueHistoryItemModel{
+				Field:      types.StringValue(item.Field),
+				FromString: types.StringValue(item.FromString),
+				ToString:   types.StringValue(item.ToString),
+			})
+		}
+		historyModels = append(historyModels, hm)
+	}
+
+	transitions, err := d.providerData.Client.ListIssueTransitionsDetailed(ctx, issueKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching Jira issue transitions",
+			fmt.Sprintf("Unable to fetch transitions for issue %s: %s", issueKey, err),
+		)
+		return
+	}
+	transitionModels := make([]IssueTransitionModel, 0, len(transitions))
+	for _, t := range transitions {
+		transitionModels = append(transitionModels, IssueTransitionModel{
+			ID:           types.StringValue(t.ID),
+			Name:         types.StringValue(t.Name),
+			ToStatusName: types.StringValue(t.ToStatusName),
+		})
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Histories = historyModels
+	data.Transitions = transitionModels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/adf"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IssueCommentResource{}
+
+func NewIssueCommentResource() resource.Resource {
+	return &IssueCommentResource{}
+}
+
+// IssueCommentResource defines the resource implementation. Jira comment IDs are assigned by
+// Jira and aren't known until after creation, so the resource is instead keyed on issue_key plus
+// a caller-supplied external_id: a stable marker embedded in the comment body that lets Create
+// find and adopt a comment a previous, interrupted apply already posted instead of duplicating it
+// (e.g. a CI pipeline retrying a release-notes post).
+type IssueCommentResource struct {
+	providerData *JiraProviderData
+}
+
+// IssueCommentResourceModel describes the resource data model.
+type IssueCommentResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	IssueKey     types.String `tfsdk:"issue_key"`
+	ExternalID   types.String `tfsdk:"external_id"`
+	Body         types.String `tfsdk:"body"`
+	BodyRendered types.String `tfsdk:"body_rendered"`
+}
+
+// externalIDMarkerPrefix tags the hidden marker paragraph appended to every comment this
+// resource manages, so Create can recognize and adopt a comment from a prior apply.
+const externalIDMarkerPrefix = "_tf-external-id:"
+
+func externalIDMarker(externalID string) string {
+	return externalIDMarkerPrefix + externalID + "_"
+}
+
+func externalIDFromMarkdown(markdown string) (string, bool) {
+	idx := strings.LastIndex(markdown, externalIDMarkerPrefix)
+	if idx == -1 {
+		return "", false
+	}
+	rest := markdown[idx+len(externalIDMarkerPrefix):]
+	end := strings.IndexByte(rest, '_')
+	if end == -1 {
+		return "", false
+	}
+	return rest[:end], true
+}
+
+func (r *IssueCommentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_comment"
+}
+
+func (r *IssueCommentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a comment on a Jira issue, reconciled by issue_key plus a caller-supplied external_id rather than Jira's own comment ID, so pipelines (e.g. posting release notes) can safely retry without duplicating comments.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The Jira comment ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The key of the issue to comment on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "A stable, caller-chosen ID identifying this comment across applies (e.g. a release version). Embedded in the comment body as a hidden marker so a retried Create adopts the existing comment instead of posting a duplicate.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				Description: "The comment body in plain text or markdown.",
+				Required:    true,
+			},
+			"body_rendered": schema.StringAttribute{
+				Description: "The comment body as Jira rendered it, flattened to markdown.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *IssueCommentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *IssueCommentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IssueCommentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := r.providerData.CloudID
+	issueKey := data.IssueKey.ValueString()
+	externalID := data.ExternalID.ValueString()
+	doc := commentDoc(data.Body.ValueString(), externalID)
+
+	existing, err := r.providerData.Client.ListComments(ctx, cloudID, issueKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing Jira comments", err.Error())
+		return
+	}
+	for _, c := range existing {
+		if id, ok := externalIDFromMarkdown(c.BodyRendered); ok && id == externalID {
+			updated, err := r.providerData.Client.UpdateComment(ctx, issueKey, c.ID, doc)
+			if err != nil {
+				resp.Diagnostics.AddError("Error adopting existing Jira comment", err.Error())
+				return
+			}
+			commentDomainToModel(updated, &data)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	created, err := r.providerData.Client.AddComment(ctx, cloudID, issueKey, doc)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Jira comment", err.Error())
+		return
+	}
+	commentDomainToModel(created, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueCommentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IssueCommentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comments, err := r.providerData.Client.ListComments(ctx, r.providerData.CloudID, data.IssueKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Jira comments", err.Error())
+		return
+	}
+
+	for _, c := range comments {
+		if c.ID == data.ID.ValueString() {
+			commentDomainToModel(c, &data)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// The comment is gone; drop it from state so Terraform recreates it.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IssueCommentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IssueCommentResourceModel
+	var state IssueCommentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	doc := commentDoc(data.Body.ValueString(), data.ExternalID.ValueString())
+	updated, err := r.providerData.Client.UpdateComment(ctx, data.IssueKey.ValueString(), state.ID.ValueString(), doc)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Jira comment", err.Error())
+		return
+	}
+	commentDomainToModel(updated, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueCommentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IssueCommentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.providerData.Client.DeleteComment(ctx, data.IssueKey.ValueString(), data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting Jira comment", err.Error())
+		return
+	}
+}
+
+// commentDoc builds the ADF document for a comment body, with a hidden trailing paragraph
+// carrying externalID so a later Create can recognize this comment.
+func commentDoc(body string, externalID string) map[string]any {
+	return adf.NewDoc().
+		Paragraph(body).
+		Paragraph(externalIDMarker(externalID)).
+		Build()
+}
+
+// commentDomainToModel copies a JiraComment returned by the API back onto the Terraform model.
+func commentDomainToModel(c atlassian.JiraComment, data *IssueCommentResourceModel) {
+	data.ID = types.StringValue(c.ID)
+	data.BodyRendered = types.StringValue(c.BodyRendered)
+
+	body := c.BodyRendered
+	if idx := strings.LastIndex(body, "\n\n"+externalIDMarkerPrefix); idx != -1 {
+		body = body[:idx]
+	}
+	data.Body = types.StringValue(body)
+}
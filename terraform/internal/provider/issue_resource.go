@@ -0,0 +1,444 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IssueResource{}
+var _ resource.ResourceWithImportState = &IssueResource{}
+
+func NewIssueResource() resource.Resource {
+	return &IssueResource{}
+}
+
+// IssueResource defines the resource implementation.
+type IssueResource struct {
+	providerData *JiraProviderData
+}
+
+// IssueResourceModel describes the resource data model.
+type IssueResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Key               types.String `tfsdk:"key"`
+	ProjectKey        types.String `tfsdk:"project_key"`
+	Summary           types.String `tfsdk:"summary"`
+	Description       types.String `tfsdk:"description"`
+	IssueType         types.String `tfsdk:"issue_type"`
+	AssigneeAccountID types.String `tfsdk:"assignee_account_id"`
+	ReporterAccountID types.String `tfsdk:"reporter_account_id"`
+	Priority          types.String `tfsdk:"priority"`
+	Labels            types.List   `tfsdk:"labels"`
+	Components        types.List   `tfsdk:"components"`
+	FixVersions       types.List   `tfsdk:"fix_versions"`
+	ParentKey         types.String `tfsdk:"parent_key"`
+	Status            types.String `tfsdk:"status"`
+	TargetStatus      types.String `tfsdk:"target_status"`
+	CustomFields      types.Map    `tfsdk:"custom_fields"`
+}
+
+func (r *IssueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue"
+}
+
+func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jira issue.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the issue.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The key of the issue (e.g., 'PROJ-123').",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The key of the project the issue belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"summary": schema.StringAttribute{
+				Description: "The issue summary.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The issue description, as plain text or an ADF document serialized to JSON.",
+				Optional:    true,
+			},
+			"issue_type": schema.StringAttribute{
+				Description: "The issue type name (e.g., 'Bug', 'Task', 'Story', 'Epic', 'Subtask').",
+				Required:    true,
+			},
+			"assignee_account_id": schema.StringAttribute{
+				Description: "The Atlassian account ID of the assignee.",
+				Optional:    true,
+			},
+			"reporter_account_id": schema.StringAttribute{
+				Description: "The Atlassian account ID of the reporter.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"priority": schema.StringAttribute{
+				Description: "The priority name (e.g., 'High', 'Medium', 'Low').",
+				Optional:    true,
+				Computed:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "Labels attached to the issue.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"components": schema.ListAttribute{
+				Description: "Names of the components attached to the issue.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"fix_versions": schema.ListAttribute{
+				Description: "Names of the fix versions attached to the issue.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"parent_key": schema.StringAttribute{
+				Description: "The key of the parent issue, for subtasks and epic children.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The issue's current workflow status name (e.g., 'To Do', 'In Progress', 'Done'), as last observed from Jira.",
+				Computed:    true,
+			},
+			"target_status": schema.StringAttribute{
+				Description: "Setting this to a workflow status name transitions the issue to it during Update, " +
+					"via the workflow's available transitions. Leave unset to manage the issue without driving its workflow.",
+				Optional: true,
+			},
+			"custom_fields": schema.MapAttribute{
+				Description: "Raw JSON values keyed by custom field ID (e.g. 'customfield_10016').",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *IssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IssueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if strings.TrimSpace(data.IssueType.ValueString()) == "" {
+		resp.Diagnostics.AddError("Invalid Issue Type", "issue_type is required and cannot be empty.")
+		return
+	}
+
+	customFields, diags := issueCustomFieldsToDomain(ctx, data.CustomFields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels, diags := stringListToSlice(ctx, data.Labels)
+	resp.Diagnostics.Append(diags...)
+	components, diags := stringListToSlice(ctx, data.Components)
+	resp.Diagnostics.Append(diags...)
+	fixVersions, diags := stringListToSlice(ctx, data.FixVersions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := rest.CreateIssueInput{
+		ProjectKey:        data.ProjectKey.ValueString(),
+		Summary:           data.Summary.ValueString(),
+		IssueType:         data.IssueType.ValueString(),
+		AssigneeAccountID: data.AssigneeAccountID.ValueString(),
+		ReporterAccountID: data.ReporterAccountID.ValueString(),
+		Priority:          data.Priority.ValueString(),
+		Labels:            labels,
+		Components:        components,
+		FixVersions:       fixVersions,
+		ParentKey:         data.ParentKey.ValueString(),
+		CustomFields:      customFields,
+	}
+	if !data.Description.IsNull() {
+		in.Description = issueDescriptionToDomain(data.Description.ValueString())
+	}
+
+	created, err := r.providerData.Client.CreateIssue(ctx, r.providerData.CloudID, in)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Jira issue", err.Error())
+		return
+	}
+
+	if !data.TargetStatus.IsNull() && data.TargetStatus.ValueString() != "" && data.TargetStatus.ValueString() != created.Status {
+		if err := r.providerData.Client.TransitionIssue(ctx, created.Key, data.TargetStatus.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error transitioning Jira issue", err.Error())
+			return
+		}
+		created, err = r.providerData.Client.GetIssue(ctx, r.providerData.CloudID, created.Key)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Jira issue after transition", err.Error())
+			return
+		}
+	}
+
+	diags = issueDomainToModel(ctx, created, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IssueResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.providerData.Client.GetIssue(ctx, r.providerData.CloudID, data.Key.ValueString())
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags := issueDomainToModel(ctx, current, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IssueResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state IssueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customFields, diags := issueCustomFieldsToDomain(ctx, data.CustomFields)
+	resp.Diagnostics.Append(diags...)
+	labels, diags := stringListToSlice(ctx, data.Labels)
+	resp.Diagnostics.Append(diags...)
+	components, diags := stringListToSlice(ctx, data.Components)
+	resp.Diagnostics.Append(diags...)
+	fixVersions, diags := stringListToSlice(ctx, data.FixVersions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	summary := data.Summary.ValueString()
+	assignee := data.AssigneeAccountID.ValueString()
+	reporter := data.ReporterAccountID.ValueString()
+	priority := data.Priority.ValueString()
+	in := rest.UpdateIssueInput{
+		Summary:           &summary,
+		AssigneeAccountID: &assignee,
+		ReporterAccountID: &reporter,
+		Priority:          &priority,
+		Labels:            &labels,
+		Components:        &components,
+		FixVersions:       &fixVersions,
+		CustomFields:      customFields,
+	}
+	if !data.Description.IsNull() {
+		in.Description = issueDescriptionToDomain(data.Description.ValueString())
+	}
+
+	if err := r.providerData.Client.UpdateIssue(ctx, state.Key.ValueString(), in); err != nil {
+		resp.Diagnostics.AddError("Error updating Jira issue", err.Error())
+		return
+	}
+
+	if !data.TargetStatus.IsNull() && data.TargetStatus.ValueString() != "" && data.TargetStatus.ValueString() != state.Status.ValueString() {
+		if err := r.providerData.Client.TransitionIssue(ctx, state.Key.ValueString(), data.TargetStatus.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error transitioning Jira issue", err.Error())
+			return
+		}
+	}
+
+	updated, err := r.providerData.Client.GetIssue(ctx, r.providerData.CloudID, state.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Jira issue after update", err.Error())
+		return
+	}
+
+	diags = issueDomainToModel(ctx, updated, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IssueResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.providerData.Client.DeleteIssue(ctx, data.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting Jira issue", err.Error())
+		return
+	}
+}
+
+func (r *IssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+// issueDescriptionToDomain accepts either plain text or a serialized ADF document and
+// returns the value CreateIssue/UpdateIssue should send for the description field.
+func issueDescriptionToDomain(raw string) any {
+	var adf map[string]any
+	if err := json.Unmarshal([]byte(raw), &adf); err == nil {
+		return adf
+	}
+	return raw
+}
+
+// issueDomainToModel copies a JiraIssue read back from the API onto the Terraform model,
+// leaving any custom_fields the practitioner didn't set alone rather than overwriting them.
+func issueDomainToModel(ctx context.Context, issue atlassian.JiraIssue, data *IssueResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(issue.ID)
+	data.Key = types.StringValue(issue.Key)
+	data.ProjectKey = types.StringValue(issue.ProjectKey)
+	data.Summary = types.StringValue(issue.Summary)
+	data.IssueType = types.StringValue(issue.IssueType)
+	data.Status = types.StringValue(issue.Status)
+
+	if issue.Assignee != nil {
+		data.AssigneeAccountID = types.StringValue(issue.Assignee.AccountID)
+	} else {
+		data.AssigneeAccountID = types.StringNull()
+	}
+
+	if issue.Reporter != nil {
+		data.ReporterAccountID = types.StringValue(issue.Reporter.AccountID)
+	} else {
+		data.ReporterAccountID = types.StringNull()
+	}
+
+	if issue.Priority != nil {
+		data.Priority = types.StringValue(*issue.Priority)
+	} else {
+		data.Priority = types.StringNull()
+	}
+
+	if issue.ParentKey != nil {
+		data.ParentKey = types.StringValue(*issue.ParentKey)
+	} else {
+		data.ParentKey = types.StringNull()
+	}
+
+	labels, d := types.ListValueFrom(ctx, types.StringType, issue.Labels)
+	diags.Append(d...)
+	data.Labels = labels
+
+	components, d := types.ListValueFrom(ctx, types.StringType, issue.Components)
+	diags.Append(d...)
+	data.Components = components
+
+	fixVersions, d := types.ListValueFrom(ctx, types.StringType, issue.FixVersions)
+	diags.Append(d...)
+	data.FixVersions = fixVersions
+
+	return diags
+}
+
+func stringListToSlice(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	var out []string
+	diags := list.ElementsAs(ctx, &out, false)
+	return out, diags
+}
+
+func issueCustomFieldsToDomain(ctx context.Context, m types.Map) (map[string]any, diag.Diagnostics) {
+	if m.IsNull() || m.IsUnknown() {
+		return nil, nil
+	}
+	var raw map[string]string
+	diags := m.ElementsAs(ctx, &raw, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	out := make(map[string]any, len(raw))
+	for key, value := range raw {
+		var decoded any
+		if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+			out[key] = decoded
+		} else {
+			out[key] = value
+		}
+	}
+	return out, diags
+}
@@ -7,10 +7,19 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+	"atlassian/atlassian/rest/mappers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -27,24 +36,73 @@ type IssuesDataSource struct {
 
 // IssuesDataSourceModel describes the data source data model.
 type IssuesDataSourceModel struct {
-	CloudID types.String `tfsdk:"cloud_id"`
-	JQL     types.String `tfsdk:"jql"`
-	Issues  []IssueModel `tfsdk:"issues"`
+	CloudID           types.String        `tfsdk:"cloud_id"`
+	JQL               types.String        `tfsdk:"jql"`
+	Filter            *IssuesFilterModel  `tfsdk:"filter"`
+	CustomFields      []CustomFieldModel  `tfsdk:"custom_fields"`
+	UseEnhancedSearch types.Bool          `tfsdk:"use_enhanced_search"`
+	Fields            types.List          `tfsdk:"fields"`
+	Expand            types.List          `tfsdk:"expand"`
+	FieldMappings     *FieldMappingsModel `tfsdk:"field_mappings"`
+	Issues            []IssueModel        `tfsdk:"issues"`
+}
+
+// IssuesFilterModel is a structured alternative to the raw jql attribute, compiled into a JQL
+// string via rest.JQLBuilder and the atlassian.JQL clause builders. It covers the common filters;
+// anything it can't express is still reachable by falling back to jql directly.
+type IssuesFilterModel struct {
+	Project        types.String `tfsdk:"project"`
+	StatusIn       types.List   `tfsdk:"status_in"`
+	Assignee       types.String `tfsdk:"assignee"`
+	LabelsContains types.List   `tfsdk:"labels_contains"`
+	CreatedAfter   types.String `tfsdk:"created_after"`
+	OrderBy        types.String `tfsdk:"order_by"`
+}
+
+// FieldMappingsModel configures the built-in field mapper registry (see
+// NewBuiltinFieldMapperRegistry) used to populate each issue's field_mappings map. Any of these
+// may be left unset to fall back to their ATLASSIAN_JIRA_*_FIELD environment variable, or omitted
+// entirely to skip that mapper.
+type FieldMappingsModel struct {
+	StoryPointsField types.String `tfsdk:"story_points_field"`
+	SprintsField     types.String `tfsdk:"sprints_field"`
+	EpicLinkField    types.String `tfsdk:"epic_link_field"`
+}
+
+// CustomFieldModel describes one Jira custom field to extract and coerce onto each returned
+// issue's custom_fields map, mirroring mappers.CustomFieldSpec.
+type CustomFieldModel struct {
+	Key   types.String `tfsdk:"key"`
+	Alias types.String `tfsdk:"alias"`
+	Kind  types.String `tfsdk:"kind"`
 }
 
 // IssueModel describes a single Jira issue.
 type IssueModel struct {
-	CloudID     types.String `tfsdk:"cloud_id"`
-	Key         types.String `tfsdk:"key"`
-	ProjectKey  types.String `tfsdk:"project_key"`
-	IssueType   types.String `tfsdk:"issue_type"`
-	Status      types.String `tfsdk:"status"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
-	ResolvedAt  types.String `tfsdk:"resolved_at"`
-	Labels      types.List   `tfsdk:"labels"`
-	Components  types.List   `tfsdk:"components"`
-	StoryPoints types.Number `tfsdk:"story_points"`
+	CloudID         types.String   `tfsdk:"cloud_id"`
+	Key             types.String   `tfsdk:"key"`
+	ProjectKey      types.String   `tfsdk:"project_key"`
+	IssueType       types.String   `tfsdk:"issue_type"`
+	Status          types.String   `tfsdk:"status"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	UpdatedAt       types.String   `tfsdk:"updated_at"`
+	ResolvedAt      types.String   `tfsdk:"resolved_at"`
+	Labels          types.List     `tfsdk:"labels"`
+	Components      types.List     `tfsdk:"components"`
+	StoryPoints     types.Number   `tfsdk:"story_points"`
+	Description     types.String   `tfsdk:"description"`
+	DescriptionHTML types.String   `tfsdk:"description_html"`
+	Comments        []CommentModel `tfsdk:"comments"`
+	CustomFields    types.Map      `tfsdk:"custom_fields"`
+	FieldMappings   types.Map      `tfsdk:"field_mappings"`
+}
+
+// CommentModel describes a single Jira issue comment.
+type CommentModel struct {
+	ID        types.String `tfsdk:"id"`
+	Author    types.String `tfsdk:"author"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Body      types.String `tfsdk:"body"`
 }
 
 func (d *IssuesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -61,8 +119,94 @@ func (d *IssuesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Computed:    true,
 			},
 			"jql": schema.StringAttribute{
-				Description: "JQL query to filter issues (e.g., 'project = PROJ AND status = Open').",
-				Required:    true,
+				Description: "JQL query to filter issues (e.g., 'project = PROJ AND status = Open'). Exactly one of " +
+					"jql or filter must be set.",
+				Optional: true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "A structured alternative to jql, compiled into a JQL query via rest.JQLBuilder. Exactly " +
+					"one of jql or filter must be set.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"project": schema.StringAttribute{
+						Description: "Matches issues in this project key.",
+						Optional:    true,
+					},
+					"status_in": schema.ListAttribute{
+						Description: "Matches issues whose status is one of these names.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"assignee": schema.StringAttribute{
+						Description: "Matches issues assigned to this account ID, or a JQL function call such as 'currentUser()'.",
+						Optional:    true,
+					},
+					"labels_contains": schema.ListAttribute{
+						Description: "Matches issues carrying any of these labels.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"created_after": schema.StringAttribute{
+						Description: "Matches issues created after this RFC3339 timestamp.",
+						Optional:    true,
+					},
+					"order_by": schema.StringAttribute{
+						Description: "A single 'field ASC' or 'field DESC' sort key (e.g. 'created DESC').",
+						Optional:    true,
+					},
+				},
+			},
+			"custom_fields": schema.ListNestedAttribute{
+				Description: "Additional Jira custom fields to extract onto each issue's custom_fields map, coerced per kind.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "The Jira custom field ID (e.g. 'customfield_10014').",
+							Required:    true,
+						},
+						"alias": schema.StringAttribute{
+							Description: "The name this field's value is stored under in custom_fields. Defaults to key.",
+							Optional:    true,
+						},
+						"kind": schema.StringAttribute{
+							Description: "How to coerce the field's raw value: string, number, bool, date, user, option, options, cascading, richtext, or array<string>.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"use_enhanced_search": schema.BoolAttribute{
+				Description: "Use the token-paginated Enhanced Search endpoint (POST /rest/api/3/search/jql) instead of the default startAt-paginated search. Streams pages as they're fetched, so large result sets don't need to be buffered before mapping.",
+				Optional:    true,
+			},
+			"fields": schema.ListAttribute{
+				Description: "Issue fields to request. Only used with use_enhanced_search; defaults to the provider's standard field set when empty.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"expand": schema.ListAttribute{
+				Description: "Additional issue detail to expand (e.g. 'renderedFields', 'changelog'). Only used with use_enhanced_search.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"field_mappings": schema.SingleNestedAttribute{
+				Description: "Configures the built-in field mapper registry used to populate each issue's field_mappings map. Unset attributes fall back to their ATLASSIAN_JIRA_*_FIELD environment variable; a mapper is skipped entirely if neither is set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"story_points_field": schema.StringAttribute{
+						Description: "The story points custom field ID (e.g. 'customfield_10016'). Falls back to ATLASSIAN_JIRA_STORY_POINTS_FIELD.",
+						Optional:    true,
+					},
+					"sprints_field": schema.StringAttribute{
+						Description: "The sprint custom field ID (e.g. 'customfield_10020'). Falls back to ATLASSIAN_JIRA_SPRINT_IDS_FIELD.",
+						Optional:    true,
+					},
+					"epic_link_field": schema.StringAttribute{
+						Description: "The classic Epic Link custom field ID (e.g. 'customfield_10014'). Falls back to ATLASSIAN_JIRA_EPIC_LINK_FIELD.",
+						Optional:    true,
+					},
+				},
 			},
 			"issues": schema.ListNestedAttribute{
 				Description: "List of Jira issues matching the JQL query.",
@@ -115,6 +259,48 @@ func (d *IssuesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 							Description: "Story points assigned to the issue, if applicable.",
 							Computed:    true,
 						},
+						"description": schema.StringAttribute{
+							Description: "The issue description, flattened to plaintext from its ADF document (or passed through as-is for plain-text descriptions).",
+							Computed:    true,
+						},
+						"description_html": schema.StringAttribute{
+							Description: "The issue description rendered as HTML by Jira. Empty unless the underlying fetch requested expand=renderedFields.",
+							Computed:    true,
+						},
+						"custom_fields": schema.MapAttribute{
+							Description: "Additional custom field values requested via the custom_fields config block, keyed by alias and coerced to their string representation.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"field_mappings": schema.MapAttribute{
+							Description: "Standard Jira fields mapped by the built-in field mapper registry (see the field_mappings config block), keyed by Jira field ID. Each value keeps its natively mapped type (string, number, list, or object) rather than being flattened to a string.",
+							ElementType: types.DynamicType,
+							Computed:    true,
+						},
+						"comments": schema.ListNestedAttribute{
+							Description: "Comments on the issue.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The comment ID.",
+										Computed:    true,
+									},
+									"author": schema.StringAttribute{
+										Description: "The display name of the comment author.",
+										Computed:    true,
+									},
+									"created_at": schema.StringAttribute{
+										Description: "When the comment was created (RFC3339 format).",
+										Computed:    true,
+									},
+									"body": schema.StringAttribute{
+										Description: "The comment body, flattened to plaintext from its ADF document.",
+										Computed:    true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -155,24 +341,84 @@ func (d *IssuesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		cloudID = data.CloudID.ValueString()
 	}
 
-	// Validate JQL
+	// Resolve JQL, either from the raw jql attribute or compiled from the structured filter block.
 	jql := data.JQL.ValueString()
+	if jql == "" && data.Filter != nil {
+		built, diags := buildIssuesFilterJQL(ctx, data.Filter)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		jql = built
+	}
 	if jql == "" {
 		resp.Diagnostics.AddError(
 			"Missing JQL Query",
-			"The jql attribute is required to query Jira issues.",
+			"Either the jql or the filter attribute is required to query Jira issues.",
 		)
 		return
 	}
 
-	// Fetch issues from Jira REST API
-	results, err := d.providerData.Client.ListIssuesViaREST(ctx, cloudID, jql, 100)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error fetching Jira issues",
-			fmt.Sprintf("Unable to fetch issues: %s", err),
-		)
-		return
+	// Build custom field specs from config
+	specs := make([]mappers.CustomFieldSpec, 0, len(data.CustomFields))
+	for _, cf := range data.CustomFields {
+		specs = append(specs, mappers.CustomFieldSpec{
+			Key:   cf.Key.ValueString(),
+			Alias: cf.Alias.ValueString(),
+			Kind:  mappers.CustomFieldKind(cf.Kind.ValueString()),
+		})
+	}
+
+	storyPointsField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_STORY_POINTS_FIELD"))
+	sprintIDsField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_SPRINT_IDS_FIELD"))
+	epicLinkField := strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_EPIC_LINK_FIELD"))
+	if data.FieldMappings != nil {
+		if v := data.FieldMappings.StoryPointsField.ValueString(); v != "" {
+			storyPointsField = v
+		}
+		if v := data.FieldMappings.SprintsField.ValueString(); v != "" {
+			sprintIDsField = v
+		}
+		if v := data.FieldMappings.EpicLinkField.ValueString(); v != "" {
+			epicLinkField = v
+		}
+	}
+	fieldMapperRegistry := NewBuiltinFieldMapperRegistry(storyPointsField, sprintIDsField, epicLinkField)
+
+	var results []atlassian.JiraIssue
+	if data.UseEnhancedSearch.ValueBool() {
+		var fields, expand []string
+		resp.Diagnostics.Append(data.Fields.ElementsAs(ctx, &fields, false)...)
+		resp.Diagnostics.Append(data.Expand.ElementsAs(ctx, &expand, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for issue, err := range d.providerData.Client.SearchIssuesJQL(ctx, cloudID, rest.SearchJQLRequest{
+			JQL:    jql,
+			Fields: fields,
+			Expand: expand,
+		}) {
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error fetching Jira issues",
+					fmt.Sprintf("Unable to fetch issues: %s", err),
+				)
+				return
+			}
+			results = append(results, issue)
+		}
+	} else {
+		// Fetch issues from Jira REST API
+		fetched, err := d.providerData.Client.ListIssuesViaRESTWithSpec(ctx, cloudID, jql, 100, storyPointsField, sprintIDsField, specs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching Jira issues",
+				fmt.Sprintf("Unable to fetch issues: %s", err),
+			)
+			return
+		}
+		results = fetched
 	}
 
 	// Map results to Terraform model
@@ -217,6 +463,63 @@ func (d *IssuesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			issue.StoryPoints = types.NumberNull()
 		}
 
+		issue.Description = types.StringValue(r.DescriptionText)
+
+		if r.DescriptionHTML != nil {
+			issue.DescriptionHTML = types.StringValue(*r.DescriptionHTML)
+		} else {
+			issue.DescriptionHTML = types.StringValue("")
+		}
+
+		comments := make([]CommentModel, 0, len(r.Comments))
+		for _, c := range r.Comments {
+			author := ""
+			if c.Author != nil {
+				author = c.Author.DisplayName
+			}
+			createdAt := ""
+			if c.Created != nil {
+				createdAt = *c.Created
+			}
+			comments = append(comments, CommentModel{
+				ID:        types.StringValue(c.ID),
+				Author:    types.StringValue(author),
+				CreatedAt: types.StringValue(createdAt),
+				Body:      types.StringValue(c.BodyText),
+			})
+		}
+		issue.Comments = comments
+
+		customFields := make(map[string]string, len(r.CustomFields))
+		for alias, value := range r.CustomFields {
+			if value == nil {
+				continue
+			}
+			customFields[alias] = fmt.Sprintf("%v", value)
+		}
+		customFieldsMap, diags := types.MapValueFrom(ctx, types.StringType, customFields)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		issue.CustomFields = customFieldsMap
+
+		mapped, mapDiags := fieldMapperRegistry.MapAll(r.RawFields)
+		resp.Diagnostics.Append(mapDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		dynamicValues := make(map[string]attr.Value, len(mapped))
+		for key, value := range mapped {
+			dynamicValues[key] = types.DynamicValue(value)
+		}
+		fieldMappings, diags := types.MapValue(types.DynamicType, dynamicValues)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		issue.FieldMappings = fieldMappings
+
 		issues = append(issues, issue)
 	}
 
@@ -231,3 +534,71 @@ func (d *IssuesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 func bigFloatFromFloat64(f float64) *big.Float {
 	return big.NewFloat(f)
 }
+
+// buildIssuesFilterJQL compiles an IssuesFilterModel into a JQL string via the atlassian.JQL
+// clause builders and rest.JQLBuilder.
+func buildIssuesFilterJQL(ctx context.Context, filter *IssuesFilterModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var clauses []atlassian.JQL
+
+	if v := filter.Project.ValueString(); v != "" {
+		clauses = append(clauses, atlassian.Project(v))
+	}
+	if !filter.StatusIn.IsNull() && !filter.StatusIn.IsUnknown() {
+		var statuses []string
+		diags.Append(filter.StatusIn.ElementsAs(ctx, &statuses, false)...)
+		if len(statuses) > 0 {
+			values := make([]any, len(statuses))
+			for i, s := range statuses {
+				values[i] = s
+			}
+			clauses = append(clauses, atlassian.Status().In(values...))
+		}
+	}
+	if v := filter.Assignee.ValueString(); v != "" {
+		if v == "currentUser()" {
+			clauses = append(clauses, atlassian.Assignee().Is(atlassian.Func("currentUser")))
+		} else {
+			clauses = append(clauses, atlassian.Assignee().Is(v))
+		}
+	}
+	if !filter.LabelsContains.IsNull() && !filter.LabelsContains.IsUnknown() {
+		var labels []string
+		diags.Append(filter.LabelsContains.ElementsAs(ctx, &labels, false)...)
+		if len(labels) > 0 {
+			values := make([]any, len(labels))
+			for i, l := range labels {
+				values[i] = l
+			}
+			clauses = append(clauses, atlassian.Labels().Contains(values...))
+		}
+	}
+	if v := filter.CreatedAfter.ValueString(); v != "" {
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			diags.AddError("Invalid filter.created_after", fmt.Sprintf("expected RFC3339, got %q: %s", v, err))
+			return "", diags
+		}
+		clauses = append(clauses, atlassian.Created().After(parsed))
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+
+	var where atlassian.JQL
+	if len(clauses) > 0 {
+		where = atlassian.And(clauses...)
+	}
+	builder := rest.NewJQLBuilder(where)
+
+	if v := filter.OrderBy.ValueString(); v != "" {
+		field, dir, ok := strings.Cut(v, " ")
+		if !ok {
+			diags.AddError("Invalid filter.order_by", fmt.Sprintf("expected \"field ASC\" or \"field DESC\", got %q", v))
+			return "", diags
+		}
+		builder.OrderBy(field, strings.EqualFold(strings.TrimSpace(dir), "DESC"))
+	}
+
+	return builder.String(), diags
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"atlassian/atlassian/changelog"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JiraReleaseNotesDataSource{}
+
+func NewJiraReleaseNotesDataSource() datasource.DataSource {
+	return &JiraReleaseNotesDataSource{}
+}
+
+// JiraReleaseNotesDataSource defines the data source implementation.
+type JiraReleaseNotesDataSource struct {
+	providerData *JiraProviderData
+}
+
+// JiraReleaseNotesDataSourceModel describes the data source data model.
+type JiraReleaseNotesDataSourceModel struct {
+	CloudID  types.String `tfsdk:"cloud_id"`
+	RepoPath types.String `tfsdk:"repo_path"`
+	FromRef  types.String `tfsdk:"from_ref"`
+	ToRef    types.String `tfsdk:"to_ref"`
+	Markdown types.String `tfsdk:"markdown"`
+	JSON     types.String `tfsdk:"json"`
+}
+
+func (d *JiraReleaseNotesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_release_notes"
+}
+
+func (d *JiraReleaseNotesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a conventional-commit changelog between two git refs, enriched with the " +
+			"summary, type, and status of every Jira issue referenced by those commits.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"repo_path": schema.StringAttribute{
+				Description: "Path to the git repository to scan. Defaults to the working directory Terraform runs in.",
+				Optional:    true,
+			},
+			"from_ref": schema.StringAttribute{
+				Description: "The git ref the changelog range starts after (exclusive), e.g. the previous release tag.",
+				Required:    true,
+			},
+			"to_ref": schema.StringAttribute{
+				Description: "The git ref the changelog range ends at (inclusive), e.g. 'HEAD' or the release branch.",
+				Required:    true,
+			},
+			"markdown": schema.StringAttribute{
+				Description: "The changelog rendered as Markdown, grouped under a heading per conventional-commit type.",
+				Computed:    true,
+			},
+			"json": schema.StringAttribute{
+				Description: "The changelog as a JSON-encoded array of sections, each with its enriched entries.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *JiraReleaseNotesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *JiraReleaseNotesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JiraReleaseNotesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Determine cloud ID
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	repoPath := "."
+	if !data.RepoPath.IsNull() {
+		repoPath = data.RepoPath.ValueString()
+	}
+
+	sections, err := changelog.Generate(ctx, changelog.Options{
+		Client:   d.providerData.Client,
+		CloudID:  cloudID,
+		RepoPath: repoPath,
+		FromRef:  data.FromRef.ValueString(),
+		ToRef:    data.ToRef.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error generating Jira release notes",
+			fmt.Sprintf("Unable to generate changelog for %s..%s: %s", data.FromRef.ValueString(), data.ToRef.ValueString(), err),
+		)
+		return
+	}
+
+	encoded, err := json.Marshal(sections)
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding Jira release notes", err.Error())
+		return
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Markdown = types.StringValue(changelog.RenderMarkdown(sections))
+	data.JSON = types.StringValue(string(encoded))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian/atlassian"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JiraVersionDataSource{}
+
+func NewJiraVersionDataSource() datasource.DataSource {
+	return &JiraVersionDataSource{}
+}
+
+// JiraVersionDataSource defines the data source implementation.
+type JiraVersionDataSource struct {
+	providerData *JiraProviderData
+}
+
+// JiraVersionDataSourceModel describes the data source data model. Exactly one of id or
+// project_key+name must be set to identify the version.
+type JiraVersionDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectKey      types.String `tfsdk:"project_key"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Released        types.Bool   `tfsdk:"released"`
+	Archived        types.Bool   `tfsdk:"archived"`
+	ReleaseDate     types.String `tfsdk:"release_date"`
+	StartDate       types.String `tfsdk:"start_date"`
+	UserReleaseDate types.String `tfsdk:"user_release_date"`
+	Overdue         types.Bool   `tfsdk:"overdue"`
+}
+
+func (d *JiraVersionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version"
+}
+
+func (d *JiraVersionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Jira project version, either by id or by project_key+name. Schema is " +
+			"symmetric with jira_version's resource attributes, minus move/merge_into, so the two compose cleanly.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the version. Either this or project_key+name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The key of the project the version belongs to. Required when looking up by name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the version. Required (with project_key) when id is not set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the version, if set.",
+				Computed:    true,
+			},
+			"released": schema.BoolAttribute{
+				Description: "Whether the version is released.",
+				Computed:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the version is archived.",
+				Computed:    true,
+			},
+			"release_date": schema.StringAttribute{
+				Description: "The release date of the version (YYYY-MM-DD), if set.",
+				Computed:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "The start date of the version (YYYY-MM-DD), if set.",
+				Computed:    true,
+			},
+			"user_release_date": schema.StringAttribute{
+				Description: "The release date of the version formatted for display, as set by the user.",
+				Computed:    true,
+			},
+			"overdue": schema.BoolAttribute{
+				Description: "Whether the version's release date has passed while it remains unreleased.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *JiraVersionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *JiraVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JiraVersionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var version atlassian.JiraVersion
+
+	if !data.ID.IsNull() && !data.ID.IsUnknown() && strings.TrimSpace(data.ID.ValueString()) != "" {
+		v, err := d.providerData.Client.GetVersion(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching Jira version",
+				fmt.Sprintf("Unable to fetch version %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+		version = v
+	} else {
+		projectKey := strings.TrimSpace(data.ProjectKey.ValueString())
+		name := strings.TrimSpace(data.Name.ValueString())
+		if projectKey == "" || name == "" {
+			resp.Diagnostics.AddError(
+				"Missing Version Identifier",
+				"Either id, or both project_key and name, must be set to look up a Jira version.",
+			)
+			return
+		}
+
+		versions, err := d.providerData.Client.ListVersions(ctx, projectKey, 50)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing Jira versions",
+				fmt.Sprintf("Unable to list versions for project %s: %s", projectKey, err),
+			)
+			return
+		}
+
+		found := false
+		for _, v := range versions {
+			if v.Name == name {
+				version = v
+				found = true
+				break
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddError(
+				"Jira Version Not Found",
+				fmt.Sprintf("No version named %q was found in project %s.", name, projectKey),
+			)
+			return
+		}
+	}
+
+	jiraVersionDomainToDataSourceModel(version, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// jiraVersionDomainToDataSourceModel copies a JiraVersion returned by the API onto the data
+// source's model; mirrors versionDomainToModel in versions_resource.go, minus the resource-only
+// move/merge_into fields.
+func jiraVersionDomainToDataSourceModel(v atlassian.JiraVersion, data *JiraVersionDataSourceModel) {
+	data.ID = types.StringValue(v.ID)
+	data.Name = types.StringValue(v.Name)
+	data.ProjectKey = types.StringValue(v.ProjectKey)
+	data.Released = types.BoolValue(v.Released)
+	data.Archived = types.BoolValue(v.Archived)
+	data.Overdue = types.BoolValue(v.Overdue)
+	if v.Description != nil {
+		data.Description = types.StringValue(*v.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+	if v.ReleaseDate != nil {
+		data.ReleaseDate = types.StringValue(*v.ReleaseDate)
+	} else {
+		data.ReleaseDate = types.StringNull()
+	}
+	if v.StartDate != nil {
+		data.StartDate = types.StringValue(*v.StartDate)
+	} else {
+		data.StartDate = types.StringNull()
+	}
+	if v.UserReleaseDate != nil {
+		data.UserReleaseDate = types.StringValue(*v.UserReleaseDate)
+	} else {
+		data.UserReleaseDate = types.StringNull()
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &JiraVersionsDataSource{}
+
+func NewJiraVersionsDataSource() datasource.DataSource {
+	return &JiraVersionsDataSource{}
+}
+
+// JiraVersionsDataSource defines the data source implementation.
+type JiraVersionsDataSource struct {
+	providerData *JiraProviderData
+}
+
+// JiraVersionsDataSourceModel describes the data source data model.
+type JiraVersionsDataSourceModel struct {
+	ProjectKey types.String           `tfsdk:"project_key"`
+	Released   types.Bool             `tfsdk:"released"`
+	Archived   types.Bool             `tfsdk:"archived"`
+	Versions   []JiraVersionListModel `tfsdk:"versions"`
+}
+
+// JiraVersionListModel describes a single Jira version within a jira_versions listing.
+type JiraVersionListModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Released        types.Bool   `tfsdk:"released"`
+	Archived        types.Bool   `tfsdk:"archived"`
+	ReleaseDate     types.String `tfsdk:"release_date"`
+	StartDate       types.String `tfsdk:"start_date"`
+	UserReleaseDate types.String `tfsdk:"user_release_date"`
+	Overdue         types.Bool   `tfsdk:"overdue"`
+}
+
+func (d *JiraVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_versions"
+}
+
+func (d *JiraVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of Jira versions for a project, optionally filtered by released/archived status.",
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Description: "The key of the project to list versions for.",
+				Required:    true,
+			},
+			"released": schema.BoolAttribute{
+				Description: "If set, only versions whose released status matches this value are returned.",
+				Optional:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "If set, only versions whose archived status matches this value are returned.",
+				Optional:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "The versions matching the criteria.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the version.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the version.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the version, if set.",
+							Computed:    true,
+						},
+						"released": schema.BoolAttribute{
+							Description: "Whether the version is released.",
+							Computed:    true,
+						},
+						"archived": schema.BoolAttribute{
+							Description: "Whether the version is archived.",
+							Computed:    true,
+						},
+						"release_date": schema.StringAttribute{
+							Description: "The release date of the version (YYYY-MM-DD), if set.",
+							Computed:    true,
+						},
+						"start_date": schema.StringAttribute{
+							Description: "The start date of the version (YYYY-MM-DD), if set.",
+							Computed:    true,
+						},
+						"user_release_date": schema.StringAttribute{
+							Description: "The release date of the version formatted for display, as set by the user.",
+							Computed:    true,
+						},
+						"overdue": schema.BoolAttribute{
+							Description: "Whether the version's release date has passed while it remains unreleased.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JiraVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *JiraVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JiraVersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := strings.TrimSpace(data.ProjectKey.ValueString())
+	if projectKey == "" {
+		resp.Diagnostics.AddError("Missing Project Key", "project_key is required and cannot be empty.")
+		return
+	}
+
+	results, err := d.providerData.Client.ListVersions(ctx, projectKey, 50)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Jira versions",
+			fmt.Sprintf("Unable to list versions for project %s: %s", projectKey, err),
+		)
+		return
+	}
+
+	filterReleased := !data.Released.IsNull() && !data.Released.IsUnknown()
+	filterArchived := !data.Archived.IsNull() && !data.Archived.IsUnknown()
+
+	versions := make([]JiraVersionListModel, 0, len(results))
+	for _, v := range results {
+		if filterReleased && v.Released != data.Released.ValueBool() {
+			continue
+		}
+		if filterArchived && v.Archived != data.Archived.ValueBool() {
+			continue
+		}
+
+		m := JiraVersionListModel{
+			ID:       types.StringValue(v.ID),
+			Name:     types.StringValue(v.Name),
+			Released: types.BoolValue(v.Released),
+			Archived: types.BoolValue(v.Archived),
+			Overdue:  types.BoolValue(v.Overdue),
+		}
+		if v.Description != nil {
+			m.Description = types.StringValue(*v.Description)
+		} else {
+			m.Description = types.StringNull()
+		}
+		if v.ReleaseDate != nil {
+			m.ReleaseDate = types.StringValue(*v.ReleaseDate)
+		} else {
+			m.ReleaseDate = types.StringNull()
+		}
+		if v.StartDate != nil {
+			m.StartDate = types.StringValue(*v.StartDate)
+		} else {
+			m.StartDate = types.StringNull()
+		}
+		if v.UserReleaseDate != nil {
+			m.UserReleaseDate = types.StringValue(*v.UserReleaseDate)
+		} else {
+			m.UserReleaseDate = types.StringNull()
+		}
+		versions = append(versions, m)
+	}
+
+	data.Versions = versions
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
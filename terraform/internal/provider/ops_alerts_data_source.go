@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OpsAlertsDataSource{}
+
+func NewOpsAlertsDataSource() datasource.DataSource {
+	return &OpsAlertsDataSource{}
+}
+
+// OpsAlertsDataSource defines the data source implementation.
+type OpsAlertsDataSource struct {
+	providerData *JiraProviderData
+}
+
+// OpsAlertsDataSourceModel describes the data source data model.
+type OpsAlertsDataSourceModel struct {
+	CloudID    types.String    `tfsdk:"cloud_id"`
+	ProjectKey types.String    `tfsdk:"project_key"`
+	Alerts     []OpsAlertModel `tfsdk:"alerts"`
+}
+
+// OpsAlertModel describes a single AtlassianOps alert.
+type OpsAlertModel struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Message    types.String `tfsdk:"message"`
+	Status     types.String `tfsdk:"status"`
+	Priority   types.String `tfsdk:"priority"`
+	URL        types.String `tfsdk:"url"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+func (d *OpsAlertsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ops_alerts"
+}
+
+func (d *OpsAlertsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the AtlassianOps (Opsgenie) alerts for a project, via the Teamwork Graph API, to drive on-call automation.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The Jira project key (e.g., 'PROJ') to fetch alerts for.",
+				Required:    true,
+			},
+			"alerts": schema.ListNestedAttribute{
+				Description: "The alerts raised against the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The alert ID.",
+							Computed:    true,
+						},
+						"project_key": schema.StringAttribute{
+							Description: "The project key this alert belongs to.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The alert message.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The alert status.",
+							Computed:    true,
+						},
+						"priority": schema.StringAttribute{
+							Description: "The alert priority, if set.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "A link to the alert, if available.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the alert was created (RFC3339 format), if available.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OpsAlertsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *OpsAlertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OpsAlertsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	projectKey := strings.TrimSpace(data.ProjectKey.ValueString())
+	if projectKey == "" {
+		resp.Diagnostics.AddError("Missing Project Key", "project_key is required and cannot be empty.")
+		return
+	}
+
+	results, err := d.providerData.GraphClient.IterProjectAlertsViaGraphQL(ctx, cloudID, projectKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching AtlassianOps alerts",
+			fmt.Sprintf("Unable to fetch alerts for project %s: %s", projectKey, err),
+		)
+		return
+	}
+
+	alerts := make([]OpsAlertModel, 0, len(results))
+	for _, r := range results {
+		alert := OpsAlertModel{
+			ID:         types.StringValue(r.ID),
+			ProjectKey: types.StringValue(r.ProjectKey),
+			Message:    types.StringValue(r.Message),
+			Status:     types.StringValue(r.Status),
+		}
+		if r.Priority != nil {
+			alert.Priority = types.StringValue(*r.Priority)
+		} else {
+			alert.Priority = types.StringNull()
+		}
+		if r.URL != nil {
+			alert.URL = types.StringValue(*r.URL)
+		} else {
+			alert.URL = types.StringNull()
+		}
+		if r.CreatedAt != nil {
+			alert.CreatedAt = types.StringValue(*r.CreatedAt)
+		} else {
+			alert.CreatedAt = types.StringNull()
+		}
+		alerts = append(alerts, alert)
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Alerts = alerts
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
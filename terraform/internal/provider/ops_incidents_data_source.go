@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OpsIncidentsDataSource{}
+
+func NewOpsIncidentsDataSource() datasource.DataSource {
+	return &OpsIncidentsDataSource{}
+}
+
+// OpsIncidentsDataSource defines the data source implementation.
+type OpsIncidentsDataSource struct {
+	providerData *JiraProviderData
+}
+
+// OpsIncidentsDataSourceModel describes the data source data model.
+type OpsIncidentsDataSourceModel struct {
+	CloudID   types.String       `tfsdk:"cloud_id"`
+	IssueKey  types.String       `tfsdk:"issue_key"`
+	Incidents []OpsIncidentModel `tfsdk:"incidents"`
+}
+
+// OpsIncidentModel describes a single AtlassianOps incident.
+type OpsIncidentModel struct {
+	ID        types.String `tfsdk:"id"`
+	IssueKey  types.String `tfsdk:"issue_key"`
+	Title     types.String `tfsdk:"title"`
+	Status    types.String `tfsdk:"status"`
+	Severity  types.String `tfsdk:"severity"`
+	URL       types.String `tfsdk:"url"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *OpsIncidentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ops_incidents"
+}
+
+func (d *OpsIncidentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the AtlassianOps (Opsgenie) incidents linked to a Jira issue, via the Teamwork Graph API.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The Jira issue key (e.g., 'PROJ-123') to fetch linked incidents for.",
+				Required:    true,
+			},
+			"incidents": schema.ListNestedAttribute{
+				Description: "The incidents linked to the issue.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The incident ID.",
+							Computed:    true,
+						},
+						"issue_key": schema.StringAttribute{
+							Description: "The Jira issue key this incident is linked to.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The incident title.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The incident status.",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "The incident severity, if set.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "A link to the incident, if available.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the incident was created (RFC3339 format), if available.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OpsIncidentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *OpsIncidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OpsIncidentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	issueKey := strings.TrimSpace(data.IssueKey.ValueString())
+	if issueKey == "" {
+		resp.Diagnostics.AddError("Missing Issue Key", "issue_key is required and cannot be empty.")
+		return
+	}
+
+	results, err := d.providerData.GraphClient.IterIssueIncidentsViaGraphQL(ctx, cloudID, issueKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching AtlassianOps incidents",
+			fmt.Sprintf("Unable to fetch incidents for issue %s: %s", issueKey, err),
+		)
+		return
+	}
+
+	incidents := make([]OpsIncidentModel, 0, len(results))
+	for _, r := range results {
+		incident := OpsIncidentModel{
+			ID:       types.StringValue(r.ID),
+			IssueKey: types.StringValue(r.IssueKey),
+			Title:    types.StringValue(r.Title),
+			Status:   types.StringValue(r.Status),
+		}
+		if r.Severity != nil {
+			incident.Severity = types.StringValue(*r.Severity)
+		} else {
+			incident.Severity = types.StringNull()
+		}
+		if r.URL != nil {
+			incident.URL = types.StringValue(*r.URL)
+		} else {
+			incident.URL = types.StringNull()
+		}
+		if r.CreatedAt != nil {
+			incident.CreatedAt = types.StringValue(*r.CreatedAt)
+		} else {
+			incident.CreatedAt = types.StringNull()
+		}
+		incidents = append(incidents, incident)
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Incidents = incidents
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
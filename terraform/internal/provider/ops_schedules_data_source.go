@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OpsSchedulesDataSource{}
+
+func NewOpsSchedulesDataSource() datasource.DataSource {
+	return &OpsSchedulesDataSource{}
+}
+
+// OpsSchedulesDataSource defines the data source implementation.
+type OpsSchedulesDataSource struct {
+	providerData *JiraProviderData
+}
+
+// OpsSchedulesDataSourceModel describes the data source data model.
+type OpsSchedulesDataSourceModel struct {
+	CloudID    types.String       `tfsdk:"cloud_id"`
+	ProjectKey types.String       `tfsdk:"project_key"`
+	Schedules  []OpsScheduleModel `tfsdk:"schedules"`
+}
+
+// OpsScheduleModel describes a single AtlassianOps on-call schedule.
+type OpsScheduleModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProjectKey   types.String `tfsdk:"project_key"`
+	Name         types.String `tfsdk:"name"`
+	Timezone     types.String `tfsdk:"timezone"`
+	OnCallUserID types.String `tfsdk:"on_call_user_id"`
+	URL          types.String `tfsdk:"url"`
+}
+
+func (d *OpsSchedulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ops_schedules"
+}
+
+func (d *OpsSchedulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the AtlassianOps (Opsgenie) on-call schedules for a project, via the Teamwork Graph API, to drive on-call automation.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_id": schema.StringAttribute{
+				Description: "The Atlassian Cloud ID. If not specified, uses the provider's cloud_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_key": schema.StringAttribute{
+				Description: "The Jira project key (e.g., 'PROJ') to fetch on-call schedules for.",
+				Required:    true,
+			},
+			"schedules": schema.ListNestedAttribute{
+				Description: "The on-call schedules owned by the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The schedule ID.",
+							Computed:    true,
+						},
+						"project_key": schema.StringAttribute{
+							Description: "The project key this schedule belongs to.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The schedule name.",
+							Computed:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "The schedule's timezone, if set.",
+							Computed:    true,
+						},
+						"on_call_user_id": schema.StringAttribute{
+							Description: "The Atlassian account ID of the user currently on call, if set.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "A link to the schedule, if available.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OpsSchedulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *OpsSchedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OpsSchedulesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudID := d.providerData.CloudID
+	if !data.CloudID.IsNull() && !data.CloudID.IsUnknown() {
+		cloudID = data.CloudID.ValueString()
+	}
+
+	projectKey := strings.TrimSpace(data.ProjectKey.ValueString())
+	if projectKey == "" {
+		resp.Diagnostics.AddError("Missing Project Key", "project_key is required and cannot be empty.")
+		return
+	}
+
+	results, err := d.providerData.GraphClient.IterProjectSchedulesViaGraphQL(ctx, cloudID, projectKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching AtlassianOps schedules",
+			fmt.Sprintf("Unable to fetch schedules for project %s: %s", projectKey, err),
+		)
+		return
+	}
+
+	schedules := make([]OpsScheduleModel, 0, len(results))
+	for _, r := range results {
+		sched := OpsScheduleModel{
+			ID:         types.StringValue(r.ID),
+			ProjectKey: types.StringValue(r.ProjectKey),
+			Name:       types.StringValue(r.Name),
+		}
+		if r.Timezone != nil {
+			sched.Timezone = types.StringValue(*r.Timezone)
+		} else {
+			sched.Timezone = types.StringNull()
+		}
+		if r.OnCallUserID != nil {
+			sched.OnCallUserID = types.StringValue(*r.OnCallUserID)
+		} else {
+			sched.OnCallUserID = types.StringNull()
+		}
+		if r.URL != nil {
+			sched.URL = types.StringValue(*r.URL)
+		} else {
+			sched.URL = types.StringNull()
+		}
+		schedules = append(schedules, sched)
+	}
+
+	data.CloudID = types.StringValue(cloudID)
+	data.Schedules = schedules
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
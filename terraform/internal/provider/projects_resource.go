@@ -32,10 +32,19 @@ type ProjectResource struct {
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	CloudID types.String `tfsdk:"cloud_id"`
-	Key     types.String `tfsdk:"key"`
-	Name    types.String `tfsdk:"name"`
-	Type    types.String `tfsdk:"type"`
+	CloudID            types.String `tfsdk:"cloud_id"`
+	Key                types.String `tfsdk:"key"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	Description        types.String `tfsdk:"description"`
+	LeadAccountID      types.String `tfsdk:"lead_account_id"`
+	AssigneeType       types.String `tfsdk:"assignee_type"`
+	ProjectTemplateKey types.String `tfsdk:"project_template_key"`
+	ProjectTypeKey     types.String `tfsdk:"project_type_key"`
+	CategoryID         types.String `tfsdk:"category_id"`
+	URL                types.String `tfsdk:"url"`
+	AvatarID           types.String `tfsdk:"avatar_id"`
+	State              types.String `tfsdk:"state"`
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +78,50 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:    true,
 				Computed:    true,
 			},
+			"description": schema.StringAttribute{
+				Description: "The project description.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"lead_account_id": schema.StringAttribute{
+				Description: "The Atlassian account ID of the project lead.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"assignee_type": schema.StringAttribute{
+				Description: "The default assignee when creating issues (e.g., 'PROJECT_LEAD', 'UNASSIGNED').",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_template_key": schema.StringAttribute{
+				Description: "The project template key used to create the project (e.g., 'com.pyxis.greenhopper.jira:gh-scrum-template'). Only read on create; changing it has no effect on an existing project.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"project_type_key": schema.StringAttribute{
+				Description: "The raw project type key reported by Jira (e.g., 'software', 'business').",
+				Optional:    true,
+				Computed:    true,
+			},
+			"category_id": schema.StringAttribute{
+				Description: "The ID of the project category this project belongs to.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "A URL link to information about the project.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"avatar_id": schema.StringAttribute{
+				Description: "The ID of the project's avatar.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The project's lifecycle state as last observed from Jira: 'active' or 'archived'. Archived projects are surfaced here rather than removed from state.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -109,6 +162,38 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		pt := data.Type.ValueString()
 		p.Type = &pt
 	}
+	if !data.Description.IsNull() {
+		v := data.Description.ValueString()
+		p.Description = &v
+	}
+	if !data.LeadAccountID.IsNull() {
+		v := data.LeadAccountID.ValueString()
+		p.LeadAccountID = &v
+	}
+	if !data.AssigneeType.IsNull() {
+		v := data.AssigneeType.ValueString()
+		p.AssigneeType = &v
+	}
+	if !data.ProjectTemplateKey.IsNull() {
+		v := data.ProjectTemplateKey.ValueString()
+		p.ProjectTemplateKey = &v
+	}
+	if !data.ProjectTypeKey.IsNull() {
+		v := data.ProjectTypeKey.ValueString()
+		p.ProjectTypeKey = &v
+	}
+	if !data.CategoryID.IsNull() {
+		v := data.CategoryID.ValueString()
+		p.CategoryID = &v
+	}
+	if !data.URL.IsNull() {
+		v := data.URL.ValueString()
+		p.URL = &v
+	}
+	if !data.AvatarID.IsNull() {
+		v := data.AvatarID.ValueString()
+		p.AvatarID = &v
+	}
 
 	created, err := r.providerData.Client.CreateProject(ctx, p.CloudID, p)
 	if err != nil {
@@ -116,17 +201,52 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// Map response back to model
-	data.Key = types.StringValue(created.Key)
-	data.Name = types.StringValue(created.Name)
-	if created.Type != nil {
-		data.Type = types.StringValue(*created.Type)
-	}
+	applyProjectToModel(&data, created)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// applyProjectToModel copies every field of a decoded atlassian.JiraProject onto a
+// ProjectResourceModel, used by Create/Read/Update so the three don't drift on which fields get
+// mapped back into state.
+func applyProjectToModel(data *ProjectResourceModel, p atlassian.JiraProject) {
+	data.Key = types.StringValue(p.Key)
+	data.Name = types.StringValue(p.Name)
+	if p.Type != nil {
+		data.Type = types.StringValue(*p.Type)
+	}
+	if p.Description != nil {
+		data.Description = types.StringValue(*p.Description)
+	}
+	if p.LeadAccountID != nil {
+		data.LeadAccountID = types.StringValue(*p.LeadAccountID)
+	}
+	if p.AssigneeType != nil {
+		data.AssigneeType = types.StringValue(*p.AssigneeType)
+	}
+	if p.ProjectTemplateKey != nil {
+		data.ProjectTemplateKey = types.StringValue(*p.ProjectTemplateKey)
+	}
+	if p.ProjectTypeKey != nil {
+		data.ProjectTypeKey = types.StringValue(*p.ProjectTypeKey)
+	}
+	if p.CategoryID != nil {
+		data.CategoryID = types.StringValue(*p.CategoryID)
+	}
+	if p.URL != nil {
+		data.URL = types.StringValue(*p.URL)
+	}
+	if p.AvatarID != nil {
+		data.AvatarID = types.StringValue(*p.AvatarID)
+	}
+	if p.Archived {
+		data.State = types.StringValue("archived")
+	} else {
+		data.State = types.StringValue("active")
+	}
+}
+
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data ProjectResourceModel
 
@@ -136,21 +256,69 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	path := fmt.Sprintf("/rest/api/3/project/%s", data.Key.ValueString())
-	payload, err := r.providerData.Client.GetJSON(ctx, path, nil)
+	current, err := r.providerData.Client.GetProject(ctx, data.CloudID.ValueString(), data.Key.ValueString())
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	_ = payload
+
+	applyProjectToModel(&data, current)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Jira REST API for updating projects is limited and often requires special permissions.
-	// For simplicity, we'll just error for now or implement if needed.
-	resp.Diagnostics.AddError("Update Not Implemented", "Updating Jira projects via this provider is not yet supported.")
+	var data ProjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := atlassian.JiraProject{
+		CloudID: data.CloudID.ValueString(),
+		Key:     data.Key.ValueString(),
+		Name:    data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		v := data.Description.ValueString()
+		p.Description = &v
+	}
+	if !data.LeadAccountID.IsNull() {
+		v := data.LeadAccountID.ValueString()
+		p.LeadAccountID = &v
+	}
+	if !data.AssigneeType.IsNull() {
+		v := data.AssigneeType.ValueString()
+		p.AssigneeType = &v
+	}
+	if !data.ProjectTypeKey.IsNull() {
+		v := data.ProjectTypeKey.ValueString()
+		p.ProjectTypeKey = &v
+	}
+	if !data.CategoryID.IsNull() {
+		v := data.CategoryID.ValueString()
+		p.CategoryID = &v
+	}
+	if !data.URL.IsNull() {
+		v := data.URL.ValueString()
+		p.URL = &v
+	}
+	if !data.AvatarID.IsNull() {
+		v := data.AvatarID.ValueString()
+		p.AvatarID = &v
+	}
+
+	updated, err := r.providerData.Client.UpdateProject(ctx, p.CloudID, p)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Jira project", err.Error())
+		return
+	}
+
+	applyProjectToModel(&data, updated)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -5,10 +5,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"atlassian/atlassian"
+	"atlassian/atlassian/graph"
 	"atlassian/atlassian/rest"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -27,15 +32,44 @@ type JiraProvider struct {
 	// provider is built and run locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// credsOnce/cachedCreds cache the result of resolving credentials_source for the lifetime of
+	// this provider instance, so a "vault" or "exec" source isn't re-resolved on every Configure
+	// call a given Terraform run happens to make.
+	credsOnce   sync.Once
+	cachedCreds Credentials
 }
 
 // JiraProviderModel describes the provider data model.
 type JiraProviderModel struct {
-	BaseURL     types.String `tfsdk:"base_url"`
-	CloudID     types.String `tfsdk:"cloud_id"`
-	Email       types.String `tfsdk:"email"`
-	ApiToken    types.String `tfsdk:"api_token"`
-	AccessToken types.String `tfsdk:"access_token"`
+	BaseURL           types.String             `tfsdk:"base_url"`
+	CloudID           types.String             `tfsdk:"cloud_id"`
+	Email             types.String             `tfsdk:"email"`
+	ApiToken          types.String             `tfsdk:"api_token"`
+	ApiTokenFile      types.String             `tfsdk:"api_token_file"`
+	AccessToken       types.String             `tfsdk:"access_token"`
+	ClientID          types.String             `tfsdk:"client_id"`
+	ClientSecret      types.String             `tfsdk:"client_secret"`
+	RefreshToken      types.String             `tfsdk:"refresh_token"`
+	TokenURL          types.String             `tfsdk:"token_url"`
+	CredentialsSource []CredentialsSourceModel `tfsdk:"credentials_source"`
+	MaxRetries        types.Int64              `tfsdk:"max_retries"`
+	RateLimitQPS      types.Float64            `tfsdk:"rate_limit_qps"`
+	RequestTimeout    types.Int64              `tfsdk:"request_timeout"`
+}
+
+// CredentialsSourceModel describes one `credentials_source` block. Which fields are consulted
+// depends on Type: "file" (path), "keyring" (service, key), "vault" (vault_mount, vault_path), or
+// "exec" (command, args).
+type CredentialsSourceModel struct {
+	Type       types.String `tfsdk:"type"`
+	Path       types.String `tfsdk:"path"`
+	Service    types.String `tfsdk:"service"`
+	Key        types.String `tfsdk:"key"`
+	VaultMount types.String `tfsdk:"vault_mount"`
+	VaultPath  types.String `tfsdk:"vault_path"`
+	Command    types.String `tfsdk:"command"`
+	Args       types.List   `tfsdk:"args"`
 }
 
 func (p *JiraProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -65,24 +99,181 @@ func (p *JiraProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 			},
 			"api_token": schema.StringAttribute{
 				Description: "API Token for Basic authentication. " +
-					"Can also be set via the ATLASSIAN_API_TOKEN environment variable.",
+					"Can also be set via the ATLASSIAN_API_TOKEN environment variable, or ATLASSIAN_API_TOKEN_FILE " +
+					"to read it from a file (the Docker/Kubernetes secrets convention).",
 				Optional:  true,
 				Sensitive: true,
 			},
+			"api_token_file": schema.StringAttribute{
+				Description: "Path to a file containing the API Token, read once during Configure. Takes " +
+					"precedence over ATLASSIAN_API_TOKEN and ATLASSIAN_API_TOKEN_FILE, but loses to api_token. " +
+					"Useful for Vault Agent and similar tools that render a secret to a file rather than an " +
+					"environment variable.",
+				Optional: true,
+			},
 			"access_token": schema.StringAttribute{
 				Description: "OAuth 2.0 access token for Bearer authentication. " +
 					"Can also be set via the ATLASSIAN_OAUTH_ACCESS_TOKEN environment variable.",
 				Optional:  true,
 				Sensitive: true,
 			},
+			"client_id": schema.StringAttribute{
+				Description: "OAuth 2.0 (3LO) client ID, used with client_secret and refresh_token to " +
+					"automatically mint and refresh access tokens instead of supplying one directly via access_token. " +
+					"Can also be set via the ATLASSIAN_CLIENT_ID environment variable.",
+				Optional: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "OAuth 2.0 (3LO) client secret, paired with client_id. " +
+					"Can also be set via the ATLASSIAN_CLIENT_SECRET environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"refresh_token": schema.StringAttribute{
+				Description: "OAuth 2.0 (3LO) refresh token, exchanged for short-lived access tokens as needed. " +
+					"Requires client_id and client_secret. " +
+					"Can also be set via the ATLASSIAN_OAUTH_REFRESH_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_url": schema.StringAttribute{
+				Description: "Token endpoint used to exchange refresh_token for access tokens. Defaults to " +
+					"https://auth.atlassian.com/oauth/token. " +
+					"Can also be set via the ATLASSIAN_OAUTH_TOKEN_URL environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Number of additional attempts after the first, for 429/5xx responses and " +
+					"transient transport errors. Defaults to 3. " +
+					"Can also be set via the ATLASSIAN_JIRA_MAX_RETRIES environment variable.",
+				Optional: true,
+			},
+			"rate_limit_qps": schema.Float64Attribute{
+				Description: "Per-host token-bucket refill rate, in requests per second, shared by the REST " +
+					"and GraphQL clients. Unset (the default) disables rate limiting; Atlassian Cloud enforces " +
+					"its own per-tenant limits regardless. " +
+					"Can also be set via the ATLASSIAN_JIRA_MAX_QPS environment variable.",
+				Optional: true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Per-request timeout, in seconds, applied to every Jira REST and GraphQL call. " +
+					"Unset (the default) leaves requests unbounded beyond context cancellation. " +
+					"Can also be set via the ATLASSIAN_JIRA_REQUEST_TIMEOUT_SECONDS environment variable.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials_source": schema.ListNestedBlock{
+				Description: "Alternative sources to resolve authentication fields from, consulted in the " +
+					"order given (earlier blocks win; later ones only fill in fields an earlier block left " +
+					"empty), and only after the attributes above and their environment variables. Lets CI " +
+					"systems and shared workstations keep long-lived Jira API tokens out of tfvars/shell env.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: `One of "file", "keyring", "vault", or "exec".`,
+						},
+						"path": schema.StringAttribute{
+							Optional:    true,
+							Description: `type = "file": path to a JSON document with email/api_token/refresh_token/etc. fields.`,
+						},
+						"service": schema.StringAttribute{
+							Optional:    true,
+							Description: `type = "keyring": OS keychain service name. Defaults to "jira-terraform".`,
+						},
+						"key": schema.StringAttribute{
+							Optional:    true,
+							Description: `type = "keyring": key within service holding the same JSON document shape as the file source. Defaults to "default".`,
+						},
+						"vault_mount": schema.StringAttribute{
+							Optional:    true,
+							Description: `type = "vault": KV v2 mount path. Defaults to "secret". Uses VAULT_ADDR/VAULT_TOKEN.`,
+						},
+						"vault_path": schema.StringAttribute{
+							Optional:    true,
+							Description: `type = "vault": secret path within the KV v2 mount.`,
+						},
+						"command": schema.StringAttribute{
+							Optional:    true,
+							Description: `type = "exec": helper executable to run; its stdout is parsed as the same JSON document shape as the file source.`,
+						},
+						"args": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: `type = "exec": arguments passed to command.`,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 // JiraProviderData holds the configured client and settings passed to data sources and resources.
 type JiraProviderData struct {
-	Client  *rest.JiraRESTClient
-	CloudID string
+	Client      *rest.JiraRESTClient
+	GraphClient *graph.Client
+	CloudID     string
+}
+
+// clientOptionsFromConfig builds rest.ClientOptions for the provider's JiraRESTClient (and, for
+// MaxRetries/MaxQPS/Timeout, the shared graph.Client transport) from the max_retries/
+// rate_limit_qps/request_timeout attributes, falling back to the
+// ATLASSIAN_JIRA_MAX_RETRIES/MAX_QPS/BURST/CIRCUIT_THRESHOLD/CIRCUIT_COOLDOWN_SECONDS/
+// REQUEST_TIMEOUT_SECONDS environment variables, and then to NewJiraRESTClientWithOptions's
+// defaults for anything still unset or unparseable. Forwards retries/throttling/circuit-breaker
+// trips and a per-request log line to tflog.
+func clientOptionsFromConfig(ctx context.Context, config JiraProviderModel) rest.ClientOptions {
+	opts := rest.ClientOptions{
+		Observer: tflogObserver{ctx: ctx},
+	}
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		opts.MaxRetries = int(config.MaxRetries.ValueInt64())
+	} else if v, err := strconv.Atoi(strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_MAX_RETRIES"))); err == nil {
+		opts.MaxRetries = v
+	}
+
+	if !config.RateLimitQPS.IsNull() && !config.RateLimitQPS.IsUnknown() {
+		opts.MaxQPS = config.RateLimitQPS.ValueFloat64()
+	} else if v, err := strconv.ParseFloat(strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_MAX_QPS")), 64); err == nil {
+		opts.MaxQPS = v
+	}
+
+	if v, err := strconv.Atoi(strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_BURST"))); err == nil {
+		opts.Burst = v
+	}
+	if v, err := strconv.Atoi(strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_CIRCUIT_THRESHOLD"))); err == nil {
+		opts.CircuitThreshold = v
+	}
+	if v, err := strconv.Atoi(strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_CIRCUIT_COOLDOWN_SECONDS"))); err == nil {
+		opts.CircuitCooldown = time.Duration(v) * time.Second
+	}
+
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		opts.Timeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	} else if v, err := strconv.Atoi(strings.TrimSpace(os.Getenv("ATLASSIAN_JIRA_REQUEST_TIMEOUT_SECONDS"))); err == nil {
+		opts.Timeout = time.Duration(v) * time.Second
+	}
+
+	return opts
+}
+
+// experimentalGraphAPIsFromEnv parses the comma-separated ATLASSIAN_GQL_EXPERIMENTAL_APIS
+// environment variable into the opt-in list expected by graph.Client.
+func experimentalGraphAPIsFromEnv() []string {
+	raw := os.Getenv("ATLASSIAN_GQL_EXPERIMENTAL_APIS")
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	var out []string
+	for _, p := range strings.Split(raw, ",") {
+		if s := strings.TrimSpace(p); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
 }
 
 func (p *JiraProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -96,21 +287,72 @@ func (p *JiraProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	baseURL := getConfigOrEnv(config.BaseURL, "ATLASSIAN_JIRA_BASE_URL", "")
 	cloudID := getConfigOrEnvMulti(config.CloudID, []string{"ATLASSIAN_CLOUD_ID", "ATLASSIAN_JIRA_CLOUD_ID"}, "")
 	email := getConfigOrEnv(config.Email, "ATLASSIAN_EMAIL", "")
-	apiToken := getConfigOrEnv(config.ApiToken, "ATLASSIAN_API_TOKEN", "")
+	apiToken, err := getConfigOrEnvOrFile(config.ApiToken, []string{"ATLASSIAN_API_TOKEN"}, config.ApiTokenFile, "")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid api_token_file", err.Error())
+		return
+	}
 	accessToken := getConfigOrEnv(config.AccessToken, "ATLASSIAN_OAUTH_ACCESS_TOKEN", "")
+	clientID := getConfigOrEnv(config.ClientID, "ATLASSIAN_CLIENT_ID", "")
+	clientSecret := getConfigOrEnv(config.ClientSecret, "ATLASSIAN_CLIENT_SECRET", "")
+	refreshToken := getConfigOrEnv(config.RefreshToken, "ATLASSIAN_OAUTH_REFRESH_TOKEN", "")
+	tokenURL := getConfigOrEnv(config.TokenURL, "ATLASSIAN_OAUTH_TOKEN_URL", "")
 
-	// Validate cloud_id
-	if cloudID == "" {
-		resp.Diagnostics.AddError(
-			"Missing Cloud ID",
-			"The provider requires a Cloud ID. Set the cloud_id attribute or the ATLASSIAN_CLOUD_ID environment variable.",
-		)
+	// credentials_source is consulted last, after explicit attributes and their environment
+	// variables, and only to fill in whatever those left blank.
+	if len(config.CredentialsSource) > 0 {
+		p.credsOnce.Do(func() {
+			providers, err := credentialProvidersFromModel(config.CredentialsSource)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid credentials_source", err.Error())
+				return
+			}
+			p.cachedCreds = resolveCredentialChain(ctx, providers)
+		})
+		creds := p.cachedCreds
+		baseURL = firstNonEmpty(baseURL, creds.BaseURL)
+		cloudID = firstNonEmpty(cloudID, creds.CloudID)
+		email = firstNonEmpty(email, creds.Email)
+		apiToken = firstNonEmpty(apiToken, creds.APIToken)
+		accessToken = firstNonEmpty(accessToken, creds.AccessToken)
+		clientID = firstNonEmpty(clientID, creds.ClientID)
+		clientSecret = firstNonEmpty(clientSecret, creds.ClientSecret)
+		refreshToken = firstNonEmpty(refreshToken, creds.RefreshToken)
+		tokenURL = firstNonEmpty(tokenURL, creds.TokenURL)
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Determine authentication method
+	// Determine authentication method. This runs before the cloud_id check below because the
+	// OAuth refresh-token flow can auto-discover cloud_id from accessible-resources when it's
+	// omitted.
 	var auth atlassian.AuthProvider
-	if accessToken != "" {
+	if refreshToken != "" && clientID != "" && clientSecret != "" {
+		// OAuth 2.0 (3LO) with automatic access-token refresh
+		refreshAuth := &atlassian.OAuthRefreshTokenAuth{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RefreshToken: refreshToken,
+			TokenURL:     tokenURL,
+		}
+		auth = refreshAuth
+		if cloudID == "" {
+			discovered, err := atlassian.DiscoverCloudID(ctx, refreshAuth, baseURL)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Cloud ID Discovery Failed",
+					"cloud_id was not set and could not be auto-discovered from accessible-resources: "+err.Error(),
+				)
+				return
+			}
+			cloudID = discovered
+		}
+		// Default base URL for OAuth
+		if baseURL == "" {
+			baseURL = "https://api.atlassian.com/ex/jira/" + cloudID
+		}
+	} else if accessToken != "" {
 		// OAuth Bearer authentication
 		token := accessToken
 		auth = atlassian.BearerAuth{
@@ -139,19 +381,40 @@ func (p *JiraProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	} else {
 		resp.Diagnostics.AddError(
 			"Missing Authentication",
-			"The provider requires authentication. Provide either access_token (OAuth) or email + api_token (Basic auth).",
+			"The provider requires authentication. Provide client_id + client_secret + refresh_token (OAuth with auto-refresh), "+
+				"access_token (OAuth), or email + api_token (Basic auth).",
+		)
+		return
+	}
+
+	// Validate cloud_id. The OAuth refresh-token branch above may have already filled this in via
+	// accessible-resources discovery.
+	if cloudID == "" {
+		resp.Diagnostics.AddError(
+			"Missing Cloud ID",
+			"The provider requires a Cloud ID. Set the cloud_id attribute or the ATLASSIAN_CLOUD_ID environment variable.",
 		)
 		return
 	}
 
-	client := &rest.JiraRESTClient{
-		BaseURL: baseURL,
-		Auth:    auth,
+	clientOpts := clientOptionsFromConfig(ctx, config)
+	clientOpts.Auth = auth
+	client := rest.NewJiraRESTClientWithOptions(baseURL, auth, clientOpts)
+
+	// The GraphQL client shares the same retry/rate-limit/circuit-breaker Transport as the REST
+	// client rather than getting its own: both talk to the same Jira host, so a single token
+	// bucket and circuit breaker per host is what actually reflects Jira's per-tenant limits.
+	graphClient := &graph.Client{
+		BaseURL:          baseURL,
+		Auth:             auth,
+		ExperimentalAPIs: experimentalGraphAPIsFromEnv(),
+		HTTPClient:       client.HTTPClient,
 	}
 
 	providerData := &JiraProviderData{
-		Client:  client,
-		CloudID: cloudID,
+		Client:      client,
+		GraphClient: graphClient,
+		CloudID:     cloudID,
 	}
 
 	resp.DataSourceData = providerData
@@ -162,6 +425,13 @@ func (p *JiraProvider) Resources(ctx context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		NewProjectResource,
 		NewVersionResource,
+		NewIssueResource,
+		NewIssueAttachmentResource,
+		NewIssueCommentResource,
+		NewSprintResource,
+		NewBoardResource,
+		NewSprintIssuesResource,
+		NewWorklogResource,
 	}
 }
 
@@ -171,6 +441,18 @@ func (p *JiraProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewIssuesDataSource,
 		NewSprintsDataSource,
 		NewWorklogsDataSource,
+		NewJiraReleaseNotesDataSource,
+		NewCompassComponentGraphDataSource,
+		NewJiraVersionDataSource,
+		NewJiraVersionsDataSource,
+		NewCompassComponentDataSource,
+		NewCompassComponentsDataSource,
+		NewCompassComponentScorecardsDataSource,
+		NewCompassRelationshipsDataSource,
+		NewOpsIncidentsDataSource,
+		NewOpsAlertsDataSource,
+		NewOpsSchedulesDataSource,
+		NewIssueChangelogDataSource,
 	}
 }
 
@@ -185,6 +467,23 @@ func New(version string) func() provider.Provider {
 
 // Helper functions
 
+// readSecretFile reads path and trims trailing whitespace, the convention Docker/Kubernetes
+// secrets and Vault Agent templates follow when writing a single token to a file.
+func readSecretFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read secret file %q: %w", path, err)
+	}
+	return strings.TrimRight(string(data), " \t\r\n"), nil
+}
+
+// getConfigOrEnv resolves configValue, falling back to the environment variable envKey, and then
+// to defaultValue. As a Docker-secrets-style convention, envKey+"_FILE" is also probed when envKey
+// itself is unset: if set, its contents are read and trimmed. A read failure here (e.g. the file
+// doesn't exist) is treated the same as the env var being unset rather than raised as an error,
+// matching resolveCredentialChain's tolerant treatment of secondary credential sources elsewhere in
+// this file; callers that need read errors surfaced (e.g. permission denied) should use
+// getConfigOrEnvOrFile instead.
 func getConfigOrEnv(configValue types.String, envKey string, defaultValue string) string {
 	if !configValue.IsNull() && !configValue.IsUnknown() {
 		v := strings.TrimSpace(configValue.ValueString())
@@ -195,9 +494,45 @@ func getConfigOrEnv(configValue types.String, envKey string, defaultValue string
 	if v := strings.TrimSpace(os.Getenv(envKey)); v != "" {
 		return v
 	}
+	if path := strings.TrimSpace(os.Getenv(envKey + "_FILE")); path != "" {
+		if v, err := readSecretFile(path); err == nil && v != "" {
+			return v
+		}
+	}
 	return defaultValue
 }
 
+// credentialProvidersFromModel builds a CredentialProvider chain from the credentials_source
+// blocks in declaration order, dispatching on each block's type.
+func credentialProvidersFromModel(sources []CredentialsSourceModel) ([]CredentialProvider, error) {
+	out := make([]CredentialProvider, 0, len(sources))
+	for i, src := range sources {
+		switch strings.TrimSpace(src.Type.ValueString()) {
+		case "file":
+			out = append(out, fileCredentialProvider{Path: src.Path.ValueString()})
+		case "keyring":
+			out = append(out, keyringCredentialProvider{Service: src.Service.ValueString(), Key: src.Key.ValueString()})
+		case "vault":
+			out = append(out, vaultCredentialProvider{Mount: src.VaultMount.ValueString(), Path: src.VaultPath.ValueString()})
+		case "exec":
+			var args []string
+			if !src.Args.IsNull() && !src.Args.IsUnknown() {
+				for _, v := range src.Args.Elements() {
+					if s, ok := v.(types.String); ok {
+						args = append(args, s.ValueString())
+					}
+				}
+			}
+			out = append(out, execCredentialProvider{Command: src.Command.ValueString(), Args: args})
+		default:
+			return nil, fmt.Errorf(`credentials_source[%d]: unknown type %q, expected "file", "keyring", "vault", or "exec"`, i, src.Type.ValueString())
+		}
+	}
+	return out, nil
+}
+
+// getConfigOrEnvMulti is getConfigOrEnv for attributes with more than one recognized environment
+// variable (checked in the order given); each key's "_FILE" counterpart is probed the same way.
 func getConfigOrEnvMulti(configValue types.String, envKeys []string, defaultValue string) string {
 	if !configValue.IsNull() && !configValue.IsUnknown() {
 		v := strings.TrimSpace(configValue.ValueString())
@@ -209,6 +544,35 @@ func getConfigOrEnvMulti(configValue types.String, envKeys []string, defaultValu
 		if v := strings.TrimSpace(os.Getenv(key)); v != "" {
 			return v
 		}
+		if path := strings.TrimSpace(os.Getenv(key + "_FILE")); path != "" {
+			if v, err := readSecretFile(path); err == nil && v != "" {
+				return v
+			}
+		}
 	}
 	return defaultValue
 }
+
+// getConfigOrEnvOrFile resolves an attribute that has its own dedicated "_file" provider attribute
+// (e.g. api_token_file alongside api_token), in precedence order: the explicit cfg attribute wins
+// over everything; fileAttr (read from disk) wins over every env var; then envKeys and their
+// "_FILE" counterparts are checked in order, exactly as getConfigOrEnvMulti does; and finally
+// defaultValue. Unlike getConfigOrEnv/getConfigOrEnvMulti, a file read failure is returned to the
+// caller instead of silently falling through, so Configure can surface e.g. a permission error as a
+// diagnostic rather than a provider silently authenticating with no credential at all.
+func getConfigOrEnvOrFile(cfg types.String, envKeys []string, fileAttr types.String, defaultValue string) (string, error) {
+	if !cfg.IsNull() && !cfg.IsUnknown() {
+		if v := strings.TrimSpace(cfg.ValueString()); v != "" {
+			return v, nil
+		}
+	}
+	if !fileAttr.IsNull() && !fileAttr.IsUnknown() {
+		if path := strings.TrimSpace(fileAttr.ValueString()); path != "" {
+			return readSecretFile(path)
+		}
+	}
+	if v := getConfigOrEnvMulti(types.StringNull(), envKeys, ""); v != "" {
+		return v, nil
+	}
+	return defaultValue, nil
+}
@@ -5,6 +5,8 @@ package provider
 
 import (
 	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -147,3 +149,160 @@ func TestGetConfigOrEnvMulti(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConfigOrEnvFallsBackToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	os.Unsetenv("TEST_ENV_KEY_FILE_ONLY")
+	os.Unsetenv("TEST_ENV_KEY_FILE_ONLY_FILE")
+	t.Setenv("TEST_ENV_KEY_FILE_ONLY_FILE", path)
+
+	got := getConfigOrEnv(types.StringNull(), "TEST_ENV_KEY_FILE_ONLY", "default")
+	if got != "from-file" {
+		t.Errorf("getConfigOrEnv() = %q, want %q (trailing whitespace trimmed)", got, "from-file")
+	}
+}
+
+func TestGetConfigOrEnvPrefersPlainEnvOverFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("from-file"), 0o600); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	t.Setenv("TEST_ENV_KEY_BOTH", "from-env")
+	t.Setenv("TEST_ENV_KEY_BOTH_FILE", path)
+
+	got := getConfigOrEnv(types.StringNull(), "TEST_ENV_KEY_BOTH", "default")
+	if got != "from-env" {
+		t.Errorf("getConfigOrEnv() = %q, want %q (plain env var should win over _FILE)", got, "from-env")
+	}
+}
+
+func TestGetConfigOrEnvMultiFallsBackToFilePerKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("from-file"), 0o600); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	os.Unsetenv("TEST_MULTI_KEY1")
+	t.Setenv("TEST_MULTI_KEY1_FILE", path)
+	os.Unsetenv("TEST_MULTI_KEY2")
+	os.Unsetenv("TEST_MULTI_KEY2_FILE")
+
+	got := getConfigOrEnvMulti(types.StringNull(), []string{"TEST_MULTI_KEY1", "TEST_MULTI_KEY2"}, "default")
+	if got != "from-file" {
+		t.Errorf("getConfigOrEnvMulti() = %q, want %q", got, "from-file")
+	}
+}
+
+func TestGetConfigOrEnvOrFilePrecedence(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(filePath, []byte("from-file"), 0o600); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	os.Unsetenv("TEST_OEF_KEY")
+	os.Unsetenv("TEST_OEF_KEY_FILE")
+
+	t.Run("explicit cfg wins over everything", func(t *testing.T) {
+		t.Setenv("TEST_OEF_KEY", "from-env")
+		got, err := getConfigOrEnvOrFile(types.StringValue("from-cfg"), []string{"TEST_OEF_KEY"}, types.StringValue(filePath), "default")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "from-cfg" {
+			t.Errorf("got %q, want %q", got, "from-cfg")
+		}
+	})
+
+	t.Run("file attribute wins over env vars", func(t *testing.T) {
+		t.Setenv("TEST_OEF_KEY", "from-env")
+		got, err := getConfigOrEnvOrFile(types.StringNull(), []string{"TEST_OEF_KEY"}, types.StringValue(filePath), "default")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "from-file" {
+			t.Errorf("got %q, want %q", got, "from-file")
+		}
+	})
+
+	t.Run("env var used when no cfg or file attribute", func(t *testing.T) {
+		t.Setenv("TEST_OEF_KEY", "from-env")
+		got, err := getConfigOrEnvOrFile(types.StringNull(), []string{"TEST_OEF_KEY"}, types.StringNull(), "default")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "from-env" {
+			t.Errorf("got %q, want %q", got, "from-env")
+		}
+	})
+
+	t.Run("X_FILE env var used when X unset", func(t *testing.T) {
+		os.Unsetenv("TEST_OEF_KEY")
+		t.Setenv("TEST_OEF_KEY_FILE", filePath)
+		got, err := getConfigOrEnvOrFile(types.StringNull(), []string{"TEST_OEF_KEY"}, types.StringNull(), "default")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "from-file" {
+			t.Errorf("got %q, want %q", got, "from-file")
+		}
+	})
+
+	t.Run("default when nothing set", func(t *testing.T) {
+		os.Unsetenv("TEST_OEF_KEY")
+		os.Unsetenv("TEST_OEF_KEY_FILE")
+		got, err := getConfigOrEnvOrFile(types.StringNull(), []string{"TEST_OEF_KEY"}, types.StringNull(), "default")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != "default" {
+			t.Errorf("got %q, want %q", got, "default")
+		}
+	})
+}
+
+func TestGetConfigOrEnvOrFileMissingFileReturnsError(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "does-not-exist")
+	_, err := getConfigOrEnvOrFile(types.StringNull(), nil, types.StringValue(missing), "default")
+	if err == nil {
+		t.Fatal("expected an error for a nonexistent file attribute path")
+	}
+}
+
+func TestGetConfigOrEnvOrFileEmptyFileReturnsEmptyString(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(path, []byte(""), 0o600); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	got, err := getConfigOrEnvOrFile(types.StringNull(), nil, types.StringValue(path), "default")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "" {
+		t.Errorf("got %q, want empty string (file attribute short-circuits env/default once set)", got)
+	}
+}
+
+func TestGetConfigOrEnvOrFilePermissionErrorSurfaced(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permission bits aren't enforced the same way on windows")
+	}
+	if os.Geteuid() == 0 {
+		t.Skip("running as root, which bypasses file permission bits")
+	}
+
+	path := filepath.Join(t.TempDir(), "unreadable")
+	if err := os.WriteFile(path, []byte("secret"), 0o000); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	_, err := getConfigOrEnvOrFile(types.StringNull(), nil, types.StringValue(path), "default")
+	if err == nil {
+		t.Fatal("expected a permission error for an unreadable file attribute")
+	}
+}
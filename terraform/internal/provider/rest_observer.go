@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"atlassian/atlassian/rest"
+	"atlassian/graphql"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ rest.Observer = tflogObserver{}
+
+// tflogObserver implements rest.Observer by forwarding every retry, circuit-breaker trip, and
+// throttled request to tflog, so they show up in Terraform's own log output (TF_LOG) instead of
+// being silently absorbed by the HTTP transport.
+type tflogObserver struct {
+	ctx context.Context
+}
+
+func (o tflogObserver) OnRetry(host string, attempt int, err error) {
+	tflog.Debug(o.ctx, "atlassian: retrying Jira REST request", map[string]any{
+		"host":    host,
+		"attempt": attempt,
+		"error":   errString(err),
+	})
+}
+
+func (o tflogObserver) OnCircuitOpen(host string) {
+	tflog.Warn(o.ctx, "atlassian: circuit breaker open for Jira REST host", map[string]any{
+		"host": host,
+	})
+}
+
+func (o tflogObserver) OnThrottled(host string) {
+	tflog.Debug(o.ctx, "atlassian: Jira REST request throttled by QPS limiter", map[string]any{
+		"host": host,
+	})
+}
+
+// OnRequest emits one structured "request complete" debug line per logical request (after
+// retries and any 401 reauthentication have settled), with req's headers sanitized via
+// graphql.SanitizeHeaders so Authorization/Cookie never reach TF_LOG output.
+func (o tflogObserver) OnRequest(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+	fields := map[string]any{
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"headers":    graphql.SanitizeHeaders(req.Header),
+		"elapsed_ms": elapsed.Milliseconds(),
+		"error":      errString(err),
+	}
+	if resp != nil {
+		fields["status"] = resp.StatusCode
+	}
+	tflog.Debug(o.ctx, "atlassian: Jira REST request complete", fields)
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SprintIssuesResource{}
+var _ resource.ResourceWithImportState = &SprintIssuesResource{}
+
+func NewSprintIssuesResource() resource.Resource {
+	return &SprintIssuesResource{}
+}
+
+// SprintIssuesResource manages the full set of issues assigned to a sprint, in contrast to
+// SprintResource's "move_issues" attribute, which only ever adds issues on create/update and
+// never removes any. Don't point both resources at the same sprint, since they'd fight over
+// membership: jira_sprint_issues diffs its issue_keys list against prior state and moves issues
+// out to the backlog when they're dropped, while jira_sprint's move_issues is a one-way add.
+type SprintIssuesResource struct {
+	providerData *JiraProviderData
+}
+
+// SprintIssuesResourceModel describes the resource data model.
+type SprintIssuesResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	SprintID  types.String `tfsdk:"sprint_id"`
+	IssueKeys types.List   `tfsdk:"issue_keys"`
+}
+
+func (r *SprintIssuesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_sprint_issues"
+}
+
+func (r *SprintIssuesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the set of issue keys assigned to a Jira Agile sprint. Issues added to issue_keys are " +
+			"moved into the sprint via POST /sprint/{id}/issue; issues removed from issue_keys are moved back to the " +
+			"board's backlog via POST /backlog/issue. Destroying this resource moves every managed issue back to the " +
+			"backlog; it does not delete the sprint itself.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Matches sprint_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sprint_id": schema.StringAttribute{
+				Description: "The ID of the sprint to manage issue membership for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_keys": schema.ListAttribute{
+				Description: "The complete set of issue keys that should be in the sprint. Keys present in state but " +
+					"absent here are moved back to the backlog.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *SprintIssuesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *SprintIssuesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SprintIssuesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sprintID := data.SprintID.ValueString()
+
+	issueKeys, diags := stringListToSlice(ctx, data.IssueKeys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.providerData.Client.MoveIssuesToSprint(ctx, sprintID, issueKeys); err != nil {
+		resp.Diagnostics.AddError("Error moving issues into Jira sprint", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(sprintID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is a no-op beyond echoing back prior state: the Jira Agile REST API has no endpoint to
+// list which issues are currently in a sprint, so membership drift outside of Terraform can't be
+// detected here. This mirrors SprintResource's move_issues attribute, which has the same
+// limitation.
+func (r *SprintIssuesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SprintIssuesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SprintIssuesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SprintIssuesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sprintID := plan.SprintID.ValueString()
+
+	planKeys, diags := stringListToSlice(ctx, plan.IssueKeys)
+	resp.Diagnostics.Append(diags...)
+	stateKeys, diags := stringListToSlice(ctx, state.IssueKeys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateSet := make(map[string]struct{}, len(stateKeys))
+	for _, key := range stateKeys {
+		stateSet[key] = struct{}{}
+	}
+	planSet := make(map[string]struct{}, len(planKeys))
+	for _, key := range planKeys {
+		planSet[key] = struct{}{}
+	}
+
+	var added, removed []string
+	for _, key := range planKeys {
+		if _, ok := stateSet[key]; !ok {
+			added = append(added, key)
+		}
+	}
+	for _, key := range stateKeys {
+		if _, ok := planSet[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	if len(added) > 0 {
+		if err := r.providerData.Client.MoveIssuesToSprint(ctx, sprintID, added); err != nil {
+			resp.Diagnostics.AddError("Error moving issues into Jira sprint", err.Error())
+			return
+		}
+	}
+	if len(removed) > 0 {
+		if err := r.providerData.Client.MoveIssuesToBacklog(ctx, removed); err != nil {
+			resp.Diagnostics.AddError("Error moving issues back to the Jira backlog", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(sprintID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SprintIssuesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SprintIssuesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	issueKeys, diags := stringListToSlice(ctx, data.IssueKeys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.providerData.Client.MoveIssuesToBacklog(ctx, issueKeys); err != nil {
+		resp.Diagnostics.AddError("Error moving issues back to the Jira backlog", err.Error())
+		return
+	}
+}
+
+func (r *SprintIssuesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sprint_id"), req.ID)...)
+}
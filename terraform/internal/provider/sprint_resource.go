@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SprintResource{}
+var _ resource.ResourceWithImportState = &SprintResource{}
+
+// sprintStateOrder is the sequence Jira allows a sprint to move through. A sprint can only be
+// advanced, never reverted, which is why TransitionSprintState walks it one hop at a time.
+var sprintStateOrder = []string{"future", "active", "closed"}
+
+func sprintStateIndex(state string) int {
+	for i, s := range sprintStateOrder {
+		if s == state {
+			return i
+		}
+	}
+	return -1
+}
+
+func NewSprintResource() resource.Resource {
+	return &SprintResource{}
+}
+
+// SprintResource defines the resource implementation.
+type SprintResource struct {
+	providerData *JiraProviderData
+}
+
+// SprintResourceModel describes the resource data model.
+type SprintResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	BoardID    types.Int64  `tfsdk:"board_id"`
+	Name       types.String `tfsdk:"name"`
+	Goal       types.String `tfsdk:"goal"`
+	StartAt    types.String `tfsdk:"start_at"`
+	EndAt      types.String `tfsdk:"end_at"`
+	CompleteAt types.String `tfsdk:"complete_at"`
+	State      types.String `tfsdk:"state"`
+	MoveIssues types.List   `tfsdk:"move_issues"`
+}
+
+func (r *SprintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_sprint"
+}
+
+func (r *SprintResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jira Agile sprint, including its future -> active -> closed lifecycle.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The sprint ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"board_id": schema.Int64Attribute{
+				Description: "The ID of the Jira Agile board the sprint is created on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The sprint name.",
+				Required:    true,
+			},
+			"goal": schema.StringAttribute{
+				Description: "The sprint goal.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"start_at": schema.StringAttribute{
+				Description: "When the sprint starts (RFC3339 format). Only takes effect once the sprint is started.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"end_at": schema.StringAttribute{
+				Description: "When the sprint is scheduled to end (RFC3339 format).",
+				Optional:    true,
+				Computed:    true,
+			},
+			"complete_at": schema.StringAttribute{
+				Description: "When the sprint was completed (RFC3339 format), as last observed from Jira.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The sprint's lifecycle state: 'future', 'active', or 'closed'. Jira always creates a " +
+					"sprint in 'future'; setting this to 'active' or 'closed' advances it through the intervening " +
+					"states on create/update. A sprint's state can never be moved backward.",
+				Optional: true,
+				Computed: true,
+			},
+			"move_issues": schema.ListAttribute{
+				Description: "Issue keys to move into this sprint. Applied on create, and again on update whenever " +
+					"the list changes, via POST /sprint/{id}/issue.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *SprintResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *SprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SprintResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	boardID := int(data.BoardID.ValueInt64())
+	if boardID <= 0 {
+		resp.Diagnostics.AddError("Invalid Board ID", "board_id must be a positive integer.")
+		return
+	}
+
+	in := rest.CreateSprintInput{
+		Name:          data.Name.ValueString(),
+		OriginBoardID: boardID,
+	}
+	if !data.Goal.IsNull() {
+		in.Goal = data.Goal.ValueString()
+	}
+	if !data.StartAt.IsNull() {
+		in.StartDate = data.StartAt.ValueString()
+	}
+	if !data.EndAt.IsNull() {
+		in.EndDate = data.EndAt.ValueString()
+	}
+
+	created, err := r.providerData.Client.CreateSprint(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Jira sprint", err.Error())
+		return
+	}
+
+	if !data.State.IsNull() && data.State.ValueString() != "" {
+		sprint, err := r.transitionSprintState(ctx, created, data.State.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error transitioning Jira sprint state", err.Error())
+			return
+		}
+		created = sprint
+	}
+
+	issueKeys, diags := stringListToSlice(ctx, data.MoveIssues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(issueKeys) > 0 {
+		if err := r.providerData.Client.MoveIssuesToSprint(ctx, created.ID, issueKeys); err != nil {
+			resp.Diagnostics.AddError("Error moving issues into Jira sprint", err.Error())
+			return
+		}
+	}
+
+	applySprintToModel(&data, created)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// applySprintToModel copies a decoded atlassian.JiraSprint onto a SprintResourceModel, used by
+// Create/Read/Update so the three don't drift on which fields get mapped back into state.
+func applySprintToModel(data *SprintResourceModel, sprint atlassian.JiraSprint) {
+	data.ID = types.StringValue(sprint.ID)
+	data.Name = types.StringValue(sprint.Name)
+	data.State = types.StringValue(sprint.State)
+	if sprint.StartAt != nil {
+		data.StartAt = types.StringValue(*sprint.StartAt)
+	} else {
+		data.StartAt = types.StringNull()
+	}
+	if sprint.EndAt != nil {
+		data.EndAt = types.StringValue(*sprint.EndAt)
+	} else {
+		data.EndAt = types.StringNull()
+	}
+	if sprint.CompleteAt != nil {
+		data.CompleteAt = types.StringValue(*sprint.CompleteAt)
+	} else {
+		data.CompleteAt = types.StringNull()
+	}
+	if sprint.Goal != nil {
+		data.Goal = types.StringValue(*sprint.Goal)
+	} else {
+		data.Goal = types.StringNull()
+	}
+	if sprint.BoardID != nil {
+		if boardID, err := strconv.ParseInt(*sprint.BoardID, 10, 64); err == nil {
+			data.BoardID = types.Int64Value(boardID)
+		}
+	}
+}
+
+// readSprint fetches a sprint using graph.Client.GetSprintByID for the richer GraphQL-mapped
+// fields (goal, board linkage, complete_at), falling back to the Agile REST API when
+// ExperimentalAPIs is disabled for the configured provider.
+func (r *SprintResource) readSprint(ctx context.Context, sprintID string) (atlassian.JiraSprint, error) {
+	if r.providerData.GraphClient != nil && len(r.providerData.GraphClient.ExperimentalAPIs) > 0 {
+		sprint, err := r.providerData.GraphClient.GetSprintByID(ctx, sprintID)
+		if err != nil {
+			return atlassian.JiraSprint{}, err
+		}
+		return *sprint, nil
+	}
+	return r.providerData.Client.GetSprintViaREST(ctx, sprintID)
+}
+
+// transitionSprintState idempotently drives a sprint from its current state toward target,
+// stepping through the future -> active -> closed sequence one hop at a time since Jira requires
+// a sprint to be started before it can be closed. It is a no-op when the sprint is already at
+// target, and an error if target would move the sprint backward.
+func (r *SprintResource) transitionSprintState(ctx context.Context, current atlassian.JiraSprint, target string) (atlassian.JiraSprint, error) {
+	currentIdx := sprintStateIndex(current.State)
+	targetIdx := sprintStateIndex(target)
+	if targetIdx < 0 {
+		return atlassian.JiraSprint{}, fmt.Errorf("state must be one of %v, got %q", sprintStateOrder, target)
+	}
+	if targetIdx < currentIdx {
+		return atlassian.JiraSprint{}, fmt.Errorf("cannot transition sprint %s from %q back to %q", current.ID, current.State, target)
+	}
+
+	sprint := current
+	for idx := currentIdx; idx < targetIdx; idx++ {
+		nextState := sprintStateOrder[idx+1]
+		updated, err := r.providerData.Client.UpdateSprint(ctx, sprint.ID, rest.UpdateSprintInput{State: &nextState})
+		if err != nil {
+			return atlassian.JiraSprint{}, err
+		}
+		sprint = updated
+	}
+	return sprint, nil
+}
+
+func (r *SprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SprintResourceModel
+
+	// Read Terraform current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.readSprint(ctx, data.ID.ValueString())
+	if err != nil {
+		// If the sprint is gone, drop it from state so Terraform recreates it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	applySprintToModel(&data, current)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SprintResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sprintID := data.ID.ValueString()
+
+	in := rest.UpdateSprintInput{}
+	name := data.Name.ValueString()
+	in.Name = &name
+	if !data.Goal.IsNull() {
+		goal := data.Goal.ValueString()
+		in.Goal = &goal
+	}
+	if !data.StartAt.IsNull() {
+		startAt := data.StartAt.ValueString()
+		in.StartDate = &startAt
+	}
+	if !data.EndAt.IsNull() {
+		endAt := data.EndAt.ValueString()
+		in.EndDate = &endAt
+	}
+
+	updated, err := r.providerData.Client.UpdateSprint(ctx, sprintID, in)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Jira sprint", err.Error())
+		return
+	}
+
+	if !data.State.IsNull() && data.State.ValueString() != "" {
+		sprint, err := r.transitionSprintState(ctx, updated, data.State.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error transitioning Jira sprint state", err.Error())
+			return
+		}
+		updated = sprint
+	}
+
+	issueKeys, diags := stringListToSlice(ctx, data.MoveIssues)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(issueKeys) > 0 {
+		if err := r.providerData.Client.MoveIssuesToSprint(ctx, sprintID, issueKeys); err != nil {
+			resp.Diagnostics.AddError("Error moving issues into Jira sprint", err.Error())
+			return
+		}
+	}
+
+	applySprintToModel(&data, updated)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SprintResourceModel
+
+	// Read Terraform current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.providerData.Client.DeleteSprint(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting Jira sprint", err.Error())
+		return
+	}
+}
+
+// ImportState accepts either a bare sprint ID, or "boardId/sprintName" for when the sprint ID
+// isn't known up front. In the latter form, the board's sprints are listed across every state
+// ("future", "active", "closed" - ListBoardSprintsViaREST's state parameter only narrows the
+// search) and matched by exact name.
+func (r *SprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	boardID, sprintName, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	id, err := strconv.Atoi(strings.TrimSpace(boardID))
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("expected \"boardId/sprintName\" with a numeric boardId, got %q", req.ID))
+		return
+	}
+
+	sprints, err := r.providerData.Client.ListBoardSprintsViaREST(ctx, id, "", 50)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Board Sprints", err.Error())
+		return
+	}
+
+	var matched *atlassian.JiraSprint
+	for i, sprint := range sprints {
+		if sprint.Name == sprintName {
+			matched = &sprints[i]
+			break
+		}
+	}
+	if matched == nil {
+		resp.Diagnostics.AddError("Sprint Not Found", fmt.Sprintf("no sprint named %q was found on board %d", sprintName, id))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matched.ID)...)
+}
@@ -32,11 +32,18 @@ type VersionResource struct {
 
 // VersionResourceModel describes the resource data model.
 type VersionResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	ProjectKey  types.String `tfsdk:"project_key"`
-	Released    types.Bool   `tfsdk:"released"`
-	ReleaseDate types.String `tfsdk:"release_date"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ProjectKey      types.String `tfsdk:"project_key"`
+	Description     types.String `tfsdk:"description"`
+	Released        types.Bool   `tfsdk:"released"`
+	Archived        types.Bool   `tfsdk:"archived"`
+	ReleaseDate     types.String `tfsdk:"release_date"`
+	StartDate       types.String `tfsdk:"start_date"`
+	UserReleaseDate types.String `tfsdk:"user_release_date"`
+	Overdue         types.Bool   `tfsdk:"overdue"`
+	Move            types.String `tfsdk:"move"`
+	MergeInto       types.String `tfsdk:"merge_into"`
 }
 
 func (r *VersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,15 +72,47 @@ func (r *VersionResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"description": schema.StringAttribute{
+				Description: "The description of the version.",
+				Optional:    true,
+			},
 			"released": schema.BoolAttribute{
 				Description: "Whether the version is released.",
 				Optional:    true,
 				Computed:    true,
 			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the version is archived.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"release_date": schema.StringAttribute{
 				Description: "The release date of the version (YYYY-MM-DD).",
 				Optional:    true,
 			},
+			"start_date": schema.StringAttribute{
+				Description: "The start date of the version (YYYY-MM-DD).",
+				Optional:    true,
+			},
+			"user_release_date": schema.StringAttribute{
+				Description: "The release date of the version formatted for display, as set by the user (e.g. 'Jan 2026').",
+				Optional:    true,
+				Computed:    true,
+			},
+			"overdue": schema.BoolAttribute{
+				Description: "Whether the version's release date has passed while it remains unreleased.",
+				Computed:    true,
+			},
+			"move": schema.StringAttribute{
+				Description: "The ID of the version this one should be placed immediately after within the project's version order. " +
+					"Applied on every create/update.",
+				Optional: true,
+			},
+			"merge_into": schema.StringAttribute{
+				Description: "The ID of another version to merge this version's issues into before it is destroyed. " +
+					"Only takes effect on `terraform destroy`; has no effect on create or update.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -105,15 +144,7 @@ func (r *VersionResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Create version via Jira REST API
-	v := atlassian.JiraVersion{
-		Name:       data.Name.ValueString(),
-		ProjectKey: data.ProjectKey.ValueString(),
-		Released:   data.Released.ValueBool(),
-	}
-	if !data.ReleaseDate.IsNull() {
-		rd := data.ReleaseDate.ValueString()
-		v.ReleaseDate = &rd
-	}
+	v := versionModelToDomain(data)
 
 	created, err := r.providerData.Client.CreateVersion(ctx, v.ProjectKey, v)
 	if err != nil {
@@ -122,10 +153,24 @@ func (r *VersionResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Map response back to model
-	data.ID = types.StringValue(created.ID)
-	data.Released = types.BoolValue(created.Released)
-	if created.ReleaseDate != nil {
-		data.ReleaseDate = types.StringValue(*created.ReleaseDate)
+	versionDomainToModel(created, &data)
+
+	if v.Archived {
+		archived, err := r.providerData.Client.ArchiveVersion(ctx, created.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error archiving Jira version", err.Error())
+			return
+		}
+		versionDomainToModel(archived, &data)
+	}
+
+	if !data.Move.IsNull() {
+		moved, err := r.providerData.Client.MoveVersionAfter(ctx, created.ID, data.Move.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error moving Jira version", err.Error())
+			return
+		}
+		versionDomainToModel(moved, &data)
 	}
 
 	// Save data into Terraform state
@@ -141,49 +186,31 @@ func (r *VersionResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	// Fetch versions and find the one with the matching ID
-	// Note: Jira API doesn't have a direct "Get Version by ID" in all versions?
-	// Actually it does: /rest/api/3/version/{id}
-	// Let's assume we can fetch it or just list. For efficiency we should add GetVersion to client.
-	
-	// For now, I'll just skip the detailed implementation of Read and assume we'll add GetVersion later if needed,
-	// or use ListVersions if ID matches.
-	// Actually I'll implemented GetJSON in client already.
-	
-	path := fmt.Sprintf("/rest/api/3/version/%s", data.ID.ValueString())
-	payload, err := r.providerData.Client.GetJSON(ctx, path, nil)
+	current, err := r.providerData.Client.GetVersion(ctx, data.ID.ValueString())
 	if err != nil {
-		// If 404, resource no longer exists
+		// If the version is gone, drop it from state so Terraform recreates it.
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	// We would normally decode payload here. I'll use a simplified check for now or assume it exists.
-	// Ideally we'd update `data` with latest values.
-	_ = payload 
-	
+	versionDomainToModel(current, &data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *VersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data VersionResourceModel
+	var state VersionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	v := atlassian.JiraVersion{
-		ID:         data.ID.ValueString(),
-		Name:       data.Name.ValueString(),
-		ProjectKey: data.ProjectKey.ValueString(),
-		Released:   data.Released.ValueBool(),
-	}
-	if !data.ReleaseDate.IsNull() {
-		rd := data.ReleaseDate.ValueString()
-		v.ReleaseDate = &rd
-	}
+	v := versionModelToDomain(data)
+	v.ID = data.ID.ValueString()
 
 	updated, err := r.providerData.Client.UpdateVersion(ctx, v.ProjectKey, v)
 	if err != nil {
@@ -191,9 +218,32 @@ func (r *VersionResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	data.Released = types.BoolValue(updated.Released)
-	if updated.ReleaseDate != nil {
-		data.ReleaseDate = types.StringValue(*updated.ReleaseDate)
+	versionDomainToModel(updated, &data)
+
+	// Archived status isn't honored by the generic update above (see ArchiveVersion), so it's
+	// transitioned separately whenever the plan changes it.
+	if !state.Archived.IsNull() && state.Archived.ValueBool() != data.Archived.ValueBool() {
+		var archiveErr error
+		var archived atlassian.JiraVersion
+		if data.Archived.ValueBool() {
+			archived, archiveErr = r.providerData.Client.ArchiveVersion(ctx, v.ID)
+		} else {
+			archived, archiveErr = r.providerData.Client.UnarchiveVersion(ctx, v.ID)
+		}
+		if archiveErr != nil {
+			resp.Diagnostics.AddError("Error changing archived status of Jira version", archiveErr.Error())
+			return
+		}
+		versionDomainToModel(archived, &data)
+	}
+
+	if !data.Move.IsNull() {
+		moved, err := r.providerData.Client.MoveVersionAfter(ctx, v.ID, data.Move.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error moving Jira version", err.Error())
+			return
+		}
+		versionDomainToModel(moved, &data)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -208,8 +258,29 @@ func (r *VersionResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.providerData.Client.DeleteVersion(ctx, data.ID.ValueString())
-	if err != nil {
+	if !data.MergeInto.IsNull() {
+		if err := r.providerData.Client.MergeVersionInto(ctx, data.ID.ValueString(), data.MergeInto.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error merging Jira version before destroy", err.Error())
+			return
+		}
+		// MergeVersionInto already deletes the source version as part of the merge.
+		return
+	}
+
+	counts, err := r.providerData.Client.GetVersionRelatedIssueCounts(ctx, data.ID.ValueString())
+	if err == nil && (counts.Fixed > 0 || counts.Affected > 0) {
+		resp.Diagnostics.AddError(
+			"Jira version still referenced by issues",
+			fmt.Sprintf(
+				"Version %s is referenced by %d issue(s) (as fix version or affects version) and cannot be deleted directly. "+
+					"Set merge_into to move those issues onto another version before destroy.",
+				data.ID.ValueString(), counts.Fixed+counts.Affected,
+			),
+		)
+		return
+	}
+
+	if err := r.providerData.Client.DeleteVersion(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting Jira version", err.Error())
 		return
 	}
@@ -218,3 +289,56 @@ func (r *VersionResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *VersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// versionModelToDomain builds a JiraVersion from the Terraform model for Create/Update requests.
+func versionModelToDomain(data VersionResourceModel) atlassian.JiraVersion {
+	v := atlassian.JiraVersion{
+		Name:       data.Name.ValueString(),
+		ProjectKey: data.ProjectKey.ValueString(),
+		Released:   data.Released.ValueBool(),
+		Archived:   data.Archived.ValueBool(),
+	}
+	if !data.Description.IsNull() {
+		d := data.Description.ValueString()
+		v.Description = &d
+	}
+	if !data.ReleaseDate.IsNull() {
+		rd := data.ReleaseDate.ValueString()
+		v.ReleaseDate = &rd
+	}
+	if !data.StartDate.IsNull() {
+		sd := data.StartDate.ValueString()
+		v.StartDate = &sd
+	}
+	return v
+}
+
+// versionDomainToModel copies a JiraVersion returned by the API back onto the Terraform model.
+func versionDomainToModel(v atlassian.JiraVersion, data *VersionResourceModel) {
+	data.ID = types.StringValue(v.ID)
+	data.Name = types.StringValue(v.Name)
+	data.ProjectKey = types.StringValue(v.ProjectKey)
+	data.Released = types.BoolValue(v.Released)
+	data.Archived = types.BoolValue(v.Archived)
+	data.Overdue = types.BoolValue(v.Overdue)
+	if v.Description != nil {
+		data.Description = types.StringValue(*v.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+	if v.ReleaseDate != nil {
+		data.ReleaseDate = types.StringValue(*v.ReleaseDate)
+	} else {
+		data.ReleaseDate = types.StringNull()
+	}
+	if v.StartDate != nil {
+		data.StartDate = types.StringValue(*v.StartDate)
+	} else {
+		data.StartDate = types.StringNull()
+	}
+	if v.UserReleaseDate != nil {
+		data.UserReleaseDate = types.StringValue(*v.UserReleaseDate)
+	} else {
+		data.UserReleaseDate = types.StringNull()
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atlassian/atlassian"
+	"atlassian/atlassian/rest"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorklogResource{}
+var _ resource.ResourceWithImportState = &WorklogResource{}
+
+func NewWorklogResource() resource.Resource {
+	return &WorklogResource{}
+}
+
+// WorklogResource defines the resource implementation.
+type WorklogResource struct {
+	providerData *JiraProviderData
+}
+
+// WorklogResourceModel describes the resource data model.
+type WorklogResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	IssueKey         types.String `tfsdk:"issue_key"`
+	StartedAt        types.String `tfsdk:"started_at"`
+	TimeSpentSeconds types.Int64  `tfsdk:"time_spent_seconds"`
+	Comment          types.String `tfsdk:"comment"`
+}
+
+func (r *WorklogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_worklog"
+}
+
+func (r *WorklogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a worklog entry on a Jira issue.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The worklog ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The key of the issue the worklog is logged against.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				Description: "When the work was started (RFC3339 format).",
+				Required:    true,
+			},
+			"time_spent_seconds": schema.Int64Attribute{
+				Description: "The time spent in seconds. Must be a positive integer.",
+				Required:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "An optional comment describing the work done, in plain text or markdown.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *WorklogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*JiraProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *JiraProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *WorklogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorklogResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeSpent := data.TimeSpentSeconds.ValueInt64()
+	if timeSpent <= 0 {
+		resp.Diagnostics.AddError("Invalid Time Spent", "time_spent_seconds must be a positive integer.")
+		return
+	}
+
+	in := rest.CreateWorklogInput{
+		StartedAt:        data.StartedAt.ValueString(),
+		TimeSpentSeconds: int(timeSpent),
+	}
+	if !data.Comment.IsNull() {
+		in.Comment = data.Comment.ValueString()
+	}
+
+	created, err := r.providerData.Client.CreateWorklog(ctx, data.IssueKey.ValueString(), in)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Jira worklog", err.Error())
+		return
+	}
+
+	applyWorklogToModel(&data, created)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// applyWorklogToModel copies a decoded atlassian.JiraWorklog onto a WorklogResourceModel, used by
+// Create/Read/Update so the three don't drift on which fields get mapped back into state.
+func applyWorklogToModel(data *WorklogResourceModel, worklog atlassian.JiraWorklog) {
+	data.ID = types.StringValue(worklog.WorklogID)
+	data.IssueKey = types.StringValue(worklog.IssueKey)
+	data.StartedAt = types.StringValue(worklog.StartedAt)
+	data.TimeSpentSeconds = types.Int64Value(int64(worklog.TimeSpentSeconds))
+	if worklog.Comment != nil {
+		data.Comment = types.StringValue(*worklog.Comment)
+	} else {
+		data.Comment = types.StringNull()
+	}
+}
+
+func (r *WorklogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorklogResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.providerData.Client.GetWorklog(ctx, data.IssueKey.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if rest.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Jira worklog", err.Error())
+		return
+	}
+
+	applyWorklogToModel(&data, current)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorklogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorklogResourceModel
+	var state WorklogResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeSpent := data.TimeSpentSeconds.ValueInt64()
+	if timeSpent <= 0 {
+		resp.Diagnostics.AddError("Invalid Time Spent", "time_spent_seconds must be a positive integer.")
+		return
+	}
+
+	startedAt := data.StartedAt.ValueString()
+	timeSpentSeconds := int(timeSpent)
+	in := rest.UpdateWorklogInput{
+		StartedAt:        &startedAt,
+		TimeSpentSeconds: &timeSpentSeconds,
+	}
+	if !data.Comment.IsNull() {
+		in.Comment = data.Comment.ValueString()
+	}
+
+	updated, err := r.providerData.Client.UpdateWorklog(ctx, state.IssueKey.ValueString(), state.ID.ValueString(), in)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Jira worklog", err.Error())
+		return
+	}
+
+	applyWorklogToModel(&data, updated)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorklogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorklogResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.providerData.Client.DeleteWorklog(ctx, data.IssueKey.ValueString(), data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting Jira worklog", err.Error())
+		return
+	}
+}
+
+// ImportState expects "issueKey/worklogId", since a worklog's ID alone isn't enough to fetch it
+// back (GetWorklog is scoped to an issue).
+func (r *WorklogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	issueKey, worklogID, ok := strings.Cut(req.ID, "/")
+	if !ok || strings.TrimSpace(issueKey) == "" || strings.TrimSpace(worklogID) == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("expected \"issueKey/worklogId\", got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_key"), issueKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), worklogID)...)
+}
@@ -4,35 +4,15 @@
 package provider
 
 import (
-	"net/http"
-	"strings"
+	"context"
 	"testing"
+
+	"atlassian/atlassian/rest/recorder"
 )
 
 func TestWorklogsDataSource_Read(t *testing.T) {
-	client := newMockClient(t, func(req *http.Request) *http.Response {
-		if !strings.Contains(req.URL.Path, "/rest/api/3/issue/PROJ-123/worklog") {
-			t.Errorf("unexpected path: %s", req.URL.Path)
-		}
-		return jsonResponse(http.StatusOK, `{
-			"startAt": 0,
-			"maxResults": 100,
-			"total": 1,
-			"worklogs": [
-				{
-					"id": "10001",
-					"author": {
-						"accountId": "author-id",
-						"displayName": "Author Name"
-					},
-					"started": "2023-01-01T00:00:00.000+0000",
-					"timeSpentSeconds": 3600,
-					"created": "2023-01-01T00:00:00.000+0000",
-					"updated": "2023-01-01T00:00:00.000+0000"
-				}
-			]
-		}`)
-	})
+	ctx := context.Background()
+	client := recorder.NewJiraRESTClient(t, "", "worklogs_data_source", "JIRA_RECORD_MODE", recorder.ClientConfig{})
 
 	ds := &WorklogsDataSource{
 		providerData: &JiraProviderData{
@@ -40,8 +20,15 @@ func TestWorklogsDataSource_Read(t *testing.T) {
 			CloudID: "fake-cloud-id",
 		},
 	}
-	
-	if ds == nil {
-		t.Fatal("ds is nil")
+
+	worklogs, err := ds.providerData.Client.ListIssueWorklogsViaREST(ctx, "PROJ-123", 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(worklogs) != 1 {
+		t.Fatalf("expected 1 worklog, got %d", len(worklogs))
+	}
+	if worklogs[0].WorklogID != "10001" || worklogs[0].TimeSpentSeconds != 3600 {
+		t.Fatalf("unexpected worklog: %+v", worklogs[0])
 	}
 }